@@ -0,0 +1,114 @@
+package auth
+
+import (
+	"bufio"
+	"context"
+	"github.com/autom8ter/geodb/config"
+	grpc_auth "github.com/grpc-ecosystem/go-grpc-middleware/auth"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"os"
+	"strings"
+)
+
+// KeyStore validates api keys
+type KeyStore interface {
+	Valid(key string) bool
+}
+
+// StaticKeys is a fixed set of api keys
+type StaticKeys map[string]struct{}
+
+func NewStaticKeys(keys ...string) StaticKeys {
+	s := StaticKeys{}
+	for _, key := range keys {
+		if key = strings.TrimSpace(key); key != "" {
+			s[key] = struct{}{}
+		}
+	}
+	return s
+}
+
+func (s StaticKeys) Valid(key string) bool {
+	_, ok := s[key]
+	return ok
+}
+
+// LoadKeyFile reads api keys from a file with one key per line. blank lines & lines starting with # are ignored
+func LoadKeyFile(path string) (StaticKeys, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	var keys []string
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		if line := strings.TrimSpace(scanner.Text()); line != "" && !strings.HasPrefix(line, "#") {
+			keys = append(keys, line)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return NewStaticKeys(keys...), nil
+}
+
+// KeyStoreFromConfig returns the api keys configured by GEODB_API_KEYS_FILE or GEODB_API_KEYS. it returns nil if
+// api key authentication isn't enabled. api keys replace the basic auth password(GEODB_PASSWORD), so configuring both
+// is rejected
+func KeyStoreFromConfig() (KeyStore, error) {
+	if (config.Config.IsSet("GEODB_API_KEYS_FILE") || config.Config.IsSet("GEODB_API_KEYS")) && config.Config.IsSet("GEODB_PASSWORD") {
+		return nil, status.Error(codes.InvalidArgument, "GEODB_PASSWORD can't be set with GEODB_API_KEYS or GEODB_API_KEYS_FILE")
+	}
+	if config.Config.IsSet("GEODB_API_KEYS_FILE") {
+		return LoadKeyFile(config.Config.GetString("GEODB_API_KEYS_FILE"))
+	}
+	if config.Config.IsSet("GEODB_API_KEYS") {
+		return NewStaticKeys(strings.Split(config.Config.GetString("GEODB_API_KEYS"), ",")...), nil
+	}
+	return nil, nil
+}
+
+// exemptMethods can be called without an api key so load balancers & orchestrators can probe the server
+var exemptMethods = map[string]bool{
+	"/api.GeoDB/Ping":              true,
+	"/api.GeoDB/Health":            true,
+	"/grpc.health.v1.Health/Check": true,
+	"/grpc.health.v1.Health/Watch": true,
+}
+
+func authenticate(ctx context.Context, keys KeyStore, method string) error {
+	if exemptMethods[method] {
+		return nil
+	}
+	key, err := grpc_auth.AuthFromMD(ctx, "bearer")
+	if err != nil {
+		return status.Errorf(codes.Unauthenticated, "failed to find authorization header with bearer scheme\n%v", err)
+	}
+	if !keys.Valid(key) {
+		return status.Error(codes.Unauthenticated, "invalid api key")
+	}
+	return nil
+}
+
+// APIKeyUnaryInterceptor rejects unary calls without a valid api key in the authorization header(authorization: bearer <key>)
+func APIKeyUnaryInterceptor(keys KeyStore) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if err := authenticate(ctx, keys, info.FullMethod); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// APIKeyStreamInterceptor rejects streams without a valid api key in the authorization header(authorization: bearer <key>)
+func APIKeyStreamInterceptor(keys KeyStore) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := authenticate(ss.Context(), keys, info.FullMethod); err != nil {
+			return err
+		}
+		return handler(srv, ss)
+	}
+}
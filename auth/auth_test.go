@@ -0,0 +1,88 @@
+package auth
+
+import (
+	"context"
+	"github.com/autom8ter/geodb/config"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+type mockServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (m *mockServerStream) Context() context.Context {
+	return m.ctx
+}
+
+func withKey(key string) context.Context {
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "bearer "+key))
+}
+
+func TestAPIKeyInterceptors(t *testing.T) {
+	dir, err := ioutil.TempDir("", "geodb_keys")
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "keys")
+	if err := ioutil.WriteFile(path, []byte("# dispatch\nfile_key\n\n"), 0600); err != nil {
+		t.Fatal(err.Error())
+	}
+	fileKeys, err := LoadKeyFile(path)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	for name, keys := range map[string]KeyStore{"static": NewStaticKeys("static_key", " "), "file": fileKeys} {
+		valid := map[string]string{"static": "static_key", "file": "file_key"}[name]
+		unary := APIKeyUnaryInterceptor(keys)
+		streaming := APIKeyStreamInterceptor(keys)
+		for _, tc := range []struct {
+			ctx    context.Context
+			method string
+			code   codes.Code
+		}{
+			{withKey(valid), "/api.GeoDB/Get", codes.OK},
+			{withKey("wrong"), "/api.GeoDB/Get", codes.Unauthenticated},
+			{withKey(""), "/api.GeoDB/Get", codes.Unauthenticated},
+			{context.Background(), "/api.GeoDB/Delete", codes.Unauthenticated},
+			{context.Background(), "/api.GeoDB/Ping", codes.OK},
+			{context.Background(), "/api.GeoDB/Health", codes.OK},
+		} {
+			_, err := unary(tc.ctx, nil, &grpc.UnaryServerInfo{FullMethod: tc.method}, func(ctx context.Context, req interface{}) (interface{}, error) {
+				return nil, nil
+			})
+			if status.Code(err) != tc.code {
+				t.Fatalf("%s: expected %v for unary %s, got: %v", name, tc.code, tc.method, err)
+			}
+			err = streaming(nil, &mockServerStream{ctx: tc.ctx}, &grpc.StreamServerInfo{FullMethod: tc.method}, func(srv interface{}, stream grpc.ServerStream) error {
+				return nil
+			})
+			if status.Code(err) != tc.code {
+				t.Fatalf("%s: expected %v for stream %s, got: %v", name, tc.code, tc.method, err)
+			}
+		}
+	}
+	if _, err := LoadKeyFile(filepath.Join(dir, "missing")); err == nil {
+		t.Fatal("expected an error for a missing key file")
+	}
+}
+
+func TestKeyStoreFromConfigRejectsPassword(t *testing.T) {
+	config.Config.Set("GEODB_API_KEYS", "dispatch_key")
+	config.Config.Set("GEODB_PASSWORD", "secret")
+	defer func() {
+		config.Config.Set("GEODB_API_KEYS", nil)
+		config.Config.Set("GEODB_PASSWORD", nil)
+	}()
+	if _, err := KeyStoreFromConfig(); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected api keys with a password to be rejected, got: %v", err)
+	}
+}
@@ -8,8 +8,33 @@ func init() {
 	Config = viper.New()
 	Config.SetDefault("GEODB_PORT", ":8080")
 	Config.SetDefault("GEODB_PATH", "/tmp/geodb")
+	Config.SetDefault("GEODB_IN_MEMORY", false)
+	Config.SetDefault("GEODB_SYNC_WRITES", true)
 	Config.SetDefault("GEODB_GC_INTERVAL", "5m")
+	Config.SetDefault("GEODB_GC_DISCARD_RATIO", 0.7)
 	Config.SetDefault("GEODB_GMAPS_CACHE_DURATION", "1h")
+	Config.SetDefault("GEODB_MAX_MATRIX_KEYS", 100)
+	Config.SetDefault("GEODB_MAX_RESULTS", 10000)
+	Config.SetDefault("GEODB_MAX_RESULTS_CEILING", 100000)
+	Config.SetDefault("GEODB_READ_SESSION_TTL", "1m")
+	Config.SetDefault("GEODB_READ_SESSION_MAX", 100)
+	Config.SetDefault("GEODB_INACTIVITY_SWEEP_INTERVAL", "1m")
+	Config.SetDefault("GEODB_GRPC_COMPRESSION_LEVEL", -1)
+	Config.SetDefault("GEODB_GRPC_MAX_RECV_MSG_SIZE", 4<<20)
+	Config.SetDefault("GEODB_GRPC_MAX_SEND_MSG_SIZE", 4<<20)
+	Config.SetDefault("GEODB_STREAM_PAUSE_BUFFER", 1000)
+	Config.SetDefault("GEODB_STREAM_BUFFER", 5000)
+	Config.SetDefault("GEODB_STREAM_CLIENT_BUFFER", 100)
+	Config.SetDefault("GEODB_STREAM_SLOW_CLIENT_POLICY", "DROP_NEWEST")
+	Config.SetDefault("GEODB_WARMUP", true)
+	Config.SetDefault("GEODB_SET_RATE_BURST", 10)
+	Config.SetDefault("GEODB_CLIENT_READ_RATE_BURST", 10)
+	Config.SetDefault("GEODB_CLIENT_WRITE_RATE_BURST", 10)
+	Config.SetDefault("GEODB_GEOHASH_PRECISION", 9)
+	Config.SetDefault("GEODB_DISTANCE_MODE", "haversine")
+	Config.SetDefault("GEODB_HISTORY_MAX", 100)
+	Config.SetDefault("GEODB_SCAN_PREFETCH_SIZE", 100)
+	Config.SetDefault("GEODB_LOG_LEVEL", "info")
 	Config.AutomaticEnv()
 }
 
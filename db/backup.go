@@ -0,0 +1,29 @@
+package db
+
+import (
+	"context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"io"
+)
+
+// the max number of pending writes while loading a backup
+const restorePendingWrites = 256
+
+// Backup writes a backup of every change after the since version(0 for a full backup) to w and returns the version to use for the next incremental backup
+func (s *Store) Backup(ctx context.Context, w io.Writer, since uint64) (uint64, error) {
+	version, err := s.db.Backup(w, since)
+	if err != nil {
+		return 0, status.Errorf(codes.Internal, "failed to backup database: %s", err.Error())
+	}
+	return version, nil
+}
+
+// Restore loads a backup created by Backup into the database. the database must be disk backed: badger doesn't restore
+// values that were stored in the value log into an in-memory database
+func (s *Store) Restore(ctx context.Context, r io.Reader) error {
+	if err := s.db.Load(r, restorePendingWrites); err != nil {
+		return status.Errorf(codes.Internal, "failed to restore database: %s", err.Error())
+	}
+	return nil
+}
@@ -0,0 +1,128 @@
+package db
+
+import (
+	"context"
+	api "github.com/autom8ter/geodb/gen/go/geodb"
+	"github.com/dgraph-io/badger/v2"
+	"golang.org/x/time/rate"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// setAtomic writes every object or none of them in a single transaction. batches over badger's transaction size limit
+// are rejected without writing anything. rate limit tokens are only spent if the batch is committed
+func (s *Store) setAtomic(ctx context.Context, objs []*api.Object) ([]*api.ObjectDetail, error) {
+	now := s.now()
+	var reservations []*rate.Reservation
+	for _, obj := range objs {
+		if err := s.validateObject(ctx, obj); err != nil {
+			release(reservations, now)
+			return nil, err
+		}
+		if s.limiter != nil {
+			r, ok := s.limiter.reserve(obj.Key, now)
+			if !ok {
+				release(reservations, now)
+				return nil, status.Errorf(codes.ResourceExhausted, "rate limit exceeded for key: %s", obj.Key)
+			}
+			reservations = append(reservations, r)
+		}
+		s.resolveExpiration(obj)
+	}
+	txn := s.db.NewTransaction(true)
+	defer txn.Discard()
+	var details []*api.ObjectDetail
+	for i, obj := range objs {
+		// computed after the previous objects were written, so repeated keys transition from the batch's earlier writes
+		detail := s.objectDetail(ctx, txn, obj)
+		if err := s.writeAtomic(txn, detail); err != nil {
+			release(reservations, now)
+			if err == badger.ErrTxnTooBig {
+				return nil, status.Errorf(codes.InvalidArgument, "atomic batch exceeds the transaction size limit after %v of %v objects(nothing was written). split it into smaller batches", i, len(objs))
+			}
+			return nil, status.Errorf(codes.Internal, "failed to set objects(nothing was written): %s", err.Error())
+		}
+		details = append(details, detail)
+	}
+	if err := txn.Commit(); err != nil {
+		release(reservations, now)
+		return nil, status.Errorf(codes.Aborted, "failed to set objects(nothing was written): %s", err.Error())
+	}
+	for i, detail := range details {
+		details[i] = s.publish(detail)
+	}
+	return details, nil
+}
+
+// writeAtomic writes the detail of an atomic batch's object along with its history & events
+func (s *Store) writeAtomic(txn *badger.Txn, detail *api.ObjectDetail) error {
+	if err := setStoredFields(txn, detail.Object, 0); err != nil {
+		return err
+	}
+	if err := writeDetail(txn, detail); err != nil {
+		return err
+	}
+	if err := s.writeHistory(txn, detail.Object, s.monotonicNanos()); err != nil {
+		return err
+	}
+	return s.writeEvents(txn, detail)
+}
+
+// commitChunked applies the writes in as few transactions as badger's transaction size limit allows & returns
+// the number of writes that were committed
+func (s *Store) commitChunked(writes []func(txn *badger.Txn) error) (int, error) {
+	committed := 0
+	for committed < len(writes) {
+		remaining := writes[committed:]
+		txn := s.db.NewTransaction(true)
+		fit, err := applyWrites(txn, remaining)
+		if err != nil {
+			txn.Discard()
+			return committed, err
+		}
+		if fit < len(remaining) {
+			// the write that overflowed the transaction may have partially applied, so replay the writes that
+			// fit into a fresh transaction
+			txn.Discard()
+			if fit == 0 {
+				return committed, badger.ErrTxnTooBig
+			}
+			txn = s.db.NewTransaction(true)
+			if _, err := applyWrites(txn, remaining[:fit]); err != nil {
+				txn.Discard()
+				return committed, err
+			}
+		}
+		if err := txn.Commit(); err != nil {
+			return committed, err
+		}
+		committed += fit
+	}
+	return committed, nil
+}
+
+// commitRetried commits the writes with commitChunked, retrying the uncommitted writes up to attempts times while they
+// conflict with concurrent writes, & returns the number of writes that were committed. writes must be safe to replay
+func (s *Store) commitRetried(writes []func(txn *badger.Txn) error, attempts int) (int, error) {
+	total := 0
+	for attempt := 1; ; attempt++ {
+		committed, err := s.commitChunked(writes[total:])
+		total += committed
+		if err != badger.ErrConflict || attempt == attempts {
+			return total, err
+		}
+	}
+}
+
+// applyWrites applies the writes to txn until one overflows it & returns the number that were applied
+func applyWrites(txn *badger.Txn, writes []func(txn *badger.Txn) error) (int, error) {
+	for i, write := range writes {
+		if err := write(txn); err != nil {
+			if err == badger.ErrTxnTooBig {
+				return i, nil
+			}
+			return i, err
+		}
+	}
+	return len(writes), nil
+}
@@ -0,0 +1,163 @@
+package db
+
+import (
+	"context"
+	api "github.com/autom8ter/geodb/gen/go/geodb"
+	"github.com/autom8ter/geodb/helpers"
+	"github.com/dgraph-io/badger/v2"
+	"github.com/gogo/protobuf/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// bulkAttempts bounds the retries of moves that conflict with concurrent writes to the same objects
+const bulkAttempts = 10
+
+// BulkUpdatePositions moves existing objects to new points & returns their details along with the keys that aren't stored.
+// the stored objects & tracker targets are read in a single transaction, tracker events are computed against the
+// batch's new positions(so objects moving together see each other where they ended up) and the moves are committed in
+// as few transactions as badger's size limit allows. each move re-reads its object in the write transaction & only
+// changes its point, so fields written concurrently aren't overwritten & transitions are computed from its latest detail. the google maps integration isn't used, so
+// moved objects don't get an address, timezone or directions.
+func (s *Store) BulkUpdatePositions(ctx context.Context, updates []*api.PositionUpdate) ([]*api.ObjectDetail, []string, error) {
+	var order []string
+	points := map[string]*api.Point{}
+	for _, update := range updates {
+		if err := update.Validate(); err != nil {
+			return nil, nil, status.Errorf(codes.InvalidArgument, "%s: %s", update.Key, err.Error())
+		}
+		if _, ok := points[update.Key]; !ok {
+			order = append(order, update.Key)
+		}
+		points[update.Key] = update.Point
+	}
+	txn := s.db.NewTransaction(false)
+	defer txn.Discard()
+	var (
+		moved    []*api.Object
+		notFound []string
+		// every object's position as of the end of the batch
+		positions = map[string]*api.Object{}
+	)
+	for _, key := range order {
+		stored, err := s.liveDetail(txn, key)
+		if err != nil {
+			return nil, nil, status.Errorf(codes.Internal, "failed to get key: %s", err.Error())
+		}
+		if stored.GetObject() == nil || !inScope(ctx, []byte(key)) {
+			notFound = append(notFound, key)
+			continue
+		}
+		obj := proto.Clone(stored.Object).(*api.Object)
+		obj.Point = points[key]
+		if err := s.prepareObject(ctx, obj); err != nil {
+			return nil, nil, err
+		}
+		obj.UpdatedUnix = s.now().Unix()
+		obj.Geohash = helpers.Geohash(obj.Point, s.geohashPrecision)
+		positions[key] = obj
+		moved = append(moved, obj)
+	}
+	for _, obj := range moved {
+		for _, tracker := range obj.GetTracking().GetTrackers() {
+			if _, ok := positions[tracker.TargetObjectKey]; ok {
+				continue
+			}
+			target, err := s.liveDetail(txn, tracker.TargetObjectKey)
+			if err != nil {
+				return nil, nil, status.Errorf(codes.Internal, "failed to get key: %s", err.Error())
+			}
+			if target.GetObject() != nil {
+				positions[tracker.TargetObjectKey] = target.Object
+			}
+		}
+	}
+	fences, err := listGeofences(txn)
+	if err != nil {
+		return nil, nil, err
+	}
+	nanos := s.monotonicNanos()
+	metadataKeys := trackerEventMetadataKeys()
+	var details []*api.ObjectDetail
+	for _, obj := range moved {
+		details = append(details, &api.ObjectDetail{Object: obj})
+	}
+	var (
+		writes []func(txn *badger.Txn) error
+		// the objects deleted since the batch was read
+		deleted = map[string]bool{}
+	)
+	for _, detail := range details {
+		detail := detail
+		moved := detail.Object
+		writes = append(writes, func(txn *badger.Txn) error {
+			delete(deleted, moved.Key)
+			previous, err := s.liveDetail(txn, moved.Key)
+			if err != nil {
+				return err
+			}
+			if previous.GetObject() == nil {
+				deleted[moved.Key] = true
+				return nil
+			}
+			stored := previous.Object
+			stored.Point, stored.UpdatedUnix, stored.Geohash = moved.Point, moved.UpdatedUnix, moved.Geohash
+			s.resolveExpiration(stored)
+			detail.Object = stored
+			// transitions are computed from the detail stored as of the write, not the batch's read
+			s.positionEvents(detail, previous, positions, fences, nanos, metadataKeys)
+			if err := setStoredFields(txn, detail.Object, 0); err != nil {
+				return err
+			}
+			if err := writeDetail(txn, detail); err != nil {
+				return err
+			}
+			if err := s.writeHistory(txn, detail.Object, s.monotonicNanos()); err != nil {
+				return err
+			}
+			return s.writeEvents(txn, detail)
+		})
+	}
+	committed, err := s.commitRetried(writes, bulkAttempts)
+	var written []*api.ObjectDetail
+	for _, detail := range details[:committed] {
+		if deleted[detail.Object.Key] {
+			notFound = append(notFound, detail.Object.Key)
+			continue
+		}
+		written = append(written, s.publish(detail))
+	}
+	if err != nil {
+		return nil, nil, status.Errorf(codes.Internal, "failed to update positions(%v/%v written): %s", committed, len(details), err.Error())
+	}
+	return written, notFound, nil
+}
+
+// positionEvents sets the tracker & geofence events of the moved object's detail against the batch's positions, with
+// transitions from the object's previous detail
+func (s *Store) positionEvents(detail, previous *api.ObjectDetail, positions map[string]*api.Object, fences []*api.Geofence, nanos int64, metadataKeys []string) {
+	obj := detail.Object
+	detail.GeofenceEvents = geofenceEvents(fences, obj, insideGeofences(previous), nanos)
+	detail.TrackerEvents = nil
+	wasInside := insideTargets(previous)
+	carried := map[string]bool{}
+	for _, tracker := range obj.GetTracking().GetTrackers() {
+		target, ok := positions[tracker.TargetObjectKey]
+		if !ok || target.Key == obj.Key {
+			continue
+		}
+		var event *api.TrackerEvent
+		if s.triggers(obj, target) {
+			event = newTrackerEvent(obj, target, tracker, wasInside, nanos, metadataKeys)
+		}
+		if event == nil {
+			// skipped targets keep their inside state, so they don't enter again once they're tracked
+			if wasInside[target.Key] {
+				carried[target.Key] = true
+			}
+			continue
+		}
+		detail.TrackerEvents = append(detail.TrackerEvents, event)
+	}
+	detail.InsideTargets = insideTargetKeys(detail.TrackerEvents, carried)
+}
@@ -0,0 +1,79 @@
+package db
+
+import (
+	api "github.com/autom8ter/geodb/gen/go/geodb"
+	"github.com/autom8ter/geodb/metrics"
+	"sync"
+	"time"
+)
+
+// eventCooldown remembers when each (tracking object, target) pair last emitted an overlapping(Enter/Inside) event
+type eventCooldown struct {
+	window    time.Duration
+	mu        *sync.Mutex
+	emitted   map[string]time.Time
+	lastSweep time.Time
+}
+
+func newEventCooldown(window time.Duration) *eventCooldown {
+	return &eventCooldown{
+		window:  window,
+		mu:      &sync.Mutex{},
+		emitted: map[string]time.Time{},
+	}
+}
+
+// allow reports whether the event of the tracking object with the given key should be emitted. Enter & Inside events
+// are emitted at most once per window for each pair, while Exit & Outside events are always emitted & reset the pair
+func (c *eventCooldown) allow(key string, event *api.TrackerEvent, now time.Time) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	// an entry older than the window no longer suppresses anything, so it can be dropped
+	if now.Sub(c.lastSweep) > c.window {
+		for pair, emitted := range c.emitted {
+			if now.Sub(emitted) >= c.window {
+				delete(c.emitted, pair)
+			}
+		}
+		c.lastSweep = now
+	}
+	pair := key + "\x00" + event.GetObject().GetKey()
+	switch event.EventType {
+	case api.EventType_Enter, api.EventType_Inside:
+		if emitted, ok := c.emitted[pair]; ok && now.Sub(emitted) < c.window {
+			return false
+		}
+		c.emitted[pair] = now
+		return true
+	default:
+		delete(c.emitted, pair)
+		return true
+	}
+}
+
+// publish records the committed detail's location metric, streams it to subscribers & returns it. if an event cooldown is
+// configured, the returned & streamed detail only includes the tracker events that are outside of their pair's cooldown.
+// the stored detail keeps every event, so geofence transitions are still computed from the pair's real state
+func (s *Store) publish(detail *api.ObjectDetail) *api.ObjectDetail {
+	metrics.GaugeObjectLocation(detail.Object.Key, detail.Object.Point)
+	if s.cooldown != nil && len(detail.TrackerEvents) > 0 {
+		now := s.now()
+		var events []*api.TrackerEvent
+		for _, event := range detail.TrackerEvents {
+			if s.cooldown.allow(detail.GetObject().GetKey(), event, now) {
+				events = append(events, event)
+			}
+		}
+		detail = &api.ObjectDetail{
+			Object:         detail.Object,
+			Address:        detail.Address,
+			Timezone:       detail.Timezone,
+			TrackerEvents:  events,
+			Deleted:        detail.Deleted,
+			GeofenceEvents: detail.GeofenceEvents,
+			InsideTargets:  detail.InsideTargets,
+		}
+	}
+	s.hub.PublishObject(detail)
+	return detail
+}
@@ -0,0 +1,99 @@
+package db
+
+import (
+	"context"
+	api "github.com/autom8ter/geodb/gen/go/geodb"
+	"github.com/dgraph-io/badger/v2"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"hash/fnv"
+	"sync"
+)
+
+const (
+	// incrementAttempts bounds the retries of an increment that conflicts with concurrent writes to the same object
+	incrementAttempts = 100
+	// counterLockStripes is the number of locks increments are serialized by(keys share a lock by their hash)
+	counterLockStripes = 64
+)
+
+func newCounterLocks() []*sync.Mutex {
+	locks := make([]*sync.Mutex, counterLockStripes)
+	for i := range locks {
+		locks[i] = &sync.Mutex{}
+	}
+	return locks
+}
+
+// counterLock returns the lock serializing the increments of key, so they don't conflict with one another
+func (s *Store) counterLock(key string) *sync.Mutex {
+	h := fnv.New32a()
+	h.Write([]byte(key))
+	return s.counterLocks[h.Sum32()%counterLockStripes]
+}
+
+// Increment adds delta to the named counter of the stored object with the given key & returns the counter's new value.
+// increments of the same key are serialized & the read and write happen in a single transaction that's retried when a
+// concurrent write(ex: Set) to the object conflicts with it, so concurrent increments are never lost. the object doesn't move, so its tracker & geofence events aren't recomputed
+func (s *Store) Increment(ctx context.Context, key, counter string, delta int64) (int64, *api.ObjectDetail, error) {
+	if !inScope(ctx, []byte(key)) {
+		return 0, nil, status.Errorf(codes.NotFound, "object not found: %s", key)
+	}
+	if s.limiter != nil && !s.limiter.allow(key, s.now()) {
+		return 0, nil, status.Errorf(codes.ResourceExhausted, "rate limit exceeded for key: %s", key)
+	}
+	lock := s.counterLock(key)
+	lock.Lock()
+	defer lock.Unlock()
+	for attempt := 1; ; attempt++ {
+		value, detail, err := s.increment(key, counter, delta)
+		if err == badger.ErrConflict {
+			if attempt < incrementAttempts {
+				continue
+			}
+			return 0, nil, status.Errorf(codes.Aborted, "concurrent writes to key: %s(%v attempts)", key, attempt)
+		}
+		if err != nil {
+			return 0, nil, err
+		}
+		// only the counter changed, so the stored events aren't streamed again
+		return value, s.publish(&api.ObjectDetail{
+			Object:   detail.Object,
+			Address:  detail.Address,
+			Timezone: detail.Timezone,
+		}), nil
+	}
+}
+
+func (s *Store) increment(key, counter string, delta int64) (int64, *api.ObjectDetail, error) {
+	txn := s.db.NewTransaction(true)
+	defer txn.Discard()
+	detail, err := s.liveDetail(txn, key)
+	if err != nil {
+		return 0, nil, status.Errorf(codes.Internal, "failed to get key: %s", err.Error())
+	}
+	if detail.GetObject() == nil {
+		return 0, nil, status.Errorf(codes.NotFound, "object not found: %s", key)
+	}
+	obj := detail.Object
+	if err := setStoredFields(txn, obj, 0); err != nil {
+		return 0, nil, status.Errorf(codes.Internal, "failed to get key: %s", err.Error())
+	}
+	counters := map[string]int64{}
+	for name, value := range obj.Counters {
+		counters[name] = value
+	}
+	counters[counter] += delta
+	obj.Counters = counters
+	obj.UpdatedUnix = s.now().Unix()
+	if err := writeDetail(txn, detail); err != nil {
+		return 0, nil, status.Errorf(codes.Internal, "failed to increment counter: %s", err.Error())
+	}
+	if err := txn.Commit(); err != nil {
+		if err == badger.ErrConflict {
+			return 0, nil, err
+		}
+		return 0, nil, status.Errorf(codes.Internal, "failed to commit object: %s", err.Error())
+	}
+	return counters[counter], detail, nil
+}
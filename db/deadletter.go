@@ -0,0 +1,141 @@
+package db
+
+import (
+	"context"
+	"encoding/binary"
+	api "github.com/autom8ter/geodb/gen/go/geodb"
+	"github.com/dgraph-io/badger/v2"
+	"github.com/gogo/protobuf/proto"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"sync"
+	"time"
+)
+
+// dead letters are stored under \x00deadletter\x00<big endian unix nanos> so they iterate in the order they were recorded
+const deadLetterMeta = 7
+
+var deadLetterPrefix = []byte("\x00deadletter\x00")
+
+// DeadLetters is a size capped log of object details that couldn't be delivered to stream clients.
+type DeadLetters struct {
+	db        *badger.DB
+	max       int
+	mu        *sync.Mutex
+	count     int
+	lastNanos int64
+}
+
+// NewDeadLetters creates a dead letter log that keeps at most max entries, dropping the oldest first.
+func NewDeadLetters(db *badger.DB, max int) *DeadLetters {
+	d := &DeadLetters{
+		db:  db,
+		max: max,
+		mu:  &sync.Mutex{},
+	}
+	txn := db.NewTransaction(false)
+	defer txn.Discard()
+	opts := badger.DefaultIteratorOptions
+	opts.PrefetchValues = false
+	iter := txn.NewIterator(opts)
+	defer iter.Close()
+	for iter.Seek(deadLetterPrefix); iter.ValidForPrefix(deadLetterPrefix); iter.Next() {
+		if iter.Item().UserMeta() == deadLetterMeta {
+			d.count++
+		}
+	}
+	return d
+}
+
+// Record adds an undeliverable object detail & the reason it couldn't be delivered to the log.
+func (d *DeadLetters) Record(obj *api.ObjectDetail, reason string) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	nanos := time.Now().UnixNano()
+	if nanos <= d.lastNanos {
+		nanos = d.lastNanos + 1
+	}
+	d.lastNanos = nanos
+	bits, err := proto.Marshal(&api.DeadLetter{
+		Object:         obj,
+		Reason:         reason,
+		TimestampNanos: nanos,
+	})
+	if err != nil {
+		log.Error(err.Error())
+		return
+	}
+	key := make([]byte, len(deadLetterPrefix)+8)
+	copy(key, deadLetterPrefix)
+	binary.BigEndian.PutUint64(key[len(deadLetterPrefix):], uint64(nanos))
+	if err := d.db.Update(func(txn *badger.Txn) error {
+		return txn.SetEntry(&badger.Entry{
+			Key:      key,
+			Value:    bits,
+			UserMeta: deadLetterMeta,
+		})
+	}); err != nil {
+		log.Error(err.Error())
+		return
+	}
+	d.count++
+	if d.count > d.max {
+		if err := d.trim(); err != nil {
+			log.Error(err.Error())
+		}
+	}
+}
+
+// trim deletes the oldest dead letters so at most max remain. only the excess entries are read, so recording a dead
+// letter past the cap deletes a single entry instead of scanning the whole log
+func (d *DeadLetters) trim() error {
+	return d.db.Update(func(txn *badger.Txn) error {
+		opts := badger.DefaultIteratorOptions
+		opts.PrefetchValues = false
+		iter := txn.NewIterator(opts)
+		defer iter.Close()
+		excess := d.count - d.max
+		for iter.Seek(deadLetterPrefix); iter.ValidForPrefix(deadLetterPrefix) && excess > 0; iter.Next() {
+			if iter.Item().UserMeta() != deadLetterMeta {
+				continue
+			}
+			if err := txn.Delete(iter.Item().KeyCopy(nil)); err != nil {
+				return err
+			}
+			excess--
+		}
+		d.count = d.max + excess
+		return nil
+	})
+}
+
+// List returns up to limit dead letters, newest first. a limit <= 0 returns every dead letter.
+func (d *DeadLetters) List(ctx context.Context, limit int) ([]*api.DeadLetter, error) {
+	txn := d.db.NewTransaction(false)
+	defer txn.Discard()
+	opts := badger.DefaultIteratorOptions
+	opts.Reverse = true
+	iter := txn.NewIterator(opts)
+	defer iter.Close()
+	var letters []*api.DeadLetter
+	for iter.Seek(append(append([]byte{}, deadLetterPrefix...), 0xFF)); iter.ValidForPrefix(deadLetterPrefix); iter.Next() {
+		if limit > 0 && len(letters) >= limit {
+			break
+		}
+		item := iter.Item()
+		if item.UserMeta() != deadLetterMeta {
+			continue
+		}
+		res, err := item.ValueCopy(nil)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to copy data: %s", err.Error())
+		}
+		var letter = &api.DeadLetter{}
+		if err := proto.Unmarshal(res, letter); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to unmarshal protobuf: %s", err.Error())
+		}
+		letters = append(letters, letter)
+	}
+	return letters, nil
+}
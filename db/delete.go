@@ -0,0 +1,46 @@
+package db
+
+import (
+	"context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// the max number of objects deleted per write transaction by bulk deletes
+const deleteBatchSize = 1000
+
+// DeletePrefix deletes every object whose key has the given prefix and returns the number deleted
+func (s *Store) DeletePrefix(ctx context.Context, prefix string) (int64, error) {
+	if prefix == "" {
+		return 0, status.Error(codes.InvalidArgument, "empty prefix")
+	}
+	return s.deleteBatches(s.GetPrefixKeys(ctx, prefix, 0))
+}
+
+// DeleteRegex deletes every object whose key(after the prefix of ctx's namespace) matches the regex and returns the number deleted
+func (s *Store) DeleteRegex(ctx context.Context, regex string) (int64, error) {
+	if regex == "" {
+		return 0, status.Error(codes.InvalidArgument, "empty regex")
+	}
+	keys, err := s.GetRegexKeys(ctx, scopePrefix(ctx), regex)
+	if err != nil {
+		return 0, err
+	}
+	return s.deleteBatches(keys)
+}
+
+func (s *Store) deleteBatches(keys []string) (int64, error) {
+	var deleted int64
+	for len(keys) > 0 {
+		batch := keys
+		if len(batch) > deleteBatchSize {
+			batch = batch[:deleteBatchSize]
+		}
+		if err := s.deleteKeys(batch); err != nil {
+			return deleted, err
+		}
+		deleted += int64(len(batch))
+		keys = keys[len(batch):]
+	}
+	return deleted, nil
+}
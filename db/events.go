@@ -0,0 +1,108 @@
+package db
+
+import (
+	"context"
+	"encoding/binary"
+	api "github.com/autom8ter/geodb/gen/go/geodb"
+	"github.com/dgraph-io/badger/v2"
+	"github.com/gogo/protobuf/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"time"
+)
+
+// persisted tracker events are stored under \x00event\x00<big endian unix nanos>\x00<object key>\x00<target key> so
+// they iterate in the order they were computed
+const eventMeta = 11
+
+var eventPrefix = []byte("\x00event\x00")
+
+// eventTimePrefix returns the prefix of the events computed at nanos
+func eventTimePrefix(nanos int64) []byte {
+	k := make([]byte, len(eventPrefix)+8)
+	copy(k, eventPrefix)
+	binary.BigEndian.PutUint64(k[len(eventPrefix):], uint64(nanos))
+	return k
+}
+
+func eventKey(nanos int64, key, target string) []byte {
+	return []byte(string(eventTimePrefix(nanos)) + "\x00" + key + "\x00" + target)
+}
+
+// writeEvents persists the tracker events of detail within txn(if the event log is enabled)
+func (s *Store) writeEvents(txn *badger.Txn, detail *api.ObjectDetail) error {
+	if !s.eventLog {
+		return nil
+	}
+	var expiresAt uint64
+	if s.eventRetention > 0 {
+		expiresAt = uint64(s.now().Add(s.eventRetention).Unix())
+	}
+	for _, event := range detail.TrackerEvents {
+		bits, err := proto.Marshal(&api.ObjectEvent{
+			Key:   detail.Object.Key,
+			Event: event,
+		})
+		if err != nil {
+			return err
+		}
+		if err := txn.SetEntry(&badger.Entry{
+			Key:       eventKey(event.TimestampNanos, detail.Object.Key, event.GetObject().GetKey()),
+			Value:     bits,
+			UserMeta:  eventMeta,
+			ExpiresAt: expiresAt,
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetEvents returns up to limit persisted tracker events computed in [start, end), oldest first. a zero start or end
+// leaves that side of the range open. if key is set, only the events of the tracking or target object with that key
+// are returned. events are only persisted if the event log is enabled(see WithEventLog)
+func (s *Store) GetEvents(ctx context.Context, start, end time.Time, key string, limit int) ([]*api.ObjectEvent, error) {
+	if !s.eventLog {
+		return nil, status.Error(codes.FailedPrecondition, "event log is disabled(see GEODB_EVENT_RETENTION)")
+	}
+	if !start.IsZero() && !end.IsZero() && !start.Before(end) {
+		return nil, status.Errorf(codes.InvalidArgument, "start %v is not before end %v", start.UnixNano(), end.UnixNano())
+	}
+	txn := s.db.NewTransaction(false)
+	defer txn.Discard()
+	opts := s.scanOptions()
+	opts.Prefix = eventPrefix
+	iter := txn.NewIterator(opts)
+	defer iter.Close()
+	seek := eventPrefix
+	if !start.IsZero() {
+		seek = eventTimePrefix(start.UnixNano())
+	}
+	var events []*api.ObjectEvent
+	for iter.Seek(seek); iter.ValidForPrefix(eventPrefix); iter.Next() {
+		if limit > 0 && len(events) >= limit {
+			break
+		}
+		item := iter.Item()
+		if item.UserMeta() != eventMeta || len(item.Key()) < len(eventPrefix)+8 {
+			continue
+		}
+		nanos := int64(binary.BigEndian.Uint64(item.Key()[len(eventPrefix):]))
+		if !end.IsZero() && nanos >= end.UnixNano() {
+			break
+		}
+		res, err := item.ValueCopy(nil)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to copy data: %s", err.Error())
+		}
+		var event = &api.ObjectEvent{}
+		if err := proto.Unmarshal(res, event); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to unmarshal protobuf: %s", err.Error())
+		}
+		if !inScope(ctx, []byte(event.Key)) || (key != "" && event.Key != key && event.GetEvent().GetObject().GetKey() != key) {
+			continue
+		}
+		events = append(events, event)
+	}
+	return events, nil
+}
@@ -0,0 +1,167 @@
+package db
+
+import (
+	"context"
+	api "github.com/autom8ter/geodb/gen/go/geodb"
+	"github.com/dgraph-io/badger/v2"
+	"github.com/gogo/protobuf/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"time"
+)
+
+// expired reports whether the item's ttl has passed by the store's clock. badger hides items that expired by the wall
+// clock & drops them on compaction, but the store's clock may be ahead of it, so reads check the item themselves
+func (s *Store) expired(item *badger.Item) bool {
+	return item.ExpiresAt() != 0 && (item.ExpiresAt() <= uint64(s.now().Unix()) || item.IsDeletedOrExpired())
+}
+
+// live reports whether the item is an object that hasn't expired
+func (s *Store) live(item *badger.Item) bool {
+	return item.UserMeta() == 1 && !s.expired(item)
+}
+
+// DeleteExpired deletes every expired object along with its index entries & history, publishes a tombstone for each
+// one so stream clients can remove it & returns the number deleted. reads never return expired objects & badger drops
+// them on compaction, but without publishing anything
+func (s *Store) DeleteExpired(ctx context.Context) (int64, error) {
+	objs, err := s.expiredObjects()
+	if err != nil {
+		return 0, err
+	}
+	var deleted int64
+	for len(objs) > 0 {
+		batch := objs
+		if len(batch) > deleteBatchSize {
+			batch = batch[:deleteBatchSize]
+		}
+		n, err := s.deleteExpiredBatch(batch)
+		deleted += n
+		if err != nil {
+			return deleted, err
+		}
+		objs = objs[len(batch):]
+	}
+	return deleted, nil
+}
+
+// expiredObjects returns the latest version of every object whose ttl has passed, including those badger already hides
+func (s *Store) expiredObjects() ([]*api.Object, error) {
+	txn := s.db.NewTransaction(false)
+	defer txn.Discard()
+	opts := s.scanOptions()
+	opts.AllVersions = true
+	iter := txn.NewIterator(opts)
+	defer iter.Close()
+	var (
+		objs    []*api.Object
+		lastKey []byte
+	)
+	for iter.Rewind(); iter.Valid(); iter.Next() {
+		item := iter.Item()
+		// versions of a key iterate newest first, so only the first one is current
+		if lastKey != nil && string(item.Key()) == string(lastKey) {
+			continue
+		}
+		lastKey = item.KeyCopy(lastKey)
+		if item.UserMeta() != 1 || !s.expired(item) {
+			continue
+		}
+		res, err := item.ValueCopy(nil)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to copy data: %s", err.Error())
+		}
+		var detail = &api.ObjectDetail{}
+		if err := proto.Unmarshal(res, detail); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to unmarshal protobuf: %s", err.Error())
+		}
+		objs = append(objs, detail.Object)
+	}
+	return objs, nil
+}
+
+// deleteExpiredBatch deletes the expired objects in a single transaction, skipping those written again since they
+// were scanned
+func (s *Store) deleteExpiredBatch(objs []*api.Object) (int64, error) {
+	txn := s.db.NewTransaction(true)
+	defer txn.Discard()
+	var tombstones []*api.ObjectDetail
+	for _, obj := range objs {
+		item, err := txn.Get([]byte(obj.Key))
+		if err == nil && s.live(item) {
+			continue
+		}
+		if err != nil && err != badger.ErrKeyNotFound {
+			return 0, status.Errorf(codes.Internal, "failed to get key: %s %s", obj.Key, err.Error())
+		}
+		if err := unindexTags(txn, obj.Key, obj.GetTags()); err != nil {
+			return 0, status.Errorf(codes.Internal, "failed to delete key: %s %s", obj.Key, err.Error())
+		}
+		if err := unindexGeohash(txn, obj.Key, obj.GetGeohash()); err != nil {
+			return 0, status.Errorf(codes.Internal, "failed to delete key: %s %s", obj.Key, err.Error())
+		}
+		if err := deleteHistory(txn, obj.Key); err != nil {
+			return 0, status.Errorf(codes.Internal, "failed to delete key: %s %s", obj.Key, err.Error())
+		}
+		if err := txn.Delete([]byte(obj.Key)); err != nil {
+			return 0, status.Errorf(codes.Internal, "failed to delete key: %s %s", obj.Key, err.Error())
+		}
+		tombstones = append(tombstones, &api.ObjectDetail{Object: obj, Deleted: true})
+	}
+	if err := txn.Commit(); err != nil {
+		return 0, status.Errorf(codes.Internal, "failed to delete keys %s", err.Error())
+	}
+	for _, tombstone := range tombstones {
+		s.hub.PublishObject(tombstone)
+	}
+	return int64(len(tombstones)), nil
+}
+
+// refreshAttempts bounds the retries of a ttl refresh that conflicts with concurrent writes to the same objects
+const refreshAttempts = 10
+
+// RefreshTTL restarts the expiration of the stored objects with the given keys that were written with a ttl_seconds
+// (sliding expiration), so objects that are read stay alive. it returns the new expires_unix of each refreshed object.
+// expired objects & objects without a ttl_seconds aren't refreshed. the objects are rewritten, but their versions don't
+// change & nothing is streamed
+func (s *Store) RefreshTTL(ctx context.Context, keys []string) (map[string]int64, error) {
+	refreshed := map[string]int64{}
+	var writes []func(txn *badger.Txn) error
+	for _, key := range keys {
+		key := key
+		writes = append(writes, func(txn *badger.Txn) error {
+			delete(refreshed, key)
+			item, err := txn.Get([]byte(key))
+			if err == badger.ErrKeyNotFound {
+				return nil
+			}
+			if err != nil {
+				return err
+			}
+			if !s.visible(ctx, item) {
+				return nil
+			}
+			detail, err := storedDetail(txn, key)
+			if err != nil {
+				return err
+			}
+			if detail.GetObject().GetTtlSeconds() <= 0 {
+				return nil
+			}
+			detail.Object.ExpiresUnix = s.now().Add(time.Duration(detail.Object.TtlSeconds) * time.Second).Unix()
+			if err := writeDetail(txn, detail); err != nil {
+				return err
+			}
+			refreshed[key] = detail.Object.ExpiresUnix
+			return nil
+		})
+	}
+	_, err := s.commitRetried(writes, refreshAttempts)
+	if err == badger.ErrConflict {
+		return nil, status.Errorf(codes.Aborted, "concurrent writes while refreshing ttls(%v attempts)", refreshAttempts)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to refresh ttls: %s", err.Error())
+	}
+	return refreshed, nil
+}
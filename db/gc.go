@@ -0,0 +1,79 @@
+package db
+
+import (
+	"context"
+	"github.com/autom8ter/geodb/config"
+	"github.com/autom8ter/geodb/metrics"
+	"github.com/dgraph-io/badger/v2"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"os"
+	"path/filepath"
+)
+
+// RunGC runs badger's value log garbage collection until no more value log files can be rewritten, returning the number
+// of files rewritten & the value log disk space reclaimed(bytes). files with at least discardRatio stale data are rewritten
+func (s *Store) RunGC(ctx context.Context, discardRatio float64) (int64, int64, error) {
+	before := vlogSize()
+	var runs int64
+	for ctx.Err() == nil {
+		err := s.db.RunValueLogGC(discardRatio)
+		if err == nil {
+			runs++
+			continue
+		}
+		if err == badger.ErrNoRewrite {
+			break
+		}
+		switch err {
+		case badger.ErrGCInMemoryMode:
+			return runs, 0, status.Error(codes.FailedPrecondition, "in memory databases don't have a value log to collect")
+		case badger.ErrRejected:
+			return runs, 0, status.Error(codes.Aborted, "value log garbage collection is already running")
+		default:
+			return runs, 0, status.Errorf(codes.Internal, "failed to run value log garbage collection: %s", err.Error())
+		}
+	}
+	// badger only refreshes its reported sizes once a minute, so the value log files are measured directly
+	reclaimed := before - vlogSize()
+	if reclaimed < 0 {
+		reclaimed = 0
+	}
+	metrics.AddGCReclaimedBytes(reclaimed)
+	return runs, reclaimed, nil
+}
+
+// vlogSize returns the combined size(bytes) of the value log files under GEODB_VALUE_PATH(or GEODB_PATH if it isn't set).
+// in memory databases don't have a value log
+func vlogSize() int64 {
+	if config.Config.GetBool("GEODB_IN_MEMORY") {
+		return 0
+	}
+	dir := config.Config.GetString("GEODB_VALUE_PATH")
+	if dir == "" {
+		dir = config.Config.GetString("GEODB_PATH")
+	}
+	return filesSize(dir, "*.vlog")
+}
+
+// lsmSize returns the combined size(bytes) of the LSM tree's tables under GEODB_PATH. in memory databases report
+// badger's size instead
+func (s *Store) lsmSize() int64 {
+	if config.Config.GetBool("GEODB_IN_MEMORY") {
+		lsm, _ := s.db.Size()
+		return lsm
+	}
+	return filesSize(config.Config.GetString("GEODB_PATH"), "*.sst")
+}
+
+// filesSize returns the combined size(bytes) of the files in dir matching the pattern
+func filesSize(dir, pattern string) int64 {
+	files, _ := filepath.Glob(filepath.Join(dir, pattern))
+	var size int64
+	for _, file := range files {
+		if info, err := os.Stat(file); err == nil {
+			size += info.Size()
+		}
+	}
+	return size
+}
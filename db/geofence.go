@@ -0,0 +1,143 @@
+package db
+
+import (
+	"context"
+	api "github.com/autom8ter/geodb/gen/go/geodb"
+	"github.com/autom8ter/geodb/helpers"
+	"github.com/dgraph-io/badger/v2"
+	"github.com/gogo/protobuf/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// geofences are stored under \x00geofence\x00<name> so they iterate in name order
+const geofenceMeta = 12
+
+var geofencePrefix = []byte("\x00geofence\x00")
+
+func geofenceKey(name string) []byte {
+	return append(append([]byte{}, geofencePrefix...), name...)
+}
+
+// CreateGeofence registers a named circular(center & radius) or polygon geofence. objects written afterwards are checked
+// against it, producing geofence events. names are unique, so an existing geofence must be deleted before it's replaced
+func (s *Store) CreateGeofence(ctx context.Context, fence *api.Geofence) (*api.Geofence, error) {
+	if err := fence.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%s: %s", fence.GetName(), err.Error())
+	}
+	if len(fence.Polygon) == 0 && (fence.Center == nil || fence.Radius == 0) {
+		return nil, status.Errorf(codes.InvalidArgument, "%s: a geofence requires a center & radius or a polygon", fence.Name)
+	}
+	if len(fence.Polygon) > 0 && len(fence.Polygon) < 3 {
+		return nil, status.Errorf(codes.InvalidArgument, "%s: a polygon requires at least 3 vertices", fence.Name)
+	}
+	bits, err := proto.Marshal(fence)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to marshal protobuf: %s", err.Error())
+	}
+	if err := s.db.Update(func(txn *badger.Txn) error {
+		if _, err := txn.Get(geofenceKey(fence.Name)); err == nil {
+			return status.Errorf(codes.AlreadyExists, "geofence already exists: %s", fence.Name)
+		} else if err != badger.ErrKeyNotFound {
+			return status.Errorf(codes.Internal, "failed to get geofence: %s", err.Error())
+		}
+		return txn.SetEntry(&badger.Entry{
+			Key:      geofenceKey(fence.Name),
+			Value:    bits,
+			UserMeta: geofenceMeta,
+		})
+	}); err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		return nil, status.Errorf(codes.Internal, "failed to create geofence: %s", err.Error())
+	}
+	return fence, nil
+}
+
+// DeleteGeofence removes the geofence with the given name. objects that were inside of it don't get an Exit event
+func (s *Store) DeleteGeofence(ctx context.Context, name string) error {
+	if err := s.db.Update(func(txn *badger.Txn) error {
+		if _, err := txn.Get(geofenceKey(name)); err == badger.ErrKeyNotFound {
+			return status.Errorf(codes.NotFound, "geofence not found: %s", name)
+		} else if err != nil {
+			return status.Errorf(codes.Internal, "failed to get geofence: %s", err.Error())
+		}
+		return txn.Delete(geofenceKey(name))
+	}); err != nil {
+		if _, ok := status.FromError(err); ok {
+			return err
+		}
+		return status.Errorf(codes.Internal, "failed to delete geofence: %s", err.Error())
+	}
+	return nil
+}
+
+// ListGeofences returns every geofence ordered by name
+func (s *Store) ListGeofences(ctx context.Context) ([]*api.Geofence, error) {
+	txn := s.db.NewTransaction(false)
+	defer txn.Discard()
+	return listGeofences(txn)
+}
+
+func listGeofences(txn *badger.Txn) ([]*api.Geofence, error) {
+	iter := txn.NewIterator(badger.DefaultIteratorOptions)
+	defer iter.Close()
+	var fences []*api.Geofence
+	for iter.Seek(geofencePrefix); iter.ValidForPrefix(geofencePrefix); iter.Next() {
+		item := iter.Item()
+		if item.UserMeta() != geofenceMeta {
+			continue
+		}
+		res, err := item.ValueCopy(nil)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to copy data: %s", err.Error())
+		}
+		var fence = &api.Geofence{}
+		if err := proto.Unmarshal(res, fence); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to unmarshal protobuf: %s", err.Error())
+		}
+		fences = append(fences, fence)
+	}
+	return fences, nil
+}
+
+// fenceObject returns the geometry of the geofence as an object so it's measured like a tracker target
+func fenceObject(fence *api.Geofence) *api.Object {
+	if len(fence.Polygon) >= 3 {
+		return &api.Object{Key: fence.Name, Point: helpers.Centroid(fence.Polygon), Polygon: fence.Polygon}
+	}
+	return &api.Object{Key: fence.Name, Point: fence.Center, Radius: fence.Radius}
+}
+
+// geofenceEvents returns the transitions of obj relative to each geofence, given the geofences it was inside of
+func geofenceEvents(fences []*api.Geofence, obj *api.Object, wasInside map[string]bool, nanos int64) []*api.GeofenceEvent {
+	var events []*api.GeofenceEvent
+	for _, fence := range fences {
+		target := fenceObject(fence)
+		dist := helpers.ObjectDistance(obj, target)
+		inside := dist <= float64(obj.Radius+target.Radius)
+		if !inside && !wasInside[fence.Name] {
+			continue
+		}
+		events = append(events, &api.GeofenceEvent{
+			Fence:          fence.Name,
+			EventType:      eventType(wasInside[fence.Name], inside),
+			Inside:         inside,
+			Distance:       dist,
+			TimestampNanos: nanos,
+		})
+	}
+	return events
+}
+
+// insideGeofences returns the names of the geofences the detail's object was inside of
+func insideGeofences(detail *api.ObjectDetail) map[string]bool {
+	inside := map[string]bool{}
+	for _, event := range detail.GetGeofenceEvents() {
+		if event.Inside {
+			inside[event.Fence] = true
+		}
+	}
+	return inside
+}
@@ -0,0 +1,145 @@
+package db
+
+import (
+	"context"
+	api "github.com/autom8ter/geodb/gen/go/geodb"
+	"github.com/autom8ter/geodb/helpers"
+	"github.com/dgraph-io/badger/v2"
+	"github.com/gogo/protobuf/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"strings"
+)
+
+// maxCoverCells limits the number of geohash cells visited per lat/lon box. larger boxes are covered by coarser cells
+const maxCoverCells = 64
+
+// geohash index entries are stored under \x00geohash\x00<geohash>\x00<object key> with an empty value so
+// nearby objects are co-located in the keyspace
+const geohashIndexMeta = 9
+
+func geohashIndexPrefix(geohash string) []byte {
+	return []byte("\x00geohash\x00" + geohash)
+}
+
+func geohashIndexKey(geohash, key string) []byte {
+	return append(geohashIndexPrefix(geohash+"\x00"), key...)
+}
+
+// indexGeohash replaces the object's geohash index entry within txn. previous is the geohash of the stored object(if any).
+func indexGeohash(txn *badger.Txn, obj *api.Object, previous string) error {
+	if err := unindexGeohash(txn, obj.Key, previous); err != nil {
+		return err
+	}
+	if obj.Geohash == "" {
+		return nil
+	}
+	return txn.SetEntry(&badger.Entry{
+		Key:       geohashIndexKey(obj.Geohash, obj.Key),
+		UserMeta:  geohashIndexMeta,
+		ExpiresAt: uint64(obj.ExpiresUnix),
+	})
+}
+
+func unindexGeohash(txn *badger.Txn, key, geohash string) error {
+	if geohash == "" {
+		return nil
+	}
+	return txn.Delete(geohashIndexKey(geohash, key))
+}
+
+// GetByGeohashPrefix returns the objects whose geohash starts with prefix using the geohash index
+func (s *Store) GetByGeohashPrefix(ctx context.Context, prefix string) (map[string]*api.ObjectDetail, error) {
+	txn := s.db.NewTransaction(false)
+	defer txn.Discard()
+	indexPrefix := geohashIndexPrefix(prefix)
+	opts := badger.DefaultIteratorOptions
+	opts.PrefetchValues = false
+	iter := txn.NewIterator(opts)
+	defer iter.Close()
+	objects := map[string]*api.ObjectDetail{}
+	for iter.Seek(indexPrefix); iter.ValidForPrefix(indexPrefix); iter.Next() {
+		if iter.Item().UserMeta() != geohashIndexMeta {
+			continue
+		}
+		indexKey := string(iter.Item().Key()[len(indexPrefix):])
+		sep := strings.IndexByte(indexKey, 0)
+		if sep < 0 {
+			continue
+		}
+		key := indexKey[sep+1:]
+		item, err := txn.Get([]byte(key))
+		if err == badger.ErrKeyNotFound {
+			continue
+		}
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get key: %s", err.Error())
+		}
+		if !s.visible(ctx, item) {
+			continue
+		}
+		res, err := item.ValueCopy(nil)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to copy data: %s", err.Error())
+		}
+		var obj = &api.ObjectDetail{}
+		if err := proto.Unmarshal(res, obj); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to unmarshal protobuf: %s", err.Error())
+		}
+		if strings.HasPrefix(obj.Object.Geohash, prefix) {
+			objects[key] = obj
+			if err := checkMaxResults(ctx, len(objects)); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return objects, nil
+}
+
+// eachInBox calls fn with every stored object that may be inside the lat/lon box(minLon > maxLon crosses the
+// antimeridian). only the geohash index entries of the cells overlapping the box are visited, so callers must still
+// check the exact query region. objects outside of ctx's namespace are skipped
+func (s *Store) eachInBox(ctx context.Context, txn *badger.Txn, minLat, minLon, maxLat, maxLon float64, fn func(key string, obj *api.ObjectDetail) error) error {
+	var cells []string
+	if minLon <= maxLon {
+		cells = helpers.GeohashCover(minLat, minLon, maxLat, maxLon, s.geohashPrecision, maxCoverCells)
+	} else {
+		cells = append(
+			helpers.GeohashCover(minLat, minLon, maxLat, 180, s.geohashPrecision, maxCoverCells),
+			helpers.GeohashCover(minLat, -180, maxLat, maxLon, s.geohashPrecision, maxCoverCells)...,
+		)
+	}
+	opts := badger.DefaultIteratorOptions
+	opts.PrefetchValues = false
+	iter := txn.NewIterator(opts)
+	defer iter.Close()
+	seen := map[string]struct{}{}
+	for _, cell := range cells {
+		indexPrefix := geohashIndexPrefix(cell)
+		for iter.Seek(indexPrefix); iter.ValidForPrefix(indexPrefix); iter.Next() {
+			if iter.Item().UserMeta() != geohashIndexMeta {
+				continue
+			}
+			indexKey := string(iter.Item().Key()[len(indexPrefix):])
+			sep := strings.IndexByte(indexKey, 0)
+			if sep < 0 {
+				continue
+			}
+			key := indexKey[sep+1:]
+			if _, ok := seen[key]; ok || !inScope(ctx, []byte(key)) {
+				continue
+			}
+			seen[key] = struct{}{}
+			obj, err := s.liveDetail(txn, key)
+			if err != nil {
+				return status.Errorf(codes.Internal, "failed to get key: %s", err.Error())
+			}
+			if obj != nil {
+				if err := fn(key, obj); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,57 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"github.com/dgraph-io/badger/v2"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"strconv"
+)
+
+// the health check sentinel is stored under \x00health with the unix nanosecond timestamp of the last check
+const healthMeta = 8
+
+var healthKey = []byte("\x00health")
+
+// Health writes & reads back a sentinel key to verify the database is usable, returning the LSM tree & value log sizes(bytes)
+func (s *Store) Health(ctx context.Context) (int64, int64, error) {
+	val := []byte(strconv.FormatInt(s.now().UnixNano(), 10))
+	if err := s.db.Update(func(txn *badger.Txn) error {
+		return txn.SetEntry(&badger.Entry{
+			Key:      healthKey,
+			Value:    val,
+			UserMeta: healthMeta,
+		})
+	}); err != nil {
+		return 0, 0, status.Errorf(codes.Unavailable, "failed to write health check: %s", err.Error())
+	}
+	if err := s.db.View(func(txn *badger.Txn) error {
+		item, err := txn.Get(healthKey)
+		if err != nil {
+			return err
+		}
+		return item.Value(func(stored []byte) error {
+			if string(stored) != string(val) {
+				return fmt.Errorf("unexpected health check value: %s", string(stored))
+			}
+			return nil
+		})
+	}); err != nil {
+		return 0, 0, status.Errorf(codes.Unavailable, "failed to read health check: %s", err.Error())
+	}
+	lsm, vlog := s.db.Size()
+	return lsm, vlog, nil
+}
+
+// Stats returns the LSM tree & value log sizes(bytes) and an estimate of the number of keys without scanning the keyspace.
+// the sizes are measured from the files under GEODB_PATH & GEODB_VALUE_PATH because badger only refreshes its reported sizes once a minute.
+// the estimate is read from the LSM tree's table metadata, so it includes index entries & overwritten versions that
+// haven't been compacted yet, and excludes recent writes that haven't been flushed from memory
+func (s *Store) Stats(ctx context.Context) (int64, int64, int64) {
+	var keys uint64
+	for _, table := range s.db.Tables(true) {
+		keys += table.KeyCount
+	}
+	return s.lsmSize(), vlogSize(), int64(keys)
+}
@@ -0,0 +1,121 @@
+package db
+
+import (
+	"context"
+	"encoding/binary"
+	api "github.com/autom8ter/geodb/gen/go/geodb"
+	"github.com/dgraph-io/badger/v2"
+	"github.com/gogo/protobuf/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// history entries are stored under \x00history\x00<object key>\x00<big endian unix nanos> so an object's trail
+// iterates oldest first
+const historyMeta = 10
+
+func historyPrefix(key string) []byte {
+	return []byte("\x00history\x00" + key + "\x00")
+}
+
+func historyKey(key string, nanos int64) []byte {
+	prefix := historyPrefix(key)
+	k := make([]byte, len(prefix)+8)
+	copy(k, prefix)
+	binary.BigEndian.PutUint64(k[len(prefix):], uint64(nanos))
+	return k
+}
+
+// writeHistory appends obj's position to its history within txn(if the object keeps one), trimming the oldest
+// entries beyond the store's history max
+func (s *Store) writeHistory(txn *badger.Txn, obj *api.Object, nanos int64) error {
+	if !obj.KeepHistory || s.historyMax <= 0 {
+		return nil
+	}
+	bits, err := proto.Marshal(&api.HistoryPoint{
+		Point:          obj.Point,
+		UpdatedUnix:    obj.UpdatedUnix,
+		TimestampNanos: nanos,
+	})
+	if err != nil {
+		return err
+	}
+	if err := txn.SetEntry(&badger.Entry{
+		Key:       historyKey(obj.Key, nanos),
+		Value:     bits,
+		UserMeta:  historyMeta,
+		ExpiresAt: uint64(obj.ExpiresUnix),
+	}); err != nil {
+		return err
+	}
+	keys := historyKeys(txn, obj.Key)
+	for len(keys) > s.historyMax {
+		if err := txn.Delete(keys[0]); err != nil {
+			return err
+		}
+		keys = keys[1:]
+	}
+	return nil
+}
+
+// deleteHistory deletes every history entry of the object with the given key within txn
+func deleteHistory(txn *badger.Txn, key string) error {
+	for _, k := range historyKeys(txn, key) {
+		if err := txn.Delete(k); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func historyKeys(txn *badger.Txn, key string) [][]byte {
+	var keys [][]byte
+	prefix := historyPrefix(key)
+	opts := badger.DefaultIteratorOptions
+	opts.PrefetchValues = false
+	opts.Prefix = prefix
+	iter := txn.NewIterator(opts)
+	defer iter.Close()
+	for iter.Seek(prefix); iter.ValidForPrefix(prefix); iter.Next() {
+		if iter.Item().UserMeta() != historyMeta {
+			continue
+		}
+		keys = append(keys, iter.Item().KeyCopy(nil))
+	}
+	return keys
+}
+
+// GetHistory returns the recorded positions of the object with the given key, oldest first. if limit > 0 only the
+// most recent limit positions are returned.
+func (s *Store) GetHistory(ctx context.Context, key string, limit int) ([]*api.HistoryPoint, error) {
+	if !inScope(ctx, []byte(key)) {
+		return nil, nil
+	}
+	txn := s.db.NewTransaction(false)
+	defer txn.Discard()
+	var points []*api.HistoryPoint
+	prefix := historyPrefix(key)
+	opts := s.scanOptions()
+	opts.Prefix = prefix
+	iter := txn.NewIterator(opts)
+	defer iter.Close()
+	for iter.Seek(prefix); iter.ValidForPrefix(prefix); iter.Next() {
+		item := iter.Item()
+		if item.UserMeta() != historyMeta {
+			continue
+		}
+		res, err := item.ValueCopy(nil)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to copy data: %s", err.Error())
+		}
+		var point = &api.HistoryPoint{}
+		if err := proto.Unmarshal(res, point); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to unmarshal protobuf: %s", err.Error())
+		}
+		points = append(points, point)
+	}
+	if limit > 0 && len(points) > limit {
+		points = points[len(points)-limit:]
+	}
+	return points, nil
+}
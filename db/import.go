@@ -0,0 +1,197 @@
+package db
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	api "github.com/autom8ter/geodb/gen/go/geodb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"io"
+	"strconv"
+	"strings"
+)
+
+type csvColumns struct {
+	key      int
+	lat      int
+	lon      int
+	radius   int
+	metadata map[string]int
+}
+
+func (s *Store) ImportCSV(ctx context.Context, r *api.ImportCSVRequest) (*api.ImportCSVResponse, error) {
+	reader := csv.NewReader(strings.NewReader(r.Csv))
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+	resp := &api.ImportCSVResponse{}
+	var (
+		columns *csvColumns
+		line    int64
+	)
+	if r.Header {
+		header, err := reader.Read()
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "failed to read header: %s", err.Error())
+		}
+		line++
+		columns, err = headerColumns(header, r.Columns)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		var err error
+		columns, err = indexColumns(r.Columns)
+		if err != nil {
+			return nil, err
+		}
+	}
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		line++
+		if err != nil {
+			if perr, ok := err.(*csv.ParseError); ok {
+				line = int64(perr.Line)
+				resp.Errors = append(resp.Errors, &api.CSVRowError{Line: line, Error: perr.Err.Error()})
+				continue
+			}
+			return nil, status.Errorf(codes.InvalidArgument, "failed to read csv: %s", err.Error())
+		}
+		obj, err := csvObject(record, columns, r.DefaultRadius)
+		if err == nil {
+			// rows are imported into ctx's namespace
+			obj.Key = scopePrefix(ctx) + obj.Key
+			err = obj.Validate()
+		}
+		if err != nil {
+			resp.Errors = append(resp.Errors, &api.CSVRowError{Line: line, Error: err.Error()})
+			continue
+		}
+		if !r.DryRun {
+			if _, err := s.Set(ctx, obj); err != nil {
+				resp.Errors = append(resp.Errors, &api.CSVRowError{Line: line, Error: err.Error()})
+				continue
+			}
+		}
+		resp.Imported++
+	}
+	return resp, nil
+}
+
+func headerColumns(header []string, mapping *api.CSVColumns) (*csvColumns, error) {
+	names := map[string]int{}
+	for i, name := range header {
+		names[strings.TrimSpace(name)] = i
+	}
+	lookup := func(name, def string, required bool) (int, error) {
+		if name == "" {
+			name = def
+		}
+		i, ok := names[name]
+		if !ok {
+			if required {
+				return -1, status.Errorf(codes.InvalidArgument, "missing csv column: %s", name)
+			}
+			return -1, nil
+		}
+		return i, nil
+	}
+	columns := &csvColumns{metadata: map[string]int{}}
+	var err error
+	if columns.key, err = lookup(mapping.GetKey(), "key", true); err != nil {
+		return nil, err
+	}
+	if columns.lat, err = lookup(mapping.GetLat(), "lat", true); err != nil {
+		return nil, err
+	}
+	if columns.lon, err = lookup(mapping.GetLon(), "lon", true); err != nil {
+		return nil, err
+	}
+	if columns.radius, err = lookup(mapping.GetRadius(), "radius", mapping.GetRadius() != ""); err != nil {
+		return nil, err
+	}
+	for _, name := range mapping.GetMetadata() {
+		i, err := lookup(name, "", true)
+		if err != nil {
+			return nil, err
+		}
+		columns.metadata[name] = i
+	}
+	return columns, nil
+}
+
+func indexColumns(mapping *api.CSVColumns) (*csvColumns, error) {
+	index := func(col string, def int) (int, error) {
+		if col == "" {
+			return def, nil
+		}
+		i, err := strconv.Atoi(col)
+		if err != nil || i < 0 {
+			return -1, status.Errorf(codes.InvalidArgument, "invalid csv column index: %s", col)
+		}
+		return i, nil
+	}
+	columns := &csvColumns{metadata: map[string]int{}}
+	var err error
+	if columns.key, err = index(mapping.GetKey(), 0); err != nil {
+		return nil, err
+	}
+	if columns.lat, err = index(mapping.GetLat(), 1); err != nil {
+		return nil, err
+	}
+	if columns.lon, err = index(mapping.GetLon(), 2); err != nil {
+		return nil, err
+	}
+	if columns.radius, err = index(mapping.GetRadius(), 3); err != nil {
+		return nil, err
+	}
+	for _, col := range mapping.GetMetadata() {
+		i, err := index(col, -1)
+		if err != nil {
+			return nil, err
+		}
+		columns.metadata[col] = i
+	}
+	return columns, nil
+}
+
+func csvObject(record []string, columns *csvColumns, defaultRadius int64) (*api.Object, error) {
+	field := func(i int) string {
+		if i < 0 || i >= len(record) {
+			return ""
+		}
+		return strings.TrimSpace(record[i])
+	}
+	lat, err := strconv.ParseFloat(field(columns.lat), 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid lat: %q", field(columns.lat))
+	}
+	lon, err := strconv.ParseFloat(field(columns.lon), 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid lon: %q", field(columns.lon))
+	}
+	radius := defaultRadius
+	if val := field(columns.radius); val != "" {
+		radius, err = strconv.ParseInt(val, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid radius: %q", val)
+		}
+	}
+	obj := &api.Object{
+		Key:    field(columns.key),
+		Point:  &api.Point{Lat: lat, Lon: lon},
+		Radius: radius,
+	}
+	if len(columns.metadata) > 0 {
+		obj.Metadata = map[string]string{}
+		for name, i := range columns.metadata {
+			if val := field(i); val != "" {
+				obj.Metadata[name] = val
+			}
+		}
+	}
+	return obj, nil
+}
@@ -1,32 +1,68 @@
 package db
 
 import (
+	"context"
 	"github.com/dgraph-io/badger/v2"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"regexp"
 )
 
-func GetKeys(db *badger.DB) []string {
-	txn := db.NewTransaction(false)
-	defer txn.Discard()
+// GetKeys returns up to limit object keys with the given prefix(optional) in key order(or reverse key order), resuming after cursor.
+// iteration stops before endKey(optional, exclusive). if more keys remain, the last returned key is returned as the next cursor.
+// a limit <= 0 returns every key
+func (s *Store) GetKeys(ctx context.Context, prefix, cursor, endKey string, limit int, reverse bool) ([]string, string) {
+	txn, done := s.readTxn(ctx)
+	defer done()
 	keys := []string{}
 	opts := badger.DefaultIteratorOptions
 	opts.PrefetchValues = false
+	opts.Reverse = reverse
 	iter := txn.NewIterator(opts)
-	for iter.Rewind(); iter.Valid(); iter.Next() {
+	defer iter.Close()
+	for seekCursor(iter, prefix, cursor, reverse); iter.ValidForPrefix([]byte(prefix)); iter.Next() {
 		item := iter.Item()
-		if item.UserMeta() != 1 {
+		if !s.visible(ctx, item) {
 			continue
 		}
+		if endKey != "" {
+			if key := string(item.Key()); (!reverse && key >= endKey) || (reverse && key <= endKey) {
+				break
+			}
+		}
+		if limit > 0 && len(keys) == limit {
+			return keys, keys[len(keys)-1]
+		}
 		keys = append(keys, string(item.Key()))
 	}
-	iter.Close()
-	return keys
+	return keys, ""
 }
 
-func GetPrefixKeys(db *badger.DB, prefix string) []string {
-	txn := db.NewTransaction(false)
+// seekCursor positions iter at the first key after cursor, or at the first key with the prefix if cursor is empty. reverse
+// must match the iterator's options, in which case the first key is the last one with the prefix
+func seekCursor(iter *badger.Iterator, prefix, cursor string, reverse bool) {
+	if cursor == "" {
+		switch {
+		case !reverse:
+			iter.Seek([]byte(prefix))
+		case prefix == "":
+			iter.Rewind()
+		default:
+			// reverse seeks land on the last key <= the seek key, so seek past every key with the prefix
+			iter.Seek(append([]byte(prefix), 0xff))
+		}
+		return
+	}
+	iter.Seek([]byte(cursor))
+	if iter.Valid() && string(iter.Item().Key()) == cursor {
+		iter.Next()
+	}
+}
+
+// GetPrefixKeys returns up to limit keys with the given prefix in key order without reading their values. a limit <= 0
+// returns every key with the prefix
+func (s *Store) GetPrefixKeys(ctx context.Context, prefix string, limit int) []string {
+	txn := s.db.NewTransaction(false)
 	defer txn.Discard()
 	keys := []string{}
 	opts := badger.DefaultIteratorOptions
@@ -34,35 +70,131 @@ func GetPrefixKeys(db *badger.DB, prefix string) []string {
 	iter := txn.NewIterator(opts)
 	for iter.Seek([]byte(prefix)); iter.ValidForPrefix([]byte(prefix)); iter.Next() {
 		item := iter.Item()
-		if item.UserMeta() != 1 {
+		if !s.visible(ctx, item) {
 			continue
 		}
+		if limit > 0 && len(keys) == limit {
+			break
+		}
 		keys = append(keys, string(item.Key()))
 	}
 	iter.Close()
 	return keys
 }
 
-func GetRegexKeys(db *badger.DB, regex string) ([]string, error) {
-	txn := db.NewTransaction(false)
+// GetRegexKeys returns the keys with the given prefix(optional) whose remainder after the prefix matches regex
+func (s *Store) GetRegexKeys(ctx context.Context, prefix, regex string) ([]string, error) {
+	re, err := regexp.Compile(regex)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to match regex: %s", err.Error())
+	}
+	txn := s.db.NewTransaction(false)
 	defer txn.Discard()
 	keys := []string{}
 	opts := badger.DefaultIteratorOptions
 	opts.PrefetchValues = false
 	iter := txn.NewIterator(opts)
-	for iter.Rewind(); iter.Valid(); iter.Next() {
+	for iter.Seek([]byte(prefix)); iter.ValidForPrefix([]byte(prefix)); iter.Next() {
 		item := iter.Item()
-		if item.UserMeta() != 1 {
+		if !s.visible(ctx, item) {
 			continue
 		}
-		match, err := regexp.MatchString(string(regex), string(item.Key()))
-		if err != nil {
-			return nil, status.Error(codes.InvalidArgument, err.Error())
-		}
-		if match {
+		if re.Match(item.Key()[len(prefix):]) {
 			keys = append(keys, string(item.Key()))
+			if err := checkMaxResults(ctx, len(keys)); err != nil {
+				iter.Close()
+				return nil, err
+			}
 		}
 	}
 	iter.Close()
 	return keys, nil
 }
+
+// Exists reports whether an object is stored under each key. values aren't read, and keys holding anything other than an
+// object(indexes, history, ...) are reported as missing
+func (s *Store) Exists(ctx context.Context, keys []string) (map[string]bool, error) {
+	txn := s.db.NewTransaction(false)
+	defer txn.Discard()
+	exists := map[string]bool{}
+	for _, key := range keys {
+		item, err := txn.Get([]byte(key))
+		if err != nil {
+			if err == badger.ErrKeyNotFound {
+				exists[key] = false
+				continue
+			}
+			return nil, status.Errorf(codes.Internal, "failed to get key: %s", err.Error())
+		}
+		exists[key] = s.visible(ctx, item)
+	}
+	return exists, nil
+}
+
+// sentinel TTLs returned by GetTTL
+const (
+	TTLNoExpiration int64 = -1
+	TTLNotFound     int64 = -2
+)
+
+// GetTTL returns the seconds until the object stored under each key expires. objects that don't expire are TTLNoExpiration &
+// missing or already expired objects are TTLNotFound. values aren't read
+func (s *Store) GetTTL(ctx context.Context, keys []string) (map[string]int64, error) {
+	txn := s.db.NewTransaction(false)
+	defer txn.Discard()
+	now := s.now().Unix()
+	ttls := map[string]int64{}
+	for _, key := range keys {
+		item, err := txn.Get([]byte(key))
+		if err != nil {
+			if err == badger.ErrKeyNotFound {
+				ttls[key] = TTLNotFound
+				continue
+			}
+			return nil, status.Errorf(codes.Internal, "failed to get key: %s", err.Error())
+		}
+		switch {
+		case !s.visible(ctx, item):
+			ttls[key] = TTLNotFound
+		case item.ExpiresAt() == 0:
+			ttls[key] = TTLNoExpiration
+		case int64(item.ExpiresAt()) < now:
+			// expired, but not yet hidden by badger(ex: the store's clock is ahead)
+			ttls[key] = TTLNotFound
+		default:
+			ttls[key] = int64(item.ExpiresAt()) - now
+		}
+	}
+	return ttls, nil
+}
+
+// Count returns the number of objects whose keys match the optional prefix and regex without reading their values
+func (s *Store) Count(ctx context.Context, prefix, regex string) (int64, error) {
+	var re *regexp.Regexp
+	if regex != "" {
+		var err error
+		re, err = regexp.Compile(regex)
+		if err != nil {
+			return 0, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+	txn := s.db.NewTransaction(false)
+	defer txn.Discard()
+	opts := badger.DefaultIteratorOptions
+	opts.PrefetchValues = false
+	opts.Prefix = []byte(prefix)
+	iter := txn.NewIterator(opts)
+	defer iter.Close()
+	var count int64
+	for iter.Rewind(); iter.Valid(); iter.Next() {
+		item := iter.Item()
+		if !s.visible(ctx, item) {
+			continue
+		}
+		if re != nil && !re.Match(scopedKey(ctx, item.Key())) {
+			continue
+		}
+		count++
+	}
+	return count, nil
+}
@@ -0,0 +1,23 @@
+package db
+
+import (
+	"context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type maxResultsCtxKey struct{}
+
+// WithMaxResults caps the number of objects returned by the store's scans with ctx: scans matching more than max
+// objects fail with RESOURCE_EXHAUSTED instead of buffering every match. max <= 0 disables the cap
+func WithMaxResults(ctx context.Context, max int) context.Context {
+	return context.WithValue(ctx, maxResultsCtxKey{}, max)
+}
+
+// checkMaxResults fails once a scan with ctx matched more than its max results(see WithMaxResults)
+func checkMaxResults(ctx context.Context, matched int) error {
+	if max, _ := ctx.Value(maxResultsCtxKey{}).(int); max > 0 && matched > max {
+		return status.Errorf(codes.ResourceExhausted, "more than %v objects match(GEODB_MAX_RESULTS). narrow the query", max)
+	}
+	return nil
+}
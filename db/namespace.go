@@ -0,0 +1,74 @@
+package db
+
+import (
+	"bytes"
+	"context"
+	api "github.com/autom8ter/geodb/gen/go/geodb"
+	"github.com/dgraph-io/badger/v2"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"strings"
+)
+
+// NamespaceSeparator separates a namespace from the keys of its objects(stored as <namespace>\x1f<key>). keys can't
+// contain it, so namespaced keys never collide with global ones
+const NamespaceSeparator = "\x1f"
+
+type namespaceCtxKey struct{}
+
+// NamespacePrefix returns the key prefix of the namespace's objects. the empty namespace is the global keyspace & has no prefix
+func NamespacePrefix(namespace string) string {
+	if namespace == "" {
+		return ""
+	}
+	return namespace + NamespaceSeparator
+}
+
+// InNamespace reports whether key belongs to the namespace with the given prefix. global keys are the ones outside of every namespace
+func InNamespace(prefix, key string) bool {
+	return strings.HasPrefix(key, prefix) && !strings.Contains(key[len(prefix):], NamespaceSeparator)
+}
+
+// WithNamespace scopes the store's reads & writes with ctx to the objects of the namespace: objects outside of it are
+// skipped by reads & can't be written. reads with a ctx without a namespace see every object
+func WithNamespace(ctx context.Context, namespace string) context.Context {
+	return context.WithValue(ctx, namespaceCtxKey{}, NamespacePrefix(namespace))
+}
+
+// inScope reports whether key belongs to ctx's namespace(if any)
+func inScope(ctx context.Context, key []byte) bool {
+	prefix, ok := ctx.Value(namespaceCtxKey{}).(string)
+	if !ok {
+		return true
+	}
+	return bytes.HasPrefix(key, []byte(prefix)) && !bytes.Contains(key[len(prefix):], []byte(NamespaceSeparator))
+}
+
+// scopePrefix returns the key prefix of ctx's namespace(empty if it has none)
+func scopePrefix(ctx context.Context) string {
+	prefix, _ := ctx.Value(namespaceCtxKey{}).(string)
+	return prefix
+}
+
+// scopedKey returns key without the prefix of ctx's namespace, which is what regexes are matched against
+func scopedKey(ctx context.Context, key []byte) []byte {
+	return bytes.TrimPrefix(key, []byte(scopePrefix(ctx)))
+}
+
+// visible reports whether the item is a live object in ctx's namespace
+func (s *Store) visible(ctx context.Context, item *badger.Item) bool {
+	return s.live(item) && inScope(ctx, item.Key())
+}
+
+// checkScope rejects objects outside of ctx's namespace & trackers targeting objects outside of it
+func checkScope(ctx context.Context, obj *api.Object) error {
+	if !inScope(ctx, []byte(obj.Key)) {
+		return status.Errorf(codes.InvalidArgument, "%s: key outside of namespace", obj.Key)
+	}
+	for _, tracker := range obj.GetTracking().GetTrackers() {
+		if !inScope(ctx, []byte(tracker.TargetObjectKey)) {
+			return status.Errorf(codes.InvalidArgument, "%s: tracker target outside of namespace: %s", obj.Key, tracker.TargetObjectKey)
+		}
+	}
+	return nil
+}
@@ -2,70 +2,222 @@ package db
 
 import (
 	"context"
+	"github.com/autom8ter/geodb/config"
 	api "github.com/autom8ter/geodb/gen/go/geodb"
 	"github.com/autom8ter/geodb/helpers"
-	"github.com/autom8ter/geodb/maps"
-	"github.com/autom8ter/geodb/metrics"
-	"github.com/autom8ter/geodb/stream"
+	"github.com/autom8ter/geodb/logging"
 	"github.com/dgraph-io/badger/v2"
 	"github.com/gogo/protobuf/proto"
-	geo "github.com/paulmach/go.geo"
-	log "github.com/sirupsen/logrus"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"regexp"
+	"sort"
+	"strings"
 	"sync"
 	"time"
 )
 
-func Set(db *badger.DB, maps *maps.Client, hub *stream.Hub, obj *api.Object) (*api.ObjectDetail, error) {
+func (s *Store) Set(ctx context.Context, obj *api.Object) (*api.ObjectDetail, error) {
+	return s.SetIfVersion(ctx, obj, 0)
+}
+
+// SetIfVersion writes obj only if the stored object's version is ifVersion, returning FAILED_PRECONDITION otherwise.
+// the version check & write happen in a single transaction. an ifVersion of 0 writes unconditionally
+func (s *Store) SetIfVersion(ctx context.Context, obj *api.Object, ifVersion int64) (*api.ObjectDetail, error) {
+	return s.SetWithOptions(ctx, obj, SetOptions{IfVersion: ifVersion})
+}
+
+// DryRun returns the object detail(version, odometer & tracker events) SetIfVersion would write for obj against the
+// stored data. the write transaction is discarded instead of committed & nothing is streamed
+func (s *Store) DryRun(ctx context.Context, obj *api.Object, ifVersion int64) (*api.ObjectDetail, error) {
+	return s.SetWithOptions(ctx, obj, SetOptions{IfVersion: ifVersion, DryRun: true})
+}
+
+// SetMergeMetadata is SetIfVersion, except obj's metadata is merged into the stored object's metadata(obj's values win on
+// conflict) instead of replacing it. the stored object is read in the write transaction. if dryRun is set, nothing is
+// written like DryRun
+func (s *Store) SetMergeMetadata(ctx context.Context, obj *api.Object, ifVersion int64, dryRun bool) (*api.ObjectDetail, error) {
+	return s.SetWithOptions(ctx, obj, SetOptions{IfVersion: ifVersion, DryRun: dryRun, MergeMetadata: true})
+}
+
+// SetOptions configures a write(see SetWithOptions)
+type SetOptions struct {
+	// IfVersion only writes the object if the stored object's version matches(see SetIfVersion). 0 writes unconditionally
+	IfVersion int64
+	// DryRun discards the write instead of committing it(see DryRun)
+	DryRun bool
+	// MergeMetadata merges the object's metadata into the stored object's metadata(see SetMergeMetadata)
+	MergeMetadata bool
+	// RefreshTTL restarts the stored object's ttl_seconds from the write if the object is written without an expiration,
+	// so objects written periodically stay alive(sliding expiration) without resending their ttl
+	RefreshTTL bool
+}
+
+// SetWithOptions writes obj as configured by opts
+func (s *Store) SetWithOptions(ctx context.Context, obj *api.Object, opts SetOptions) (*api.ObjectDetail, error) {
+	return s.set(ctx, obj, opts)
+}
+
+func (s *Store) set(ctx context.Context, obj *api.Object, opts SetOptions) (*api.ObjectDetail, error) {
+	// checked before the default ttl is applied
+	unexpiring := obj.TtlSeconds == 0 && obj.ExpiresUnix == 0
+	if err := s.prepareObject(ctx, obj); err != nil {
+		return nil, err
+	}
+	txn := s.db.NewTransaction(true)
+	defer txn.Discard()
+	if opts.MergeMetadata || (opts.RefreshTTL && unexpiring) {
+		previous, err := storedObject(txn, obj.Key)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get key: %s", err.Error())
+		}
+		if opts.RefreshTTL && unexpiring && previous.GetTtlSeconds() > 0 {
+			obj.TtlSeconds = previous.TtlSeconds
+			obj.ExpiresUnix = s.now().Add(time.Duration(obj.TtlSeconds) * time.Second).Unix()
+		}
+		if opts.MergeMetadata && len(previous.GetMetadata()) > 0 {
+			metadata := map[string]string{}
+			for k, v := range previous.Metadata {
+				metadata[k] = v
+			}
+			for k, v := range obj.Metadata {
+				metadata[k] = v
+			}
+			obj.Metadata = metadata
+		}
+	}
+	detail := s.objectDetail(ctx, txn, obj)
+	if err := setStoredFields(txn, obj, opts.IfVersion); err != nil {
+		if status.Code(err) == codes.FailedPrecondition {
+			return nil, err
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get key: %s", err.Error())
+	}
+	if err := writeDetail(txn, detail); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to set object: %s", err.Error())
+	}
+	if err := s.writeHistory(txn, obj, s.monotonicNanos()); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to record history: %s", err.Error())
+	}
+	if err := s.writeEvents(txn, detail); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to record events: %s", err.Error())
+	}
+	if opts.DryRun {
+		return detail, nil
+	}
+	if err := txn.Commit(); err != nil {
+		if err == badger.ErrConflict {
+			if opts.IfVersion > 0 {
+				// the concurrent write changed the version
+				return nil, status.Errorf(codes.FailedPrecondition, "version mismatch for key: %s(concurrent write)", obj.Key)
+			}
+			return nil, status.Errorf(codes.Aborted, "concurrent write to key: %s", obj.Key)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to commit object: %s", err.Error())
+	}
+	return s.publish(detail), nil
+}
+
+// prepareObject validates obj, applies the write rate limit & resolves its expiration
+func (s *Store) prepareObject(ctx context.Context, obj *api.Object) error {
+	if err := s.validateObject(ctx, obj); err != nil {
+		return err
+	}
+	if s.limiter != nil && !s.limiter.allow(obj.Key, s.now()) {
+		return status.Errorf(codes.ResourceExhausted, "rate limit exceeded for key: %s", obj.Key)
+	}
+	s.resolveExpiration(obj)
+	return nil
+}
+
+// validateObject rejects invalid objects & objects outside of ctx's namespace
+func (s *Store) validateObject(ctx context.Context, obj *api.Object) error {
 	if err := obj.Validate(); err != nil {
-		return nil, status.Error(codes.InvalidArgument, err.Error())
+		return status.Errorf(codes.InvalidArgument, "%s: %s", obj.Key, err.Error())
+	}
+	if err := checkScope(ctx, obj); err != nil {
+		return err
+	}
+	if n := len(obj.Polygon); n > 0 && n < 3 {
+		return status.Errorf(codes.InvalidArgument, "%s: a polygon needs at least 3 vertices, got: %v", obj.Key, n)
+	}
+	if len(obj.Polyline) == 1 {
+		return status.Errorf(codes.InvalidArgument, "%s: a polyline needs at least 2 points", obj.Key)
+	}
+	return nil
+}
+
+// resolveExpiration sets the expiration of obj from its ttl_seconds or the store's default ttl
+func (s *Store) resolveExpiration(obj *api.Object) {
+	switch {
+	case obj.TtlSeconds > 0:
+		obj.ExpiresUnix = s.now().Add(time.Duration(obj.TtlSeconds) * time.Second).Unix()
+	case obj.ExpiresUnix == 0 && s.ttl > 0:
+		obj.ExpiresUnix = s.now().Add(s.ttl).Unix()
 	}
+}
+
+// objectDetail computes the tracker events, geofence events, address and timezone of obj. the transitions are computed
+// from the object's previous detail as of txn, which is read in the transaction writing obj so concurrent writes to the
+// same key conflict instead of computing transitions from a stale detail
+func (s *Store) objectDetail(ctx context.Context, txn *badger.Txn, obj *api.Object) *api.ObjectDetail {
 	if obj.UpdatedUnix == 0 {
-		obj.UpdatedUnix = time.Now().Unix()
+		obj.UpdatedUnix = s.now().Unix()
 	}
-	metrics.GaugeObjectLocation(obj.Key, obj.Point)
-	point1 := geo.NewPointFromLatLng(obj.Point.Lat, obj.Point.Lon)
+	obj.Geohash = helpers.Geohash(obj.Point, s.geohashPrecision)
+	eventNanos := s.monotonicNanos()
 	mu := &sync.Mutex{}
 	wg := &sync.WaitGroup{}
-	var events = map[string]*api.TrackerEvent{}
+	var (
+		events = map[string]*api.TrackerEvent{}
+		// targets without an event that the object was inside of
+		carried = map[string]bool{}
+	)
+	eventMetadataKeys := trackerEventMetadataKeys()
+	previous := s.previousDetail(ctx, txn, obj.Key)
 	if obj.GetTracking() != nil && len(obj.GetTracking().GetTrackers()) > 0 {
+		wasInside := insideTargets(previous)
 		for _, t := range obj.GetTracking().GetTrackers() {
+			if t.GetTargetObjectKey() == obj.Key {
+				// an object is always inside itself
+				continue
+			}
 			wg.Add(1)
 			go func(val *api.Object, tracker *api.ObjectTracker) {
 				defer wg.Done()
-				txn := db.NewTransaction(false)
-
+				txn := s.db.NewTransaction(false)
+				defer txn.Discard()
 				item, err := txn.Get([]byte(tracker.GetTargetObjectKey()))
-				if err != nil {
+				if err != nil || !s.live(item) {
 					return
 				}
 				res, err := item.ValueCopy(nil)
 				if err != nil {
-					log.Error(err.Error())
+					logging.Entry(ctx).Error(err.Error())
 					return
 				}
 				var obj = &api.ObjectDetail{}
 				if err := proto.Unmarshal(res, obj); err != nil {
-					log.Error(err.Error())
+					logging.Entry(ctx).Error(err.Error())
 					return
 				}
-				if obj.Object.Point == nil {
-					return
+				var trackerEvent *api.TrackerEvent
+				if s.triggers(val, obj.Object) {
+					trackerEvent = newTrackerEvent(val, obj.Object, tracker, wasInside, eventNanos, eventMetadataKeys)
 				}
-				point2 := geo.NewPointFromLatLng(obj.Object.Point.Lat, obj.Object.Point.Lon)
-				dist := point1.GeoDistanceFrom(point2, true)
-				trackerEvent := &api.TrackerEvent{
-					Object:        obj.Object,
-					Distance:      dist,
-					Inside:        dist <= float64(val.Radius+obj.Object.Radius),
-					TimestampUnix: val.UpdatedUnix,
+				if trackerEvent == nil {
+					// skipped targets keep their inside state, so they don't enter again once they're tracked
+					if wasInside[obj.Object.Key] {
+						mu.Lock()
+						carried[obj.Object.Key] = true
+						mu.Unlock()
+					}
+					return
 				}
-				if maps != nil && val.Tracking != nil {
-					directions, eta, dist, err := maps.TravelDetail(context.Background(), val.Point, obj.Object.Point, helpers.ToTravelMode(val.GetTracking().GetTravelMode()))
+				if s.maps != nil && val.Tracking != nil {
+					directions, eta, dist, err := s.maps.TravelDetail(ctx, val.Point, obj.Object.Point, helpers.ToTravelMode(val.GetTracking().GetTravelMode()))
 					if err != nil {
-						log.Error(err.Error())
+						logging.Entry(ctx).Error(err.Error())
 					} else {
 						trackerEvent.Direction = &api.Directions{}
 						if tracker.TrackDirections {
@@ -82,21 +234,34 @@ func Set(db *badger.DB, maps *maps.Client, hub *stream.Hub, obj *api.Object) (*a
 				mu.Lock()
 				events[obj.Object.Key] = trackerEvent
 				mu.Unlock()
-				txn.Discard()
 			}(obj, t)
 		}
 	}
 	var (
 		address *api.Address
 		zone    string
+		fenced  []*api.GeofenceEvent
 	)
 	wg.Add(1)
 	go func(val *api.Object) {
 		defer wg.Done()
-		if maps != nil && val.GetAddress {
-			addr, err := maps.GetAddress(val.Point)
+		// txn isn't safe for concurrent use
+		txn := s.db.NewTransaction(false)
+		defer txn.Discard()
+		fences, err := listGeofences(txn)
+		if err != nil {
+			logging.Entry(ctx).Error(err.Error())
+			return
+		}
+		fenced = geofenceEvents(fences, val, insideGeofences(previous), eventNanos)
+	}(obj)
+	wg.Add(1)
+	go func(val *api.Object) {
+		defer wg.Done()
+		if s.maps != nil && val.GetAddress {
+			addr, err := s.maps.GetAddress(val.Point)
 			if err != nil {
-				log.Error(err.Error())
+				logging.Entry(ctx).Error(err.Error())
 			} else {
 				address = addr
 			}
@@ -105,10 +270,10 @@ func Set(db *badger.DB, maps *maps.Client, hub *stream.Hub, obj *api.Object) (*a
 	wg.Add(1)
 	go func(val *api.Object) {
 		defer wg.Done()
-		if maps != nil && val.GetTimezone {
-			z, err := maps.GetTimezone(val.Point)
+		if s.maps != nil && val.GetTimezone {
+			z, err := s.maps.GetTimezone(val.Point)
 			if err != nil {
-				log.Error(err.Error())
+				logging.Entry(ctx).Error(err.Error())
 			} else {
 				zone = z
 			}
@@ -129,37 +294,188 @@ func Set(db *badger.DB, maps *maps.Client, hub *stream.Hub, obj *api.Object) (*a
 			detail.TrackerEvents = append(detail.TrackerEvents, event)
 		}
 	}
+	detail.GeofenceEvents = fenced
+	detail.InsideTargets = insideTargetKeys(detail.TrackerEvents, carried)
+	return detail
+}
+
+// previousDetail returns the detail of the object with the given key stored as of txn, or nil if it isn't stored or expired
+func (s *Store) previousDetail(ctx context.Context, txn *badger.Txn, key string) *api.ObjectDetail {
+	item, err := txn.Get([]byte(key))
+	if err != nil || !s.live(item) {
+		return nil
+	}
+	res, err := item.ValueCopy(nil)
+	if err != nil {
+		logging.Entry(ctx).Error(err.Error())
+		return nil
+	}
+	var previous = &api.ObjectDetail{}
+	if err := proto.Unmarshal(res, previous); err != nil {
+		logging.Entry(ctx).Error(err.Error())
+		return nil
+	}
+	return previous
+}
+
+// insideTargets returns the target keys the detail's object was overlapping. details stored before inside_targets was
+// recorded only have their tracker events
+func insideTargets(detail *api.ObjectDetail) map[string]bool {
+	inside := map[string]bool{}
+	for _, key := range detail.GetInsideTargets() {
+		inside[key] = true
+	}
+	for _, event := range detail.GetTrackerEvents() {
+		if event.Inside {
+			inside[event.GetObject().GetKey()] = true
+		}
+	}
+	return inside
+}
+
+// insideTargetKeys returns the sorted keys of the overlapping events' targets & the carried targets
+func insideTargetKeys(events []*api.TrackerEvent, carried map[string]bool) []string {
+	var keys []string
+	for key := range carried {
+		keys = append(keys, key)
+	}
+	for _, event := range events {
+		if event.Inside && !carried[event.GetObject().GetKey()] {
+			keys = append(keys, event.GetObject().GetKey())
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// newTrackerEvent returns the tracker event of val against target, or nil if the target doesn't pass the tracker's tag filters
+func newTrackerEvent(val, target *api.Object, tracker *api.ObjectTracker, wasInside map[string]bool, nanos int64, metadataKeys []string) *api.TrackerEvent {
+	if target.GetPoint() == nil {
+		return nil
+	}
+	if !helpers.MatchTags(target.Tags, tracker.TargetTags) || !helpers.MatchTagRelation(val.Tags, target.Tags, val.GetTracking().GetTagRelation()) {
+		return nil
+	}
+	dist := helpers.ObjectDistance(val, target)
+	event := &api.TrackerEvent{
+		Object:         target,
+		Distance:       helpers.FromMeters(dist, val.GetTracking().GetDistanceUnit()),
+		Inside:         dist <= float64(val.Radius+target.Radius),
+		TimestampUnix:  val.UpdatedUnix,
+		TimestampNanos: nanos,
+	}
+	event.EventType = eventType(wasInside[target.Key], event.Inside)
+	if len(metadataKeys) > 0 {
+		event.Metadata = helpers.SelectMetadata(target.Metadata, metadataKeys)
+	}
+	return event
+}
+
+// trackerEventMetadataKeys returns the target metadata keys copied onto tracker events(GEODB_TRACKER_EVENT_METADATA_KEYS)
+func trackerEventMetadataKeys() []string {
+	if keys := config.Config.GetString("GEODB_TRACKER_EVENT_METADATA_KEYS"); keys != "" {
+		return strings.Split(keys, ",")
+	}
+	return nil
+}
+
+func eventType(wasInside, inside bool) api.EventType {
+	switch {
+	case inside && wasInside:
+		return api.EventType_Inside
+	case inside:
+		return api.EventType_Enter
+	case wasInside:
+		return api.EventType_Exit
+	default:
+		return api.EventType_Outside
+	}
+}
+
+// setStoredFields sets the server assigned fields of obj that depend on the stored object: the version is set to the stored
+// object's version + 1, the odometer is advanced by the distance from the stored point & the stored counters are kept.
+// if ifVersion > 0, the stored object's version must match it
+func setStoredFields(txn *badger.Txn, obj *api.Object, ifVersion int64) error {
+	previous, err := storedObject(txn, obj.Key)
+	if err != nil {
+		return err
+	}
+	if ifVersion > 0 && previous.GetVersion() != ifVersion {
+		return status.Errorf(codes.FailedPrecondition, "version mismatch for key: %s expected: %v stored: %v", obj.Key, ifVersion, previous.GetVersion())
+	}
+	obj.Version = previous.GetVersion() + 1
+	obj.Counters = previous.GetCounters()
+	obj.OdometerMeters = 0
+	if obj.TrackOdometer {
+		obj.OdometerMeters = previous.GetOdometerMeters()
+		if previous.GetPoint() != nil && obj.Point != nil {
+			obj.OdometerMeters += helpers.Distance(previous.Point, obj.Point)
+		}
+	}
+	return nil
+}
 
+// writeDetail stores detail and indexes its tags & geohash within txn
+func writeDetail(txn *badger.Txn, detail *api.ObjectDetail) error {
 	bits, err := proto.Marshal(detail)
 	if err != nil {
-		return nil, err
+		return err
+	}
+	previous, err := storedObject(txn, detail.Object.Key)
+	if err != nil {
+		return err
+	}
+	if err := indexTags(txn, detail.Object, previous.GetTags()); err != nil {
+		return err
+	}
+	if err := indexGeohash(txn, detail.Object, previous.GetGeohash()); err != nil {
+		return err
 	}
-	txn := db.NewTransaction(true)
-	if err := txn.SetEntry(&badger.Entry{
-		Key:       []byte(obj.Key),
+	return txn.SetEntry(&badger.Entry{
+		Key:       []byte(detail.Object.Key),
 		Value:     bits,
 		UserMeta:  1,
-		ExpiresAt: uint64(obj.ExpiresUnix),
-	}); err != nil {
-		return nil, err
+		ExpiresAt: uint64(detail.Object.ExpiresUnix),
+	})
+}
+
+// SetMany writes the objects in order. if atomic is set, either every object is written or none of them are(see setAtomic).
+func (s *Store) SetMany(ctx context.Context, objs []*api.Object, rejectDuplicates, atomic bool) ([]*api.ObjectDetail, error) {
+	seen := map[string]struct{}{}
+	for _, obj := range objs {
+		if err := obj.Validate(); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "%s: %s", obj.Key, err.Error())
+		}
+		if _, ok := seen[obj.Key]; ok && rejectDuplicates {
+			return nil, status.Errorf(codes.InvalidArgument, "duplicate key: %s", obj.Key)
+		}
+		seen[obj.Key] = struct{}{}
 	}
-	if err := txn.Commit(); err != nil {
-		return nil, err
+	if atomic {
+		return s.setAtomic(ctx, objs)
 	}
-	hub.PublishObject(detail)
-	return detail, nil
+	var details []*api.ObjectDetail
+	for _, obj := range objs {
+		detail, err := s.Set(ctx, obj)
+		if err != nil {
+			return nil, err
+		}
+		details = append(details, detail)
+	}
+	return details, nil
 }
 
-func Get(db *badger.DB, keys []string) (map[string]*api.ObjectDetail, error) {
-	txn := db.NewTransaction(false)
-	defer txn.Discard()
+// Get returns the objects with the given keys(or every object if keys is empty). missing keys are omitted from the result
+func (s *Store) Get(ctx context.Context, keys []string) (map[string]*api.ObjectDetail, error) {
+	txn, done := s.readTxn(ctx)
+	defer done()
 	objects := map[string]*api.ObjectDetail{}
 	if len(keys) == 0 {
-		iter := txn.NewIterator(badger.DefaultIteratorOptions)
+		iter := txn.NewIterator(s.scanOptions())
 		defer iter.Close()
 		for iter.Rewind(); iter.Valid(); iter.Next() {
 			item := iter.Item()
-			if item.UserMeta() != 1 {
+			if !s.visible(ctx, item) {
 				continue
 			}
 			res, err := item.ValueCopy(nil)
@@ -177,10 +493,13 @@ func Get(db *badger.DB, keys []string) (map[string]*api.ObjectDetail, error) {
 	} else {
 		for _, key := range keys {
 			i, err := txn.Get([]byte(key))
+			if err == badger.ErrKeyNotFound {
+				continue
+			}
 			if err != nil {
-				return nil, status.Errorf(codes.InvalidArgument, "failed to get key: %s", err.Error())
+				return nil, status.Errorf(codes.Internal, "failed to get key: %s", err.Error())
 			}
-			if i.UserMeta() != 1 {
+			if !s.visible(ctx, i) {
 				continue
 			}
 			res, err := i.ValueCopy(nil)
@@ -197,48 +516,71 @@ func Get(db *badger.DB, keys []string) (map[string]*api.ObjectDetail, error) {
 	return objects, nil
 }
 
-func GetRegex(db *badger.DB, regex string) (map[string]*api.ObjectDetail, error) {
-	txn := db.NewTransaction(false)
-	defer txn.Discard()
+// GetRegex returns up to limit objects with the given prefix(optional) whose keys match regex after the prefix, resuming after cursor in key order.
+// keyPrefix(optional) narrows the scan to the keys that begin with it after the prefix, so only they're matched against the regex.
+// if more matches remain, the last returned key is returned as the next cursor. a limit <= 0 returns every match. objects must also match the
+// metadata selector(optional) & have been updated within the time window(optional)
+func (s *Store) GetRegex(ctx context.Context, prefix, keyPrefix, regex, cursor string, limit int, metadata map[string]string, window *api.TimeWindow) (map[string]*api.ObjectDetail, string, error) {
+	re, err := regexp.Compile(regex)
+	if err != nil {
+		return nil, "", status.Errorf(codes.InvalidArgument, "failed to match regex: %s", err.Error())
+	}
+	txn, done := s.readTxn(ctx)
+	defer done()
 	objects := map[string]*api.ObjectDetail{}
+	scan := prefix + keyPrefix
+	if cursor < scan {
+		// the cursor is before every key in the scan, so start at the first one
+		cursor = ""
+	}
 	opts := badger.DefaultIteratorOptions
 	opts.PrefetchValues = false
+	opts.Prefix = []byte(scan)
 	iter := txn.NewIterator(opts)
 	defer iter.Close()
-	for iter.Rewind(); iter.Valid(); iter.Next() {
+	var last string
+	for seekCursor(iter, scan, cursor, false); iter.ValidForPrefix([]byte(scan)); iter.Next() {
 		item := iter.Item()
-		if item.UserMeta() != 1 {
+		if !s.visible(ctx, item) {
 			continue
 		}
-		match, err := regexp.MatchString(regex, string(item.Key()))
-		if err != nil {
-			return nil, status.Errorf(codes.InvalidArgument, "failed to match regex: %s", err.Error())
-		}
-		if match {
+		if re.Match(item.Key()[len(prefix):]) {
 			res, err := item.ValueCopy(nil)
 			if err != nil {
-				return nil, status.Errorf(codes.Internal, "failed to copy data: %s", err.Error())
+				return nil, "", status.Errorf(codes.Internal, "failed to copy data: %s", err.Error())
 			}
 			var obj = &api.ObjectDetail{}
 			if err := proto.Unmarshal(res, obj); err != nil {
-				return nil, status.Errorf(codes.Internal, "failed to unmarshal protobuf: %s", err.Error())
+				return nil, "", status.Errorf(codes.Internal, "failed to unmarshal protobuf: %s", err.Error())
+			}
+			if !helpers.MatchMetadata(obj.Object.Metadata, metadata) || !helpers.MatchTimeWindow(obj.Object, window) {
+				continue
 			}
-			objects[string(item.Key())] = obj
+			if limit > 0 && len(objects) == limit {
+				return objects, last, nil
+			}
+			last = string(item.Key())
+			objects[last] = obj
 		}
-
 	}
-	return objects, nil
+	return objects, "", nil
 }
 
-func GetPrefix(db *badger.DB, prefix string) (map[string]*api.ObjectDetail, error) {
-	txn := db.NewTransaction(false)
-	defer txn.Discard()
+func (s *Store) GetPrefix(ctx context.Context, prefix string, metadata map[string]string) (map[string]*api.ObjectDetail, error) {
+	return s.GetPrefixMax(ctx, prefix, metadata, nil, 0)
+}
+
+// GetPrefixMax is GetPrefix, but only returns objects updated within the time window(optional) & fails with
+// codes.ResourceExhausted as soon as more than max objects match instead of materializing the rest. a max <= 0 returns every match
+func (s *Store) GetPrefixMax(ctx context.Context, prefix string, metadata map[string]string, window *api.TimeWindow, max int) (map[string]*api.ObjectDetail, error) {
+	txn, done := s.readTxn(ctx)
+	defer done()
 	objects := map[string]*api.ObjectDetail{}
-	iter := txn.NewIterator(badger.DefaultIteratorOptions)
+	iter := txn.NewIterator(s.scanOptions())
 	defer iter.Close()
 	for iter.Seek([]byte(prefix)); iter.ValidForPrefix([]byte(prefix)); iter.Next() {
 		item := iter.Item()
-		if item.UserMeta() != 1 {
+		if !s.visible(ctx, item) {
 			continue
 		}
 		res, err := item.ValueCopy(nil)
@@ -249,27 +591,244 @@ func GetPrefix(db *badger.DB, prefix string) (map[string]*api.ObjectDetail, erro
 		if err := proto.Unmarshal(res, obj); err != nil {
 			return nil, status.Errorf(codes.Internal, "failed to unmarshal protobuf: %s", err.Error())
 		}
+		if !helpers.MatchMetadata(obj.Object.Metadata, metadata) || !helpers.MatchTimeWindow(obj.Object, window) {
+			continue
+		}
+		if max > 0 && len(objects) == max {
+			return nil, status.Errorf(codes.ResourceExhausted, "more than %v objects match prefix %s(GEODB_MAX_RESULTS). use a longer prefix or page the results with GetRegex", max, prefix)
+		}
 		objects[string(item.Key())] = obj
 	}
 	return objects, nil
 }
 
-func Delete(db *badger.DB, keys []string) error {
-	txn := db.NewTransaction(true)
-	defer txn.Discard()
+// GetUpdatedSince returns the objects with the given prefix(optional) updated at or after sinceUnix, ordered by updated_unix
+func (s *Store) GetUpdatedSince(ctx context.Context, prefix string, sinceUnix int64) ([]*api.ObjectDetail, error) {
+	objects, err := s.GetPrefix(ctx, prefix, nil)
+	if err != nil {
+		return nil, err
+	}
+	var updated []*api.ObjectDetail
+	for _, obj := range objects {
+		if obj.GetObject().GetUpdatedUnix() >= sinceUnix {
+			updated = append(updated, obj)
+		}
+	}
+	sort.Slice(updated, func(i, j int) bool {
+		if updated[i].Object.UpdatedUnix != updated[j].Object.UpdatedUnix {
+			return updated[i].Object.UpdatedUnix < updated[j].Object.UpdatedUnix
+		}
+		return updated[i].Object.Key < updated[j].Object.Key
+	})
+	return updated, nil
+}
+
+// Delete deletes the objects with the given keys, skipping those outside of ctx's namespace. "*" drops every object,
+// or only the namespace's objects if ctx has one
+func (s *Store) Delete(ctx context.Context, keys []string) error {
 	if len(keys) > 0 && keys[0] == "*" {
-		if err := db.DropAll(); err != nil {
+		if prefix := scopePrefix(ctx); prefix != "" {
+			_, err := s.deleteBatches(s.GetPrefixKeys(ctx, prefix, 0))
+			return err
+		}
+		if err := s.db.DropAll(); err != nil {
 			return status.Errorf(codes.Internal, "failed to delete key: %s", err.Error())
 		}
-	} else {
-		for _, key := range keys {
-			if err := txn.Delete([]byte(key)); err != nil {
-				return status.Errorf(codes.Internal, "failed to delete key: %s %s", key, err.Error())
-			}
+		return nil
+	}
+	var scoped []string
+	for _, key := range keys {
+		if inScope(ctx, []byte(key)) {
+			scoped = append(scoped, key)
+		}
+	}
+	return s.deleteKeys(scoped)
+}
+
+// deleteKeys deletes the objects, their index entries & history in a single transaction, then publishes a tombstone
+// for each deleted object so stream clients can remove it
+func (s *Store) deleteKeys(keys []string) error {
+	txn := s.db.NewTransaction(true)
+	defer txn.Discard()
+	var tombstones []*api.ObjectDetail
+	for _, key := range keys {
+		obj, err := storedObject(txn, key)
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to get key: %s %s", key, err.Error())
+		}
+		if obj != nil {
+			tombstones = append(tombstones, &api.ObjectDetail{Object: obj, Deleted: true})
+		}
+		if err := unindexTags(txn, key, obj.GetTags()); err != nil {
+			return status.Errorf(codes.Internal, "failed to delete key: %s %s", key, err.Error())
+		}
+		if err := unindexGeohash(txn, key, obj.GetGeohash()); err != nil {
+			return status.Errorf(codes.Internal, "failed to delete key: %s %s", key, err.Error())
+		}
+		if err := deleteHistory(txn, key); err != nil {
+			return status.Errorf(codes.Internal, "failed to delete key: %s %s", key, err.Error())
+		}
+		if err := txn.Delete([]byte(key)); err != nil {
+			return status.Errorf(codes.Internal, "failed to delete key: %s %s", key, err.Error())
 		}
 	}
 	if err := txn.Commit(); err != nil {
 		return status.Errorf(codes.Internal, "failed to delete keys %s", err.Error())
 	}
+	for _, tombstone := range tombstones {
+		s.hub.PublishObject(tombstone)
+	}
 	return nil
 }
+
+// inactiveAttempts bounds the retries of an inactivity sweep batch that conflicts with concurrent writes
+const inactiveAttempts = 10
+
+// DeleteInactive deletes the objects in ctx's namespace whose updated_unix is before the store's clock minus
+// maxInactivity, publishes a tombstone for each one & returns their keys. objects written again since the sweep scanned
+// them are kept
+func (s *Store) DeleteInactive(ctx context.Context, maxInactivity time.Duration) ([]string, error) {
+	cutoff := s.now().Add(-maxInactivity).Unix()
+	keys, err := s.inactiveKeys(ctx, cutoff)
+	if err != nil {
+		return nil, err
+	}
+	var deleted []string
+	for len(keys) > 0 {
+		batch := keys
+		if len(batch) > deleteBatchSize {
+			batch = batch[:deleteBatchSize]
+		}
+		swept, err := s.deleteInactiveBatch(ctx, batch, cutoff)
+		deleted = append(deleted, swept...)
+		if err != nil {
+			return deleted, err
+		}
+		keys = keys[len(batch):]
+	}
+	return deleted, nil
+}
+
+// inactiveKeys returns the keys of the live objects in ctx's namespace whose updated_unix is before cutoff
+func (s *Store) inactiveKeys(ctx context.Context, cutoff int64) ([]string, error) {
+	txn := s.db.NewTransaction(false)
+	defer txn.Discard()
+	var keys []string
+	iter := txn.NewIterator(s.scanOptions())
+	defer iter.Close()
+	for iter.Rewind(); iter.Valid(); iter.Next() {
+		item := iter.Item()
+		if !s.visible(ctx, item) {
+			continue
+		}
+		detail, err := itemDetail(item)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to unmarshal protobuf: %s", err.Error())
+		}
+		if detail.GetObject().GetUpdatedUnix() < cutoff {
+			keys = append(keys, string(item.Key()))
+		}
+	}
+	return keys, nil
+}
+
+// deleteInactiveBatch deletes the objects that are still inactive in a single transaction, retrying while it conflicts
+// with concurrent writes, then publishes a tombstone for each deleted object & returns their keys
+func (s *Store) deleteInactiveBatch(ctx context.Context, keys []string, cutoff int64) ([]string, error) {
+	for attempt := 1; ; attempt++ {
+		tombstones, err := s.deleteStillInactive(ctx, keys, cutoff)
+		if err == badger.ErrConflict {
+			if attempt < inactiveAttempts {
+				continue
+			}
+			return nil, status.Errorf(codes.Aborted, "concurrent writes while deleting inactive objects(%v attempts)", inactiveAttempts)
+		}
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to delete inactive objects: %s", err.Error())
+		}
+		var deleted []string
+		for _, tombstone := range tombstones {
+			s.hub.PublishObject(tombstone)
+			deleted = append(deleted, tombstone.Object.Key)
+		}
+		return deleted, nil
+	}
+}
+
+// deleteStillInactive re-reads the objects in a write transaction & deletes the ones that are still live & inactive
+// along with their index entries & history. it returns their tombstones once the transaction commits
+func (s *Store) deleteStillInactive(ctx context.Context, keys []string, cutoff int64) ([]*api.ObjectDetail, error) {
+	txn := s.db.NewTransaction(true)
+	defer txn.Discard()
+	var tombstones []*api.ObjectDetail
+	for _, key := range keys {
+		item, err := txn.Get([]byte(key))
+		if err == badger.ErrKeyNotFound {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		if !s.visible(ctx, item) {
+			continue
+		}
+		detail, err := itemDetail(item)
+		if err != nil {
+			return nil, err
+		}
+		if detail.GetObject().GetUpdatedUnix() >= cutoff {
+			continue
+		}
+		obj := detail.Object
+		if err := unindexTags(txn, key, obj.GetTags()); err != nil {
+			return nil, err
+		}
+		if err := unindexGeohash(txn, key, obj.GetGeohash()); err != nil {
+			return nil, err
+		}
+		if err := deleteHistory(txn, key); err != nil {
+			return nil, err
+		}
+		if err := txn.Delete([]byte(key)); err != nil {
+			return nil, err
+		}
+		tombstones = append(tombstones, &api.ObjectDetail{Object: obj, Deleted: true})
+	}
+	if err := txn.Commit(); err != nil {
+		return nil, err
+	}
+	return tombstones, nil
+}
+
+func (s *Store) GetGlob(ctx context.Context, pattern string, metadata map[string]string) (map[string]*api.ObjectDetail, error) {
+	prefix := []byte(helpers.GlobPrefix(pattern))
+	txn := s.db.NewTransaction(false)
+	defer txn.Discard()
+	objects := map[string]*api.ObjectDetail{}
+	opts := badger.DefaultIteratorOptions
+	opts.PrefetchValues = false
+	iter := txn.NewIterator(opts)
+	defer iter.Close()
+	for iter.Seek(prefix); iter.ValidForPrefix(prefix); iter.Next() {
+		item := iter.Item()
+		if !s.visible(ctx, item) || !helpers.GlobMatch(pattern, string(item.Key())) {
+			continue
+		}
+		res, err := item.ValueCopy(nil)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to copy data: %s", err.Error())
+		}
+		var obj = &api.ObjectDetail{}
+		if err := proto.Unmarshal(res, obj); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to unmarshal protobuf: %s", err.Error())
+		}
+		if !helpers.MatchMetadata(obj.Object.Metadata, metadata) {
+			continue
+		}
+		objects[string(item.Key())] = obj
+		if err := checkMaxResults(ctx, len(objects)); err != nil {
+			return nil, err
+		}
+	}
+	return objects, nil
+}
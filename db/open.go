@@ -0,0 +1,88 @@
+package db
+
+import (
+	"bytes"
+	"github.com/autom8ter/geodb/config"
+	"github.com/dgraph-io/badger/v2"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"io/ioutil"
+	"time"
+)
+
+// BadgerConfig configures the durability, placement & encryption of the badger database backing a Store
+type BadgerConfig struct {
+	// Dir holds the keys(& the values unless ValueDir is set)
+	Dir string
+	// ValueDir optionally holds the value log on a separate disk(ex: keys on an ssd, values on a larger, slower disk)
+	ValueDir string
+	// InMemory keeps the whole dataset in memory, ignoring Dir & ValueDir. nothing is persisted
+	InMemory bool
+	// SyncWrites syncs every write to disk before it's acknowledged. disabling it trades the durability of the latest
+	// writes in a crash for write throughput
+	SyncWrites bool
+	// EncryptionKey(optional) encrypts the data at rest with AES. it must be 16, 24 or 32 bytes(AES-128, 192 or 256)
+	// & the same key must be used every time the database is opened
+	EncryptionKey []byte
+	// EncryptionKeyRotation is how often the data keys encrypted by EncryptionKey are rotated. 0 uses badger's default(10 days)
+	EncryptionKeyRotation time.Duration
+}
+
+// BadgerConfigFromConfig returns the badger config set by GEODB_PATH, GEODB_VALUE_PATH, GEODB_IN_MEMORY, GEODB_SYNC_WRITES,
+// GEODB_ENCRYPTION_KEY_FILE or GEODB_ENCRYPTION_KEY & GEODB_ENCRYPTION_KEY_ROTATION
+func BadgerConfigFromConfig() (BadgerConfig, error) {
+	c := BadgerConfig{
+		Dir:                   config.Config.GetString("GEODB_PATH"),
+		ValueDir:              config.Config.GetString("GEODB_VALUE_PATH"),
+		InMemory:              config.Config.GetBool("GEODB_IN_MEMORY"),
+		SyncWrites:            config.Config.GetBool("GEODB_SYNC_WRITES"),
+		EncryptionKeyRotation: config.Config.GetDuration("GEODB_ENCRYPTION_KEY_ROTATION"),
+	}
+	switch {
+	case config.Config.IsSet("GEODB_ENCRYPTION_KEY_FILE"):
+		key, err := ioutil.ReadFile(config.Config.GetString("GEODB_ENCRYPTION_KEY_FILE"))
+		if err != nil {
+			return c, status.Errorf(codes.InvalidArgument, "failed to read encryption key file: %s", err.Error())
+		}
+		// editors & echo append a newline to the key
+		c.EncryptionKey = bytes.TrimRight(key, "\r\n")
+	case config.Config.IsSet("GEODB_ENCRYPTION_KEY"):
+		c.EncryptionKey = []byte(config.Config.GetString("GEODB_ENCRYPTION_KEY"))
+	}
+	return c, nil
+}
+
+// Options returns the badger options of the config
+func (c BadgerConfig) Options() (badger.Options, error) {
+	if n := len(c.EncryptionKey); n > 0 && n != 16 && n != 24 && n != 32 {
+		return badger.Options{}, status.Errorf(codes.InvalidArgument, "encryption key must be 16, 24 or 32 bytes, got: %v", n)
+	}
+	if c.EncryptionKeyRotation < 0 {
+		return badger.Options{}, status.Errorf(codes.InvalidArgument, "encryption key rotation must be greater than 0, got: %v", c.EncryptionKeyRotation)
+	}
+	opts := badger.DefaultOptions(c.Dir)
+	if c.InMemory {
+		// nothing is written to Dir, the dataset is lost on exit
+		opts = badger.DefaultOptions("").WithInMemory(true)
+	} else if c.ValueDir != "" {
+		opts = opts.WithValueDir(c.ValueDir)
+	}
+	opts = opts.WithSyncWrites(c.SyncWrites)
+	if len(c.EncryptionKey) > 0 {
+		opts = opts.WithEncryptionKey(c.EncryptionKey)
+		if c.EncryptionKeyRotation > 0 {
+			opts = opts.WithEncryptionKeyRotationDuration(c.EncryptionKeyRotation)
+		}
+	}
+	return opts, nil
+}
+
+// Open opens the badger database described by the config. opening an encrypted database with a different key(or
+// none) fails
+func (c BadgerConfig) Open() (*badger.DB, error) {
+	opts, err := c.Options()
+	if err != nil {
+		return nil, err
+	}
+	return badger.Open(opts)
+}
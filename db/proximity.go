@@ -0,0 +1,150 @@
+package db
+
+import (
+	"context"
+	api "github.com/autom8ter/geodb/gen/go/geodb"
+	"github.com/autom8ter/geodb/helpers"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"sort"
+)
+
+func (s *Store) ProximityMatrix(ctx context.Context, keys []string) ([]*api.ProximityRow, error) {
+	objects, err := s.Get(ctx, keys)
+	if err != nil {
+		return nil, err
+	}
+	points := make([]*api.Point, len(keys))
+	for i, key := range keys {
+		obj, ok := objects[key]
+		if !ok {
+			return nil, status.Errorf(codes.NotFound, "object not found: %s", key)
+		}
+		points[i] = obj.Object.Point
+	}
+	rows := make([]*api.ProximityRow, len(keys))
+	for i := range rows {
+		rows[i] = &api.ProximityRow{
+			Distances: make([]float64, len(keys)),
+		}
+	}
+	for i := range points {
+		for j := i + 1; j < len(points); j++ {
+			dist := helpers.Distance(points[i], points[j])
+			rows[i].Distances[j] = dist
+			rows[j].Distances[i] = dist
+		}
+	}
+	return rows, nil
+}
+
+// Aggregate returns the number of matching objects, their centroid & their bounding box. objects are selected by key, or by
+// prefix & regex(optional) if keys is empty, and must match the tag filter & metadata selector(optional)
+func (s *Store) Aggregate(ctx context.Context, keys []string, prefix, regex string, tags *api.TagFilter, metadata map[string]string) (int64, *api.Point, *api.Box, error) {
+	var (
+		objects map[string]*api.ObjectDetail
+		err     error
+	)
+	switch {
+	case len(keys) > 0:
+		objects, err = s.Get(ctx, keys)
+	case regex != "":
+		objects, _, err = s.GetRegex(ctx, prefix, "", regex, "", 0, metadata, nil)
+	default:
+		objects, err = s.GetPrefix(ctx, prefix, metadata)
+	}
+	if err != nil {
+		return 0, nil, nil, err
+	}
+	var points []*api.Point
+	for _, obj := range objects {
+		if obj.Object.Point == nil || !helpers.MatchTags(obj.Object.Tags, tags) || !helpers.MatchMetadata(obj.Object.Metadata, metadata) {
+			continue
+		}
+		points = append(points, obj.Object.Point)
+	}
+	if len(points) == 0 {
+		return 0, nil, nil, status.Error(codes.NotFound, "zero objects found")
+	}
+	return int64(len(points)), helpers.Centroid(points), helpers.BoundingBox(points), nil
+}
+
+// Cluster groups the objects inside bounds(optional) that match the tag filter & metadata selector(optional) by their
+// geohash cell at the given precision, returning the non-empty cells ordered by geohash. the centroid of a cell is the
+// mean of its objects' coordinates rather than their geographic center, so it always falls within the cell
+func (s *Store) Cluster(ctx context.Context, precision int, bounds *api.Box, tags *api.TagFilter, metadata map[string]string) ([]*api.Cluster, error) {
+	if bounds == nil {
+		bounds = &api.Box{MinLat: -90, MinLon: -180, MaxLat: 90, MaxLon: 180}
+	}
+	if bounds.MinLat > bounds.MaxLat {
+		return nil, status.Errorf(codes.InvalidArgument, "min_lat %v is greater than max_lat %v", bounds.MinLat, bounds.MaxLat)
+	}
+	type sum struct {
+		count    int64
+		lat, lon float64
+	}
+	cells := map[string]*sum{}
+	txn := s.db.NewTransaction(false)
+	defer txn.Discard()
+	if err := s.eachInBox(ctx, txn, bounds.MinLat, bounds.MinLon, bounds.MaxLat, bounds.MaxLon, func(key string, obj *api.ObjectDetail) error {
+		p := obj.Object.Point
+		if p == nil || !helpers.BoxContains(bounds.MinLat, bounds.MinLon, bounds.MaxLat, bounds.MaxLon, p) {
+			return nil
+		}
+		if !helpers.MatchTags(obj.Object.Tags, tags) || !helpers.MatchMetadata(obj.Object.Metadata, metadata) {
+			return nil
+		}
+		hash := helpers.Geohash(p, precision)
+		cell, ok := cells[hash]
+		if !ok {
+			cell = &sum{}
+			cells[hash] = cell
+		}
+		cell.count++
+		cell.lat += p.Lat
+		cell.lon += p.Lon
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	clusters := make([]*api.Cluster, 0, len(cells))
+	for hash, cell := range cells {
+		clusters = append(clusters, &api.Cluster{
+			Geohash: hash,
+			Cell:    helpers.GeohashBox(hash),
+			Count:   cell.count,
+			Centroid: &api.Point{
+				Lat: cell.lat / float64(cell.count),
+				Lon: cell.lon / float64(cell.count),
+			},
+		})
+	}
+	sort.Slice(clusters, func(i, j int) bool {
+		return clusters[i].Geohash < clusters[j].Geohash
+	})
+	return clusters, nil
+}
+
+func (s *Store) BoundingCircle(ctx context.Context, keys []string, prefix string) (*api.Point, float64, error) {
+	var (
+		objects map[string]*api.ObjectDetail
+		err     error
+	)
+	if prefix != "" {
+		objects, err = s.GetPrefix(ctx, prefix, nil)
+	} else {
+		objects, err = s.Get(ctx, keys)
+	}
+	if err != nil {
+		return nil, 0, err
+	}
+	if len(objects) == 0 {
+		return nil, 0, status.Error(codes.NotFound, "zero objects found")
+	}
+	var points []*api.Point
+	for _, obj := range objects {
+		points = append(points, obj.Object.Point)
+	}
+	center, radius := helpers.MinimumEnclosingCircle(points)
+	return center, radius, nil
+}
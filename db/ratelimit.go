@@ -0,0 +1,74 @@
+package db
+
+import (
+	"golang.org/x/time/rate"
+	"sync"
+	"time"
+)
+
+// keyLimiter is a token bucket per object key
+type keyLimiter struct {
+	limit     rate.Limit
+	burst     int
+	mu        *sync.Mutex
+	limiters  map[string]*keyBucket
+	lastSweep time.Time
+}
+
+type keyBucket struct {
+	limiter  *rate.Limiter
+	lastSeen time.Time
+}
+
+func newKeyLimiter(perSecond float64, burst int) *keyLimiter {
+	if burst < 1 {
+		burst = 1
+	}
+	return &keyLimiter{
+		limit:    rate.Limit(perSecond),
+		burst:    burst,
+		mu:       &sync.Mutex{},
+		limiters: map[string]*keyBucket{},
+	}
+}
+
+func (k *keyLimiter) allow(key string, now time.Time) bool {
+	_, ok := k.reserve(key, now)
+	return ok
+}
+
+// reserve takes a token from the key's bucket if one is available at now. the token is returned to the bucket by
+// cancelling the reservation at the same now(see release)
+func (k *keyLimiter) reserve(key string, now time.Time) (*rate.Reservation, bool) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	// a bucket that has been idle long enough to refill is identical to a new one, so it can be dropped
+	refill := time.Duration(float64(k.burst) / float64(k.limit) * float64(time.Second))
+	if k.limit > 0 && now.Sub(k.lastSweep) > refill {
+		for key, b := range k.limiters {
+			if now.Sub(b.lastSeen) > refill {
+				delete(k.limiters, key)
+			}
+		}
+		k.lastSweep = now
+	}
+	b, ok := k.limiters[key]
+	if !ok {
+		b = &keyBucket{limiter: rate.NewLimiter(k.limit, k.burst)}
+		k.limiters[key] = b
+	}
+	b.lastSeen = now
+	r := b.limiter.ReserveN(now, 1)
+	if !r.OK() || r.DelayFrom(now) > 0 {
+		r.CancelAt(now)
+		return nil, false
+	}
+	return r, true
+}
+
+// release returns the tokens taken by the reservations made at now
+func release(reservations []*rate.Reservation, now time.Time) {
+	for _, r := range reservations {
+		r.CancelAt(now)
+	}
+}
@@ -0,0 +1,47 @@
+package db
+
+import (
+	api "github.com/autom8ter/geodb/gen/go/geodb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"strings"
+)
+
+// AnyRole matches every role(including objects without one) in a RolePair
+const AnyRole = "*"
+
+// RolePair is a tracking object role & a target object role, ex: vehicle -> zone
+type RolePair struct {
+	Tracking string
+	Target   string
+}
+
+// ParseRolePairs parses comma separated tracking:target role pairs(ex: vehicle:zone,*:no_fly_zone)
+func ParseRolePairs(pairs string) ([]RolePair, error) {
+	var parsed []RolePair
+	for _, pair := range strings.Split(pairs, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		roles := strings.Split(pair, ":")
+		if len(roles) != 2 || roles[0] == "" || roles[1] == "" {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid role pair: %s(expected tracking_role:target_role)", pair)
+		}
+		parsed = append(parsed, RolePair{Tracking: roles[0], Target: roles[1]})
+	}
+	return parsed, nil
+}
+
+// triggers reports whether val tracking target produces tracker events. without trigger roles every pair does
+func (s *Store) triggers(val, target *api.Object) bool {
+	if len(s.triggerRoles) == 0 {
+		return true
+	}
+	for _, pair := range s.triggerRoles {
+		if (pair.Tracking == AnyRole || pair.Tracking == val.Role) && (pair.Target == AnyRole || pair.Target == target.Role) {
+			return true
+		}
+	}
+	return false
+}
@@ -1,18 +1,24 @@
 package db
 
 import (
+	"context"
 	api "github.com/autom8ter/geodb/gen/go/geodb"
+	"github.com/autom8ter/geodb/helpers"
+	"github.com/autom8ter/geodb/isochrone"
 	"github.com/dgraph-io/badger/v2"
 	"github.com/gogo/protobuf/proto"
 	geo "github.com/paulmach/go.geo"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"math"
 	"regexp"
+	"sort"
+	"time"
 )
 
-func ScanBound(db *badger.DB, bound *api.Bound, keys []string) (map[string]*api.ObjectDetail, error) {
-	geoBound := geo.NewGeoBoundAroundPoint(geo.NewPointFromLatLng(bound.Center.Lat, bound.Center.Lon), bound.Radius)
-	txn := db.NewTransaction(false)
+func (s *Store) ScanBound(ctx context.Context, bound *api.Bound, keys []string, tags *api.TagFilter) (map[string]*api.ObjectDetail, error) {
+	geoBound := geo.NewGeoBoundAroundPoint(geo.NewPointFromLatLng(bound.Center.Lat, bound.Center.Lon), helpers.ToMeters(bound.Radius, bound.Unit))
+	txn := s.db.NewTransaction(false)
 	defer txn.Discard()
 	objects := map[string]*api.ObjectDetail{}
 	if len(keys) > 0 {
@@ -21,6 +27,9 @@ func ScanBound(db *badger.DB, bound *api.Bound, keys []string) (map[string]*api.
 			if err != nil {
 				return nil, status.Errorf(codes.Internal, "failed to get key: %s", err.Error())
 			}
+			if !s.visible(ctx, item) {
+				continue
+			}
 			res, err := item.ValueCopy(nil)
 			if err != nil {
 				return nil, status.Errorf(codes.Internal, "failed to copy data: %s", err.Error())
@@ -30,17 +39,20 @@ func ScanBound(db *badger.DB, bound *api.Bound, keys []string) (map[string]*api.
 				if err := proto.Unmarshal(res, obj); err != nil {
 					return nil, status.Errorf(codes.Internal, "failed to unmarshal protobuf: %s", err.Error())
 				}
-				if geoBound.Contains(geo.NewPointFromLatLng(obj.Object.Point.Lat, obj.Object.Point.Lon)) {
+				if helpers.BoundContains(geoBound, obj.Object.Point) && helpers.MatchTags(obj.Object.Tags, tags) {
 					objects[string(item.Key())] = obj
+					if err := checkMaxResults(ctx, len(objects)); err != nil {
+						return nil, err
+					}
 				}
 			}
 		}
 	} else {
-		iter := txn.NewIterator(badger.DefaultIteratorOptions)
+		iter := txn.NewIterator(s.scanOptions())
 		defer iter.Close()
 		for iter.Rewind(); iter.Valid(); iter.Next() {
 			item := iter.Item()
-			if item.UserMeta() != 1 {
+			if !s.visible(ctx, item) {
 				continue
 			}
 			res, err := item.ValueCopy(nil)
@@ -52,8 +64,11 @@ func ScanBound(db *badger.DB, bound *api.Bound, keys []string) (map[string]*api.
 				if err := proto.Unmarshal(res, obj); err != nil {
 					return nil, status.Errorf(codes.Internal, "(all) %s failed to unmarshal protobuf: %s", string(item.Key()), err.Error())
 				}
-				if geoBound.Contains(geo.NewPointFromLatLng(obj.Object.Point.Lat, obj.Object.Point.Lon)) {
+				if helpers.BoundContains(geoBound, obj.Object.Point) && helpers.MatchTags(obj.Object.Tags, tags) {
 					objects[string(item.Key())] = obj
+					if err := checkMaxResults(ctx, len(objects)); err != nil {
+						return nil, err
+					}
 				}
 			}
 		}
@@ -61,9 +76,13 @@ func ScanBound(db *badger.DB, bound *api.Bound, keys []string) (map[string]*api.
 	return objects, nil
 }
 
-func ScanRegexBound(db *badger.DB, bound *api.Bound, rgex string) (map[string]*api.ObjectDetail, error) {
-	geoBound := geo.NewGeoBoundAroundPoint(geo.NewPointFromLatLng(bound.Center.Lat, bound.Center.Lon), bound.Radius)
-	txn := db.NewTransaction(false)
+func (s *Store) ScanRegexBound(ctx context.Context, bound *api.Bound, rgex string, tags *api.TagFilter) (map[string]*api.ObjectDetail, error) {
+	re, err := regexp.Compile(rgex)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to match regex: %s", err.Error())
+	}
+	geoBound := geo.NewGeoBoundAroundPoint(geo.NewPointFromLatLng(bound.Center.Lat, bound.Center.Lon), helpers.ToMeters(bound.Radius, bound.Unit))
+	txn := s.db.NewTransaction(false)
 	defer txn.Discard()
 	objects := map[string]*api.ObjectDetail{}
 	opts := badger.DefaultIteratorOptions
@@ -72,14 +91,10 @@ func ScanRegexBound(db *badger.DB, bound *api.Bound, rgex string) (map[string]*a
 	defer iter.Close()
 	for iter.Rewind(); iter.Valid(); iter.Next() {
 		item := iter.Item()
-		if item.UserMeta() != 1 {
+		if !s.visible(ctx, item) {
 			continue
 		}
-		match, err := regexp.MatchString(rgex, string(item.Key()))
-		if err != nil {
-			return nil, status.Errorf(codes.InvalidArgument, "failed to match regex: %s", err.Error())
-		}
-		if match {
+		if re.Match(scopedKey(ctx, item.Key())) {
 			res, err := item.ValueCopy(nil)
 			if err != nil {
 				return nil, status.Errorf(codes.Internal, "failed to copy data: %s", err.Error())
@@ -88,24 +103,27 @@ func ScanRegexBound(db *badger.DB, bound *api.Bound, rgex string) (map[string]*a
 			if err := proto.Unmarshal(res, obj); err != nil {
 				return nil, status.Errorf(codes.Internal, "failed to unmarshal protobuf: %s", err.Error())
 			}
-			if geoBound.Contains(geo.NewPointFromLatLng(obj.Object.Point.Lat, obj.Object.Point.Lon)) {
+			if helpers.BoundContains(geoBound, obj.Object.Point) && helpers.MatchTags(obj.Object.Tags, tags) {
 				objects[string(item.Key())] = obj
+				if err := checkMaxResults(ctx, len(objects)); err != nil {
+					return nil, err
+				}
 			}
 		}
 	}
 	return objects, nil
 }
 
-func ScanPrefixBound(db *badger.DB, bound *api.Bound, prefix string) (map[string]*api.ObjectDetail, error) {
-	geoBound := geo.NewGeoBoundAroundPoint(geo.NewPointFromLatLng(bound.Center.Lat, bound.Center.Lon), bound.Radius)
-	txn := db.NewTransaction(false)
+func (s *Store) ScanPrefixBound(ctx context.Context, bound *api.Bound, prefix string, tags *api.TagFilter) (map[string]*api.ObjectDetail, error) {
+	geoBound := geo.NewGeoBoundAroundPoint(geo.NewPointFromLatLng(bound.Center.Lat, bound.Center.Lon), helpers.ToMeters(bound.Radius, bound.Unit))
+	txn := s.db.NewTransaction(false)
 	defer txn.Discard()
 	objects := map[string]*api.ObjectDetail{}
-	iter := txn.NewIterator(badger.DefaultIteratorOptions)
+	iter := txn.NewIterator(s.scanOptions())
 	defer iter.Close()
 	for iter.Seek([]byte(prefix)); iter.ValidForPrefix([]byte(prefix)); iter.Next() {
 		item := iter.Item()
-		if item.UserMeta() != 1 {
+		if !s.visible(ctx, item) {
 			continue
 		}
 		res, err := item.ValueCopy(nil)
@@ -116,9 +134,228 @@ func ScanPrefixBound(db *badger.DB, bound *api.Bound, prefix string) (map[string
 		if err := proto.Unmarshal(res, obj); err != nil {
 			return nil, status.Errorf(codes.Internal, "failed to unmarshal protobuf: %s", err.Error())
 		}
-		if geoBound.Contains(geo.NewPointFromLatLng(obj.Object.Point.Lat, obj.Object.Point.Lon)) {
+		if helpers.BoundContains(geoBound, obj.Object.Point) && helpers.MatchTags(obj.Object.Tags, tags) {
 			objects[string(item.Key())] = obj
+			if err := checkMaxResults(ctx, len(objects)); err != nil {
+				return nil, err
+			}
 		}
 	}
 	return objects, nil
 }
+
+func (s *Store) ScanIsochrone(ctx context.Context, polygon []*api.Point, center *api.Point, budget time.Duration, mode api.TravelMode, tags *api.TagFilter) (map[string]*api.ObjectDetail, []*api.Point, error) {
+	if len(polygon) == 0 {
+		if center == nil || budget <= 0 {
+			return nil, nil, status.Error(codes.InvalidArgument, "a polygon or a center & travel time are required")
+		}
+		var err error
+		polygon, err = isochrone.Default().Isochrone(ctx, center, budget, mode)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	objects, err := s.WithinPolygon(ctx, polygon, tags)
+	if err != nil {
+		return nil, nil, err
+	}
+	return objects, polygon, nil
+}
+
+// WithinPolygon returns the objects inside(or on the boundary of) the polygon
+func (s *Store) WithinPolygon(ctx context.Context, polygon []*api.Point, tags *api.TagFilter) (map[string]*api.ObjectDetail, error) {
+	if len(polygon) < 3 {
+		return nil, status.Errorf(codes.InvalidArgument, "polygon requires at least 3 points, got: %v", len(polygon))
+	}
+	txn := s.db.NewTransaction(false)
+	defer txn.Discard()
+	objects := map[string]*api.ObjectDetail{}
+	iter := txn.NewIterator(s.scanOptions())
+	defer iter.Close()
+	for iter.Rewind(); iter.Valid(); iter.Next() {
+		item := iter.Item()
+		if !s.visible(ctx, item) {
+			continue
+		}
+		res, err := item.ValueCopy(nil)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to copy data: %s", err.Error())
+		}
+		var obj = &api.ObjectDetail{}
+		if err := proto.Unmarshal(res, obj); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to unmarshal protobuf: %s", err.Error())
+		}
+		if helpers.PolygonContains(polygon, obj.Object.Point) && helpers.MatchTags(obj.Object.Tags, tags) {
+			objects[string(item.Key())] = obj
+			if err := checkMaxResults(ctx, len(objects)); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return objects, nil
+}
+
+func (s *Store) WithinCorridor(ctx context.Context, route []*api.Point, buffer float64, tags *api.TagFilter) (map[string]*api.ObjectDetail, error) {
+	if len(route) < 2 {
+		return nil, status.Errorf(codes.InvalidArgument, "route requires at least 2 points, got: %v", len(route))
+	}
+	txn := s.db.NewTransaction(false)
+	defer txn.Discard()
+	objects := map[string]*api.ObjectDetail{}
+	iter := txn.NewIterator(s.scanOptions())
+	defer iter.Close()
+	for iter.Rewind(); iter.Valid(); iter.Next() {
+		item := iter.Item()
+		if !s.visible(ctx, item) {
+			continue
+		}
+		res, err := item.ValueCopy(nil)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to copy data: %s", err.Error())
+		}
+		var obj = &api.ObjectDetail{}
+		if err := proto.Unmarshal(res, obj); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to unmarshal protobuf: %s", err.Error())
+		}
+		if helpers.MatchTags(obj.Object.Tags, tags) && helpers.DistanceToRoute(obj.Object.Point, route) <= buffer {
+			objects[string(item.Key())] = obj
+			if err := checkMaxResults(ctx, len(objects)); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return objects, nil
+}
+
+func (s *Store) GetWithinBounds(ctx context.Context, minLat, minLon, maxLat, maxLon float64, tags *api.TagFilter) (map[string]*api.ObjectDetail, error) {
+	if minLat > maxLat {
+		return nil, status.Errorf(codes.InvalidArgument, "min_lat %v is greater than max_lat %v", minLat, maxLat)
+	}
+	txn := s.db.NewTransaction(false)
+	defer txn.Discard()
+	objects := map[string]*api.ObjectDetail{}
+	if err := s.eachInBox(ctx, txn, minLat, minLon, maxLat, maxLon, func(key string, obj *api.ObjectDetail) error {
+		if helpers.BoxContains(minLat, minLon, maxLat, maxLon, obj.Object.Point) && helpers.MatchTags(obj.Object.Tags, tags) {
+			objects[key] = obj
+		}
+		return checkMaxResults(ctx, len(objects))
+	}); err != nil {
+		return nil, err
+	}
+	return objects, nil
+}
+
+// Nearest returns the k objects nearest to center, ordered by ascending distance(ties are ordered by key). every object
+// is scanned, but only the k nearest are kept, so it isn't capped by GEODB_MAX_RESULTS
+func (s *Store) Nearest(ctx context.Context, center *api.Point, k int, tags *api.TagFilter, metadata map[string]string) ([]*api.NearestObject, error) {
+	return s.withinDistance(ctx, center, math.Inf(1), k, tags, metadata)
+}
+
+// WithinRadius returns the objects whose distance from center is <= meters, ordered by ascending distance(ties are ordered by key)
+func (s *Store) WithinRadius(ctx context.Context, center *api.Point, meters float64, tags *api.TagFilter, metadata map[string]string) ([]*api.NearestObject, error) {
+	return s.withinDistance(ctx, center, meters, 0, tags, metadata)
+}
+
+// withinDistance returns the objects within meters of center, keeping only the k nearest if k > 0. finite distances
+// only visit the geohash cells overlapping the radius, otherwise every object is scanned.
+func (s *Store) withinDistance(ctx context.Context, center *api.Point, meters float64, k int, tags *api.TagFilter, metadata map[string]string) ([]*api.NearestObject, error) {
+	txn := s.db.NewTransaction(false)
+	defer txn.Discard()
+	var nearest []*api.NearestObject
+	visit := func(key string, obj *api.ObjectDetail) error {
+		if !helpers.MatchTags(obj.Object.Tags, tags) || !helpers.MatchMetadata(obj.Object.Metadata, metadata) {
+			return nil
+		}
+		dist := helpers.Distance(center, obj.Object.Point)
+		if dist > meters {
+			return nil
+		}
+		// nearest is kept ordered so the farthest object is dropped once there are more than k
+		i := sort.Search(len(nearest), func(i int) bool {
+			if nearest[i].Distance != dist {
+				return nearest[i].Distance > dist
+			}
+			return nearest[i].Object.Object.Key > obj.Object.Key
+		})
+		if k > 0 && i == k {
+			return nil
+		}
+		nearest = append(nearest, nil)
+		copy(nearest[i+1:], nearest[i:])
+		nearest[i] = &api.NearestObject{
+			Object:   obj,
+			Distance: dist,
+		}
+		if k > 0 && len(nearest) > k {
+			nearest = nearest[:k]
+		}
+		return checkMaxResults(ctx, len(nearest))
+	}
+	if math.IsInf(meters, 1) {
+		iter := txn.NewIterator(s.scanOptions())
+		defer iter.Close()
+		for iter.Rewind(); iter.Valid(); iter.Next() {
+			item := iter.Item()
+			if !s.visible(ctx, item) {
+				continue
+			}
+			res, err := item.ValueCopy(nil)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to copy data: %s", err.Error())
+			}
+			var obj = &api.ObjectDetail{}
+			if err := proto.Unmarshal(res, obj); err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to unmarshal protobuf: %s", err.Error())
+			}
+			if err := visit(string(item.Key()), obj); err != nil {
+				return nil, err
+			}
+		}
+	} else {
+		minLat, minLon, maxLat, maxLon := helpers.RadiusBox(center, meters)
+		if err := s.eachInBox(ctx, txn, minLat, minLon, maxLat, maxLon, visit); err != nil {
+			return nil, err
+		}
+	}
+	return nearest, nil
+}
+
+// ForEach calls fn with every stored object whose key has the prefix and matches the regex(both optional) in key order.
+// iteration stops at the first error returned by fn or when ctx is done
+func (s *Store) ForEach(ctx context.Context, prefix, regex string, fn func(obj *api.ObjectDetail) error) error {
+	var re *regexp.Regexp
+	if regex != "" {
+		var err error
+		re, err = regexp.Compile(regex)
+		if err != nil {
+			return status.Errorf(codes.InvalidArgument, "failed to match regex: %s", err.Error())
+		}
+	}
+	txn := s.db.NewTransaction(false)
+	defer txn.Discard()
+	opts := s.scanOptions()
+	opts.Prefix = []byte(prefix)
+	iter := txn.NewIterator(opts)
+	defer iter.Close()
+	for iter.Rewind(); iter.Valid(); iter.Next() {
+		if err := ctx.Err(); err != nil {
+			return status.FromContextError(err).Err()
+		}
+		item := iter.Item()
+		if !s.visible(ctx, item) || (re != nil && !re.Match(scopedKey(ctx, item.Key()))) {
+			continue
+		}
+		res, err := item.ValueCopy(nil)
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to copy data: %s", err.Error())
+		}
+		var obj = &api.ObjectDetail{}
+		if err := proto.Unmarshal(res, obj); err != nil {
+			return status.Errorf(codes.Internal, "failed to unmarshal protobuf: %s", err.Error())
+		}
+		if err := fn(obj); err != nil {
+			return err
+		}
+	}
+	return nil
+}
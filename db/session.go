@@ -0,0 +1,102 @@
+package db
+
+import (
+	"context"
+	"github.com/dgraph-io/badger/v2"
+	"github.com/gofrs/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"sync"
+	"time"
+)
+
+// readSession is a read only transaction kept open across requests, so every read made with its token observes the
+// snapshot of the database as of when it was opened
+type readSession struct {
+	txn    *badger.Txn
+	ttl    time.Duration
+	timer  *time.Timer
+	mu     *sync.RWMutex
+	closed bool
+}
+
+type readSessionCtxKey struct{}
+
+// OpenReadSession pins a read snapshot of the database & returns its token. the session expires after ttl without a read
+// (every read with the token restarts the countdown) or when it's closed with CloseReadSession. open sessions keep the
+// versions in their snapshot from being garbage collected, so they should be closed once a read is finished
+func (s *Store) OpenReadSession(ctx context.Context, ttl time.Duration) (string, error) {
+	if ttl <= 0 {
+		return "", status.Error(codes.InvalidArgument, "read session ttl must be greater than 0")
+	}
+	id, err := uuid.NewV4()
+	if err != nil {
+		return "", status.Errorf(codes.Internal, "failed to generate read session token: %s", err.Error())
+	}
+	token := id.String()
+	s.sessionsMu.Lock()
+	defer s.sessionsMu.Unlock()
+	if s.maxReadSessions > 0 && len(s.sessions) >= s.maxReadSessions {
+		return "", status.Errorf(codes.ResourceExhausted, "too many open read sessions: %v(GEODB_READ_SESSION_MAX). close finished sessions with CloseReadSession", len(s.sessions))
+	}
+	s.sessions[token] = &readSession{
+		txn: s.db.NewTransaction(false),
+		ttl: ttl,
+		timer: time.AfterFunc(ttl, func() {
+			s.CloseReadSession(context.Background(), token)
+		}),
+		mu: &sync.RWMutex{},
+	}
+	return token, nil
+}
+
+// CloseReadSession discards the read session's snapshot once the reads using it have finished. closing an expired or
+// unknown session is a no-op
+func (s *Store) CloseReadSession(ctx context.Context, token string) {
+	s.sessionsMu.Lock()
+	session, ok := s.sessions[token]
+	delete(s.sessions, token)
+	s.sessionsMu.Unlock()
+	if !ok {
+		return
+	}
+	session.timer.Stop()
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	session.closed = true
+	session.txn.Discard()
+}
+
+// ReadSession returns a context whose reads(Get, GetRegex, GetPrefix & GetKeys) observe the read session's snapshot
+// & restarts the session's countdown. release must be called once the reads are finished. an empty token returns ctx,
+// so reads observe the latest data
+func (s *Store) ReadSession(ctx context.Context, token string) (context.Context, func(), error) {
+	if token == "" {
+		return ctx, func() {}, nil
+	}
+	s.sessionsMu.Lock()
+	session, ok := s.sessions[token]
+	if ok {
+		session.timer.Reset(session.ttl)
+	}
+	s.sessionsMu.Unlock()
+	if !ok {
+		return nil, nil, status.Errorf(codes.NotFound, "read session not found(it may have expired): %s", token)
+	}
+	session.mu.RLock()
+	if session.closed {
+		session.mu.RUnlock()
+		return nil, nil, status.Errorf(codes.NotFound, "read session not found(it may have expired): %s", token)
+	}
+	return context.WithValue(ctx, readSessionCtxKey{}, session), session.mu.RUnlock, nil
+}
+
+// readTxn returns the read session's transaction if ctx has one(see ReadSession), otherwise a new read only transaction.
+// done must be called once the transaction is no longer used
+func (s *Store) readTxn(ctx context.Context) (*badger.Txn, func()) {
+	if session, ok := ctx.Value(readSessionCtxKey{}).(*readSession); ok {
+		return session.txn, func() {}
+	}
+	txn := s.db.NewTransaction(false)
+	return txn, txn.Discard
+}
@@ -0,0 +1,189 @@
+package db
+
+import (
+	"github.com/autom8ter/geodb/config"
+	"github.com/autom8ter/geodb/maps"
+	"github.com/autom8ter/geodb/stream"
+	"github.com/dgraph-io/badger/v2"
+	"sync"
+	"time"
+)
+
+// Store is geodb's storage and proximity engine. It can be embedded directly in a go application
+// without running the grpc server.
+type Store struct {
+	db               *badger.DB
+	maps             *maps.Client
+	hub              *stream.Hub
+	now              func() time.Time
+	clockMu          *sync.Mutex
+	lastNanos        int64
+	limiter          *keyLimiter
+	ttl              time.Duration
+	geohashPrecision int
+	historyMax       int
+	prefetchSize     int
+	cooldown         *eventCooldown
+	eventLog         bool
+	eventRetention   time.Duration
+	triggerRoles     []RolePair
+	sessionsMu       *sync.Mutex
+	sessions         map[string]*readSession
+	maxReadSessions  int
+	counterLocks     []*sync.Mutex
+}
+
+// StoreOption configures a Store.
+type StoreOption func(s *Store)
+
+// StoreOptionsFromConfig returns the store options set by GEODB_GEOHASH_PRECISION, GEODB_HISTORY_MAX,
+// GEODB_SCAN_PREFETCH_SIZE, GEODB_READ_SESSION_MAX, GEODB_SET_RATE_LIMIT, GEODB_TRACKER_EVENT_COOLDOWN,
+// GEODB_TRACKER_TRIGGER_ROLES, GEODB_EVENT_RETENTION & GEODB_DEFAULT_TTL
+func StoreOptionsFromConfig() []StoreOption {
+	opts := []StoreOption{
+		WithGeohashPrecision(config.Config.GetInt("GEODB_GEOHASH_PRECISION")),
+		WithHistoryMax(config.Config.GetInt("GEODB_HISTORY_MAX")),
+		WithPrefetchSize(config.Config.GetInt("GEODB_SCAN_PREFETCH_SIZE")),
+		WithMaxReadSessions(config.Config.GetInt("GEODB_READ_SESSION_MAX")),
+	}
+	if config.Config.IsSet("GEODB_SET_RATE_LIMIT") {
+		opts = append(opts, WithRateLimit(config.Config.GetFloat64("GEODB_SET_RATE_LIMIT"), config.Config.GetInt("GEODB_SET_RATE_BURST")))
+	}
+	if config.Config.IsSet("GEODB_TRACKER_EVENT_COOLDOWN") {
+		opts = append(opts, WithEventCooldown(config.Config.GetDuration("GEODB_TRACKER_EVENT_COOLDOWN")))
+	}
+	if config.Config.IsSet("GEODB_TRACKER_TRIGGER_ROLES") {
+		// invalid pairs are rejected on startup by server.NewServer
+		pairs, _ := ParseRolePairs(config.Config.GetString("GEODB_TRACKER_TRIGGER_ROLES"))
+		opts = append(opts, WithTriggerRoles(pairs...))
+	}
+	if config.Config.IsSet("GEODB_EVENT_RETENTION") {
+		opts = append(opts, WithEventLog(config.Config.GetDuration("GEODB_EVENT_RETENTION")))
+	}
+	if config.Config.IsSet("GEODB_DEFAULT_TTL") {
+		opts = append(opts, WithDefaultTTL(config.Config.GetDuration("GEODB_DEFAULT_TTL")))
+	}
+	return opts
+}
+
+// WithClock overrides the clock used for server assigned timestamps(defaults to time.Now).
+func WithClock(now func() time.Time) StoreOption {
+	return func(s *Store) {
+		s.now = now
+	}
+}
+
+// WithRateLimit limits writes to perSecond updates per object key, allowing bursts of up to burst updates.
+// writes over the limit are rejected with codes.ResourceExhausted
+func WithRateLimit(perSecond float64, burst int) StoreOption {
+	return func(s *Store) {
+		s.limiter = newKeyLimiter(perSecond, burst)
+	}
+}
+
+// WithDefaultTTL expires objects written without an expires_unix or ttl_seconds after ttl.
+func WithDefaultTTL(ttl time.Duration) StoreOption {
+	return func(s *Store) {
+		s.ttl = ttl
+	}
+}
+
+// WithGeohashPrecision sets the number of characters in the geohash computed for each object(1-12, defaults to 9).
+func WithGeohashPrecision(precision int) StoreOption {
+	return func(s *Store) {
+		s.geohashPrecision = precision
+	}
+}
+
+// WithHistoryMax sets the max number of positions kept in the history of objects with keep_history set(defaults to 100).
+func WithHistoryMax(max int) StoreOption {
+	return func(s *Store) {
+		s.historyMax = max
+	}
+}
+
+// WithPrefetchSize sets the number of values prefetched by scans that read every object they iterate(defaults to 100).
+// scans that only need keys(GetKeys, Count, ...) never prefetch values
+func WithPrefetchSize(size int) StoreOption {
+	return func(s *Store) {
+		s.prefetchSize = size
+	}
+}
+
+// WithEventCooldown suppresses repeated Enter/Inside tracker events for the same pair of objects within window.
+// a pair's first overlapping event is emitted, then it's quiet until the window passes or the objects stop overlapping
+func WithEventCooldown(window time.Duration) StoreOption {
+	return func(s *Store) {
+		if window > 0 {
+			s.cooldown = newEventCooldown(window)
+		}
+	}
+}
+
+// WithEventLog persists every tracker event so it can be queried with GetEvents. persisted events expire after
+// retention(a retention <= 0 keeps them until they're deleted)
+func WithEventLog(retention time.Duration) StoreOption {
+	return func(s *Store) {
+		s.eventLog = true
+		s.eventRetention = retention
+	}
+}
+
+// WithTriggerRoles limits tracker events to objects tracking targets with one of the role pairs, making geofencing one
+// directional(ex: vehicle -> zone but not zone -> zone). without trigger roles every tracker produces events
+func WithTriggerRoles(pairs ...RolePair) StoreOption {
+	return func(s *Store) {
+		s.triggerRoles = pairs
+	}
+}
+
+// WithMaxReadSessions limits the number of open read sessions(defaults to 100). a max <= 0 allows any number of sessions
+func WithMaxReadSessions(max int) StoreOption {
+	return func(s *Store) {
+		s.maxReadSessions = max
+	}
+}
+
+// NewStore creates a Store. gmaps is optional and enables the google maps integration.
+func NewStore(db *badger.DB, hub *stream.Hub, gmaps *maps.Client, opts ...StoreOption) *Store {
+	s := &Store{
+		db:               db,
+		maps:             gmaps,
+		hub:              hub,
+		now:              time.Now,
+		clockMu:          &sync.Mutex{},
+		geohashPrecision: 9,
+		historyMax:       100,
+		prefetchSize:     badger.DefaultIteratorOptions.PrefetchSize,
+		sessionsMu:       &sync.Mutex{},
+		sessions:         map[string]*readSession{},
+		maxReadSessions:  100,
+		counterLocks:     newCounterLocks(),
+	}
+	for _, o := range opts {
+		o(s)
+	}
+	return s
+}
+
+// scanOptions returns the iterator options for scans that read the value of every item they iterate
+func (s *Store) scanOptions() badger.IteratorOptions {
+	opts := badger.DefaultIteratorOptions
+	if s.prefetchSize > 0 {
+		opts.PrefetchSize = s.prefetchSize
+	}
+	return opts
+}
+
+// monotonicNanos returns a unix nanosecond timestamp from the store's clock that is strictly greater than
+// any it previously returned, even if the clock moves backwards.
+func (s *Store) monotonicNanos() int64 {
+	s.clockMu.Lock()
+	defer s.clockMu.Unlock()
+	nanos := s.now().UnixNano()
+	if nanos <= s.lastNanos {
+		nanos = s.lastNanos + 1
+	}
+	s.lastNanos = nanos
+	return nanos
+}
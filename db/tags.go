@@ -0,0 +1,183 @@
+package db
+
+import (
+	"context"
+	api "github.com/autom8ter/geodb/gen/go/geodb"
+	"github.com/autom8ter/geodb/helpers"
+	"github.com/dgraph-io/badger/v2"
+	"github.com/gogo/protobuf/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// tag index entries are stored under \x00tag\x00<tag>\x00<object key> with an empty value
+const tagIndexMeta = 6
+
+func tagIndexPrefix(tag string) []byte {
+	return []byte("\x00tag\x00" + tag + "\x00")
+}
+
+func tagIndexKey(tag, key string) []byte {
+	return append(tagIndexPrefix(tag), key...)
+}
+
+func storedTags(txn *badger.Txn, key string) ([]string, error) {
+	obj, err := storedObject(txn, key)
+	if err != nil {
+		return nil, err
+	}
+	return obj.GetTags(), nil
+}
+
+// storedObject returns the object stored under key within txn or nil if there isn't one
+func storedObject(txn *badger.Txn, key string) (*api.Object, error) {
+	detail, err := storedDetail(txn, key)
+	if err != nil {
+		return nil, err
+	}
+	return detail.GetObject(), nil
+}
+
+// storedDetail returns the object detail stored under key within txn or nil if there isn't one. objects expired by
+// the store's clock are returned, so writes can unindex them(see liveDetail)
+func storedDetail(txn *badger.Txn, key string) (*api.ObjectDetail, error) {
+	item, err := txn.Get([]byte(key))
+	if err == badger.ErrKeyNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if item.UserMeta() != 1 {
+		return nil, nil
+	}
+	return itemDetail(item)
+}
+
+// liveDetail returns the live object detail stored under key within txn or nil if there isn't one or it expired
+func (s *Store) liveDetail(txn *badger.Txn, key string) (*api.ObjectDetail, error) {
+	item, err := txn.Get([]byte(key))
+	if err == badger.ErrKeyNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !s.live(item) {
+		return nil, nil
+	}
+	return itemDetail(item)
+}
+
+// itemDetail unmarshals the object detail stored in the item
+func itemDetail(item *badger.Item) (*api.ObjectDetail, error) {
+	res, err := item.ValueCopy(nil)
+	if err != nil {
+		return nil, err
+	}
+	var obj = &api.ObjectDetail{}
+	if err := proto.Unmarshal(res, obj); err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
+
+// indexTags replaces the object's tag index entries(previous) with entries for obj's current tags.
+func indexTags(txn *badger.Txn, obj *api.Object, previous []string) error {
+	if err := unindexTags(txn, obj.Key, previous); err != nil {
+		return err
+	}
+	for _, tag := range obj.Tags {
+		if err := txn.SetEntry(&badger.Entry{
+			Key:       tagIndexKey(tag, obj.Key),
+			UserMeta:  tagIndexMeta,
+			ExpiresAt: uint64(obj.ExpiresUnix),
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func unindexTags(txn *badger.Txn, key string, tags []string) error {
+	for _, tag := range tags {
+		if err := txn.Delete(tagIndexKey(tag, key)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func tagIndexKeys(txn *badger.Txn, tag string) map[string]struct{} {
+	keys := map[string]struct{}{}
+	prefix := tagIndexPrefix(tag)
+	opts := badger.DefaultIteratorOptions
+	opts.PrefetchValues = false
+	iter := txn.NewIterator(opts)
+	defer iter.Close()
+	for iter.Seek(prefix); iter.ValidForPrefix(prefix); iter.Next() {
+		if iter.Item().UserMeta() != tagIndexMeta {
+			continue
+		}
+		keys[string(iter.Item().Key()[len(prefix):])] = struct{}{}
+	}
+	return keys
+}
+
+func (s *Store) GetTagged(ctx context.Context, filter *api.TagFilter) (map[string]*api.ObjectDetail, error) {
+	if len(filter.GetAny()) == 0 && len(filter.GetAll()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "tag filter requires at least one any or all tag")
+	}
+	txn := s.db.NewTransaction(false)
+	defer txn.Discard()
+	var candidates map[string]struct{}
+	if len(filter.All) > 0 {
+		for _, tag := range filter.All {
+			keys := tagIndexKeys(txn, tag)
+			if candidates == nil {
+				candidates = keys
+				continue
+			}
+			for key := range candidates {
+				if _, ok := keys[key]; !ok {
+					delete(candidates, key)
+				}
+			}
+		}
+	} else {
+		candidates = map[string]struct{}{}
+		for _, tag := range filter.Any {
+			for key := range tagIndexKeys(txn, tag) {
+				candidates[key] = struct{}{}
+			}
+		}
+	}
+	objects := map[string]*api.ObjectDetail{}
+	for key := range candidates {
+		item, err := txn.Get([]byte(key))
+		if err == badger.ErrKeyNotFound {
+			continue
+		}
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get key: %s", err.Error())
+		}
+		if !s.visible(ctx, item) {
+			continue
+		}
+		res, err := item.ValueCopy(nil)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to copy data: %s", err.Error())
+		}
+		var obj = &api.ObjectDetail{}
+		if err := proto.Unmarshal(res, obj); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to unmarshal protobuf: %s", err.Error())
+		}
+		if helpers.MatchTags(obj.Object.Tags, filter) {
+			objects[key] = obj
+			if err := checkMaxResults(ctx, len(objects)); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return objects, nil
+}
@@ -0,0 +1,120 @@
+package db
+
+import (
+	"context"
+	api "github.com/autom8ter/geodb/gen/go/geodb"
+	"github.com/dgraph-io/badger/v2"
+	"github.com/gogo/protobuf/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Update merges the fields of r into the stored object with the same key. the read and write happen in a single
+// transaction, so a concurrent write to the same key aborts the update instead of being overwritten.
+func (s *Store) Update(ctx context.Context, r *api.UpdateRequest) (*api.ObjectDetail, error) {
+	if s.limiter != nil && !s.limiter.allow(r.Key, s.now()) {
+		return nil, status.Errorf(codes.ResourceExhausted, "rate limit exceeded for key: %s", r.Key)
+	}
+	txn := s.db.NewTransaction(true)
+	defer txn.Discard()
+	item, err := txn.Get([]byte(r.Key))
+	if err == badger.ErrKeyNotFound || (err == nil && !s.visible(ctx, item)) {
+		return nil, status.Errorf(codes.NotFound, "object not found: %s", r.Key)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get key: %s", err.Error())
+	}
+	res, err := item.ValueCopy(nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to copy data: %s", err.Error())
+	}
+	var existing = &api.ObjectDetail{}
+	if err := proto.Unmarshal(res, existing); err != nil {
+		return nil, status.Errorf(codes.Internal, "(keys) %s failed to unmarshal protobuf: %s", r.Key, err.Error())
+	}
+	obj := existing.GetObject()
+	if obj == nil {
+		obj = &api.Object{Key: r.Key}
+	}
+	if err := mergeObject(obj, r); err != nil {
+		return nil, err
+	}
+	obj.UpdatedUnix = 0
+	if err := obj.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%s: %s", r.Key, err.Error())
+	}
+	detail := s.objectDetail(ctx, txn, obj)
+	if err := setStoredFields(txn, obj, 0); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get key: %s", err.Error())
+	}
+	if err := writeDetail(txn, detail); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update object: %s", err.Error())
+	}
+	if err := s.writeHistory(txn, obj, s.monotonicNanos()); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to record history: %s", err.Error())
+	}
+	if err := s.writeEvents(txn, detail); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to record events: %s", err.Error())
+	}
+	if err := txn.Commit(); err != nil {
+		if err == badger.ErrConflict {
+			return nil, status.Errorf(codes.Aborted, "concurrent write to key: %s", r.Key)
+		}
+		return nil, err
+	}
+	return s.publish(detail), nil
+}
+
+// mergeObject applies the fields of r listed in its update mask to obj. if the mask is empty, every non-zero field is applied.
+func mergeObject(obj *api.Object, r *api.UpdateRequest) error {
+	mask := r.UpdateMask
+	if len(mask) == 0 {
+		if r.Point != nil {
+			mask = append(mask, "point")
+		}
+		if r.Radius != 0 {
+			mask = append(mask, "radius")
+		}
+		if r.Tracking != nil {
+			mask = append(mask, "tracking")
+		}
+		if len(r.Metadata) > 0 {
+			mask = append(mask, "metadata")
+		}
+		if r.GetAddress {
+			mask = append(mask, "get_address")
+		}
+		if r.GetTimezone {
+			mask = append(mask, "get_timezone")
+		}
+		if r.ExpiresUnix != 0 {
+			mask = append(mask, "expires_unix")
+		}
+		if len(r.Tags) > 0 {
+			mask = append(mask, "tags")
+		}
+	}
+	for _, path := range mask {
+		switch path {
+		case "point":
+			obj.Point = r.Point
+		case "radius":
+			obj.Radius = r.Radius
+		case "tracking":
+			obj.Tracking = r.Tracking
+		case "metadata":
+			obj.Metadata = r.Metadata
+		case "get_address":
+			obj.GetAddress = r.GetAddress
+		case "get_timezone":
+			obj.GetTimezone = r.GetTimezone
+		case "expires_unix":
+			obj.ExpiresUnix = r.ExpiresUnix
+		case "tags":
+			obj.Tags = r.Tags
+		default:
+			return status.Errorf(codes.InvalidArgument, "unknown update mask field: %s", path)
+		}
+	}
+	return nil
+}
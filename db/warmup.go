@@ -0,0 +1,139 @@
+package db
+
+import (
+	"bytes"
+	"context"
+	api "github.com/autom8ter/geodb/gen/go/geodb"
+	"github.com/autom8ter/geodb/helpers"
+	"github.com/dgraph-io/badger/v2"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// warmupAttempts bounds the retries of warmup writes that conflict with concurrent writes to the same objects
+const warmupAttempts = 10
+
+// RebuildTagIndex makes the tag index consistent with the stored objects: missing index entries are written and
+// stale entries are deleted. the geohash index is rebuilt the same way at the configured precision(recomputing the
+// geohash of objects stored without one or at another precision) so radius & bounds queries can find them. index
+// entries are written & deleted in transactions against the current object, so concurrent writes aren't overwritten
+// or unindexed. progress is called with the number of objects indexed so far.
+func (s *Store) RebuildTagIndex(ctx context.Context, progress func(indexed int)) (int, error) {
+	txn := s.db.NewTransaction(false)
+	defer txn.Discard()
+	iter := txn.NewIterator(s.scanOptions())
+	indexed := 0
+	var keys []string
+	for iter.Rewind(); iter.Valid(); iter.Next() {
+		if err := ctx.Err(); err != nil {
+			iter.Close()
+			return indexed, err
+		}
+		item := iter.Item()
+		if item.UserMeta() != 1 {
+			continue
+		}
+		keys = append(keys, string(item.KeyCopy(nil)))
+		indexed++
+		if progress != nil {
+			progress(indexed)
+		}
+	}
+	iter.Close()
+	if err := s.rebuildIndexes(ctx, keys); err != nil {
+		return indexed, err
+	}
+	if err := s.deleteStaleIndexEntries(ctx, txn, []byte("\x00tag\x00"), tagIndexMeta, func(obj *api.Object, tag string) bool {
+		for _, t := range obj.GetTags() {
+			if t == tag {
+				return false
+			}
+		}
+		return true
+	}); err != nil {
+		return indexed, status.Errorf(codes.Internal, "failed to delete stale tag index entries: %s", err.Error())
+	}
+	if err := s.deleteStaleIndexEntries(ctx, txn, geohashIndexPrefix(""), geohashIndexMeta, func(obj *api.Object, geohash string) bool {
+		return obj.GetGeohash() != geohash
+	}); err != nil {
+		return indexed, status.Errorf(codes.Internal, "failed to delete stale geohash index entries: %s", err.Error())
+	}
+	return indexed, nil
+}
+
+// rebuildIndexes writes the tag & geohash index entries of the live objects with the given keys, recomputing
+// geohashes at the configured precision
+func (s *Store) rebuildIndexes(ctx context.Context, keys []string) error {
+	var writes []func(txn *badger.Txn) error
+	for _, key := range keys {
+		key := key
+		writes = append(writes, func(txn *badger.Txn) error {
+			item, err := txn.Get([]byte(key))
+			if err == badger.ErrKeyNotFound || (err == nil && !s.live(item)) {
+				return nil
+			}
+			if err != nil {
+				return err
+			}
+			detail, err := storedDetail(txn, key)
+			if err != nil || detail.GetObject() == nil {
+				return err
+			}
+			if err := indexTags(txn, detail.Object, nil); err != nil {
+				return err
+			}
+			if detail.Object.Point == nil {
+				return nil
+			}
+			geohash := helpers.Geohash(detail.Object.Point, s.geohashPrecision)
+			if detail.Object.Geohash == geohash {
+				return indexGeohash(txn, detail.Object, "")
+			}
+			// writeDetail moves the index entry from the stored geohash to the new one
+			detail.Object.Geohash = geohash
+			return writeDetail(txn, detail)
+		})
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if _, err := s.commitRetried(writes, warmupAttempts); err != nil {
+		return status.Errorf(codes.Internal, "failed to index objects: %s", err.Error())
+	}
+	return nil
+}
+
+// deleteStaleIndexEntries deletes the index entries(<prefix><value>\x00<key>, read from txn) that stale reports don't
+// match their object's current value. each entry is checked against the current object in the transaction deleting it
+func (s *Store) deleteStaleIndexEntries(ctx context.Context, txn *badger.Txn, prefix []byte, meta byte, stale func(obj *api.Object, value string) bool) error {
+	opts := badger.DefaultIteratorOptions
+	opts.PrefetchValues = false
+	iter := txn.NewIterator(opts)
+	defer iter.Close()
+	var writes []func(txn *badger.Txn) error
+	for iter.Seek(prefix); iter.ValidForPrefix(prefix); iter.Next() {
+		if iter.Item().UserMeta() != meta {
+			continue
+		}
+		indexKey := iter.Item().KeyCopy(nil)
+		writes = append(writes, func(txn *badger.Txn) error {
+			sep := bytes.IndexByte(indexKey[len(prefix):], 0)
+			if sep >= 0 {
+				value, key := string(indexKey[len(prefix):len(prefix)+sep]), string(indexKey[len(prefix)+sep+1:])
+				obj, err := storedObject(txn, key)
+				if err != nil {
+					return err
+				}
+				if obj != nil && !stale(obj, value) {
+					return nil
+				}
+			}
+			return txn.Delete(indexKey)
+		})
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	_, err := s.commitRetried(writes, warmupAttempts)
+	return err
+}
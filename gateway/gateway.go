@@ -0,0 +1,263 @@
+package gateway
+
+import (
+	"context"
+	"fmt"
+	api "github.com/autom8ter/geodb/gen/go/geodb"
+	"github.com/autom8ter/geodb/ratelimit"
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+	"github.com/labstack/echo"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"net"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// Invoker calls a unary rpc(implemented by *grpc.ClientConn)
+type Invoker interface {
+	Invoke(ctx context.Context, method string, args, reply interface{}, opts ...grpc.CallOption) error
+}
+
+type route struct {
+	verb     string
+	path     string
+	rpc      string
+	request  func() proto.Message
+	response func() proto.Message
+}
+
+// GET & DELETE requests are read from the query string(nested fields are dotted ex: center.lat, repeated fields are repeated
+// ex: keys=a&keys=b, map fields are metadata_selector.<key>). other requests are read from a JSON body
+var routes = []route{
+	{http.MethodGet, "/v1/ping", "Ping", func() proto.Message { return &api.PingRequest{} }, func() proto.Message { return &api.PingResponse{} }},
+	{http.MethodGet, "/v1/health", "Health", func() proto.Message { return &api.HealthRequest{} }, func() proto.Message { return &api.HealthResponse{} }},
+	{http.MethodGet, "/v1/stats", "Stats", func() proto.Message { return &api.StatsRequest{} }, func() proto.Message { return &api.StatsResponse{} }},
+	{http.MethodPost, "/v1/gc", "RunGC", func() proto.Message { return &api.GCRequest{} }, func() proto.Message { return &api.GCResponse{} }},
+	{http.MethodPost, "/v1/objects", "Set", func() proto.Message { return &api.SetRequest{} }, func() proto.Message { return &api.SetResponse{} }},
+	{http.MethodPost, "/v1/objects/batch", "SetMany", func() proto.Message { return &api.SetManyRequest{} }, func() proto.Message { return &api.SetManyResponse{} }},
+	{http.MethodPost, "/v1/objects/positions", "BulkUpdatePositions", func() proto.Message { return &api.BulkUpdatePositionsRequest{} }, func() proto.Message { return &api.BulkUpdatePositionsResponse{} }},
+	{http.MethodPatch, "/v1/objects", "Update", func() proto.Message { return &api.UpdateRequest{} }, func() proto.Message { return &api.UpdateResponse{} }},
+	{http.MethodPost, "/v1/objects/increment", "Increment", func() proto.Message { return &api.IncrementRequest{} }, func() proto.Message { return &api.IncrementResponse{} }},
+	{http.MethodPost, "/v1/objects/csv", "ImportCSV", func() proto.Message { return &api.ImportCSVRequest{} }, func() proto.Message { return &api.ImportCSVResponse{} }},
+	{http.MethodGet, "/v1/objects", "Get", func() proto.Message { return &api.GetRequest{} }, func() proto.Message { return &api.GetResponse{} }},
+	{http.MethodGet, "/v1/objects/regex", "GetRegex", func() proto.Message { return &api.GetRegexRequest{} }, func() proto.Message { return &api.GetRegexResponse{} }},
+	{http.MethodGet, "/v1/objects/prefix", "GetPrefix", func() proto.Message { return &api.GetPrefixRequest{} }, func() proto.Message { return &api.GetPrefixResponse{} }},
+	{http.MethodGet, "/v1/objects/glob", "GetGlob", func() proto.Message { return &api.GetGlobRequest{} }, func() proto.Message { return &api.GetGlobResponse{} }},
+	{http.MethodGet, "/v1/objects/tagged", "GetTagged", func() proto.Message { return &api.GetTaggedRequest{} }, func() proto.Message { return &api.GetTaggedResponse{} }},
+	{http.MethodDelete, "/v1/objects", "Delete", func() proto.Message { return &api.DeleteRequest{} }, func() proto.Message { return &api.DeleteResponse{} }},
+	{http.MethodDelete, "/v1/objects/prefix", "DeletePrefix", func() proto.Message { return &api.DeletePrefixRequest{} }, func() proto.Message { return &api.DeletePrefixResponse{} }},
+	{http.MethodDelete, "/v1/objects/regex", "DeleteRegex", func() proto.Message { return &api.DeleteRegexRequest{} }, func() proto.Message { return &api.DeleteRegexResponse{} }},
+	{http.MethodDelete, "/v1/objects/expired", "DeleteExpired", func() proto.Message { return &api.DeleteExpiredRequest{} }, func() proto.Message { return &api.DeleteExpiredResponse{} }},
+	{http.MethodGet, "/v1/keys", "GetKeys", func() proto.Message { return &api.GetKeysRequest{} }, func() proto.Message { return &api.GetKeysResponse{} }},
+	{http.MethodGet, "/v1/keys/regex", "GetRegexKeys", func() proto.Message { return &api.GetRegexKeysRequest{} }, func() proto.Message { return &api.GetRegexKeysResponse{} }},
+	{http.MethodGet, "/v1/keys/prefix", "GetPrefixKeys", func() proto.Message { return &api.GetPrefixKeysRequest{} }, func() proto.Message { return &api.GetPrefixKeysResponse{} }},
+	{http.MethodGet, "/v1/exists", "Exists", func() proto.Message { return &api.ExistsRequest{} }, func() proto.Message { return &api.ExistsResponse{} }},
+	{http.MethodGet, "/v1/ttl", "GetTTL", func() proto.Message { return &api.TTLRequest{} }, func() proto.Message { return &api.TTLResponse{} }},
+	{http.MethodGet, "/v1/count", "Count", func() proto.Message { return &api.CountRequest{} }, func() proto.Message { return &api.CountResponse{} }},
+	{http.MethodGet, "/v1/history", "GetHistory", func() proto.Message { return &api.HistoryRequest{} }, func() proto.Message { return &api.HistoryResponse{} }},
+	{http.MethodGet, "/v1/point", "GetPoint", func() proto.Message { return &api.GetPointRequest{} }, func() proto.Message { return &api.GetPointResponse{} }},
+	{http.MethodGet, "/v1/nearest", "Nearest", func() proto.Message { return &api.NearestRequest{} }, func() proto.Message { return &api.NearestResponse{} }},
+	{http.MethodGet, "/v1/radius", "GetWithinRadius", func() proto.Message { return &api.RadiusRequest{} }, func() proto.Message { return &api.RadiusResponse{} }},
+	{http.MethodGet, "/v1/bounds", "GetWithinBounds", func() proto.Message { return &api.BoundsRequest{} }, func() proto.Message { return &api.BoundsResponse{} }},
+	{http.MethodGet, "/v1/geohash", "GetByGeohashPrefix", func() proto.Message { return &api.GeohashRequest{} }, func() proto.Message { return &api.GeohashResponse{} }},
+	{http.MethodPost, "/v1/polygon", "GetWithinPolygon", func() proto.Message { return &api.PolygonRequest{} }, func() proto.Message { return &api.PolygonResponse{} }},
+	{http.MethodPost, "/v1/corridor", "WithinCorridor", func() proto.Message { return &api.WithinCorridorRequest{} }, func() proto.Message { return &api.WithinCorridorResponse{} }},
+	{http.MethodPost, "/v1/polyline-distance", "DistanceToPolyline", func() proto.Message { return &api.PolylineRequest{} }, func() proto.Message { return &api.PolylineResponse{} }},
+	{http.MethodPost, "/v1/scan/bound", "ScanBound", func() proto.Message { return &api.ScanBoundRequest{} }, func() proto.Message { return &api.ScanBoundResponse{} }},
+	{http.MethodPost, "/v1/scan/regex", "ScanRegexBound", func() proto.Message { return &api.ScanRegexBoundRequest{} }, func() proto.Message { return &api.ScanRegexBoundResponse{} }},
+	{http.MethodPost, "/v1/scan/prefix", "ScanPrefixBound", func() proto.Message { return &api.ScanPrefixBoundRequest{} }, func() proto.Message { return &api.ScanPrefixBoundResponse{} }},
+	{http.MethodPost, "/v1/scan/isochrone", "ScanIsochrone", func() proto.Message { return &api.ScanIsochroneRequest{} }, func() proto.Message { return &api.ScanIsochroneResponse{} }},
+	{http.MethodPost, "/v1/proximity-matrix", "ProximityMatrix", func() proto.Message { return &api.ProximityMatrixRequest{} }, func() proto.Message { return &api.ProximityMatrixResponse{} }},
+	{http.MethodPost, "/v1/bounding-circle", "BoundingCircle", func() proto.Message { return &api.BoundingCircleRequest{} }, func() proto.Message { return &api.BoundingCircleResponse{} }},
+	{http.MethodPost, "/v1/aggregate", "Aggregate", func() proto.Message { return &api.AggregateRequest{} }, func() proto.Message { return &api.AggregateResponse{} }},
+	{http.MethodPost, "/v1/cluster", "Cluster", func() proto.Message { return &api.ClusterRequest{} }, func() proto.Message { return &api.ClusterResponse{} }},
+	{http.MethodGet, "/v1/dead-letters", "GetDeadLetters", func() proto.Message { return &api.GetDeadLettersRequest{} }, func() proto.Message { return &api.GetDeadLettersResponse{} }},
+	{http.MethodGet, "/v1/events", "GetEvents", func() proto.Message { return &api.GetEventsRequest{} }, func() proto.Message { return &api.GetEventsResponse{} }},
+	{http.MethodPost, "/v1/read-sessions", "OpenReadSession", func() proto.Message { return &api.OpenReadSessionRequest{} }, func() proto.Message { return &api.OpenReadSessionResponse{} }},
+	{http.MethodDelete, "/v1/read-sessions", "CloseReadSession", func() proto.Message { return &api.CloseReadSessionRequest{} }, func() proto.Message { return &api.CloseReadSessionResponse{} }},
+	{http.MethodPost, "/v1/geofences", "CreateGeofence", func() proto.Message { return &api.CreateGeofenceRequest{} }, func() proto.Message { return &api.CreateGeofenceResponse{} }},
+	{http.MethodDelete, "/v1/geofences", "DeleteGeofence", func() proto.Message { return &api.DeleteGeofenceRequest{} }, func() proto.Message { return &api.DeleteGeofenceResponse{} }},
+	{http.MethodGet, "/v1/geofences", "ListGeofences", func() proto.Message { return &api.ListGeofencesRequest{} }, func() proto.Message { return &api.ListGeofencesResponse{} }},
+	{http.MethodGet, "/v1/stream-clients", "ListStreamClients", func() proto.Message { return &api.ListClientsRequest{} }, func() proto.Message { return &api.ListClientsResponse{} }},
+}
+
+var marshaler = &jsonpb.Marshaler{OrigName: true}
+
+// Register adds a REST/JSON route to the router for every unary rpc. requests are forwarded to the grpc server through
+// conn(with the authorization header & the client's address as metadata), so they go through the same interceptors as grpc clients
+func Register(router *echo.Echo, conn Invoker) {
+	for _, r := range routes {
+		r := r
+		router.Add(r.verb, r.path, func(c echo.Context) error {
+			req := r.request()
+			if err := decode(c.Request(), req); err != nil {
+				return writeError(c, status.Errorf(codes.InvalidArgument, "failed to decode request: %s", err.Error()))
+			}
+			ctx := c.Request().Context()
+			if authorization := c.Request().Header.Get("Authorization"); authorization != "" {
+				ctx = metadata.AppendToOutgoingContext(ctx, "authorization", authorization)
+			}
+			// every request reaches the grpc server from the gateway's loopback connection, so the client's address is
+			// forwarded for the rate limiter(see ratelimit.ForwardedForHeader)
+			if host, _, err := net.SplitHostPort(c.Request().RemoteAddr); err == nil {
+				ctx = metadata.AppendToOutgoingContext(ctx, ratelimit.ForwardedForHeader, host)
+			}
+			resp := r.response()
+			if err := conn.Invoke(ctx, "/api.GeoDB/"+r.rpc, req, resp); err != nil {
+				return writeError(c, err)
+			}
+			body, err := marshaler.MarshalToString(resp)
+			if err != nil {
+				return writeError(c, status.Errorf(codes.Internal, "failed to encode response: %s", err.Error()))
+			}
+			return c.JSONBlob(http.StatusOK, []byte(body))
+		})
+	}
+}
+
+func decode(r *http.Request, msg proto.Message) error {
+	if r.Method == http.MethodGet || r.Method == http.MethodDelete {
+		return populate(reflect.ValueOf(msg).Elem(), r.URL.Query())
+	}
+	if r.ContentLength == 0 {
+		return nil
+	}
+	return jsonpb.Unmarshal(r.Body, msg)
+}
+
+// populate sets the fields of the message struct v from the query parameters named after the fields' proto names
+func populate(v reflect.Value, query url.Values) error {
+	for name, values := range query {
+		if err := setField(v, strings.Split(name, "."), values); err != nil {
+			return fmt.Errorf("%s: %s", name, err.Error())
+		}
+	}
+	return nil
+}
+
+func setField(v reflect.Value, path []string, values []string) error {
+	field, tag, ok := protoField(v, path[0])
+	if !ok {
+		return fmt.Errorf("unknown field")
+	}
+	switch {
+	case field.Kind() == reflect.Ptr && field.Type().Elem().Kind() == reflect.Struct && len(path) > 1:
+		if field.IsNil() {
+			field.Set(reflect.New(field.Type().Elem()))
+		}
+		return setField(field.Elem(), path[1:], values)
+	case field.Kind() == reflect.Map && field.Type().Key().Kind() == reflect.String && field.Type().Elem().Kind() == reflect.String && len(path) == 2:
+		if field.IsNil() {
+			field.Set(reflect.MakeMap(field.Type()))
+		}
+		field.SetMapIndex(reflect.ValueOf(path[1]), reflect.ValueOf(values[len(values)-1]))
+		return nil
+	case len(path) > 1:
+		return fmt.Errorf("not a message field")
+	case field.Kind() == reflect.Slice && field.Type().Elem().Kind() != reflect.Uint8:
+		slice := reflect.MakeSlice(field.Type(), len(values), len(values))
+		for i, value := range values {
+			if err := setScalar(slice.Index(i), tag, value); err != nil {
+				return err
+			}
+		}
+		field.Set(slice)
+		return nil
+	default:
+		return setScalar(field, tag, values[len(values)-1])
+	}
+}
+
+// protoField returns the struct field with the given proto name & its protobuf struct tag
+func protoField(v reflect.Value, name string) (reflect.Value, string, bool) {
+	for i := 0; i < v.NumField(); i++ {
+		tag := v.Type().Field(i).Tag.Get("protobuf")
+		for _, part := range strings.Split(tag, ",") {
+			if part == "name="+name {
+				return v.Field(i), tag, true
+			}
+		}
+	}
+	return reflect.Value{}, "", false
+}
+
+func setScalar(field reflect.Value, tag, value string) error {
+	switch field.Kind() {
+	case reflect.String:
+		field.SetString(value)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return err
+		}
+		field.SetBool(b)
+	case reflect.Int32, reflect.Int64:
+		for _, part := range strings.Split(tag, ",") {
+			if strings.HasPrefix(part, "enum=") {
+				enum, ok := proto.EnumValueMap(strings.TrimPrefix(part, "enum="))[value]
+				if !ok {
+					return fmt.Errorf("unknown enum value: %s", value)
+				}
+				field.SetInt(int64(enum))
+				return nil
+			}
+		}
+		i, err := strconv.ParseInt(value, 10, field.Type().Bits())
+		if err != nil {
+			return err
+		}
+		field.SetInt(i)
+	case reflect.Uint32, reflect.Uint64:
+		i, err := strconv.ParseUint(value, 10, field.Type().Bits())
+		if err != nil {
+			return err
+		}
+		field.SetUint(i)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(value, field.Type().Bits())
+		if err != nil {
+			return err
+		}
+		field.SetFloat(f)
+	default:
+		return fmt.Errorf("unsupported query parameter type: %s", field.Type())
+	}
+	return nil
+}
+
+// httpStatus maps grpc status codes to http status codes
+var httpStatus = map[codes.Code]int{
+	codes.OK:                 http.StatusOK,
+	codes.Canceled:           499,
+	codes.InvalidArgument:    http.StatusBadRequest,
+	codes.DeadlineExceeded:   http.StatusGatewayTimeout,
+	codes.NotFound:           http.StatusNotFound,
+	codes.AlreadyExists:      http.StatusConflict,
+	codes.PermissionDenied:   http.StatusForbidden,
+	codes.Unauthenticated:    http.StatusUnauthorized,
+	codes.ResourceExhausted:  http.StatusTooManyRequests,
+	codes.FailedPrecondition: http.StatusPreconditionFailed,
+	codes.Aborted:            http.StatusConflict,
+	codes.OutOfRange:         http.StatusBadRequest,
+	codes.Unimplemented:      http.StatusNotImplemented,
+	codes.Unavailable:        http.StatusServiceUnavailable,
+}
+
+func writeError(c echo.Context, err error) error {
+	st := status.Convert(err)
+	code, ok := httpStatus[st.Code()]
+	if !ok {
+		code = http.StatusInternalServerError
+	}
+	return c.JSON(code, map[string]interface{}{
+		"code":    st.Code().String(),
+		"message": st.Message(),
+	})
+}
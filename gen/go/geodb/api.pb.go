@@ -25,6 +25,99 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
+//DistanceUnit selects the unit of a request's distances. Meters is the default
+type DistanceUnit int32
+
+const (
+	DistanceUnit_Meters        DistanceUnit = 0
+	DistanceUnit_Kilometers    DistanceUnit = 1
+	DistanceUnit_Miles         DistanceUnit = 2
+	DistanceUnit_NauticalMiles DistanceUnit = 3
+)
+
+var DistanceUnit_name = map[int32]string{
+	0: "Meters",
+	1: "Kilometers",
+	2: "Miles",
+	3: "NauticalMiles",
+}
+
+var DistanceUnit_value = map[string]int32{
+	"Meters":        0,
+	"Kilometers":    1,
+	"Miles":         2,
+	"NauticalMiles": 3,
+}
+
+func (x DistanceUnit) String() string {
+	return proto.EnumName(DistanceUnit_name, int32(x))
+}
+
+func (DistanceUnit) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{0}
+}
+
+//TagRelation restricts tracking to objects based on the tags they share
+type TagRelation int32
+
+const (
+	TagRelation_AnyTags      TagRelation = 0
+	TagRelation_SharedTags   TagRelation = 1
+	TagRelation_NoSharedTags TagRelation = 2
+)
+
+var TagRelation_name = map[int32]string{
+	0: "AnyTags",
+	1: "SharedTags",
+	2: "NoSharedTags",
+}
+
+var TagRelation_value = map[string]int32{
+	"AnyTags":      0,
+	"SharedTags":   1,
+	"NoSharedTags": 2,
+}
+
+func (x TagRelation) String() string {
+	return proto.EnumName(TagRelation_name, int32(x))
+}
+
+func (TagRelation) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{1}
+}
+
+//EventType is a geofence transition computed from the previous and current value of TrackerEvent.inside
+type EventType int32
+
+const (
+	EventType_Outside EventType = 0
+	EventType_Enter   EventType = 1
+	EventType_Inside  EventType = 2
+	EventType_Exit    EventType = 3
+)
+
+var EventType_name = map[int32]string{
+	0: "Outside",
+	1: "Enter",
+	2: "Inside",
+	3: "Exit",
+}
+
+var EventType_value = map[string]int32{
+	"Outside": 0,
+	"Enter":   1,
+	"Inside":  2,
+	"Exit":    3,
+}
+
+func (x EventType) String() string {
+	return proto.EnumName(EventType_name, int32(x))
+}
+
+func (EventType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{2}
+}
+
 //TravelMode is used to generate directions based on the type of travel the object is utilizing. only necessary if using google maps
 type TravelMode int32
 
@@ -54,7 +147,68 @@ func (x TravelMode) String() string {
 }
 
 func (TravelMode) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{0}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{3}
+}
+
+//StreamAction controls delivery on a StreamControl stream
+type StreamAction int32
+
+const (
+	StreamAction_Subscribe StreamAction = 0
+	StreamAction_Pause     StreamAction = 1
+	StreamAction_Resume    StreamAction = 2
+)
+
+var StreamAction_name = map[int32]string{
+	0: "Subscribe",
+	1: "Pause",
+	2: "Resume",
+}
+
+var StreamAction_value = map[string]int32{
+	"Subscribe": 0,
+	"Pause":     1,
+	"Resume":    2,
+}
+
+func (x StreamAction) String() string {
+	return proto.EnumName(StreamAction_name, int32(x))
+}
+
+func (StreamAction) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{4}
+}
+
+//SortBy is the field a response's ordered objects are sorted by
+type SortBy int32
+
+const (
+	SortBy_Unsorted     SortBy = 0
+	SortBy_SortKey      SortBy = 1
+	SortBy_SortDistance SortBy = 2
+	SortBy_SortUpdated  SortBy = 3
+)
+
+var SortBy_name = map[int32]string{
+	0: "Unsorted",
+	1: "SortKey",
+	2: "SortDistance",
+	3: "SortUpdated",
+}
+
+var SortBy_value = map[string]int32{
+	"Unsorted":     0,
+	"SortKey":      1,
+	"SortDistance": 2,
+	"SortUpdated":  3,
+}
+
+func (x SortBy) String() string {
+	return proto.EnumName(SortBy_name, int32(x))
+}
+
+func (SortBy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{5}
 }
 
 //A Point is a simple X/Y or Lng/Lat 2d point. [X, Y] or [Lng, Lat]
@@ -106,11 +260,12 @@ func (m *Point) GetLon() float64 {
 }
 
 type Bound struct {
-	Center               *Point   `protobuf:"bytes,1,opt,name=center,proto3" json:"center,omitempty"`
-	Radius               float64  `protobuf:"fixed64,2,opt,name=radius,proto3" json:"radius,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Center               *Point       `protobuf:"bytes,1,opt,name=center,proto3" json:"center,omitempty"`
+	Radius               float64      `protobuf:"fixed64,2,opt,name=radius,proto3" json:"radius,omitempty"`
+	Unit                 DistanceUnit `protobuf:"varint,3,opt,name=unit,proto3,enum=api.DistanceUnit" json:"unit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
 }
 
 func (m *Bound) Reset()         { *m = Bound{} }
@@ -152,6 +307,13 @@ func (m *Bound) GetRadius() float64 {
 	return 0
 }
 
+func (m *Bound) GetUnit() DistanceUnit {
+	if m != nil {
+		return m.Unit
+	}
+	return DistanceUnit_Meters
+}
+
 //An Object represents anything that has a unique identifier, and a geolocation.
 type Object struct {
 	Key                  string            `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
@@ -163,6 +325,17 @@ type Object struct {
 	GetTimezone          bool              `protobuf:"varint,7,opt,name=get_timezone,json=getTimezone,proto3" json:"get_timezone,omitempty"`
 	ExpiresUnix          int64             `protobuf:"varint,8,opt,name=expires_unix,json=expiresUnix,proto3" json:"expires_unix,omitempty"`
 	UpdatedUnix          int64             `protobuf:"varint,9,opt,name=updated_unix,json=updatedUnix,proto3" json:"updated_unix,omitempty"`
+	Tags                 []string          `protobuf:"bytes,10,rep,name=tags,proto3" json:"tags,omitempty"`
+	TtlSeconds           int64             `protobuf:"varint,11,opt,name=ttl_seconds,json=ttlSeconds,proto3" json:"ttl_seconds,omitempty"`
+	Geohash              string            `protobuf:"bytes,12,opt,name=geohash,proto3" json:"geohash,omitempty"`
+	KeepHistory          bool              `protobuf:"varint,13,opt,name=keep_history,json=keepHistory,proto3" json:"keep_history,omitempty"`
+	Version              int64             `protobuf:"varint,14,opt,name=version,proto3" json:"version,omitempty"`
+	TrackOdometer        bool              `protobuf:"varint,15,opt,name=track_odometer,json=trackOdometer,proto3" json:"track_odometer,omitempty"`
+	OdometerMeters       float64           `protobuf:"fixed64,16,opt,name=odometer_meters,json=odometerMeters,proto3" json:"odometer_meters,omitempty"`
+	Polyline             []*Point          `protobuf:"bytes,17,rep,name=polyline,proto3" json:"polyline,omitempty"`
+	Polygon              []*Point          `protobuf:"bytes,18,rep,name=polygon,proto3" json:"polygon,omitempty"`
+	Role                 string            `protobuf:"bytes,19,opt,name=role,proto3" json:"role,omitempty"`
+	Counters             map[string]int64  `protobuf:"bytes,20,rep,name=counters,proto3" json:"counters,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
 	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
 	XXX_unrecognized     []byte            `json:"-"`
 	XXX_sizecache        int32             `json:"-"`
@@ -256,10 +429,145 @@ func (m *Object) GetUpdatedUnix() int64 {
 	return 0
 }
 
+func (m *Object) GetTags() []string {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+func (m *Object) GetTtlSeconds() int64 {
+	if m != nil {
+		return m.TtlSeconds
+	}
+	return 0
+}
+
+func (m *Object) GetGeohash() string {
+	if m != nil {
+		return m.Geohash
+	}
+	return ""
+}
+
+func (m *Object) GetKeepHistory() bool {
+	if m != nil {
+		return m.KeepHistory
+	}
+	return false
+}
+
+func (m *Object) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *Object) GetTrackOdometer() bool {
+	if m != nil {
+		return m.TrackOdometer
+	}
+	return false
+}
+
+func (m *Object) GetOdometerMeters() float64 {
+	if m != nil {
+		return m.OdometerMeters
+	}
+	return 0
+}
+
+func (m *Object) GetPolyline() []*Point {
+	if m != nil {
+		return m.Polyline
+	}
+	return nil
+}
+
+func (m *Object) GetPolygon() []*Point {
+	if m != nil {
+		return m.Polygon
+	}
+	return nil
+}
+
+func (m *Object) GetRole() string {
+	if m != nil {
+		return m.Role
+	}
+	return ""
+}
+
+func (m *Object) GetCounters() map[string]int64 {
+	if m != nil {
+		return m.Counters
+	}
+	return nil
+}
+
+//TagFilter matches objects by their tags. an empty filter matches every object
+type TagFilter struct {
+	Any                  []string `protobuf:"bytes,1,rep,name=any,proto3" json:"any,omitempty"`
+	All                  []string `protobuf:"bytes,2,rep,name=all,proto3" json:"all,omitempty"`
+	None                 []string `protobuf:"bytes,3,rep,name=none,proto3" json:"none,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TagFilter) Reset()         { *m = TagFilter{} }
+func (m *TagFilter) String() string { return proto.CompactTextString(m) }
+func (*TagFilter) ProtoMessage()    {}
+func (*TagFilter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{3}
+}
+
+func (m *TagFilter) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TagFilter.Unmarshal(m, b)
+}
+func (m *TagFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TagFilter.Marshal(b, m, deterministic)
+}
+func (m *TagFilter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TagFilter.Merge(m, src)
+}
+func (m *TagFilter) XXX_Size() int {
+	return xxx_messageInfo_TagFilter.Size(m)
+}
+func (m *TagFilter) XXX_DiscardUnknown() {
+	xxx_messageInfo_TagFilter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TagFilter proto.InternalMessageInfo
+
+func (m *TagFilter) GetAny() []string {
+	if m != nil {
+		return m.Any
+	}
+	return nil
+}
+
+func (m *TagFilter) GetAll() []string {
+	if m != nil {
+		return m.All
+	}
+	return nil
+}
+
+func (m *TagFilter) GetNone() []string {
+	if m != nil {
+		return m.None
+	}
+	return nil
+}
+
 //ObjectTracking configures object-object geofencing, directions, eta, etc
 type ObjectTracking struct {
 	TravelMode           TravelMode       `protobuf:"varint,1,opt,name=travel_mode,json=travelMode,proto3,enum=api.TravelMode" json:"travel_mode,omitempty"`
 	Trackers             []*ObjectTracker `protobuf:"bytes,2,rep,name=trackers,proto3" json:"trackers,omitempty"`
+	TagRelation          TagRelation      `protobuf:"varint,3,opt,name=tag_relation,json=tagRelation,proto3,enum=api.TagRelation" json:"tag_relation,omitempty"`
+	DistanceUnit         DistanceUnit     `protobuf:"varint,4,opt,name=distance_unit,json=distanceUnit,proto3,enum=api.DistanceUnit" json:"distance_unit,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
 	XXX_unrecognized     []byte           `json:"-"`
 	XXX_sizecache        int32            `json:"-"`
@@ -269,7 +577,7 @@ func (m *ObjectTracking) Reset()         { *m = ObjectTracking{} }
 func (m *ObjectTracking) String() string { return proto.CompactTextString(m) }
 func (*ObjectTracking) ProtoMessage()    {}
 func (*ObjectTracking) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{3}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{4}
 }
 
 func (m *ObjectTracking) XXX_Unmarshal(b []byte) error {
@@ -304,22 +612,37 @@ func (m *ObjectTracking) GetTrackers() []*ObjectTracker {
 	return nil
 }
 
+func (m *ObjectTracking) GetTagRelation() TagRelation {
+	if m != nil {
+		return m.TagRelation
+	}
+	return TagRelation_AnyTags
+}
+
+func (m *ObjectTracking) GetDistanceUnit() DistanceUnit {
+	if m != nil {
+		return m.DistanceUnit
+	}
+	return DistanceUnit_Meters
+}
+
 //a foreign object to track against another object
 type ObjectTracker struct {
-	TargetObjectKey      string   `protobuf:"bytes,1,opt,name=target_object_key,json=targetObjectKey,proto3" json:"target_object_key,omitempty"`
-	TrackDirections      bool     `protobuf:"varint,2,opt,name=track_directions,json=trackDirections,proto3" json:"track_directions,omitempty"`
-	TrackDistance        bool     `protobuf:"varint,3,opt,name=track_distance,json=trackDistance,proto3" json:"track_distance,omitempty"`
-	TrackEta             bool     `protobuf:"varint,4,opt,name=track_eta,json=trackEta,proto3" json:"track_eta,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	TargetObjectKey      string     `protobuf:"bytes,1,opt,name=target_object_key,json=targetObjectKey,proto3" json:"target_object_key,omitempty"`
+	TrackDirections      bool       `protobuf:"varint,2,opt,name=track_directions,json=trackDirections,proto3" json:"track_directions,omitempty"`
+	TrackDistance        bool       `protobuf:"varint,3,opt,name=track_distance,json=trackDistance,proto3" json:"track_distance,omitempty"`
+	TrackEta             bool       `protobuf:"varint,4,opt,name=track_eta,json=trackEta,proto3" json:"track_eta,omitempty"`
+	TargetTags           *TagFilter `protobuf:"bytes,5,opt,name=target_tags,json=targetTags,proto3" json:"target_tags,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
 }
 
 func (m *ObjectTracker) Reset()         { *m = ObjectTracker{} }
 func (m *ObjectTracker) String() string { return proto.CompactTextString(m) }
 func (*ObjectTracker) ProtoMessage()    {}
 func (*ObjectTracker) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{4}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{5}
 }
 
 func (m *ObjectTracker) XXX_Unmarshal(b []byte) error {
@@ -368,6 +691,13 @@ func (m *ObjectTracker) GetTrackEta() bool {
 	return false
 }
 
+func (m *ObjectTracker) GetTargetTags() *TagFilter {
+	if m != nil {
+		return m.TargetTags
+	}
+	return nil
+}
+
 //Directions if using the google maps integration
 type Directions struct {
 	HtmlDirections       string   `protobuf:"bytes,1,opt,name=html_directions,json=htmlDirections,proto3" json:"html_directions,omitempty"`
@@ -382,7 +712,7 @@ func (m *Directions) Reset()         { *m = Directions{} }
 func (m *Directions) String() string { return proto.CompactTextString(m) }
 func (*Directions) ProtoMessage()    {}
 func (*Directions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{5}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{6}
 }
 
 func (m *Directions) XXX_Unmarshal(b []byte) error {
@@ -441,7 +771,7 @@ func (m *Address) Reset()         { *m = Address{} }
 func (m *Address) String() string { return proto.CompactTextString(m) }
 func (*Address) ProtoMessage()    {}
 func (*Address) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{6}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{7}
 }
 
 func (m *Address) XXX_Unmarshal(b []byte) error {
@@ -507,21 +837,24 @@ func (m *Address) GetCity() string {
 //Tracker is data associated with the object tracking mechanism- it tracks one obects relation to another.
 //An object can have many trackers representing a one-many relationship
 type TrackerEvent struct {
-	Object               *Object     `protobuf:"bytes,1,opt,name=object,proto3" json:"object,omitempty"`
-	Distance             float64     `protobuf:"fixed64,2,opt,name=distance,proto3" json:"distance,omitempty"`
-	Inside               bool        `protobuf:"varint,3,opt,name=inside,proto3" json:"inside,omitempty"`
-	Direction            *Directions `protobuf:"bytes,4,opt,name=direction,proto3" json:"direction,omitempty"`
-	TimestampUnix        int64       `protobuf:"varint,5,opt,name=timestamp_unix,json=timestampUnix,proto3" json:"timestamp_unix,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
-	XXX_unrecognized     []byte      `json:"-"`
-	XXX_sizecache        int32       `json:"-"`
+	Object               *Object           `protobuf:"bytes,1,opt,name=object,proto3" json:"object,omitempty"`
+	Distance             float64           `protobuf:"fixed64,2,opt,name=distance,proto3" json:"distance,omitempty"`
+	Inside               bool              `protobuf:"varint,3,opt,name=inside,proto3" json:"inside,omitempty"`
+	Direction            *Directions       `protobuf:"bytes,4,opt,name=direction,proto3" json:"direction,omitempty"`
+	TimestampUnix        int64             `protobuf:"varint,5,opt,name=timestamp_unix,json=timestampUnix,proto3" json:"timestamp_unix,omitempty"`
+	Metadata             map[string]string `protobuf:"bytes,6,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	TimestampNanos       int64             `protobuf:"varint,7,opt,name=timestamp_nanos,json=timestampNanos,proto3" json:"timestamp_nanos,omitempty"`
+	EventType            EventType         `protobuf:"varint,8,opt,name=event_type,json=eventType,proto3,enum=api.EventType" json:"event_type,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
 func (m *TrackerEvent) Reset()         { *m = TrackerEvent{} }
 func (m *TrackerEvent) String() string { return proto.CompactTextString(m) }
 func (*TrackerEvent) ProtoMessage()    {}
 func (*TrackerEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{7}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{8}
 }
 
 func (m *TrackerEvent) XXX_Unmarshal(b []byte) error {
@@ -577,22 +910,46 @@ func (m *TrackerEvent) GetTimestampUnix() int64 {
 	return 0
 }
 
+func (m *TrackerEvent) GetMetadata() map[string]string {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *TrackerEvent) GetTimestampNanos() int64 {
+	if m != nil {
+		return m.TimestampNanos
+	}
+	return 0
+}
+
+func (m *TrackerEvent) GetEventType() EventType {
+	if m != nil {
+		return m.EventType
+	}
+	return EventType_Outside
+}
+
 //ObjectDetail is an enhanced view of an Object containing a human readable address and the objects latest tracking information
 type ObjectDetail struct {
-	Object               *Object         `protobuf:"bytes,1,opt,name=object,proto3" json:"object,omitempty"`
-	Address              *Address        `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
-	Timezone             string          `protobuf:"bytes,3,opt,name=timezone,proto3" json:"timezone,omitempty"`
-	TrackerEvents        []*TrackerEvent `protobuf:"bytes,4,rep,name=tracker_events,json=trackerEvents,proto3" json:"tracker_events,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
-	XXX_unrecognized     []byte          `json:"-"`
-	XXX_sizecache        int32           `json:"-"`
+	Object               *Object          `protobuf:"bytes,1,opt,name=object,proto3" json:"object,omitempty"`
+	Address              *Address         `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Timezone             string           `protobuf:"bytes,3,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	TrackerEvents        []*TrackerEvent  `protobuf:"bytes,4,rep,name=tracker_events,json=trackerEvents,proto3" json:"tracker_events,omitempty"`
+	Deleted              bool             `protobuf:"varint,5,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	GeofenceEvents       []*GeofenceEvent `protobuf:"bytes,6,rep,name=geofence_events,json=geofenceEvents,proto3" json:"geofence_events,omitempty"`
+	InsideTargets        []string         `protobuf:"bytes,7,rep,name=inside_targets,json=insideTargets,proto3" json:"inside_targets,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
 func (m *ObjectDetail) Reset()         { *m = ObjectDetail{} }
 func (m *ObjectDetail) String() string { return proto.CompactTextString(m) }
 func (*ObjectDetail) ProtoMessage()    {}
 func (*ObjectDetail) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{8}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{9}
 }
 
 func (m *ObjectDetail) XXX_Unmarshal(b []byte) error {
@@ -641,54 +998,323 @@ func (m *ObjectDetail) GetTrackerEvents() []*TrackerEvent {
 	return nil
 }
 
-type StreamRequest struct {
-	ClientId             string   `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
-	Keys                 []string `protobuf:"bytes,2,rep,name=keys,proto3" json:"keys,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
-}
-
-func (m *StreamRequest) Reset()         { *m = StreamRequest{} }
-func (m *StreamRequest) String() string { return proto.CompactTextString(m) }
-func (*StreamRequest) ProtoMessage()    {}
-func (*StreamRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{9}
-}
-
-func (m *StreamRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_StreamRequest.Unmarshal(m, b)
-}
-func (m *StreamRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_StreamRequest.Marshal(b, m, deterministic)
-}
-func (m *StreamRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_StreamRequest.Merge(m, src)
-}
-func (m *StreamRequest) XXX_Size() int {
-	return xxx_messageInfo_StreamRequest.Size(m)
-}
-func (m *StreamRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_StreamRequest.DiscardUnknown(m)
+func (m *ObjectDetail) GetDeleted() bool {
+	if m != nil {
+		return m.Deleted
+	}
+	return false
 }
 
-var xxx_messageInfo_StreamRequest proto.InternalMessageInfo
-
-func (m *StreamRequest) GetClientId() string {
+func (m *ObjectDetail) GetGeofenceEvents() []*GeofenceEvent {
 	if m != nil {
-		return m.ClientId
+		return m.GeofenceEvents
 	}
-	return ""
+	return nil
 }
 
-func (m *StreamRequest) GetKeys() []string {
+func (m *ObjectDetail) GetInsideTargets() []string {
 	if m != nil {
-		return m.Keys
+		return m.InsideTargets
 	}
 	return nil
 }
 
-type StreamResponse struct {
+//Geofence is a named static area(circle or polygon) registered separately from objects. objects are checked against every geofence when they're written
+type Geofence struct {
+	Name                 string            `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Center               *Point            `protobuf:"bytes,2,opt,name=center,proto3" json:"center,omitempty"`
+	Radius               int64             `protobuf:"varint,3,opt,name=radius,proto3" json:"radius,omitempty"`
+	Polygon              []*Point          `protobuf:"bytes,4,rep,name=polygon,proto3" json:"polygon,omitempty"`
+	Metadata             map[string]string `protobuf:"bytes,5,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *Geofence) Reset()         { *m = Geofence{} }
+func (m *Geofence) String() string { return proto.CompactTextString(m) }
+func (*Geofence) ProtoMessage()    {}
+func (*Geofence) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{10}
+}
+
+func (m *Geofence) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Geofence.Unmarshal(m, b)
+}
+func (m *Geofence) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Geofence.Marshal(b, m, deterministic)
+}
+func (m *Geofence) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Geofence.Merge(m, src)
+}
+func (m *Geofence) XXX_Size() int {
+	return xxx_messageInfo_Geofence.Size(m)
+}
+func (m *Geofence) XXX_DiscardUnknown() {
+	xxx_messageInfo_Geofence.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Geofence proto.InternalMessageInfo
+
+func (m *Geofence) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Geofence) GetCenter() *Point {
+	if m != nil {
+		return m.Center
+	}
+	return nil
+}
+
+func (m *Geofence) GetRadius() int64 {
+	if m != nil {
+		return m.Radius
+	}
+	return 0
+}
+
+func (m *Geofence) GetPolygon() []*Point {
+	if m != nil {
+		return m.Polygon
+	}
+	return nil
+}
+
+func (m *Geofence) GetMetadata() map[string]string {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+//GeofenceEvent is an object's transition relative to a geofence. objects outside of a geofence they weren't inside of don't get an event
+type GeofenceEvent struct {
+	Fence                string    `protobuf:"bytes,1,opt,name=fence,proto3" json:"fence,omitempty"`
+	EventType            EventType `protobuf:"varint,2,opt,name=event_type,json=eventType,proto3,enum=api.EventType" json:"event_type,omitempty"`
+	Inside               bool      `protobuf:"varint,3,opt,name=inside,proto3" json:"inside,omitempty"`
+	Distance             float64   `protobuf:"fixed64,4,opt,name=distance,proto3" json:"distance,omitempty"`
+	TimestampNanos       int64     `protobuf:"varint,5,opt,name=timestamp_nanos,json=timestampNanos,proto3" json:"timestamp_nanos,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *GeofenceEvent) Reset()         { *m = GeofenceEvent{} }
+func (m *GeofenceEvent) String() string { return proto.CompactTextString(m) }
+func (*GeofenceEvent) ProtoMessage()    {}
+func (*GeofenceEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{11}
+}
+
+func (m *GeofenceEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GeofenceEvent.Unmarshal(m, b)
+}
+func (m *GeofenceEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GeofenceEvent.Marshal(b, m, deterministic)
+}
+func (m *GeofenceEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GeofenceEvent.Merge(m, src)
+}
+func (m *GeofenceEvent) XXX_Size() int {
+	return xxx_messageInfo_GeofenceEvent.Size(m)
+}
+func (m *GeofenceEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_GeofenceEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GeofenceEvent proto.InternalMessageInfo
+
+func (m *GeofenceEvent) GetFence() string {
+	if m != nil {
+		return m.Fence
+	}
+	return ""
+}
+
+func (m *GeofenceEvent) GetEventType() EventType {
+	if m != nil {
+		return m.EventType
+	}
+	return EventType_Outside
+}
+
+func (m *GeofenceEvent) GetInside() bool {
+	if m != nil {
+		return m.Inside
+	}
+	return false
+}
+
+func (m *GeofenceEvent) GetDistance() float64 {
+	if m != nil {
+		return m.Distance
+	}
+	return 0
+}
+
+func (m *GeofenceEvent) GetTimestampNanos() int64 {
+	if m != nil {
+		return m.TimestampNanos
+	}
+	return 0
+}
+
+type StreamRequest struct {
+	ClientId             string     `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	Keys                 []string   `protobuf:"bytes,2,rep,name=keys,proto3" json:"keys,omitempty"`
+	Tags                 *TagFilter `protobuf:"bytes,3,opt,name=tags,proto3" json:"tags,omitempty"`
+	Box                  *Box       `protobuf:"bytes,4,opt,name=box,proto3" json:"box,omitempty"`
+	Bound                *Bound     `protobuf:"bytes,5,opt,name=bound,proto3" json:"bound,omitempty"`
+	Namespace            string     `protobuf:"bytes,6,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	SinceUnix            int64      `protobuf:"varint,7,opt,name=since_unix,json=sinceUnix,proto3" json:"since_unix,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *StreamRequest) Reset()         { *m = StreamRequest{} }
+func (m *StreamRequest) String() string { return proto.CompactTextString(m) }
+func (*StreamRequest) ProtoMessage()    {}
+func (*StreamRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{12}
+}
+
+func (m *StreamRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StreamRequest.Unmarshal(m, b)
+}
+func (m *StreamRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StreamRequest.Marshal(b, m, deterministic)
+}
+func (m *StreamRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamRequest.Merge(m, src)
+}
+func (m *StreamRequest) XXX_Size() int {
+	return xxx_messageInfo_StreamRequest.Size(m)
+}
+func (m *StreamRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StreamRequest proto.InternalMessageInfo
+
+func (m *StreamRequest) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
+}
+
+func (m *StreamRequest) GetKeys() []string {
+	if m != nil {
+		return m.Keys
+	}
+	return nil
+}
+
+func (m *StreamRequest) GetTags() *TagFilter {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+func (m *StreamRequest) GetBox() *Box {
+	if m != nil {
+		return m.Box
+	}
+	return nil
+}
+
+func (m *StreamRequest) GetBound() *Bound {
+	if m != nil {
+		return m.Bound
+	}
+	return nil
+}
+
+func (m *StreamRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *StreamRequest) GetSinceUnix() int64 {
+	if m != nil {
+		return m.SinceUnix
+	}
+	return 0
+}
+
+//Box is a lat/lon bounding box. if min_lon > max_lon the box crosses the antimeridian
+type Box struct {
+	MinLat               float64  `protobuf:"fixed64,1,opt,name=min_lat,json=minLat,proto3" json:"min_lat,omitempty"`
+	MinLon               float64  `protobuf:"fixed64,2,opt,name=min_lon,json=minLon,proto3" json:"min_lon,omitempty"`
+	MaxLat               float64  `protobuf:"fixed64,3,opt,name=max_lat,json=maxLat,proto3" json:"max_lat,omitempty"`
+	MaxLon               float64  `protobuf:"fixed64,4,opt,name=max_lon,json=maxLon,proto3" json:"max_lon,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Box) Reset()         { *m = Box{} }
+func (m *Box) String() string { return proto.CompactTextString(m) }
+func (*Box) ProtoMessage()    {}
+func (*Box) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{13}
+}
+
+func (m *Box) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Box.Unmarshal(m, b)
+}
+func (m *Box) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Box.Marshal(b, m, deterministic)
+}
+func (m *Box) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Box.Merge(m, src)
+}
+func (m *Box) XXX_Size() int {
+	return xxx_messageInfo_Box.Size(m)
+}
+func (m *Box) XXX_DiscardUnknown() {
+	xxx_messageInfo_Box.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Box proto.InternalMessageInfo
+
+func (m *Box) GetMinLat() float64 {
+	if m != nil {
+		return m.MinLat
+	}
+	return 0
+}
+
+func (m *Box) GetMinLon() float64 {
+	if m != nil {
+		return m.MinLon
+	}
+	return 0
+}
+
+func (m *Box) GetMaxLat() float64 {
+	if m != nil {
+		return m.MaxLat
+	}
+	return 0
+}
+
+func (m *Box) GetMaxLon() float64 {
+	if m != nil {
+		return m.MaxLon
+	}
+	return 0
+}
+
+type StreamResponse struct {
 	Object               *ObjectDetail `protobuf:"bytes,1,opt,name=object,proto3" json:"object,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
 	XXX_unrecognized     []byte        `json:"-"`
@@ -699,7 +1325,7 @@ func (m *StreamResponse) Reset()         { *m = StreamResponse{} }
 func (m *StreamResponse) String() string { return proto.CompactTextString(m) }
 func (*StreamResponse) ProtoMessage()    {}
 func (*StreamResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{10}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{14}
 }
 
 func (m *StreamResponse) XXX_Unmarshal(b []byte) error {
@@ -728,18 +1354,20 @@ func (m *StreamResponse) GetObject() *ObjectDetail {
 }
 
 type StreamRegexRequest struct {
-	ClientId             string   `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
-	Regex                string   `protobuf:"bytes,2,opt,name=regex,proto3" json:"regex,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	ClientId             string     `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	Regex                string     `protobuf:"bytes,2,opt,name=regex,proto3" json:"regex,omitempty"`
+	Tags                 *TagFilter `protobuf:"bytes,3,opt,name=tags,proto3" json:"tags,omitempty"`
+	Namespace            string     `protobuf:"bytes,4,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
 }
 
 func (m *StreamRegexRequest) Reset()         { *m = StreamRegexRequest{} }
 func (m *StreamRegexRequest) String() string { return proto.CompactTextString(m) }
 func (*StreamRegexRequest) ProtoMessage()    {}
 func (*StreamRegexRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{11}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{15}
 }
 
 func (m *StreamRegexRequest) XXX_Unmarshal(b []byte) error {
@@ -774,6 +1402,20 @@ func (m *StreamRegexRequest) GetRegex() string {
 	return ""
 }
 
+func (m *StreamRegexRequest) GetTags() *TagFilter {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+func (m *StreamRegexRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
 type StreamRegexResponse struct {
 	Object               *ObjectDetail `protobuf:"bytes,1,opt,name=object,proto3" json:"object,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
@@ -785,7 +1427,7 @@ func (m *StreamRegexResponse) Reset()         { *m = StreamRegexResponse{} }
 func (m *StreamRegexResponse) String() string { return proto.CompactTextString(m) }
 func (*StreamRegexResponse) ProtoMessage()    {}
 func (*StreamRegexResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{12}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{16}
 }
 
 func (m *StreamRegexResponse) XXX_Unmarshal(b []byte) error {
@@ -814,18 +1456,20 @@ func (m *StreamRegexResponse) GetObject() *ObjectDetail {
 }
 
 type StreamPrefixRequest struct {
-	ClientId             string   `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
-	Prefix               string   `protobuf:"bytes,2,opt,name=prefix,proto3" json:"prefix,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	ClientId             string     `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	Prefix               string     `protobuf:"bytes,2,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	Tags                 *TagFilter `protobuf:"bytes,3,opt,name=tags,proto3" json:"tags,omitempty"`
+	Namespace            string     `protobuf:"bytes,4,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
 }
 
 func (m *StreamPrefixRequest) Reset()         { *m = StreamPrefixRequest{} }
 func (m *StreamPrefixRequest) String() string { return proto.CompactTextString(m) }
 func (*StreamPrefixRequest) ProtoMessage()    {}
 func (*StreamPrefixRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{13}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{17}
 }
 
 func (m *StreamPrefixRequest) XXX_Unmarshal(b []byte) error {
@@ -860,6 +1504,20 @@ func (m *StreamPrefixRequest) GetPrefix() string {
 	return ""
 }
 
+func (m *StreamPrefixRequest) GetTags() *TagFilter {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+func (m *StreamPrefixRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
 type StreamPrefixResponse struct {
 	Object               *ObjectDetail `protobuf:"bytes,1,opt,name=object,proto3" json:"object,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
@@ -871,7 +1529,7 @@ func (m *StreamPrefixResponse) Reset()         { *m = StreamPrefixResponse{} }
 func (m *StreamPrefixResponse) String() string { return proto.CompactTextString(m) }
 func (*StreamPrefixResponse) ProtoMessage()    {}
 func (*StreamPrefixResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{14}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{18}
 }
 
 func (m *StreamPrefixResponse) XXX_Unmarshal(b []byte) error {
@@ -899,1837 +1557,8887 @@ func (m *StreamPrefixResponse) GetObject() *ObjectDetail {
 	return nil
 }
 
-type SetRequest struct {
-	Object               *Object  `protobuf:"bytes,1,opt,name=object,proto3" json:"object,omitempty"`
+type WatchKeyRequest struct {
+	ClientId             string   `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	Key                  string   `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	Namespace            string   `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *SetRequest) Reset()         { *m = SetRequest{} }
-func (m *SetRequest) String() string { return proto.CompactTextString(m) }
-func (*SetRequest) ProtoMessage()    {}
-func (*SetRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{15}
+func (m *WatchKeyRequest) Reset()         { *m = WatchKeyRequest{} }
+func (m *WatchKeyRequest) String() string { return proto.CompactTextString(m) }
+func (*WatchKeyRequest) ProtoMessage()    {}
+func (*WatchKeyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{19}
 }
 
-func (m *SetRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_SetRequest.Unmarshal(m, b)
+func (m *WatchKeyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WatchKeyRequest.Unmarshal(m, b)
 }
-func (m *SetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_SetRequest.Marshal(b, m, deterministic)
+func (m *WatchKeyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WatchKeyRequest.Marshal(b, m, deterministic)
 }
-func (m *SetRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SetRequest.Merge(m, src)
+func (m *WatchKeyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchKeyRequest.Merge(m, src)
 }
-func (m *SetRequest) XXX_Size() int {
-	return xxx_messageInfo_SetRequest.Size(m)
+func (m *WatchKeyRequest) XXX_Size() int {
+	return xxx_messageInfo_WatchKeyRequest.Size(m)
 }
-func (m *SetRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_SetRequest.DiscardUnknown(m)
+func (m *WatchKeyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchKeyRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SetRequest proto.InternalMessageInfo
+var xxx_messageInfo_WatchKeyRequest proto.InternalMessageInfo
 
-func (m *SetRequest) GetObject() *Object {
+func (m *WatchKeyRequest) GetClientId() string {
 	if m != nil {
-		return m.Object
+		return m.ClientId
 	}
-	return nil
+	return ""
 }
 
-type SetResponse struct {
-	Object               *ObjectDetail `protobuf:"bytes,1,opt,name=object,proto3" json:"object,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
-	XXX_unrecognized     []byte        `json:"-"`
+func (m *WatchKeyRequest) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *WatchKeyRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+type WatchKeyResponse struct {
+	Object               *ObjectDetail `protobuf:"bytes,1,opt,name=object,proto3" json:"object,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
 	XXX_sizecache        int32         `json:"-"`
 }
 
-func (m *SetResponse) Reset()         { *m = SetResponse{} }
-func (m *SetResponse) String() string { return proto.CompactTextString(m) }
-func (*SetResponse) ProtoMessage()    {}
-func (*SetResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{16}
+func (m *WatchKeyResponse) Reset()         { *m = WatchKeyResponse{} }
+func (m *WatchKeyResponse) String() string { return proto.CompactTextString(m) }
+func (*WatchKeyResponse) ProtoMessage()    {}
+func (*WatchKeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{20}
 }
 
-func (m *SetResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_SetResponse.Unmarshal(m, b)
+func (m *WatchKeyResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WatchKeyResponse.Unmarshal(m, b)
 }
-func (m *SetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_SetResponse.Marshal(b, m, deterministic)
+func (m *WatchKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WatchKeyResponse.Marshal(b, m, deterministic)
 }
-func (m *SetResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SetResponse.Merge(m, src)
+func (m *WatchKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchKeyResponse.Merge(m, src)
 }
-func (m *SetResponse) XXX_Size() int {
-	return xxx_messageInfo_SetResponse.Size(m)
+func (m *WatchKeyResponse) XXX_Size() int {
+	return xxx_messageInfo_WatchKeyResponse.Size(m)
 }
-func (m *SetResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_SetResponse.DiscardUnknown(m)
+func (m *WatchKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchKeyResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SetResponse proto.InternalMessageInfo
+var xxx_messageInfo_WatchKeyResponse proto.InternalMessageInfo
 
-func (m *SetResponse) GetObject() *ObjectDetail {
+func (m *WatchKeyResponse) GetObject() *ObjectDetail {
 	if m != nil {
 		return m.Object
 	}
 	return nil
 }
 
-type GetKeysRequest struct {
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type StreamControlRequest struct {
+	ClientId             string       `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	Keys                 []string     `protobuf:"bytes,2,rep,name=keys,proto3" json:"keys,omitempty"`
+	Action               StreamAction `protobuf:"varint,3,opt,name=action,proto3,enum=api.StreamAction" json:"action,omitempty"`
+	Namespace            string       `protobuf:"bytes,4,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
 }
 
-func (m *GetKeysRequest) Reset()         { *m = GetKeysRequest{} }
-func (m *GetKeysRequest) String() string { return proto.CompactTextString(m) }
-func (*GetKeysRequest) ProtoMessage()    {}
-func (*GetKeysRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{17}
+func (m *StreamControlRequest) Reset()         { *m = StreamControlRequest{} }
+func (m *StreamControlRequest) String() string { return proto.CompactTextString(m) }
+func (*StreamControlRequest) ProtoMessage()    {}
+func (*StreamControlRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{21}
 }
 
-func (m *GetKeysRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetKeysRequest.Unmarshal(m, b)
+func (m *StreamControlRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StreamControlRequest.Unmarshal(m, b)
 }
-func (m *GetKeysRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetKeysRequest.Marshal(b, m, deterministic)
+func (m *StreamControlRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StreamControlRequest.Marshal(b, m, deterministic)
 }
-func (m *GetKeysRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetKeysRequest.Merge(m, src)
+func (m *StreamControlRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamControlRequest.Merge(m, src)
 }
-func (m *GetKeysRequest) XXX_Size() int {
-	return xxx_messageInfo_GetKeysRequest.Size(m)
+func (m *StreamControlRequest) XXX_Size() int {
+	return xxx_messageInfo_StreamControlRequest.Size(m)
 }
-func (m *GetKeysRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetKeysRequest.DiscardUnknown(m)
+func (m *StreamControlRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamControlRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetKeysRequest proto.InternalMessageInfo
+var xxx_messageInfo_StreamControlRequest proto.InternalMessageInfo
 
-type GetKeysResponse struct {
-	Keys                 []string `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *StreamControlRequest) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
 }
 
-func (m *GetKeysResponse) Reset()         { *m = GetKeysResponse{} }
-func (m *GetKeysResponse) String() string { return proto.CompactTextString(m) }
-func (*GetKeysResponse) ProtoMessage()    {}
-func (*GetKeysResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{18}
+func (m *StreamControlRequest) GetKeys() []string {
+	if m != nil {
+		return m.Keys
+	}
+	return nil
 }
 
-func (m *GetKeysResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetKeysResponse.Unmarshal(m, b)
-}
-func (m *GetKeysResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetKeysResponse.Marshal(b, m, deterministic)
-}
-func (m *GetKeysResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetKeysResponse.Merge(m, src)
-}
-func (m *GetKeysResponse) XXX_Size() int {
-	return xxx_messageInfo_GetKeysResponse.Size(m)
-}
-func (m *GetKeysResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetKeysResponse.DiscardUnknown(m)
+func (m *StreamControlRequest) GetAction() StreamAction {
+	if m != nil {
+		return m.Action
+	}
+	return StreamAction_Subscribe
 }
 
-var xxx_messageInfo_GetKeysResponse proto.InternalMessageInfo
-
-func (m *GetKeysResponse) GetKeys() []string {
+func (m *StreamControlRequest) GetNamespace() string {
 	if m != nil {
-		return m.Keys
+		return m.Namespace
 	}
-	return nil
+	return ""
 }
 
-type GetPrefixKeysRequest struct {
-	Prefix               string   `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type StreamControlResponse struct {
+	Object               *ObjectDetail `protobuf:"bytes,1,opt,name=object,proto3" json:"object,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
-func (m *GetPrefixKeysRequest) Reset()         { *m = GetPrefixKeysRequest{} }
-func (m *GetPrefixKeysRequest) String() string { return proto.CompactTextString(m) }
-func (*GetPrefixKeysRequest) ProtoMessage()    {}
-func (*GetPrefixKeysRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{19}
+func (m *StreamControlResponse) Reset()         { *m = StreamControlResponse{} }
+func (m *StreamControlResponse) String() string { return proto.CompactTextString(m) }
+func (*StreamControlResponse) ProtoMessage()    {}
+func (*StreamControlResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{22}
 }
 
-func (m *GetPrefixKeysRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetPrefixKeysRequest.Unmarshal(m, b)
+func (m *StreamControlResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StreamControlResponse.Unmarshal(m, b)
 }
-func (m *GetPrefixKeysRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetPrefixKeysRequest.Marshal(b, m, deterministic)
+func (m *StreamControlResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StreamControlResponse.Marshal(b, m, deterministic)
 }
-func (m *GetPrefixKeysRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetPrefixKeysRequest.Merge(m, src)
+func (m *StreamControlResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamControlResponse.Merge(m, src)
 }
-func (m *GetPrefixKeysRequest) XXX_Size() int {
-	return xxx_messageInfo_GetPrefixKeysRequest.Size(m)
+func (m *StreamControlResponse) XXX_Size() int {
+	return xxx_messageInfo_StreamControlResponse.Size(m)
 }
-func (m *GetPrefixKeysRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetPrefixKeysRequest.DiscardUnknown(m)
+func (m *StreamControlResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamControlResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetPrefixKeysRequest proto.InternalMessageInfo
+var xxx_messageInfo_StreamControlResponse proto.InternalMessageInfo
 
-func (m *GetPrefixKeysRequest) GetPrefix() string {
+func (m *StreamControlResponse) GetObject() *ObjectDetail {
 	if m != nil {
-		return m.Prefix
+		return m.Object
 	}
-	return ""
+	return nil
 }
 
-type GetPrefixKeysResponse struct {
-	Keys                 []string `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+type ListClientsRequest struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetPrefixKeysResponse) Reset()         { *m = GetPrefixKeysResponse{} }
-func (m *GetPrefixKeysResponse) String() string { return proto.CompactTextString(m) }
-func (*GetPrefixKeysResponse) ProtoMessage()    {}
-func (*GetPrefixKeysResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{20}
+func (m *ListClientsRequest) Reset()         { *m = ListClientsRequest{} }
+func (m *ListClientsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListClientsRequest) ProtoMessage()    {}
+func (*ListClientsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{23}
 }
 
-func (m *GetPrefixKeysResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetPrefixKeysResponse.Unmarshal(m, b)
+func (m *ListClientsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListClientsRequest.Unmarshal(m, b)
 }
-func (m *GetPrefixKeysResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetPrefixKeysResponse.Marshal(b, m, deterministic)
+func (m *ListClientsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListClientsRequest.Marshal(b, m, deterministic)
 }
-func (m *GetPrefixKeysResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetPrefixKeysResponse.Merge(m, src)
+func (m *ListClientsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListClientsRequest.Merge(m, src)
 }
-func (m *GetPrefixKeysResponse) XXX_Size() int {
-	return xxx_messageInfo_GetPrefixKeysResponse.Size(m)
+func (m *ListClientsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListClientsRequest.Size(m)
 }
-func (m *GetPrefixKeysResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetPrefixKeysResponse.DiscardUnknown(m)
+func (m *ListClientsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListClientsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetPrefixKeysResponse proto.InternalMessageInfo
-
-func (m *GetPrefixKeysResponse) GetKeys() []string {
-	if m != nil {
-		return m.Keys
-	}
-	return nil
-}
+var xxx_messageInfo_ListClientsRequest proto.InternalMessageInfo
 
-type GetRegexKeysRequest struct {
-	Regex                string   `protobuf:"bytes,1,opt,name=regex,proto3" json:"regex,omitempty"`
+//a connected stream client
+type StreamClient struct {
+	ClientId             string   `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	ConnectedUnix        int64    `protobuf:"varint,2,opt,name=connected_unix,json=connectedUnix,proto3" json:"connected_unix,omitempty"`
+	Queued               int64    `protobuf:"varint,3,opt,name=queued,proto3" json:"queued,omitempty"`
+	Dropped              uint64   `protobuf:"varint,4,opt,name=dropped,proto3" json:"dropped,omitempty"`
+	Paused               bool     `protobuf:"varint,5,opt,name=paused,proto3" json:"paused,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetRegexKeysRequest) Reset()         { *m = GetRegexKeysRequest{} }
-func (m *GetRegexKeysRequest) String() string { return proto.CompactTextString(m) }
-func (*GetRegexKeysRequest) ProtoMessage()    {}
-func (*GetRegexKeysRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{21}
+func (m *StreamClient) Reset()         { *m = StreamClient{} }
+func (m *StreamClient) String() string { return proto.CompactTextString(m) }
+func (*StreamClient) ProtoMessage()    {}
+func (*StreamClient) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{24}
 }
 
-func (m *GetRegexKeysRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetRegexKeysRequest.Unmarshal(m, b)
+func (m *StreamClient) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StreamClient.Unmarshal(m, b)
 }
-func (m *GetRegexKeysRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetRegexKeysRequest.Marshal(b, m, deterministic)
+func (m *StreamClient) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StreamClient.Marshal(b, m, deterministic)
 }
-func (m *GetRegexKeysRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetRegexKeysRequest.Merge(m, src)
+func (m *StreamClient) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamClient.Merge(m, src)
 }
-func (m *GetRegexKeysRequest) XXX_Size() int {
-	return xxx_messageInfo_GetRegexKeysRequest.Size(m)
+func (m *StreamClient) XXX_Size() int {
+	return xxx_messageInfo_StreamClient.Size(m)
 }
-func (m *GetRegexKeysRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetRegexKeysRequest.DiscardUnknown(m)
+func (m *StreamClient) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamClient.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetRegexKeysRequest proto.InternalMessageInfo
+var xxx_messageInfo_StreamClient proto.InternalMessageInfo
 
-func (m *GetRegexKeysRequest) GetRegex() string {
+func (m *StreamClient) GetClientId() string {
 	if m != nil {
-		return m.Regex
+		return m.ClientId
 	}
 	return ""
 }
 
-type GetRegexKeysResponse struct {
-	Keys                 []string `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *StreamClient) GetConnectedUnix() int64 {
+	if m != nil {
+		return m.ConnectedUnix
+	}
+	return 0
 }
 
-func (m *GetRegexKeysResponse) Reset()         { *m = GetRegexKeysResponse{} }
-func (m *GetRegexKeysResponse) String() string { return proto.CompactTextString(m) }
-func (*GetRegexKeysResponse) ProtoMessage()    {}
-func (*GetRegexKeysResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{22}
+func (m *StreamClient) GetQueued() int64 {
+	if m != nil {
+		return m.Queued
+	}
+	return 0
 }
 
-func (m *GetRegexKeysResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetRegexKeysResponse.Unmarshal(m, b)
+func (m *StreamClient) GetDropped() uint64 {
+	if m != nil {
+		return m.Dropped
+	}
+	return 0
 }
-func (m *GetRegexKeysResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetRegexKeysResponse.Marshal(b, m, deterministic)
+
+func (m *StreamClient) GetPaused() bool {
+	if m != nil {
+		return m.Paused
+	}
+	return false
 }
-func (m *GetRegexKeysResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetRegexKeysResponse.Merge(m, src)
+
+type ListClientsResponse struct {
+	Clients              []*StreamClient `protobuf:"bytes,1,rep,name=clients,proto3" json:"clients,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
-func (m *GetRegexKeysResponse) XXX_Size() int {
-	return xxx_messageInfo_GetRegexKeysResponse.Size(m)
+
+func (m *ListClientsResponse) Reset()         { *m = ListClientsResponse{} }
+func (m *ListClientsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListClientsResponse) ProtoMessage()    {}
+func (*ListClientsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{25}
 }
-func (m *GetRegexKeysResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetRegexKeysResponse.DiscardUnknown(m)
+
+func (m *ListClientsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListClientsResponse.Unmarshal(m, b)
+}
+func (m *ListClientsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListClientsResponse.Marshal(b, m, deterministic)
+}
+func (m *ListClientsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListClientsResponse.Merge(m, src)
+}
+func (m *ListClientsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListClientsResponse.Size(m)
+}
+func (m *ListClientsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListClientsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetRegexKeysResponse proto.InternalMessageInfo
+var xxx_messageInfo_ListClientsResponse proto.InternalMessageInfo
 
-func (m *GetRegexKeysResponse) GetKeys() []string {
+func (m *ListClientsResponse) GetClients() []*StreamClient {
 	if m != nil {
-		return m.Keys
+		return m.Clients
 	}
 	return nil
 }
 
-type GetRequest struct {
-	Keys                 []string `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+type SetRequest struct {
+	Object               *Object  `protobuf:"bytes,1,opt,name=object,proto3" json:"object,omitempty"`
+	Namespace            string   `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	IfVersion            int64    `protobuf:"varint,3,opt,name=if_version,json=ifVersion,proto3" json:"if_version,omitempty"`
+	DryRun               bool     `protobuf:"varint,4,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	MergeMetadata        bool     `protobuf:"varint,5,opt,name=merge_metadata,json=mergeMetadata,proto3" json:"merge_metadata,omitempty"`
+	RefreshTtl           bool     `protobuf:"varint,6,opt,name=refresh_ttl,json=refreshTtl,proto3" json:"refresh_ttl,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetRequest) Reset()         { *m = GetRequest{} }
-func (m *GetRequest) String() string { return proto.CompactTextString(m) }
-func (*GetRequest) ProtoMessage()    {}
-func (*GetRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{23}
+func (m *SetRequest) Reset()         { *m = SetRequest{} }
+func (m *SetRequest) String() string { return proto.CompactTextString(m) }
+func (*SetRequest) ProtoMessage()    {}
+func (*SetRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{26}
 }
 
-func (m *GetRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetRequest.Unmarshal(m, b)
+func (m *SetRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetRequest.Unmarshal(m, b)
 }
-func (m *GetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetRequest.Marshal(b, m, deterministic)
+func (m *SetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetRequest.Marshal(b, m, deterministic)
 }
-func (m *GetRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetRequest.Merge(m, src)
+func (m *SetRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetRequest.Merge(m, src)
 }
-func (m *GetRequest) XXX_Size() int {
-	return xxx_messageInfo_GetRequest.Size(m)
+func (m *SetRequest) XXX_Size() int {
+	return xxx_messageInfo_SetRequest.Size(m)
 }
-func (m *GetRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetRequest.DiscardUnknown(m)
+func (m *SetRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetRequest proto.InternalMessageInfo
+var xxx_messageInfo_SetRequest proto.InternalMessageInfo
 
-func (m *GetRequest) GetKeys() []string {
+func (m *SetRequest) GetObject() *Object {
 	if m != nil {
-		return m.Keys
+		return m.Object
 	}
 	return nil
 }
 
-type GetResponse struct {
-	Objects              map[string]*ObjectDetail `protobuf:"bytes,1,rep,name=objects,proto3" json:"objects,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
-	XXX_unrecognized     []byte                   `json:"-"`
-	XXX_sizecache        int32                    `json:"-"`
+func (m *SetRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
 }
 
-func (m *GetResponse) Reset()         { *m = GetResponse{} }
-func (m *GetResponse) String() string { return proto.CompactTextString(m) }
-func (*GetResponse) ProtoMessage()    {}
-func (*GetResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{24}
+func (m *SetRequest) GetIfVersion() int64 {
+	if m != nil {
+		return m.IfVersion
+	}
+	return 0
 }
 
-func (m *GetResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetResponse.Unmarshal(m, b)
-}
-func (m *GetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetResponse.Marshal(b, m, deterministic)
-}
-func (m *GetResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetResponse.Merge(m, src)
-}
-func (m *GetResponse) XXX_Size() int {
-	return xxx_messageInfo_GetResponse.Size(m)
-}
-func (m *GetResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetResponse.DiscardUnknown(m)
+func (m *SetRequest) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
 }
 
-var xxx_messageInfo_GetResponse proto.InternalMessageInfo
+func (m *SetRequest) GetMergeMetadata() bool {
+	if m != nil {
+		return m.MergeMetadata
+	}
+	return false
+}
 
-func (m *GetResponse) GetObjects() map[string]*ObjectDetail {
+func (m *SetRequest) GetRefreshTtl() bool {
 	if m != nil {
-		return m.Objects
+		return m.RefreshTtl
 	}
-	return nil
+	return false
 }
 
-type GetRegexRequest struct {
-	Regex                string   `protobuf:"bytes,1,opt,name=regex,proto3" json:"regex,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type SetResponse struct {
+	Object               *ObjectDetail `protobuf:"bytes,1,opt,name=object,proto3" json:"object,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
-func (m *GetRegexRequest) Reset()         { *m = GetRegexRequest{} }
-func (m *GetRegexRequest) String() string { return proto.CompactTextString(m) }
-func (*GetRegexRequest) ProtoMessage()    {}
-func (*GetRegexRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{25}
+func (m *SetResponse) Reset()         { *m = SetResponse{} }
+func (m *SetResponse) String() string { return proto.CompactTextString(m) }
+func (*SetResponse) ProtoMessage()    {}
+func (*SetResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{27}
 }
 
-func (m *GetRegexRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetRegexRequest.Unmarshal(m, b)
+func (m *SetResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetResponse.Unmarshal(m, b)
 }
-func (m *GetRegexRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetRegexRequest.Marshal(b, m, deterministic)
+func (m *SetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetResponse.Marshal(b, m, deterministic)
 }
-func (m *GetRegexRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetRegexRequest.Merge(m, src)
+func (m *SetResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetResponse.Merge(m, src)
 }
-func (m *GetRegexRequest) XXX_Size() int {
-	return xxx_messageInfo_GetRegexRequest.Size(m)
+func (m *SetResponse) XXX_Size() int {
+	return xxx_messageInfo_SetResponse.Size(m)
 }
-func (m *GetRegexRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetRegexRequest.DiscardUnknown(m)
+func (m *SetResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetRegexRequest proto.InternalMessageInfo
+var xxx_messageInfo_SetResponse proto.InternalMessageInfo
 
-func (m *GetRegexRequest) GetRegex() string {
+func (m *SetResponse) GetObject() *ObjectDetail {
 	if m != nil {
-		return m.Regex
+		return m.Object
 	}
-	return ""
+	return nil
 }
 
-type GetRegexResponse struct {
-	Objects              map[string]*ObjectDetail `protobuf:"bytes,1,rep,name=objects,proto3" json:"objects,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
-	XXX_unrecognized     []byte                   `json:"-"`
-	XXX_sizecache        int32                    `json:"-"`
+//UpdateRequest merges fields into an existing object. update_mask lists the fields to overwrite
+//(point, radius, tracking, metadata, get_address, get_timezone, expires_unix, tags) so they may be cleared by omitting their value.
+//if update_mask is empty, only non-zero fields are applied
+type UpdateRequest struct {
+	Key                  string            `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Point                *Point            `protobuf:"bytes,2,opt,name=point,proto3" json:"point,omitempty"`
+	Radius               int64             `protobuf:"varint,3,opt,name=radius,proto3" json:"radius,omitempty"`
+	Tracking             *ObjectTracking   `protobuf:"bytes,4,opt,name=tracking,proto3" json:"tracking,omitempty"`
+	Metadata             map[string]string `protobuf:"bytes,5,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	GetAddress           bool              `protobuf:"varint,6,opt,name=get_address,json=getAddress,proto3" json:"get_address,omitempty"`
+	GetTimezone          bool              `protobuf:"varint,7,opt,name=get_timezone,json=getTimezone,proto3" json:"get_timezone,omitempty"`
+	ExpiresUnix          int64             `protobuf:"varint,8,opt,name=expires_unix,json=expiresUnix,proto3" json:"expires_unix,omitempty"`
+	Tags                 []string          `protobuf:"bytes,9,rep,name=tags,proto3" json:"tags,omitempty"`
+	UpdateMask           []string          `protobuf:"bytes,10,rep,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	Namespace            string            `protobuf:"bytes,11,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
-func (m *GetRegexResponse) Reset()         { *m = GetRegexResponse{} }
-func (m *GetRegexResponse) String() string { return proto.CompactTextString(m) }
-func (*GetRegexResponse) ProtoMessage()    {}
-func (*GetRegexResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{26}
+func (m *UpdateRequest) Reset()         { *m = UpdateRequest{} }
+func (m *UpdateRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateRequest) ProtoMessage()    {}
+func (*UpdateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{28}
 }
 
-func (m *GetRegexResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetRegexResponse.Unmarshal(m, b)
+func (m *UpdateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateRequest.Unmarshal(m, b)
 }
-func (m *GetRegexResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetRegexResponse.Marshal(b, m, deterministic)
+func (m *UpdateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateRequest.Marshal(b, m, deterministic)
 }
-func (m *GetRegexResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetRegexResponse.Merge(m, src)
+func (m *UpdateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateRequest.Merge(m, src)
 }
-func (m *GetRegexResponse) XXX_Size() int {
-	return xxx_messageInfo_GetRegexResponse.Size(m)
+func (m *UpdateRequest) XXX_Size() int {
+	return xxx_messageInfo_UpdateRequest.Size(m)
 }
-func (m *GetRegexResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetRegexResponse.DiscardUnknown(m)
+func (m *UpdateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetRegexResponse proto.InternalMessageInfo
+var xxx_messageInfo_UpdateRequest proto.InternalMessageInfo
 
-func (m *GetRegexResponse) GetObjects() map[string]*ObjectDetail {
+func (m *UpdateRequest) GetKey() string {
 	if m != nil {
-		return m.Objects
+		return m.Key
+	}
+	return ""
+}
+
+func (m *UpdateRequest) GetPoint() *Point {
+	if m != nil {
+		return m.Point
 	}
 	return nil
 }
 
-type GetPrefixRequest struct {
-	Prefix               string   `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *UpdateRequest) GetRadius() int64 {
+	if m != nil {
+		return m.Radius
+	}
+	return 0
 }
 
-func (m *GetPrefixRequest) Reset()         { *m = GetPrefixRequest{} }
-func (m *GetPrefixRequest) String() string { return proto.CompactTextString(m) }
-func (*GetPrefixRequest) ProtoMessage()    {}
-func (*GetPrefixRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{27}
+func (m *UpdateRequest) GetTracking() *ObjectTracking {
+	if m != nil {
+		return m.Tracking
+	}
+	return nil
 }
 
-func (m *GetPrefixRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetPrefixRequest.Unmarshal(m, b)
+func (m *UpdateRequest) GetMetadata() map[string]string {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
 }
-func (m *GetPrefixRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetPrefixRequest.Marshal(b, m, deterministic)
+
+func (m *UpdateRequest) GetGetAddress() bool {
+	if m != nil {
+		return m.GetAddress
+	}
+	return false
 }
-func (m *GetPrefixRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetPrefixRequest.Merge(m, src)
+
+func (m *UpdateRequest) GetGetTimezone() bool {
+	if m != nil {
+		return m.GetTimezone
+	}
+	return false
 }
-func (m *GetPrefixRequest) XXX_Size() int {
-	return xxx_messageInfo_GetPrefixRequest.Size(m)
+
+func (m *UpdateRequest) GetExpiresUnix() int64 {
+	if m != nil {
+		return m.ExpiresUnix
+	}
+	return 0
 }
-func (m *GetPrefixRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetPrefixRequest.DiscardUnknown(m)
+
+func (m *UpdateRequest) GetTags() []string {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
 }
 
-var xxx_messageInfo_GetPrefixRequest proto.InternalMessageInfo
+func (m *UpdateRequest) GetUpdateMask() []string {
+	if m != nil {
+		return m.UpdateMask
+	}
+	return nil
+}
 
-func (m *GetPrefixRequest) GetPrefix() string {
+func (m *UpdateRequest) GetNamespace() string {
 	if m != nil {
-		return m.Prefix
+		return m.Namespace
 	}
 	return ""
 }
 
-type GetPrefixResponse struct {
-	Objects              map[string]*ObjectDetail `protobuf:"bytes,1,rep,name=objects,proto3" json:"objects,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
-	XXX_unrecognized     []byte                   `json:"-"`
-	XXX_sizecache        int32                    `json:"-"`
+type UpdateResponse struct {
+	Object               *ObjectDetail `protobuf:"bytes,1,opt,name=object,proto3" json:"object,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
-func (m *GetPrefixResponse) Reset()         { *m = GetPrefixResponse{} }
-func (m *GetPrefixResponse) String() string { return proto.CompactTextString(m) }
-func (*GetPrefixResponse) ProtoMessage()    {}
-func (*GetPrefixResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{28}
+func (m *UpdateResponse) Reset()         { *m = UpdateResponse{} }
+func (m *UpdateResponse) String() string { return proto.CompactTextString(m) }
+func (*UpdateResponse) ProtoMessage()    {}
+func (*UpdateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{29}
 }
 
-func (m *GetPrefixResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetPrefixResponse.Unmarshal(m, b)
+func (m *UpdateResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateResponse.Unmarshal(m, b)
 }
-func (m *GetPrefixResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetPrefixResponse.Marshal(b, m, deterministic)
+func (m *UpdateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateResponse.Marshal(b, m, deterministic)
 }
-func (m *GetPrefixResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetPrefixResponse.Merge(m, src)
+func (m *UpdateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateResponse.Merge(m, src)
 }
-func (m *GetPrefixResponse) XXX_Size() int {
-	return xxx_messageInfo_GetPrefixResponse.Size(m)
+func (m *UpdateResponse) XXX_Size() int {
+	return xxx_messageInfo_UpdateResponse.Size(m)
 }
-func (m *GetPrefixResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetPrefixResponse.DiscardUnknown(m)
+func (m *UpdateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetPrefixResponse proto.InternalMessageInfo
+var xxx_messageInfo_UpdateResponse proto.InternalMessageInfo
 
-func (m *GetPrefixResponse) GetObjects() map[string]*ObjectDetail {
+func (m *UpdateResponse) GetObject() *ObjectDetail {
 	if m != nil {
-		return m.Objects
+		return m.Object
 	}
 	return nil
 }
 
-type DeleteRequest struct {
-	Keys                 []string `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+type IncrementRequest struct {
+	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Counter              string   `protobuf:"bytes,2,opt,name=counter,proto3" json:"counter,omitempty"`
+	Delta                int64    `protobuf:"varint,3,opt,name=delta,proto3" json:"delta,omitempty"`
+	Namespace            string   `protobuf:"bytes,4,opt,name=namespace,proto3" json:"namespace,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *DeleteRequest) Reset()         { *m = DeleteRequest{} }
-func (m *DeleteRequest) String() string { return proto.CompactTextString(m) }
-func (*DeleteRequest) ProtoMessage()    {}
-func (*DeleteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{29}
+func (m *IncrementRequest) Reset()         { *m = IncrementRequest{} }
+func (m *IncrementRequest) String() string { return proto.CompactTextString(m) }
+func (*IncrementRequest) ProtoMessage()    {}
+func (*IncrementRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{30}
 }
 
-func (m *DeleteRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DeleteRequest.Unmarshal(m, b)
+func (m *IncrementRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_IncrementRequest.Unmarshal(m, b)
 }
-func (m *DeleteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DeleteRequest.Marshal(b, m, deterministic)
+func (m *IncrementRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_IncrementRequest.Marshal(b, m, deterministic)
 }
-func (m *DeleteRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DeleteRequest.Merge(m, src)
+func (m *IncrementRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IncrementRequest.Merge(m, src)
 }
-func (m *DeleteRequest) XXX_Size() int {
-	return xxx_messageInfo_DeleteRequest.Size(m)
+func (m *IncrementRequest) XXX_Size() int {
+	return xxx_messageInfo_IncrementRequest.Size(m)
 }
-func (m *DeleteRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_DeleteRequest.DiscardUnknown(m)
+func (m *IncrementRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_IncrementRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DeleteRequest proto.InternalMessageInfo
+var xxx_messageInfo_IncrementRequest proto.InternalMessageInfo
 
-func (m *DeleteRequest) GetKeys() []string {
+func (m *IncrementRequest) GetKey() string {
 	if m != nil {
-		return m.Keys
+		return m.Key
 	}
-	return nil
+	return ""
 }
 
-type DeleteResponse struct {
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *IncrementRequest) GetCounter() string {
+	if m != nil {
+		return m.Counter
+	}
+	return ""
 }
 
-func (m *DeleteResponse) Reset()         { *m = DeleteResponse{} }
-func (m *DeleteResponse) String() string { return proto.CompactTextString(m) }
-func (*DeleteResponse) ProtoMessage()    {}
-func (*DeleteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{30}
+func (m *IncrementRequest) GetDelta() int64 {
+	if m != nil {
+		return m.Delta
+	}
+	return 0
 }
 
-func (m *DeleteResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DeleteResponse.Unmarshal(m, b)
-}
-func (m *DeleteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DeleteResponse.Marshal(b, m, deterministic)
-}
-func (m *DeleteResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DeleteResponse.Merge(m, src)
-}
-func (m *DeleteResponse) XXX_Size() int {
-	return xxx_messageInfo_DeleteResponse.Size(m)
-}
-func (m *DeleteResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_DeleteResponse.DiscardUnknown(m)
+func (m *IncrementRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
 }
 
-var xxx_messageInfo_DeleteResponse proto.InternalMessageInfo
-
-type ScanBoundRequest struct {
-	Bound                *Bound   `protobuf:"bytes,1,opt,name=bound,proto3" json:"bound,omitempty"`
-	Keys                 []string `protobuf:"bytes,2,rep,name=keys,proto3" json:"keys,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type IncrementResponse struct {
+	Value                int64         `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`
+	Object               *ObjectDetail `protobuf:"bytes,2,opt,name=object,proto3" json:"object,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
-func (m *ScanBoundRequest) Reset()         { *m = ScanBoundRequest{} }
-func (m *ScanBoundRequest) String() string { return proto.CompactTextString(m) }
-func (*ScanBoundRequest) ProtoMessage()    {}
-func (*ScanBoundRequest) Descriptor() ([]byte, []int) {
+func (m *IncrementResponse) Reset()         { *m = IncrementResponse{} }
+func (m *IncrementResponse) String() string { return proto.CompactTextString(m) }
+func (*IncrementResponse) ProtoMessage()    {}
+func (*IncrementResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_00212fb1f9d3bf1c, []int{31}
 }
 
-func (m *ScanBoundRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ScanBoundRequest.Unmarshal(m, b)
+func (m *IncrementResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_IncrementResponse.Unmarshal(m, b)
 }
-func (m *ScanBoundRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ScanBoundRequest.Marshal(b, m, deterministic)
+func (m *IncrementResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_IncrementResponse.Marshal(b, m, deterministic)
 }
-func (m *ScanBoundRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ScanBoundRequest.Merge(m, src)
+func (m *IncrementResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IncrementResponse.Merge(m, src)
 }
-func (m *ScanBoundRequest) XXX_Size() int {
-	return xxx_messageInfo_ScanBoundRequest.Size(m)
+func (m *IncrementResponse) XXX_Size() int {
+	return xxx_messageInfo_IncrementResponse.Size(m)
 }
-func (m *ScanBoundRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ScanBoundRequest.DiscardUnknown(m)
+func (m *IncrementResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_IncrementResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ScanBoundRequest proto.InternalMessageInfo
+var xxx_messageInfo_IncrementResponse proto.InternalMessageInfo
 
-func (m *ScanBoundRequest) GetBound() *Bound {
+func (m *IncrementResponse) GetValue() int64 {
 	if m != nil {
-		return m.Bound
+		return m.Value
 	}
-	return nil
+	return 0
 }
 
-func (m *ScanBoundRequest) GetKeys() []string {
+func (m *IncrementResponse) GetObject() *ObjectDetail {
 	if m != nil {
-		return m.Keys
+		return m.Object
 	}
 	return nil
 }
 
-type ScanBoundResponse struct {
-	Objects              map[string]*ObjectDetail `protobuf:"bytes,1,rep,name=objects,proto3" json:"objects,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
-	XXX_unrecognized     []byte                   `json:"-"`
-	XXX_sizecache        int32                    `json:"-"`
+type SetManyRequest struct {
+	Objects              []*Object `protobuf:"bytes,1,rep,name=objects,proto3" json:"objects,omitempty"`
+	RejectDuplicates     bool      `protobuf:"varint,2,opt,name=reject_duplicates,json=rejectDuplicates,proto3" json:"reject_duplicates,omitempty"`
+	Atomic               bool      `protobuf:"varint,3,opt,name=atomic,proto3" json:"atomic,omitempty"`
+	Namespace            string    `protobuf:"bytes,4,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
 }
 
-func (m *ScanBoundResponse) Reset()         { *m = ScanBoundResponse{} }
-func (m *ScanBoundResponse) String() string { return proto.CompactTextString(m) }
-func (*ScanBoundResponse) ProtoMessage()    {}
-func (*ScanBoundResponse) Descriptor() ([]byte, []int) {
+func (m *SetManyRequest) Reset()         { *m = SetManyRequest{} }
+func (m *SetManyRequest) String() string { return proto.CompactTextString(m) }
+func (*SetManyRequest) ProtoMessage()    {}
+func (*SetManyRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_00212fb1f9d3bf1c, []int{32}
 }
 
-func (m *ScanBoundResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ScanBoundResponse.Unmarshal(m, b)
+func (m *SetManyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetManyRequest.Unmarshal(m, b)
 }
-func (m *ScanBoundResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ScanBoundResponse.Marshal(b, m, deterministic)
+func (m *SetManyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetManyRequest.Marshal(b, m, deterministic)
 }
-func (m *ScanBoundResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ScanBoundResponse.Merge(m, src)
+func (m *SetManyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetManyRequest.Merge(m, src)
 }
-func (m *ScanBoundResponse) XXX_Size() int {
-	return xxx_messageInfo_ScanBoundResponse.Size(m)
+func (m *SetManyRequest) XXX_Size() int {
+	return xxx_messageInfo_SetManyRequest.Size(m)
 }
-func (m *ScanBoundResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ScanBoundResponse.DiscardUnknown(m)
+func (m *SetManyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetManyRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ScanBoundResponse proto.InternalMessageInfo
+var xxx_messageInfo_SetManyRequest proto.InternalMessageInfo
 
-func (m *ScanBoundResponse) GetObjects() map[string]*ObjectDetail {
+func (m *SetManyRequest) GetObjects() []*Object {
 	if m != nil {
 		return m.Objects
 	}
 	return nil
 }
 
-type ScanPrefixBoundRequest struct {
-	Bound                *Bound   `protobuf:"bytes,1,opt,name=bound,proto3" json:"bound,omitempty"`
-	Prefix               string   `protobuf:"bytes,2,opt,name=prefix,proto3" json:"prefix,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *SetManyRequest) GetRejectDuplicates() bool {
+	if m != nil {
+		return m.RejectDuplicates
+	}
+	return false
 }
 
-func (m *ScanPrefixBoundRequest) Reset()         { *m = ScanPrefixBoundRequest{} }
-func (m *ScanPrefixBoundRequest) String() string { return proto.CompactTextString(m) }
-func (*ScanPrefixBoundRequest) ProtoMessage()    {}
-func (*ScanPrefixBoundRequest) Descriptor() ([]byte, []int) {
+func (m *SetManyRequest) GetAtomic() bool {
+	if m != nil {
+		return m.Atomic
+	}
+	return false
+}
+
+func (m *SetManyRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+type SetManyResponse struct {
+	Objects              []*ObjectDetail `protobuf:"bytes,1,rep,name=objects,proto3" json:"objects,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *SetManyResponse) Reset()         { *m = SetManyResponse{} }
+func (m *SetManyResponse) String() string { return proto.CompactTextString(m) }
+func (*SetManyResponse) ProtoMessage()    {}
+func (*SetManyResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_00212fb1f9d3bf1c, []int{33}
 }
 
-func (m *ScanPrefixBoundRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ScanPrefixBoundRequest.Unmarshal(m, b)
+func (m *SetManyResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetManyResponse.Unmarshal(m, b)
 }
-func (m *ScanPrefixBoundRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ScanPrefixBoundRequest.Marshal(b, m, deterministic)
+func (m *SetManyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetManyResponse.Marshal(b, m, deterministic)
 }
-func (m *ScanPrefixBoundRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ScanPrefixBoundRequest.Merge(m, src)
+func (m *SetManyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetManyResponse.Merge(m, src)
 }
-func (m *ScanPrefixBoundRequest) XXX_Size() int {
-	return xxx_messageInfo_ScanPrefixBoundRequest.Size(m)
+func (m *SetManyResponse) XXX_Size() int {
+	return xxx_messageInfo_SetManyResponse.Size(m)
 }
-func (m *ScanPrefixBoundRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ScanPrefixBoundRequest.DiscardUnknown(m)
+func (m *SetManyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetManyResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ScanPrefixBoundRequest proto.InternalMessageInfo
+var xxx_messageInfo_SetManyResponse proto.InternalMessageInfo
 
-func (m *ScanPrefixBoundRequest) GetBound() *Bound {
+func (m *SetManyResponse) GetObjects() []*ObjectDetail {
 	if m != nil {
-		return m.Bound
+		return m.Objects
 	}
 	return nil
 }
 
-func (m *ScanPrefixBoundRequest) GetPrefix() string {
-	if m != nil {
-		return m.Prefix
-	}
-	return ""
-}
-
-type ScanPrefixBoundResponse struct {
-	Objects              map[string]*ObjectDetail `protobuf:"bytes,1,rep,name=objects,proto3" json:"objects,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
-	XXX_unrecognized     []byte                   `json:"-"`
-	XXX_sizecache        int32                    `json:"-"`
+//a new position for an existing object
+type PositionUpdate struct {
+	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Point                *Point   `protobuf:"bytes,2,opt,name=point,proto3" json:"point,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ScanPrefixBoundResponse) Reset()         { *m = ScanPrefixBoundResponse{} }
-func (m *ScanPrefixBoundResponse) String() string { return proto.CompactTextString(m) }
-func (*ScanPrefixBoundResponse) ProtoMessage()    {}
-func (*ScanPrefixBoundResponse) Descriptor() ([]byte, []int) {
+func (m *PositionUpdate) Reset()         { *m = PositionUpdate{} }
+func (m *PositionUpdate) String() string { return proto.CompactTextString(m) }
+func (*PositionUpdate) ProtoMessage()    {}
+func (*PositionUpdate) Descriptor() ([]byte, []int) {
 	return fileDescriptor_00212fb1f9d3bf1c, []int{34}
 }
 
-func (m *ScanPrefixBoundResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ScanPrefixBoundResponse.Unmarshal(m, b)
+func (m *PositionUpdate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PositionUpdate.Unmarshal(m, b)
 }
-func (m *ScanPrefixBoundResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ScanPrefixBoundResponse.Marshal(b, m, deterministic)
+func (m *PositionUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PositionUpdate.Marshal(b, m, deterministic)
 }
-func (m *ScanPrefixBoundResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ScanPrefixBoundResponse.Merge(m, src)
+func (m *PositionUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PositionUpdate.Merge(m, src)
 }
-func (m *ScanPrefixBoundResponse) XXX_Size() int {
-	return xxx_messageInfo_ScanPrefixBoundResponse.Size(m)
+func (m *PositionUpdate) XXX_Size() int {
+	return xxx_messageInfo_PositionUpdate.Size(m)
 }
-func (m *ScanPrefixBoundResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ScanPrefixBoundResponse.DiscardUnknown(m)
+func (m *PositionUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_PositionUpdate.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ScanPrefixBoundResponse proto.InternalMessageInfo
+var xxx_messageInfo_PositionUpdate proto.InternalMessageInfo
 
-func (m *ScanPrefixBoundResponse) GetObjects() map[string]*ObjectDetail {
+func (m *PositionUpdate) GetKey() string {
 	if m != nil {
-		return m.Objects
+		return m.Key
+	}
+	return ""
+}
+
+func (m *PositionUpdate) GetPoint() *Point {
+	if m != nil {
+		return m.Point
 	}
 	return nil
 }
 
-type ScanRegexBoundRequest struct {
-	Bound                *Bound   `protobuf:"bytes,1,opt,name=bound,proto3" json:"bound,omitempty"`
-	Regex                string   `protobuf:"bytes,2,opt,name=regex,proto3" json:"regex,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type BulkUpdatePositionsRequest struct {
+	Updates              []*PositionUpdate `protobuf:"bytes,1,rep,name=updates,proto3" json:"updates,omitempty"`
+	Namespace            string            `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
-func (m *ScanRegexBoundRequest) Reset()         { *m = ScanRegexBoundRequest{} }
-func (m *ScanRegexBoundRequest) String() string { return proto.CompactTextString(m) }
-func (*ScanRegexBoundRequest) ProtoMessage()    {}
-func (*ScanRegexBoundRequest) Descriptor() ([]byte, []int) {
+func (m *BulkUpdatePositionsRequest) Reset()         { *m = BulkUpdatePositionsRequest{} }
+func (m *BulkUpdatePositionsRequest) String() string { return proto.CompactTextString(m) }
+func (*BulkUpdatePositionsRequest) ProtoMessage()    {}
+func (*BulkUpdatePositionsRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_00212fb1f9d3bf1c, []int{35}
 }
 
-func (m *ScanRegexBoundRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ScanRegexBoundRequest.Unmarshal(m, b)
+func (m *BulkUpdatePositionsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BulkUpdatePositionsRequest.Unmarshal(m, b)
 }
-func (m *ScanRegexBoundRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ScanRegexBoundRequest.Marshal(b, m, deterministic)
+func (m *BulkUpdatePositionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BulkUpdatePositionsRequest.Marshal(b, m, deterministic)
 }
-func (m *ScanRegexBoundRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ScanRegexBoundRequest.Merge(m, src)
+func (m *BulkUpdatePositionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BulkUpdatePositionsRequest.Merge(m, src)
 }
-func (m *ScanRegexBoundRequest) XXX_Size() int {
-	return xxx_messageInfo_ScanRegexBoundRequest.Size(m)
+func (m *BulkUpdatePositionsRequest) XXX_Size() int {
+	return xxx_messageInfo_BulkUpdatePositionsRequest.Size(m)
 }
-func (m *ScanRegexBoundRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ScanRegexBoundRequest.DiscardUnknown(m)
+func (m *BulkUpdatePositionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BulkUpdatePositionsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ScanRegexBoundRequest proto.InternalMessageInfo
+var xxx_messageInfo_BulkUpdatePositionsRequest proto.InternalMessageInfo
 
-func (m *ScanRegexBoundRequest) GetBound() *Bound {
+func (m *BulkUpdatePositionsRequest) GetUpdates() []*PositionUpdate {
 	if m != nil {
-		return m.Bound
+		return m.Updates
 	}
 	return nil
 }
 
-func (m *ScanRegexBoundRequest) GetRegex() string {
+func (m *BulkUpdatePositionsRequest) GetNamespace() string {
 	if m != nil {
-		return m.Regex
+		return m.Namespace
 	}
 	return ""
 }
 
-type ScanRegexBoundResponse struct {
-	Objects              map[string]*ObjectDetail `protobuf:"bytes,1,rep,name=objects,proto3" json:"objects,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
-	XXX_unrecognized     []byte                   `json:"-"`
-	XXX_sizecache        int32                    `json:"-"`
+type BulkUpdatePositionsResponse struct {
+	Objects              []*ObjectDetail `protobuf:"bytes,1,rep,name=objects,proto3" json:"objects,omitempty"`
+	NotFound             []string        `protobuf:"bytes,2,rep,name=not_found,json=notFound,proto3" json:"not_found,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
-func (m *ScanRegexBoundResponse) Reset()         { *m = ScanRegexBoundResponse{} }
-func (m *ScanRegexBoundResponse) String() string { return proto.CompactTextString(m) }
-func (*ScanRegexBoundResponse) ProtoMessage()    {}
-func (*ScanRegexBoundResponse) Descriptor() ([]byte, []int) {
+func (m *BulkUpdatePositionsResponse) Reset()         { *m = BulkUpdatePositionsResponse{} }
+func (m *BulkUpdatePositionsResponse) String() string { return proto.CompactTextString(m) }
+func (*BulkUpdatePositionsResponse) ProtoMessage()    {}
+func (*BulkUpdatePositionsResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_00212fb1f9d3bf1c, []int{36}
 }
 
-func (m *ScanRegexBoundResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ScanRegexBoundResponse.Unmarshal(m, b)
+func (m *BulkUpdatePositionsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BulkUpdatePositionsResponse.Unmarshal(m, b)
 }
-func (m *ScanRegexBoundResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ScanRegexBoundResponse.Marshal(b, m, deterministic)
+func (m *BulkUpdatePositionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BulkUpdatePositionsResponse.Marshal(b, m, deterministic)
 }
-func (m *ScanRegexBoundResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ScanRegexBoundResponse.Merge(m, src)
+func (m *BulkUpdatePositionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BulkUpdatePositionsResponse.Merge(m, src)
 }
-func (m *ScanRegexBoundResponse) XXX_Size() int {
-	return xxx_messageInfo_ScanRegexBoundResponse.Size(m)
+func (m *BulkUpdatePositionsResponse) XXX_Size() int {
+	return xxx_messageInfo_BulkUpdatePositionsResponse.Size(m)
 }
-func (m *ScanRegexBoundResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ScanRegexBoundResponse.DiscardUnknown(m)
+func (m *BulkUpdatePositionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BulkUpdatePositionsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ScanRegexBoundResponse proto.InternalMessageInfo
+var xxx_messageInfo_BulkUpdatePositionsResponse proto.InternalMessageInfo
 
-func (m *ScanRegexBoundResponse) GetObjects() map[string]*ObjectDetail {
+func (m *BulkUpdatePositionsResponse) GetObjects() []*ObjectDetail {
 	if m != nil {
 		return m.Objects
 	}
 	return nil
 }
 
-type GetPointRequest struct {
-	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+func (m *BulkUpdatePositionsResponse) GetNotFound() []string {
+	if m != nil {
+		return m.NotFound
+	}
+	return nil
+}
+
+//CSVColumns maps csv columns to object fields. columns are header names if the csv has a header row, otherwise zero based column indexes
+type CSVColumns struct {
+	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Lat                  string   `protobuf:"bytes,2,opt,name=lat,proto3" json:"lat,omitempty"`
+	Lon                  string   `protobuf:"bytes,3,opt,name=lon,proto3" json:"lon,omitempty"`
+	Radius               string   `protobuf:"bytes,4,opt,name=radius,proto3" json:"radius,omitempty"`
+	Metadata             []string `protobuf:"bytes,5,rep,name=metadata,proto3" json:"metadata,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetPointRequest) Reset()         { *m = GetPointRequest{} }
-func (m *GetPointRequest) String() string { return proto.CompactTextString(m) }
-func (*GetPointRequest) ProtoMessage()    {}
-func (*GetPointRequest) Descriptor() ([]byte, []int) {
+func (m *CSVColumns) Reset()         { *m = CSVColumns{} }
+func (m *CSVColumns) String() string { return proto.CompactTextString(m) }
+func (*CSVColumns) ProtoMessage()    {}
+func (*CSVColumns) Descriptor() ([]byte, []int) {
 	return fileDescriptor_00212fb1f9d3bf1c, []int{37}
 }
 
-func (m *GetPointRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetPointRequest.Unmarshal(m, b)
+func (m *CSVColumns) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CSVColumns.Unmarshal(m, b)
 }
-func (m *GetPointRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetPointRequest.Marshal(b, m, deterministic)
+func (m *CSVColumns) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CSVColumns.Marshal(b, m, deterministic)
 }
-func (m *GetPointRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetPointRequest.Merge(m, src)
+func (m *CSVColumns) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CSVColumns.Merge(m, src)
 }
-func (m *GetPointRequest) XXX_Size() int {
-	return xxx_messageInfo_GetPointRequest.Size(m)
+func (m *CSVColumns) XXX_Size() int {
+	return xxx_messageInfo_CSVColumns.Size(m)
 }
-func (m *GetPointRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetPointRequest.DiscardUnknown(m)
+func (m *CSVColumns) XXX_DiscardUnknown() {
+	xxx_messageInfo_CSVColumns.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetPointRequest proto.InternalMessageInfo
+var xxx_messageInfo_CSVColumns proto.InternalMessageInfo
 
-func (m *GetPointRequest) GetAddress() string {
+func (m *CSVColumns) GetKey() string {
 	if m != nil {
-		return m.Address
+		return m.Key
 	}
 	return ""
 }
 
-type GetPointResponse struct {
-	Point                *Point   `protobuf:"bytes,1,opt,name=point,proto3" json:"point,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *CSVColumns) GetLat() string {
+	if m != nil {
+		return m.Lat
+	}
+	return ""
 }
 
-func (m *GetPointResponse) Reset()         { *m = GetPointResponse{} }
-func (m *GetPointResponse) String() string { return proto.CompactTextString(m) }
-func (*GetPointResponse) ProtoMessage()    {}
-func (*GetPointResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{38}
+func (m *CSVColumns) GetLon() string {
+	if m != nil {
+		return m.Lon
+	}
+	return ""
 }
 
-func (m *GetPointResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetPointResponse.Unmarshal(m, b)
+func (m *CSVColumns) GetRadius() string {
+	if m != nil {
+		return m.Radius
+	}
+	return ""
 }
-func (m *GetPointResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetPointResponse.Marshal(b, m, deterministic)
+
+func (m *CSVColumns) GetMetadata() []string {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
 }
-func (m *GetPointResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetPointResponse.Merge(m, src)
+
+type ImportCSVRequest struct {
+	Csv                  string      `protobuf:"bytes,1,opt,name=csv,proto3" json:"csv,omitempty"`
+	Header               bool        `protobuf:"varint,2,opt,name=header,proto3" json:"header,omitempty"`
+	Columns              *CSVColumns `protobuf:"bytes,3,opt,name=columns,proto3" json:"columns,omitempty"`
+	DefaultRadius        int64       `protobuf:"varint,4,opt,name=default_radius,json=defaultRadius,proto3" json:"default_radius,omitempty"`
+	DryRun               bool        `protobuf:"varint,5,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	Namespace            string      `protobuf:"bytes,6,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
 }
-func (m *GetPointResponse) XXX_Size() int {
-	return xxx_messageInfo_GetPointResponse.Size(m)
+
+func (m *ImportCSVRequest) Reset()         { *m = ImportCSVRequest{} }
+func (m *ImportCSVRequest) String() string { return proto.CompactTextString(m) }
+func (*ImportCSVRequest) ProtoMessage()    {}
+func (*ImportCSVRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{38}
 }
-func (m *GetPointResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetPointResponse.DiscardUnknown(m)
+
+func (m *ImportCSVRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImportCSVRequest.Unmarshal(m, b)
+}
+func (m *ImportCSVRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImportCSVRequest.Marshal(b, m, deterministic)
+}
+func (m *ImportCSVRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportCSVRequest.Merge(m, src)
+}
+func (m *ImportCSVRequest) XXX_Size() int {
+	return xxx_messageInfo_ImportCSVRequest.Size(m)
+}
+func (m *ImportCSVRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportCSVRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetPointResponse proto.InternalMessageInfo
+var xxx_messageInfo_ImportCSVRequest proto.InternalMessageInfo
 
-func (m *GetPointResponse) GetPoint() *Point {
+func (m *ImportCSVRequest) GetCsv() string {
 	if m != nil {
-		return m.Point
+		return m.Csv
+	}
+	return ""
+}
+
+func (m *ImportCSVRequest) GetHeader() bool {
+	if m != nil {
+		return m.Header
+	}
+	return false
+}
+
+func (m *ImportCSVRequest) GetColumns() *CSVColumns {
+	if m != nil {
+		return m.Columns
 	}
 	return nil
 }
 
-type PingRequest struct {
+func (m *ImportCSVRequest) GetDefaultRadius() int64 {
+	if m != nil {
+		return m.DefaultRadius
+	}
+	return 0
+}
+
+func (m *ImportCSVRequest) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
+func (m *ImportCSVRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+//CSVRowError is an error importing a single csv row
+type CSVRowError struct {
+	Line                 int64    `protobuf:"varint,1,opt,name=line,proto3" json:"line,omitempty"`
+	Error                string   `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *PingRequest) Reset()         { *m = PingRequest{} }
-func (m *PingRequest) String() string { return proto.CompactTextString(m) }
-func (*PingRequest) ProtoMessage()    {}
-func (*PingRequest) Descriptor() ([]byte, []int) {
+func (m *CSVRowError) Reset()         { *m = CSVRowError{} }
+func (m *CSVRowError) String() string { return proto.CompactTextString(m) }
+func (*CSVRowError) ProtoMessage()    {}
+func (*CSVRowError) Descriptor() ([]byte, []int) {
 	return fileDescriptor_00212fb1f9d3bf1c, []int{39}
 }
 
-func (m *PingRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PingRequest.Unmarshal(m, b)
+func (m *CSVRowError) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CSVRowError.Unmarshal(m, b)
 }
-func (m *PingRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PingRequest.Marshal(b, m, deterministic)
+func (m *CSVRowError) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CSVRowError.Marshal(b, m, deterministic)
 }
-func (m *PingRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PingRequest.Merge(m, src)
+func (m *CSVRowError) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CSVRowError.Merge(m, src)
 }
-func (m *PingRequest) XXX_Size() int {
-	return xxx_messageInfo_PingRequest.Size(m)
+func (m *CSVRowError) XXX_Size() int {
+	return xxx_messageInfo_CSVRowError.Size(m)
 }
-func (m *PingRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_PingRequest.DiscardUnknown(m)
+func (m *CSVRowError) XXX_DiscardUnknown() {
+	xxx_messageInfo_CSVRowError.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PingRequest proto.InternalMessageInfo
+var xxx_messageInfo_CSVRowError proto.InternalMessageInfo
 
-type PingResponse struct {
-	Ok                   bool     `protobuf:"varint,1,opt,name=ok,proto3" json:"ok,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *CSVRowError) GetLine() int64 {
+	if m != nil {
+		return m.Line
+	}
+	return 0
 }
 
-func (m *PingResponse) Reset()         { *m = PingResponse{} }
-func (m *PingResponse) String() string { return proto.CompactTextString(m) }
-func (*PingResponse) ProtoMessage()    {}
-func (*PingResponse) Descriptor() ([]byte, []int) {
+func (m *CSVRowError) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type ImportCSVResponse struct {
+	Imported             int64          `protobuf:"varint,1,opt,name=imported,proto3" json:"imported,omitempty"`
+	Errors               []*CSVRowError `protobuf:"bytes,2,rep,name=errors,proto3" json:"errors,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *ImportCSVResponse) Reset()         { *m = ImportCSVResponse{} }
+func (m *ImportCSVResponse) String() string { return proto.CompactTextString(m) }
+func (*ImportCSVResponse) ProtoMessage()    {}
+func (*ImportCSVResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_00212fb1f9d3bf1c, []int{40}
 }
 
-func (m *PingResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PingResponse.Unmarshal(m, b)
+func (m *ImportCSVResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImportCSVResponse.Unmarshal(m, b)
 }
-func (m *PingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PingResponse.Marshal(b, m, deterministic)
+func (m *ImportCSVResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImportCSVResponse.Marshal(b, m, deterministic)
 }
-func (m *PingResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PingResponse.Merge(m, src)
+func (m *ImportCSVResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportCSVResponse.Merge(m, src)
 }
-func (m *PingResponse) XXX_Size() int {
-	return xxx_messageInfo_PingResponse.Size(m)
+func (m *ImportCSVResponse) XXX_Size() int {
+	return xxx_messageInfo_ImportCSVResponse.Size(m)
 }
-func (m *PingResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_PingResponse.DiscardUnknown(m)
+func (m *ImportCSVResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportCSVResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PingResponse proto.InternalMessageInfo
+var xxx_messageInfo_ImportCSVResponse proto.InternalMessageInfo
 
-func (m *PingResponse) GetOk() bool {
+func (m *ImportCSVResponse) GetImported() int64 {
 	if m != nil {
-		return m.Ok
+		return m.Imported
 	}
-	return false
+	return 0
 }
 
-func init() {
-	proto.RegisterEnum("api.TravelMode", TravelMode_name, TravelMode_value)
-	proto.RegisterType((*Point)(nil), "api.Point")
-	proto.RegisterType((*Bound)(nil), "api.Bound")
-	proto.RegisterType((*Object)(nil), "api.Object")
-	proto.RegisterMapType((map[string]string)(nil), "api.Object.MetadataEntry")
-	proto.RegisterType((*ObjectTracking)(nil), "api.ObjectTracking")
-	proto.RegisterType((*ObjectTracker)(nil), "api.ObjectTracker")
-	proto.RegisterType((*Directions)(nil), "api.Directions")
-	proto.RegisterType((*Address)(nil), "api.Address")
-	proto.RegisterType((*TrackerEvent)(nil), "api.TrackerEvent")
-	proto.RegisterType((*ObjectDetail)(nil), "api.ObjectDetail")
-	proto.RegisterType((*StreamRequest)(nil), "api.StreamRequest")
-	proto.RegisterType((*StreamResponse)(nil), "api.StreamResponse")
-	proto.RegisterType((*StreamRegexRequest)(nil), "api.StreamRegexRequest")
-	proto.RegisterType((*StreamRegexResponse)(nil), "api.StreamRegexResponse")
-	proto.RegisterType((*StreamPrefixRequest)(nil), "api.StreamPrefixRequest")
-	proto.RegisterType((*StreamPrefixResponse)(nil), "api.StreamPrefixResponse")
-	proto.RegisterType((*SetRequest)(nil), "api.SetRequest")
-	proto.RegisterType((*SetResponse)(nil), "api.SetResponse")
-	proto.RegisterType((*GetKeysRequest)(nil), "api.GetKeysRequest")
-	proto.RegisterType((*GetKeysResponse)(nil), "api.GetKeysResponse")
-	proto.RegisterType((*GetPrefixKeysRequest)(nil), "api.GetPrefixKeysRequest")
-	proto.RegisterType((*GetPrefixKeysResponse)(nil), "api.GetPrefixKeysResponse")
-	proto.RegisterType((*GetRegexKeysRequest)(nil), "api.GetRegexKeysRequest")
-	proto.RegisterType((*GetRegexKeysResponse)(nil), "api.GetRegexKeysResponse")
-	proto.RegisterType((*GetRequest)(nil), "api.GetRequest")
-	proto.RegisterType((*GetResponse)(nil), "api.GetResponse")
-	proto.RegisterMapType((map[string]*ObjectDetail)(nil), "api.GetResponse.ObjectsEntry")
-	proto.RegisterType((*GetRegexRequest)(nil), "api.GetRegexRequest")
-	proto.RegisterType((*GetRegexResponse)(nil), "api.GetRegexResponse")
-	proto.RegisterMapType((map[string]*ObjectDetail)(nil), "api.GetRegexResponse.ObjectsEntry")
-	proto.RegisterType((*GetPrefixRequest)(nil), "api.GetPrefixRequest")
-	proto.RegisterType((*GetPrefixResponse)(nil), "api.GetPrefixResponse")
-	proto.RegisterMapType((map[string]*ObjectDetail)(nil), "api.GetPrefixResponse.ObjectsEntry")
-	proto.RegisterType((*DeleteRequest)(nil), "api.DeleteRequest")
-	proto.RegisterType((*DeleteResponse)(nil), "api.DeleteResponse")
-	proto.RegisterType((*ScanBoundRequest)(nil), "api.ScanBoundRequest")
-	proto.RegisterType((*ScanBoundResponse)(nil), "api.ScanBoundResponse")
-	proto.RegisterMapType((map[string]*ObjectDetail)(nil), "api.ScanBoundResponse.ObjectsEntry")
-	proto.RegisterType((*ScanPrefixBoundRequest)(nil), "api.ScanPrefixBoundRequest")
-	proto.RegisterType((*ScanPrefixBoundResponse)(nil), "api.ScanPrefixBoundResponse")
-	proto.RegisterMapType((map[string]*ObjectDetail)(nil), "api.ScanPrefixBoundResponse.ObjectsEntry")
-	proto.RegisterType((*ScanRegexBoundRequest)(nil), "api.ScanRegexBoundRequest")
-	proto.RegisterType((*ScanRegexBoundResponse)(nil), "api.ScanRegexBoundResponse")
-	proto.RegisterMapType((map[string]*ObjectDetail)(nil), "api.ScanRegexBoundResponse.ObjectsEntry")
-	proto.RegisterType((*GetPointRequest)(nil), "api.GetPointRequest")
-	proto.RegisterType((*GetPointResponse)(nil), "api.GetPointResponse")
-	proto.RegisterType((*PingRequest)(nil), "api.PingRequest")
-	proto.RegisterType((*PingResponse)(nil), "api.PingResponse")
+func (m *ImportCSVResponse) GetErrors() []*CSVRowError {
+	if m != nil {
+		return m.Errors
+	}
+	return nil
 }
 
-func init() { proto.RegisterFile("api.proto", fileDescriptor_00212fb1f9d3bf1c) }
-
-var fileDescriptor_00212fb1f9d3bf1c = []byte{
-	// 1592 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0x4f, 0x73, 0x13, 0xc7,
-	0x12, 0xd7, 0x5a, 0x96, 0x2c, 0xb5, 0xfe, 0x78, 0x3d, 0xfe, 0x83, 0x2c, 0xde, 0x03, 0xbd, 0xe5,
-	0x01, 0x06, 0x3f, 0xdb, 0x3c, 0x11, 0x08, 0x04, 0x53, 0x05, 0xc2, 0x2e, 0x91, 0xa2, 0x08, 0xd4,
-	0xda, 0xa9, 0x54, 0x52, 0xa9, 0xa8, 0xd6, 0xda, 0x89, 0x98, 0x58, 0xda, 0x55, 0x76, 0x47, 0xc6,
-	0x22, 0x95, 0x0f, 0x91, 0x43, 0xce, 0xa9, 0x1c, 0x72, 0x4a, 0xe5, 0x90, 0x7b, 0xf2, 0x59, 0xa8,
-	0xf0, 0x49, 0x52, 0xf3, 0x67, 0x47, 0x33, 0xb2, 0x50, 0xec, 0x8b, 0x6f, 0x9a, 0xee, 0x5f, 0xf7,
-	0x74, 0xff, 0xa6, 0xbb, 0x67, 0x56, 0x90, 0xf7, 0xfa, 0x64, 0xb3, 0x1f, 0x85, 0x34, 0x44, 0x69,
-	0xaf, 0x4f, 0xaa, 0x77, 0x3b, 0x84, 0xbe, 0x1a, 0x1c, 0x6c, 0xb6, 0xc3, 0xde, 0x56, 0xef, 0x35,
-	0xa1, 0x87, 0xe1, 0xeb, 0xad, 0x4e, 0xb8, 0xc1, 0x11, 0x1b, 0x47, 0x5e, 0x97, 0xf8, 0x1e, 0x0d,
-	0xa3, 0x78, 0x4b, 0xfd, 0x14, 0xc6, 0xce, 0x3a, 0x64, 0x5e, 0x86, 0x24, 0xa0, 0xc8, 0x86, 0x74,
-	0xd7, 0xa3, 0x15, 0xab, 0x66, 0xad, 0x59, 0x2e, 0xfb, 0xc9, 0x25, 0x61, 0x50, 0x99, 0x91, 0x92,
-	0x30, 0x70, 0x9e, 0x40, 0xa6, 0x11, 0x0e, 0x02, 0x1f, 0x39, 0x90, 0x6d, 0xe3, 0x80, 0xe2, 0x88,
-	0xe3, 0x0b, 0x75, 0xd8, 0x64, 0xe1, 0x70, 0x47, 0xae, 0xd4, 0xa0, 0x15, 0xc8, 0x46, 0x9e, 0x4f,
-	0x06, 0xb1, 0xf4, 0x20, 0x57, 0xce, 0x2f, 0x69, 0xc8, 0xbe, 0x38, 0xf8, 0x06, 0xb7, 0x29, 0x72,
-	0x20, 0x7d, 0x88, 0x87, 0xdc, 0x47, 0xbe, 0x61, 0xbf, 0x7b, 0x7b, 0xb9, 0x08, 0xf0, 0xd5, 0xe6,
-	0x77, 0xff, 0xff, 0x5f, 0xbd, 0x7e, 0xe7, 0xfb, 0xff, 0xba, 0x4c, 0x89, 0xd6, 0x20, 0xd3, 0x67,
-	0x7e, 0xb9, 0x17, 0x63, 0xa7, 0x46, 0xf6, 0xdd, 0xdb, 0xcb, 0x33, 0x35, 0xcb, 0x15, 0x00, 0x74,
-	0x49, 0x6d, 0x98, 0xae, 0x59, 0x6b, 0x69, 0xa1, 0xb6, 0x53, 0xc9, 0xc6, 0x68, 0x0b, 0x72, 0x34,
-	0xf2, 0xda, 0x87, 0x24, 0xe8, 0x54, 0x66, 0xb9, 0xb3, 0x45, 0xee, 0x4c, 0x04, 0xb3, 0x2f, 0x55,
-	0xae, 0x02, 0xa1, 0x3b, 0x90, 0xeb, 0x61, 0xea, 0xf9, 0x1e, 0xf5, 0x2a, 0x99, 0x5a, 0x7a, 0xad,
-	0x50, 0x5f, 0xd5, 0x0c, 0x36, 0x9f, 0x4b, 0xdd, 0x6e, 0x40, 0xa3, 0xa1, 0xab, 0xa0, 0xe8, 0x32,
-	0x14, 0x3a, 0x98, 0xb6, 0x3c, 0xdf, 0x8f, 0x70, 0x1c, 0x57, 0xb2, 0x35, 0x6b, 0x2d, 0xe7, 0x42,
-	0x07, 0xd3, 0xc7, 0x42, 0x82, 0xfe, 0x03, 0x45, 0x06, 0xa0, 0xa4, 0x87, 0xdf, 0x84, 0x01, 0xae,
-	0xcc, 0x71, 0x04, 0x33, 0xda, 0x97, 0x22, 0x06, 0xc1, 0xc7, 0x7d, 0x12, 0xe1, 0xb8, 0x35, 0x08,
-	0xc8, 0x71, 0x25, 0xc7, 0x32, 0x72, 0x0b, 0x52, 0xf6, 0x69, 0x40, 0x8e, 0x19, 0x64, 0xd0, 0xf7,
-	0x3d, 0x8a, 0x7d, 0x01, 0xc9, 0x0b, 0x88, 0x94, 0x31, 0x48, 0xf5, 0x01, 0x94, 0x8c, 0x20, 0xd9,
-	0x91, 0x2a, 0xc2, 0x05, 0xbd, 0x4b, 0x90, 0x39, 0xf2, 0xba, 0x03, 0xcc, 0xe9, 0xcd, 0xbb, 0x62,
-	0xf1, 0xd1, 0xcc, 0x3d, 0xcb, 0x89, 0xa0, 0x6c, 0x32, 0x83, 0x6e, 0x41, 0x81, 0x46, 0xde, 0x11,
-	0xee, 0xb6, 0x7a, 0xa1, 0x8f, 0xb9, 0x97, 0x72, 0x7d, 0x9e, 0x53, 0xb2, 0xcf, 0xe5, 0xcf, 0x43,
-	0x1f, 0xbb, 0x40, 0xd5, 0x6f, 0xb4, 0x29, 0x29, 0xc7, 0x11, 0xab, 0x02, 0xc6, 0x20, 0x1a, 0xa7,
-	0x1c, 0x47, 0xae, 0xc2, 0x38, 0x7f, 0x58, 0x50, 0x32, 0x74, 0x68, 0x1b, 0x16, 0xa8, 0x17, 0x31,
-	0xba, 0x42, 0x2e, 0x6f, 0x4d, 0x2b, 0x98, 0x79, 0x01, 0x15, 0x1e, 0x9e, 0xe1, 0x21, 0xba, 0x01,
-	0x36, 0xf7, 0xdd, 0xf2, 0x49, 0x84, 0xdb, 0x94, 0x84, 0x81, 0xa8, 0xc6, 0x9c, 0x3b, 0xcf, 0xe5,
-	0x3b, 0x4a, 0x8c, 0xae, 0x42, 0x39, 0x81, 0xc6, 0xd4, 0x0b, 0xda, 0x98, 0x57, 0x51, 0xce, 0x2d,
-	0x49, 0xa0, 0x10, 0xa2, 0x8b, 0x90, 0x17, 0x30, 0x4c, 0x3d, 0x5e, 0x45, 0x39, 0x19, 0xfe, 0x2e,
-	0xf5, 0x9c, 0x57, 0x00, 0x9a, 0xc7, 0xeb, 0x30, 0xff, 0x8a, 0xf6, 0xba, 0xfa, 0xde, 0x82, 0xf8,
-	0x32, 0x13, 0x6b, 0x40, 0x1b, 0xd2, 0xcc, 0xdb, 0x0c, 0x3f, 0x40, 0xf6, 0x93, 0x95, 0x90, 0x64,
-	0x9a, 0x45, 0x23, 0xea, 0x39, 0x21, 0x96, 0x85, 0xe2, 0xfc, 0x60, 0xc1, 0x5c, 0x52, 0x4e, 0x4b,
-	0x90, 0x89, 0xa9, 0x47, 0xb1, 0xf4, 0x2e, 0x16, 0xa8, 0x02, 0x73, 0x49, 0x05, 0x8a, 0xa3, 0x4d,
-	0x96, 0x4c, 0xd3, 0x0e, 0x07, 0xac, 0x1e, 0xb8, 0xe3, 0xbc, 0x9b, 0x2c, 0x59, 0x20, 0x6f, 0x48,
-	0x9f, 0xa7, 0x95, 0x77, 0xd9, 0x4f, 0xd6, 0xc4, 0x5c, 0x39, 0xac, 0x64, 0xb8, 0x50, 0xae, 0x10,
-	0x82, 0xd9, 0x36, 0xa1, 0x43, 0x5e, 0xdc, 0x79, 0x97, 0xff, 0x76, 0xfe, 0xb4, 0xa0, 0x28, 0x8f,
-	0x6d, 0xf7, 0x08, 0x07, 0x14, 0x5d, 0x81, 0xac, 0x38, 0x34, 0x39, 0x25, 0x0a, 0xda, 0xd9, 0xbb,
-	0x52, 0x85, 0xaa, 0x90, 0x53, 0x8c, 0x8b, 0x41, 0xa1, 0xd6, 0x6c, 0x77, 0x12, 0xc4, 0xc4, 0x4f,
-	0xce, 0x42, 0xae, 0xd0, 0x06, 0xe4, 0x15, 0xa9, 0xb2, 0x95, 0x45, 0x19, 0x8e, 0x48, 0x75, 0x47,
-	0x08, 0x7e, 0xb4, 0xa4, 0x87, 0x63, 0xea, 0xf5, 0xfa, 0xa2, 0x57, 0x32, 0x9c, 0xd0, 0x92, 0x92,
-	0xb2, 0x6e, 0x71, 0x7e, 0xb7, 0xa0, 0x28, 0x82, 0xdb, 0xc1, 0xd4, 0x23, 0xdd, 0xd3, 0xc5, 0x7f,
-	0xcd, 0xe4, 0xb9, 0x50, 0x2f, 0x72, 0x94, 0x3c, 0x9c, 0x11, 0xeb, 0x55, 0xc8, 0xa9, 0x86, 0x17,
-	0xb4, 0xab, 0x35, 0xba, 0x27, 0x6b, 0x0f, 0x47, 0x2d, 0xcc, 0x98, 0x8b, 0x2b, 0xb3, 0xbc, 0x59,
-	0x16, 0x92, 0xde, 0x52, 0x9c, 0xca, 0x72, 0x94, 0xab, 0xd8, 0x79, 0x04, 0xa5, 0x3d, 0x1a, 0x61,
-	0xaf, 0xe7, 0xe2, 0x6f, 0x07, 0x38, 0xa6, 0xac, 0x3e, 0xdb, 0x5d, 0x82, 0x03, 0xda, 0x22, 0xbe,
-	0x2c, 0x88, 0x9c, 0x10, 0x7c, 0xec, 0xb3, 0x53, 0x3b, 0xc4, 0x43, 0xd1, 0x8a, 0x79, 0x97, 0xff,
-	0x76, 0x1e, 0x40, 0x39, 0xf1, 0x10, 0xf7, 0xc3, 0x20, 0xc6, 0xe8, 0xc6, 0x58, 0xda, 0x0b, 0x5a,
-	0xda, 0x82, 0x99, 0x24, 0x79, 0xe7, 0x73, 0x40, 0x89, 0x71, 0x07, 0x1f, 0x9f, 0x2a, 0x86, 0x6b,
-	0x90, 0x89, 0x18, 0x58, 0x54, 0xe5, 0x84, 0x26, 0x16, 0x6a, 0xe7, 0x11, 0x2c, 0x1a, 0xae, 0xcf,
-	0x1e, 0xdc, 0x97, 0x89, 0x87, 0x97, 0x11, 0xfe, 0x9a, 0x9c, 0x2e, 0xba, 0x35, 0xc8, 0xf6, 0x39,
-	0xfa, 0xbd, 0xe1, 0x49, 0xbd, 0xf3, 0x18, 0x96, 0x4c, 0xef, 0x67, 0x0f, 0xf0, 0x3e, 0xc0, 0x1e,
-	0xa6, 0x49, 0x5c, 0xeb, 0x53, 0xaa, 0x4d, 0x5d, 0x75, 0x89, 0xe9, 0x3d, 0x28, 0x70, 0xd3, 0xb3,
-	0x6f, 0x6a, 0x43, 0xb9, 0x89, 0xd9, 0x70, 0x8c, 0xe5, 0xc6, 0xce, 0x55, 0x98, 0x57, 0x12, 0xe9,
-	0x2f, 0x29, 0x14, 0x4b, 0x2b, 0x94, 0x47, 0xb0, 0xd4, 0xc4, 0x54, 0x64, 0xab, 0x99, 0x6b, 0x94,
-	0x59, 0xff, 0x40, 0xd9, 0x3a, 0x2c, 0x8f, 0x79, 0x98, 0xb2, 0xdd, 0x43, 0x58, 0x6c, 0xb2, 0x0c,
-	0x3b, 0xd8, 0xd8, 0x4d, 0x95, 0x8f, 0x35, 0xbd, 0x7c, 0x6e, 0xf2, 0x68, 0x35, 0xf3, 0x29, 0x5b,
-	0xd5, 0x00, 0x9a, 0xa3, 0x73, 0x98, 0x84, 0xf8, 0xd1, 0x82, 0x42, 0x53, 0xe3, 0xfb, 0x43, 0x98,
-	0x13, 0x74, 0x0a, 0x58, 0xa1, 0xfe, 0x6f, 0x4e, 0xb8, 0x06, 0x91, 0xe4, 0xc7, 0xe2, 0x71, 0x90,
-	0xa0, 0xab, 0xcf, 0x93, 0x11, 0x13, 0xbf, 0xef, 0x42, 0xbe, 0xae, 0x5f, 0xc8, 0x13, 0x4f, 0x52,
-	0xbb, 0xa3, 0xef, 0xf3, 0xa3, 0x33, 0x9a, 0xef, 0xb4, 0x04, 0xfd, 0x64, 0x81, 0x3d, 0xb2, 0x95,
-	0x79, 0x6d, 0x8f, 0xe7, 0xe5, 0x8c, 0xf2, 0xd2, 0x70, 0xe7, 0x93, 0xdc, 0x36, 0x0f, 0xd0, 0x6c,
-	0xde, 0xd3, 0x17, 0xdb, 0xcf, 0x16, 0x2c, 0x68, 0xe6, 0x32, 0xc1, 0x87, 0xe3, 0x09, 0x5e, 0x49,
-	0x12, 0x34, 0x81, 0xe7, 0x93, 0xe1, 0x15, 0x28, 0xed, 0xe0, 0x2e, 0xa6, 0x78, 0x5a, 0xed, 0xd9,
-	0x50, 0x4e, 0x40, 0x22, 0x36, 0xe7, 0x29, 0xd8, 0x7b, 0x6d, 0x2f, 0xe0, 0x4f, 0xf1, 0xc4, 0xb2,
-	0x06, 0x99, 0x03, 0xb6, 0x36, 0x1e, 0xe4, 0x02, 0x21, 0x14, 0x13, 0x87, 0x3f, 0x23, 0x49, 0x73,
-	0x35, 0x9d, 0xa4, 0x13, 0xc0, 0xf3, 0x21, 0xc9, 0x85, 0x15, 0xb6, 0xb3, 0x38, 0x9f, 0x33, 0xe6,
-	0xbc, 0x62, 0x8e, 0x73, 0x55, 0x1c, 0xbf, 0x59, 0x70, 0xe1, 0x84, 0x53, 0x99, 0xfd, 0x93, 0xf1,
-	0xec, 0x6f, 0xa8, 0xec, 0x27, 0xc0, 0xcf, 0x87, 0x83, 0x17, 0xb0, 0xcc, 0xf6, 0xe7, 0x4d, 0x78,
-	0x46, 0x0a, 0x96, 0x8c, 0xfb, 0x36, 0xe9, 0xfe, 0x5f, 0x2d, 0xc1, 0xaa, 0xee, 0x51, 0xe6, 0xdf,
-	0x18, 0xcf, 0x7f, 0x4d, 0xe5, 0x7f, 0x12, 0x7d, 0x3e, 0xe9, 0xaf, 0xf3, 0x31, 0x27, 0x3e, 0x2f,
-	0x65, 0xe2, 0xda, 0xf3, 0xd6, 0x32, 0x9e, 0xb7, 0xce, 0x07, 0x62, 0x6c, 0x08, 0xb0, 0xcc, 0xa9,
-	0x96, 0x7c, 0x44, 0x9e, 0xfc, 0x5c, 0x15, 0x0a, 0xa7, 0x04, 0x85, 0x97, 0xec, 0xeb, 0x4f, 0xde,
-	0x89, 0x97, 0xa0, 0x28, 0x96, 0xd2, 0x41, 0x19, 0x66, 0xc2, 0x43, 0x6e, 0x9d, 0x73, 0x67, 0xc2,
-	0xc3, 0x9b, 0x0d, 0x80, 0xd1, 0x27, 0x0f, 0x2a, 0xc0, 0xdc, 0x4e, 0x44, 0x8e, 0x48, 0xd0, 0xb1,
-	0x53, 0x6c, 0xf1, 0x99, 0xd7, 0x65, 0x1f, 0x4c, 0xb6, 0x85, 0x4a, 0x90, 0x6f, 0x90, 0xf6, 0xb0,
-	0xdd, 0x65, 0xcb, 0x19, 0xa6, 0xdb, 0x8f, 0xbc, 0x20, 0x26, 0xd4, 0x4e, 0xd7, 0xff, 0x9a, 0x83,
-	0x4c, 0x13, 0x87, 0x3b, 0x0d, 0xb4, 0x01, 0xb3, 0x6c, 0x37, 0x64, 0x8b, 0xb8, 0x46, 0x71, 0x54,
-	0x17, 0x34, 0x89, 0xec, 0xfe, 0x14, 0xba, 0x09, 0xe9, 0x3d, 0x4c, 0x91, 0x78, 0xf2, 0x8e, 0x5e,
-	0x10, 0x55, 0x7b, 0x24, 0xd0, 0xb1, 0x4d, 0x85, 0x6d, 0x8e, 0x63, 0x9b, 0x06, 0xf6, 0x3e, 0xe4,
-	0x92, 0x49, 0x8f, 0x96, 0xc6, 0x06, 0xbf, 0xb0, 0x5a, 0x9e, 0x78, 0x1d, 0x38, 0x29, 0xb4, 0x0d,
-	0x79, 0x35, 0x43, 0xd1, 0xf2, 0xf8, 0x4c, 0x15, 0xc6, 0x2b, 0x93, 0x47, 0xad, 0x93, 0x42, 0x77,
-	0x61, 0x4e, 0xbe, 0x40, 0xd0, 0x62, 0x02, 0xd2, 0x2e, 0xfd, 0xea, 0x92, 0x29, 0x54, 0x76, 0xbb,
-	0x50, 0xd4, 0x2f, 0x79, 0x54, 0x31, 0xc2, 0xd3, 0x3d, 0xac, 0x4e, 0xd0, 0x28, 0x37, 0x4f, 0xa1,
-	0x64, 0xbc, 0x4b, 0xd0, 0xaa, 0x19, 0xa9, 0xee, 0xa8, 0x3a, 0x49, 0xa5, 0x3c, 0xdd, 0x86, 0xac,
-	0x98, 0xd5, 0x48, 0x7c, 0xe7, 0x1a, 0xd3, 0xbd, 0xba, 0x68, 0xc8, 0x94, 0xd1, 0x1d, 0xc8, 0x8a,
-	0x97, 0xa4, 0x34, 0x32, 0x1e, 0xf4, 0xd2, 0xc8, 0x7c, 0xa2, 0x3b, 0xa9, 0x5b, 0x16, 0xda, 0x81,
-	0x82, 0xf6, 0x40, 0x46, 0x17, 0x0c, 0x9c, 0x76, 0x66, 0x95, 0x93, 0x0a, 0xcd, 0x4b, 0x13, 0x8a,
-	0xfa, 0x33, 0x16, 0xe9, 0x68, 0xf3, 0xf8, 0x56, 0x27, 0x68, 0x34, 0x47, 0xdb, 0x90, 0x57, 0x17,
-	0x84, 0xac, 0x80, 0xf1, 0x4b, 0x4a, 0x56, 0xc0, 0x89, 0x7b, 0xc4, 0x49, 0xa1, 0x67, 0x50, 0x36,
-	0x07, 0x0c, 0xaa, 0x4e, 0x9c, 0x3a, 0xc2, 0xcf, 0xc5, 0x29, 0x13, 0xc9, 0x49, 0xa1, 0x4f, 0x60,
-	0x7e, 0x6c, 0x5a, 0xa3, 0x8b, 0x93, 0x67, 0xb8, 0x70, 0xf7, 0xaf, 0x69, 0x03, 0x5e, 0xf5, 0x85,
-	0xf8, 0x9b, 0x4c, 0x95, 0xa2, 0x3e, 0x8d, 0x46, 0x7d, 0x61, 0x8c, 0x1d, 0x27, 0xd5, 0xc8, 0x7c,
-	0x91, 0xf6, 0xfa, 0xe4, 0x20, 0xcb, 0xff, 0x6c, 0xbb, 0xfd, 0x77, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0x90, 0xd3, 0x39, 0xf6, 0xb6, 0x13, 0x00, 0x00,
+type GetKeysRequest struct {
+	Limit                int64    `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	Cursor               string   `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Namespace            string   `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Reverse              bool     `protobuf:"varint,4,opt,name=reverse,proto3" json:"reverse,omitempty"`
+	EndKey               string   `protobuf:"bytes,5,opt,name=end_key,json=endKey,proto3" json:"end_key,omitempty"`
+	ReadSession          string   `protobuf:"bytes,6,opt,name=read_session,json=readSession,proto3" json:"read_session,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *GetKeysRequest) Reset()         { *m = GetKeysRequest{} }
+func (m *GetKeysRequest) String() string { return proto.CompactTextString(m) }
+func (*GetKeysRequest) ProtoMessage()    {}
+func (*GetKeysRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{41}
+}
 
-// GeoDBClient is the client API for GeoDB service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type GeoDBClient interface {
-	//Ping - input: empty, output: returns ok if server is healthy.
-	Ping(ctx context.Context, in *PingRequest, opts ...grpc.CallOption) (*PingResponse, error)
-	//Set - input: an object output: an object detail. Object details are enhanced when the google maps integration is active
-	Set(ctx context.Context, in *SetRequest, opts ...grpc.CallOption) (*SetResponse, error)
-	//Get - input: an array of object keys, output: returns an array of current object details
-	Get(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*GetResponse, error)
-	//GetRegex - input: a regex string, output: returns an array of current object details with keys that match the regex pattern
-	GetRegex(ctx context.Context, in *GetRegexRequest, opts ...grpc.CallOption) (*GetRegexResponse, error)
-	//GetPrefix - input: a prefix string, output: returns an array of current object details with keys that have the given prefix
-	GetPrefix(ctx context.Context, in *GetPrefixRequest, opts ...grpc.CallOption) (*GetPrefixResponse, error)
-	//GetKeys -  input: none, output: returns all keys in database
-	GetKeys(ctx context.Context, in *GetKeysRequest, opts ...grpc.CallOption) (*GetKeysResponse, error)
-	//GetRegexKeys -  input: a regex string, output: returns all keys in database that match the regex pattern
-	GetRegexKeys(ctx context.Context, in *GetRegexKeysRequest, opts ...grpc.CallOption) (*GetRegexKeysResponse, error)
-	//GetPrefixKeys - input: a prefix string, output: returns an array of of keys that have the given prefix
-	GetPrefixKeys(ctx context.Context, in *GetPrefixKeysRequest, opts ...grpc.CallOption) (*GetPrefixKeysResponse, error)
-	//Delete -  input: an array of object key strings to delete, output: none
-	Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*DeleteResponse, error)
-	//Stream -  input: a clientID(optional) and an array of object keys(optional),
-	//output: a stream of object details for realtime, targetted object geolocation updates
-	Stream(ctx context.Context, in *StreamRequest, opts ...grpc.CallOption) (GeoDB_StreamClient, error)
-	//StreamRegex -  input: a clientID(optional) a regex string,
-	//output: a stream of object details for realtime, targetted object geolocation updates that match the regex pattern
-	StreamRegex(ctx context.Context, in *StreamRegexRequest, opts ...grpc.CallOption) (GeoDB_StreamRegexClient, error)
-	//StreamPrefix -  input: a clientID(optional) a prefix string,
-	//output: a stream of object details for realtime, targetted object geolocation updates that match the prefix pattern
-	StreamPrefix(ctx context.Context, in *StreamPrefixRequest, opts ...grpc.CallOption) (GeoDB_StreamPrefixClient, error)
-	//ScanBound -  input: a geolocation boundary, output: returns an array of current object details that are within the boundary
-	ScanBound(ctx context.Context, in *ScanBoundRequest, opts ...grpc.CallOption) (*ScanBoundResponse, error)
-	//ScanRegexBound -  input: a geolocation boundary, string-array of unique object ids(optional), output: returns an array of current object details that have keys that match the regex and are within the boundary and
-	ScanRegexBound(ctx context.Context, in *ScanRegexBoundRequest, opts ...grpc.CallOption) (*ScanRegexBoundResponse, error)
-	//ScanPrefexBound -  input: a geolocation boundary, output: returns an array of current object details that have keys that match the prefix and are within the boundary and
-	ScanPrefixBound(ctx context.Context, in *ScanPrefixBoundRequest, opts ...grpc.CallOption) (*ScanPrefixBoundResponse, error)
-	//GetPoint can be used to get an addresses latitude/longitude - google maps integration is required.
-	GetPoint(ctx context.Context, in *GetPointRequest, opts ...grpc.CallOption) (*GetPointResponse, error)
+func (m *GetKeysRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetKeysRequest.Unmarshal(m, b)
+}
+func (m *GetKeysRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetKeysRequest.Marshal(b, m, deterministic)
+}
+func (m *GetKeysRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetKeysRequest.Merge(m, src)
+}
+func (m *GetKeysRequest) XXX_Size() int {
+	return xxx_messageInfo_GetKeysRequest.Size(m)
+}
+func (m *GetKeysRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetKeysRequest.DiscardUnknown(m)
 }
 
-type geoDBClient struct {
-	cc *grpc.ClientConn
+var xxx_messageInfo_GetKeysRequest proto.InternalMessageInfo
+
+func (m *GetKeysRequest) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
 }
 
-func NewGeoDBClient(cc *grpc.ClientConn) GeoDBClient {
-	return &geoDBClient{cc}
+func (m *GetKeysRequest) GetCursor() string {
+	if m != nil {
+		return m.Cursor
+	}
+	return ""
 }
 
-func (c *geoDBClient) Ping(ctx context.Context, in *PingRequest, opts ...grpc.CallOption) (*PingResponse, error) {
-	out := new(PingResponse)
-	err := c.cc.Invoke(ctx, "/api.GeoDB/Ping", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *GetKeysRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
 	}
-	return out, nil
+	return ""
 }
 
-func (c *geoDBClient) Set(ctx context.Context, in *SetRequest, opts ...grpc.CallOption) (*SetResponse, error) {
-	out := new(SetResponse)
-	err := c.cc.Invoke(ctx, "/api.GeoDB/Set", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *GetKeysRequest) GetReverse() bool {
+	if m != nil {
+		return m.Reverse
 	}
-	return out, nil
+	return false
 }
 
-func (c *geoDBClient) Get(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*GetResponse, error) {
-	out := new(GetResponse)
-	err := c.cc.Invoke(ctx, "/api.GeoDB/Get", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *GetKeysRequest) GetEndKey() string {
+	if m != nil {
+		return m.EndKey
 	}
-	return out, nil
+	return ""
 }
 
-func (c *geoDBClient) GetRegex(ctx context.Context, in *GetRegexRequest, opts ...grpc.CallOption) (*GetRegexResponse, error) {
-	out := new(GetRegexResponse)
-	err := c.cc.Invoke(ctx, "/api.GeoDB/GetRegex", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *GetKeysRequest) GetReadSession() string {
+	if m != nil {
+		return m.ReadSession
 	}
-	return out, nil
+	return ""
 }
 
-func (c *geoDBClient) GetPrefix(ctx context.Context, in *GetPrefixRequest, opts ...grpc.CallOption) (*GetPrefixResponse, error) {
-	out := new(GetPrefixResponse)
-	err := c.cc.Invoke(ctx, "/api.GeoDB/GetPrefix", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type GetKeysResponse struct {
+	Keys                 []string `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+	NextCursor           string   `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (c *geoDBClient) GetKeys(ctx context.Context, in *GetKeysRequest, opts ...grpc.CallOption) (*GetKeysResponse, error) {
-	out := new(GetKeysResponse)
-	err := c.cc.Invoke(ctx, "/api.GeoDB/GetKeys", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *GetKeysResponse) Reset()         { *m = GetKeysResponse{} }
+func (m *GetKeysResponse) String() string { return proto.CompactTextString(m) }
+func (*GetKeysResponse) ProtoMessage()    {}
+func (*GetKeysResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{42}
 }
 
-func (c *geoDBClient) GetRegexKeys(ctx context.Context, in *GetRegexKeysRequest, opts ...grpc.CallOption) (*GetRegexKeysResponse, error) {
-	out := new(GetRegexKeysResponse)
-	err := c.cc.Invoke(ctx, "/api.GeoDB/GetRegexKeys", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *GetKeysResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetKeysResponse.Unmarshal(m, b)
+}
+func (m *GetKeysResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetKeysResponse.Marshal(b, m, deterministic)
+}
+func (m *GetKeysResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetKeysResponse.Merge(m, src)
+}
+func (m *GetKeysResponse) XXX_Size() int {
+	return xxx_messageInfo_GetKeysResponse.Size(m)
+}
+func (m *GetKeysResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetKeysResponse.DiscardUnknown(m)
 }
 
-func (c *geoDBClient) GetPrefixKeys(ctx context.Context, in *GetPrefixKeysRequest, opts ...grpc.CallOption) (*GetPrefixKeysResponse, error) {
-	out := new(GetPrefixKeysResponse)
-	err := c.cc.Invoke(ctx, "/api.GeoDB/GetPrefixKeys", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_GetKeysResponse proto.InternalMessageInfo
+
+func (m *GetKeysResponse) GetKeys() []string {
+	if m != nil {
+		return m.Keys
 	}
-	return out, nil
+	return nil
 }
 
-func (c *geoDBClient) Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*DeleteResponse, error) {
-	out := new(DeleteResponse)
-	err := c.cc.Invoke(ctx, "/api.GeoDB/Delete", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *GetKeysResponse) GetNextCursor() string {
+	if m != nil {
+		return m.NextCursor
 	}
-	return out, nil
+	return ""
 }
 
-func (c *geoDBClient) Stream(ctx context.Context, in *StreamRequest, opts ...grpc.CallOption) (GeoDB_StreamClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_GeoDB_serviceDesc.Streams[0], "/api.GeoDB/Stream", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &geoDBStreamClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
-	}
-	return x, nil
+type GetPrefixKeysRequest struct {
+	Prefix               string   `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	Namespace            string   `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Limit                int64    `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-type GeoDB_StreamClient interface {
-	Recv() (*StreamResponse, error)
-	grpc.ClientStream
+func (m *GetPrefixKeysRequest) Reset()         { *m = GetPrefixKeysRequest{} }
+func (m *GetPrefixKeysRequest) String() string { return proto.CompactTextString(m) }
+func (*GetPrefixKeysRequest) ProtoMessage()    {}
+func (*GetPrefixKeysRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{43}
 }
 
-type geoDBStreamClient struct {
-	grpc.ClientStream
+func (m *GetPrefixKeysRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetPrefixKeysRequest.Unmarshal(m, b)
+}
+func (m *GetPrefixKeysRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetPrefixKeysRequest.Marshal(b, m, deterministic)
+}
+func (m *GetPrefixKeysRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPrefixKeysRequest.Merge(m, src)
+}
+func (m *GetPrefixKeysRequest) XXX_Size() int {
+	return xxx_messageInfo_GetPrefixKeysRequest.Size(m)
+}
+func (m *GetPrefixKeysRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPrefixKeysRequest.DiscardUnknown(m)
 }
 
-func (x *geoDBStreamClient) Recv() (*StreamResponse, error) {
-	m := new(StreamResponse)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+var xxx_messageInfo_GetPrefixKeysRequest proto.InternalMessageInfo
+
+func (m *GetPrefixKeysRequest) GetPrefix() string {
+	if m != nil {
+		return m.Prefix
 	}
-	return m, nil
+	return ""
 }
 
-func (c *geoDBClient) StreamRegex(ctx context.Context, in *StreamRegexRequest, opts ...grpc.CallOption) (GeoDB_StreamRegexClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_GeoDB_serviceDesc.Streams[1], "/api.GeoDB/StreamRegex", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &geoDBStreamRegexClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
+func (m *GetPrefixKeysRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
 	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+	return ""
+}
+
+func (m *GetPrefixKeysRequest) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
 	}
-	return x, nil
+	return 0
 }
 
-type GeoDB_StreamRegexClient interface {
-	Recv() (*StreamRegexResponse, error)
-	grpc.ClientStream
+type GetPrefixKeysResponse struct {
+	Keys                 []string `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-type geoDBStreamRegexClient struct {
-	grpc.ClientStream
+func (m *GetPrefixKeysResponse) Reset()         { *m = GetPrefixKeysResponse{} }
+func (m *GetPrefixKeysResponse) String() string { return proto.CompactTextString(m) }
+func (*GetPrefixKeysResponse) ProtoMessage()    {}
+func (*GetPrefixKeysResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{44}
 }
 
-func (x *geoDBStreamRegexClient) Recv() (*StreamRegexResponse, error) {
-	m := new(StreamRegexResponse)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
-	}
-	return m, nil
+func (m *GetPrefixKeysResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetPrefixKeysResponse.Unmarshal(m, b)
+}
+func (m *GetPrefixKeysResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetPrefixKeysResponse.Marshal(b, m, deterministic)
+}
+func (m *GetPrefixKeysResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPrefixKeysResponse.Merge(m, src)
+}
+func (m *GetPrefixKeysResponse) XXX_Size() int {
+	return xxx_messageInfo_GetPrefixKeysResponse.Size(m)
+}
+func (m *GetPrefixKeysResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPrefixKeysResponse.DiscardUnknown(m)
 }
 
-func (c *geoDBClient) StreamPrefix(ctx context.Context, in *StreamPrefixRequest, opts ...grpc.CallOption) (GeoDB_StreamPrefixClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_GeoDB_serviceDesc.Streams[2], "/api.GeoDB/StreamPrefix", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &geoDBStreamPrefixClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+var xxx_messageInfo_GetPrefixKeysResponse proto.InternalMessageInfo
+
+func (m *GetPrefixKeysResponse) GetKeys() []string {
+	if m != nil {
+		return m.Keys
 	}
-	return x, nil
+	return nil
 }
 
-type GeoDB_StreamPrefixClient interface {
-	Recv() (*StreamPrefixResponse, error)
-	grpc.ClientStream
+type GetRegexKeysRequest struct {
+	Regex                string   `protobuf:"bytes,1,opt,name=regex,proto3" json:"regex,omitempty"`
+	Namespace            string   `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-type geoDBStreamPrefixClient struct {
-	grpc.ClientStream
+func (m *GetRegexKeysRequest) Reset()         { *m = GetRegexKeysRequest{} }
+func (m *GetRegexKeysRequest) String() string { return proto.CompactTextString(m) }
+func (*GetRegexKeysRequest) ProtoMessage()    {}
+func (*GetRegexKeysRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{45}
 }
 
-func (x *geoDBStreamPrefixClient) Recv() (*StreamPrefixResponse, error) {
-	m := new(StreamPrefixResponse)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
-	}
-	return m, nil
+func (m *GetRegexKeysRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetRegexKeysRequest.Unmarshal(m, b)
 }
-
-func (c *geoDBClient) ScanBound(ctx context.Context, in *ScanBoundRequest, opts ...grpc.CallOption) (*ScanBoundResponse, error) {
-	out := new(ScanBoundResponse)
-	err := c.cc.Invoke(ctx, "/api.GeoDB/ScanBound", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *GetRegexKeysRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetRegexKeysRequest.Marshal(b, m, deterministic)
+}
+func (m *GetRegexKeysRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetRegexKeysRequest.Merge(m, src)
+}
+func (m *GetRegexKeysRequest) XXX_Size() int {
+	return xxx_messageInfo_GetRegexKeysRequest.Size(m)
+}
+func (m *GetRegexKeysRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetRegexKeysRequest.DiscardUnknown(m)
 }
 
-func (c *geoDBClient) ScanRegexBound(ctx context.Context, in *ScanRegexBoundRequest, opts ...grpc.CallOption) (*ScanRegexBoundResponse, error) {
-	out := new(ScanRegexBoundResponse)
-	err := c.cc.Invoke(ctx, "/api.GeoDB/ScanRegexBound", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_GetRegexKeysRequest proto.InternalMessageInfo
+
+func (m *GetRegexKeysRequest) GetRegex() string {
+	if m != nil {
+		return m.Regex
 	}
-	return out, nil
+	return ""
 }
 
-func (c *geoDBClient) ScanPrefixBound(ctx context.Context, in *ScanPrefixBoundRequest, opts ...grpc.CallOption) (*ScanPrefixBoundResponse, error) {
-	out := new(ScanPrefixBoundResponse)
-	err := c.cc.Invoke(ctx, "/api.GeoDB/ScanPrefixBound", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *GetRegexKeysRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
 	}
-	return out, nil
+	return ""
 }
 
-func (c *geoDBClient) GetPoint(ctx context.Context, in *GetPointRequest, opts ...grpc.CallOption) (*GetPointResponse, error) {
-	out := new(GetPointResponse)
+type GetRegexKeysResponse struct {
+	Keys                 []string `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetRegexKeysResponse) Reset()         { *m = GetRegexKeysResponse{} }
+func (m *GetRegexKeysResponse) String() string { return proto.CompactTextString(m) }
+func (*GetRegexKeysResponse) ProtoMessage()    {}
+func (*GetRegexKeysResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{46}
+}
+
+func (m *GetRegexKeysResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetRegexKeysResponse.Unmarshal(m, b)
+}
+func (m *GetRegexKeysResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetRegexKeysResponse.Marshal(b, m, deterministic)
+}
+func (m *GetRegexKeysResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetRegexKeysResponse.Merge(m, src)
+}
+func (m *GetRegexKeysResponse) XXX_Size() int {
+	return xxx_messageInfo_GetRegexKeysResponse.Size(m)
+}
+func (m *GetRegexKeysResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetRegexKeysResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetRegexKeysResponse proto.InternalMessageInfo
+
+func (m *GetRegexKeysResponse) GetKeys() []string {
+	if m != nil {
+		return m.Keys
+	}
+	return nil
+}
+
+type CountRequest struct {
+	Regex                string   `protobuf:"bytes,1,opt,name=regex,proto3" json:"regex,omitempty"`
+	Prefix               string   `protobuf:"bytes,2,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	Namespace            string   `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CountRequest) Reset()         { *m = CountRequest{} }
+func (m *CountRequest) String() string { return proto.CompactTextString(m) }
+func (*CountRequest) ProtoMessage()    {}
+func (*CountRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{47}
+}
+
+func (m *CountRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CountRequest.Unmarshal(m, b)
+}
+func (m *CountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CountRequest.Marshal(b, m, deterministic)
+}
+func (m *CountRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CountRequest.Merge(m, src)
+}
+func (m *CountRequest) XXX_Size() int {
+	return xxx_messageInfo_CountRequest.Size(m)
+}
+func (m *CountRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CountRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CountRequest proto.InternalMessageInfo
+
+func (m *CountRequest) GetRegex() string {
+	if m != nil {
+		return m.Regex
+	}
+	return ""
+}
+
+func (m *CountRequest) GetPrefix() string {
+	if m != nil {
+		return m.Prefix
+	}
+	return ""
+}
+
+func (m *CountRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+type CountResponse struct {
+	Count                int64    `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CountResponse) Reset()         { *m = CountResponse{} }
+func (m *CountResponse) String() string { return proto.CompactTextString(m) }
+func (*CountResponse) ProtoMessage()    {}
+func (*CountResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{48}
+}
+
+func (m *CountResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CountResponse.Unmarshal(m, b)
+}
+func (m *CountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CountResponse.Marshal(b, m, deterministic)
+}
+func (m *CountResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CountResponse.Merge(m, src)
+}
+func (m *CountResponse) XXX_Size() int {
+	return xxx_messageInfo_CountResponse.Size(m)
+}
+func (m *CountResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CountResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CountResponse proto.InternalMessageInfo
+
+func (m *CountResponse) GetCount() int64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+type ExistsRequest struct {
+	Keys                 []string `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+	Namespace            string   `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExistsRequest) Reset()         { *m = ExistsRequest{} }
+func (m *ExistsRequest) String() string { return proto.CompactTextString(m) }
+func (*ExistsRequest) ProtoMessage()    {}
+func (*ExistsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{49}
+}
+
+func (m *ExistsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExistsRequest.Unmarshal(m, b)
+}
+func (m *ExistsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExistsRequest.Marshal(b, m, deterministic)
+}
+func (m *ExistsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExistsRequest.Merge(m, src)
+}
+func (m *ExistsRequest) XXX_Size() int {
+	return xxx_messageInfo_ExistsRequest.Size(m)
+}
+func (m *ExistsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExistsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExistsRequest proto.InternalMessageInfo
+
+func (m *ExistsRequest) GetKeys() []string {
+	if m != nil {
+		return m.Keys
+	}
+	return nil
+}
+
+func (m *ExistsRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+type ExistsResponse struct {
+	Exists               map[string]bool `protobuf:"bytes,1,rep,name=exists,proto3" json:"exists,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *ExistsResponse) Reset()         { *m = ExistsResponse{} }
+func (m *ExistsResponse) String() string { return proto.CompactTextString(m) }
+func (*ExistsResponse) ProtoMessage()    {}
+func (*ExistsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{50}
+}
+
+func (m *ExistsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExistsResponse.Unmarshal(m, b)
+}
+func (m *ExistsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExistsResponse.Marshal(b, m, deterministic)
+}
+func (m *ExistsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExistsResponse.Merge(m, src)
+}
+func (m *ExistsResponse) XXX_Size() int {
+	return xxx_messageInfo_ExistsResponse.Size(m)
+}
+func (m *ExistsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExistsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExistsResponse proto.InternalMessageInfo
+
+func (m *ExistsResponse) GetExists() map[string]bool {
+	if m != nil {
+		return m.Exists
+	}
+	return nil
+}
+
+type TTLRequest struct {
+	Keys                 []string `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+	Namespace            string   `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TTLRequest) Reset()         { *m = TTLRequest{} }
+func (m *TTLRequest) String() string { return proto.CompactTextString(m) }
+func (*TTLRequest) ProtoMessage()    {}
+func (*TTLRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{51}
+}
+
+func (m *TTLRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TTLRequest.Unmarshal(m, b)
+}
+func (m *TTLRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TTLRequest.Marshal(b, m, deterministic)
+}
+func (m *TTLRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TTLRequest.Merge(m, src)
+}
+func (m *TTLRequest) XXX_Size() int {
+	return xxx_messageInfo_TTLRequest.Size(m)
+}
+func (m *TTLRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TTLRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TTLRequest proto.InternalMessageInfo
+
+func (m *TTLRequest) GetKeys() []string {
+	if m != nil {
+		return m.Keys
+	}
+	return nil
+}
+
+func (m *TTLRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+type TTLResponse struct {
+	TtlSeconds           map[string]int64 `protobuf:"bytes,1,rep,name=ttl_seconds,json=ttlSeconds,proto3" json:"ttl_seconds,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *TTLResponse) Reset()         { *m = TTLResponse{} }
+func (m *TTLResponse) String() string { return proto.CompactTextString(m) }
+func (*TTLResponse) ProtoMessage()    {}
+func (*TTLResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{52}
+}
+
+func (m *TTLResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TTLResponse.Unmarshal(m, b)
+}
+func (m *TTLResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TTLResponse.Marshal(b, m, deterministic)
+}
+func (m *TTLResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TTLResponse.Merge(m, src)
+}
+func (m *TTLResponse) XXX_Size() int {
+	return xxx_messageInfo_TTLResponse.Size(m)
+}
+func (m *TTLResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TTLResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TTLResponse proto.InternalMessageInfo
+
+func (m *TTLResponse) GetTtlSeconds() map[string]int64 {
+	if m != nil {
+		return m.TtlSeconds
+	}
+	return nil
+}
+
+//Sort orders the objects of a response. ties are ordered by key
+type Sort struct {
+	By                   SortBy   `protobuf:"varint,1,opt,name=by,proto3,enum=api.SortBy" json:"by,omitempty"`
+	Ascending            bool     `protobuf:"varint,2,opt,name=ascending,proto3" json:"ascending,omitempty"`
+	Anchor               *Point   `protobuf:"bytes,3,opt,name=anchor,proto3" json:"anchor,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Sort) Reset()         { *m = Sort{} }
+func (m *Sort) String() string { return proto.CompactTextString(m) }
+func (*Sort) ProtoMessage()    {}
+func (*Sort) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{53}
+}
+
+func (m *Sort) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Sort.Unmarshal(m, b)
+}
+func (m *Sort) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Sort.Marshal(b, m, deterministic)
+}
+func (m *Sort) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Sort.Merge(m, src)
+}
+func (m *Sort) XXX_Size() int {
+	return xxx_messageInfo_Sort.Size(m)
+}
+func (m *Sort) XXX_DiscardUnknown() {
+	xxx_messageInfo_Sort.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Sort proto.InternalMessageInfo
+
+func (m *Sort) GetBy() SortBy {
+	if m != nil {
+		return m.By
+	}
+	return SortBy_Unsorted
+}
+
+func (m *Sort) GetAscending() bool {
+	if m != nil {
+		return m.Ascending
+	}
+	return false
+}
+
+func (m *Sort) GetAnchor() *Point {
+	if m != nil {
+		return m.Anchor
+	}
+	return nil
+}
+
+//TimeWindow matches objects by their updated_unix. an empty window matches every object
+type TimeWindow struct {
+	UpdatedAfterUnix     int64    `protobuf:"varint,1,opt,name=updated_after_unix,json=updatedAfterUnix,proto3" json:"updated_after_unix,omitempty"`
+	UpdatedBeforeUnix    int64    `protobuf:"varint,2,opt,name=updated_before_unix,json=updatedBeforeUnix,proto3" json:"updated_before_unix,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TimeWindow) Reset()         { *m = TimeWindow{} }
+func (m *TimeWindow) String() string { return proto.CompactTextString(m) }
+func (*TimeWindow) ProtoMessage()    {}
+func (*TimeWindow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{54}
+}
+
+func (m *TimeWindow) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TimeWindow.Unmarshal(m, b)
+}
+func (m *TimeWindow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TimeWindow.Marshal(b, m, deterministic)
+}
+func (m *TimeWindow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TimeWindow.Merge(m, src)
+}
+func (m *TimeWindow) XXX_Size() int {
+	return xxx_messageInfo_TimeWindow.Size(m)
+}
+func (m *TimeWindow) XXX_DiscardUnknown() {
+	xxx_messageInfo_TimeWindow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TimeWindow proto.InternalMessageInfo
+
+func (m *TimeWindow) GetUpdatedAfterUnix() int64 {
+	if m != nil {
+		return m.UpdatedAfterUnix
+	}
+	return 0
+}
+
+func (m *TimeWindow) GetUpdatedBeforeUnix() int64 {
+	if m != nil {
+		return m.UpdatedBeforeUnix
+	}
+	return 0
+}
+
+type GetRequest struct {
+	Keys                 []string          `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+	MetadataSelector     map[string]string `protobuf:"bytes,2,rep,name=metadata_selector,json=metadataSelector,proto3" json:"metadata_selector,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Namespace            string            `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Sort                 *Sort             `protobuf:"bytes,4,opt,name=sort,proto3" json:"sort,omitempty"`
+	ReadSession          string            `protobuf:"bytes,5,opt,name=read_session,json=readSession,proto3" json:"read_session,omitempty"`
+	Window               *TimeWindow       `protobuf:"bytes,6,opt,name=window,proto3" json:"window,omitempty"`
+	RefreshTtl           bool              `protobuf:"varint,7,opt,name=refresh_ttl,json=refreshTtl,proto3" json:"refresh_ttl,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *GetRequest) Reset()         { *m = GetRequest{} }
+func (m *GetRequest) String() string { return proto.CompactTextString(m) }
+func (*GetRequest) ProtoMessage()    {}
+func (*GetRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{55}
+}
+
+func (m *GetRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetRequest.Unmarshal(m, b)
+}
+func (m *GetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetRequest.Marshal(b, m, deterministic)
+}
+func (m *GetRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetRequest.Merge(m, src)
+}
+func (m *GetRequest) XXX_Size() int {
+	return xxx_messageInfo_GetRequest.Size(m)
+}
+func (m *GetRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetRequest proto.InternalMessageInfo
+
+func (m *GetRequest) GetKeys() []string {
+	if m != nil {
+		return m.Keys
+	}
+	return nil
+}
+
+func (m *GetRequest) GetMetadataSelector() map[string]string {
+	if m != nil {
+		return m.MetadataSelector
+	}
+	return nil
+}
+
+func (m *GetRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *GetRequest) GetSort() *Sort {
+	if m != nil {
+		return m.Sort
+	}
+	return nil
+}
+
+func (m *GetRequest) GetReadSession() string {
+	if m != nil {
+		return m.ReadSession
+	}
+	return ""
+}
+
+func (m *GetRequest) GetWindow() *TimeWindow {
+	if m != nil {
+		return m.Window
+	}
+	return nil
+}
+
+func (m *GetRequest) GetRefreshTtl() bool {
+	if m != nil {
+		return m.RefreshTtl
+	}
+	return false
+}
+
+type GetResponse struct {
+	Objects              map[string]*ObjectDetail `protobuf:"bytes,1,rep,name=objects,proto3" json:"objects,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	NotFound             []string                 `protobuf:"bytes,2,rep,name=not_found,json=notFound,proto3" json:"not_found,omitempty"`
+	Ordered              []*ObjectDetail          `protobuf:"bytes,3,rep,name=ordered,proto3" json:"ordered,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *GetResponse) Reset()         { *m = GetResponse{} }
+func (m *GetResponse) String() string { return proto.CompactTextString(m) }
+func (*GetResponse) ProtoMessage()    {}
+func (*GetResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{56}
+}
+
+func (m *GetResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetResponse.Unmarshal(m, b)
+}
+func (m *GetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetResponse.Marshal(b, m, deterministic)
+}
+func (m *GetResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetResponse.Merge(m, src)
+}
+func (m *GetResponse) XXX_Size() int {
+	return xxx_messageInfo_GetResponse.Size(m)
+}
+func (m *GetResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetResponse proto.InternalMessageInfo
+
+func (m *GetResponse) GetObjects() map[string]*ObjectDetail {
+	if m != nil {
+		return m.Objects
+	}
+	return nil
+}
+
+func (m *GetResponse) GetNotFound() []string {
+	if m != nil {
+		return m.NotFound
+	}
+	return nil
+}
+
+func (m *GetResponse) GetOrdered() []*ObjectDetail {
+	if m != nil {
+		return m.Ordered
+	}
+	return nil
+}
+
+type GetRegexRequest struct {
+	Regex                string            `protobuf:"bytes,1,opt,name=regex,proto3" json:"regex,omitempty"`
+	Limit                int64             `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Cursor               string            `protobuf:"bytes,3,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	MetadataSelector     map[string]string `protobuf:"bytes,4,rep,name=metadata_selector,json=metadataSelector,proto3" json:"metadata_selector,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Namespace            string            `protobuf:"bytes,5,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Sort                 *Sort             `protobuf:"bytes,6,opt,name=sort,proto3" json:"sort,omitempty"`
+	ReadSession          string            `protobuf:"bytes,7,opt,name=read_session,json=readSession,proto3" json:"read_session,omitempty"`
+	Window               *TimeWindow       `protobuf:"bytes,8,opt,name=window,proto3" json:"window,omitempty"`
+	Prefix               string            `protobuf:"bytes,9,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *GetRegexRequest) Reset()         { *m = GetRegexRequest{} }
+func (m *GetRegexRequest) String() string { return proto.CompactTextString(m) }
+func (*GetRegexRequest) ProtoMessage()    {}
+func (*GetRegexRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{57}
+}
+
+func (m *GetRegexRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetRegexRequest.Unmarshal(m, b)
+}
+func (m *GetRegexRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetRegexRequest.Marshal(b, m, deterministic)
+}
+func (m *GetRegexRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetRegexRequest.Merge(m, src)
+}
+func (m *GetRegexRequest) XXX_Size() int {
+	return xxx_messageInfo_GetRegexRequest.Size(m)
+}
+func (m *GetRegexRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetRegexRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetRegexRequest proto.InternalMessageInfo
+
+func (m *GetRegexRequest) GetRegex() string {
+	if m != nil {
+		return m.Regex
+	}
+	return ""
+}
+
+func (m *GetRegexRequest) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *GetRegexRequest) GetCursor() string {
+	if m != nil {
+		return m.Cursor
+	}
+	return ""
+}
+
+func (m *GetRegexRequest) GetMetadataSelector() map[string]string {
+	if m != nil {
+		return m.MetadataSelector
+	}
+	return nil
+}
+
+func (m *GetRegexRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *GetRegexRequest) GetSort() *Sort {
+	if m != nil {
+		return m.Sort
+	}
+	return nil
+}
+
+func (m *GetRegexRequest) GetReadSession() string {
+	if m != nil {
+		return m.ReadSession
+	}
+	return ""
+}
+
+func (m *GetRegexRequest) GetWindow() *TimeWindow {
+	if m != nil {
+		return m.Window
+	}
+	return nil
+}
+
+func (m *GetRegexRequest) GetPrefix() string {
+	if m != nil {
+		return m.Prefix
+	}
+	return ""
+}
+
+type GetRegexResponse struct {
+	Objects              map[string]*ObjectDetail `protobuf:"bytes,1,rep,name=objects,proto3" json:"objects,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	NextCursor           string                   `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	Ordered              []*ObjectDetail          `protobuf:"bytes,3,rep,name=ordered,proto3" json:"ordered,omitempty"`
+	Truncated            bool                     `protobuf:"varint,4,opt,name=truncated,proto3" json:"truncated,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *GetRegexResponse) Reset()         { *m = GetRegexResponse{} }
+func (m *GetRegexResponse) String() string { return proto.CompactTextString(m) }
+func (*GetRegexResponse) ProtoMessage()    {}
+func (*GetRegexResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{58}
+}
+
+func (m *GetRegexResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetRegexResponse.Unmarshal(m, b)
+}
+func (m *GetRegexResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetRegexResponse.Marshal(b, m, deterministic)
+}
+func (m *GetRegexResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetRegexResponse.Merge(m, src)
+}
+func (m *GetRegexResponse) XXX_Size() int {
+	return xxx_messageInfo_GetRegexResponse.Size(m)
+}
+func (m *GetRegexResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetRegexResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetRegexResponse proto.InternalMessageInfo
+
+func (m *GetRegexResponse) GetObjects() map[string]*ObjectDetail {
+	if m != nil {
+		return m.Objects
+	}
+	return nil
+}
+
+func (m *GetRegexResponse) GetNextCursor() string {
+	if m != nil {
+		return m.NextCursor
+	}
+	return ""
+}
+
+func (m *GetRegexResponse) GetOrdered() []*ObjectDetail {
+	if m != nil {
+		return m.Ordered
+	}
+	return nil
+}
+
+func (m *GetRegexResponse) GetTruncated() bool {
+	if m != nil {
+		return m.Truncated
+	}
+	return false
+}
+
+type GetPrefixRequest struct {
+	Prefix               string            `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	MetadataSelector     map[string]string `protobuf:"bytes,2,rep,name=metadata_selector,json=metadataSelector,proto3" json:"metadata_selector,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Namespace            string            `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Sort                 *Sort             `protobuf:"bytes,4,opt,name=sort,proto3" json:"sort,omitempty"`
+	ReadSession          string            `protobuf:"bytes,5,opt,name=read_session,json=readSession,proto3" json:"read_session,omitempty"`
+	Window               *TimeWindow       `protobuf:"bytes,6,opt,name=window,proto3" json:"window,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *GetPrefixRequest) Reset()         { *m = GetPrefixRequest{} }
+func (m *GetPrefixRequest) String() string { return proto.CompactTextString(m) }
+func (*GetPrefixRequest) ProtoMessage()    {}
+func (*GetPrefixRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{59}
+}
+
+func (m *GetPrefixRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetPrefixRequest.Unmarshal(m, b)
+}
+func (m *GetPrefixRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetPrefixRequest.Marshal(b, m, deterministic)
+}
+func (m *GetPrefixRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPrefixRequest.Merge(m, src)
+}
+func (m *GetPrefixRequest) XXX_Size() int {
+	return xxx_messageInfo_GetPrefixRequest.Size(m)
+}
+func (m *GetPrefixRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPrefixRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetPrefixRequest proto.InternalMessageInfo
+
+func (m *GetPrefixRequest) GetPrefix() string {
+	if m != nil {
+		return m.Prefix
+	}
+	return ""
+}
+
+func (m *GetPrefixRequest) GetMetadataSelector() map[string]string {
+	if m != nil {
+		return m.MetadataSelector
+	}
+	return nil
+}
+
+func (m *GetPrefixRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *GetPrefixRequest) GetSort() *Sort {
+	if m != nil {
+		return m.Sort
+	}
+	return nil
+}
+
+func (m *GetPrefixRequest) GetReadSession() string {
+	if m != nil {
+		return m.ReadSession
+	}
+	return ""
+}
+
+func (m *GetPrefixRequest) GetWindow() *TimeWindow {
+	if m != nil {
+		return m.Window
+	}
+	return nil
+}
+
+type GetPrefixResponse struct {
+	Objects              map[string]*ObjectDetail `protobuf:"bytes,1,rep,name=objects,proto3" json:"objects,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Ordered              []*ObjectDetail          `protobuf:"bytes,2,rep,name=ordered,proto3" json:"ordered,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *GetPrefixResponse) Reset()         { *m = GetPrefixResponse{} }
+func (m *GetPrefixResponse) String() string { return proto.CompactTextString(m) }
+func (*GetPrefixResponse) ProtoMessage()    {}
+func (*GetPrefixResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{60}
+}
+
+func (m *GetPrefixResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetPrefixResponse.Unmarshal(m, b)
+}
+func (m *GetPrefixResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetPrefixResponse.Marshal(b, m, deterministic)
+}
+func (m *GetPrefixResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPrefixResponse.Merge(m, src)
+}
+func (m *GetPrefixResponse) XXX_Size() int {
+	return xxx_messageInfo_GetPrefixResponse.Size(m)
+}
+func (m *GetPrefixResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPrefixResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetPrefixResponse proto.InternalMessageInfo
+
+func (m *GetPrefixResponse) GetObjects() map[string]*ObjectDetail {
+	if m != nil {
+		return m.Objects
+	}
+	return nil
+}
+
+func (m *GetPrefixResponse) GetOrdered() []*ObjectDetail {
+	if m != nil {
+		return m.Ordered
+	}
+	return nil
+}
+
+type GetGlobRequest struct {
+	Pattern              string            `protobuf:"bytes,1,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	MetadataSelector     map[string]string `protobuf:"bytes,2,rep,name=metadata_selector,json=metadataSelector,proto3" json:"metadata_selector,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Namespace            string            `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *GetGlobRequest) Reset()         { *m = GetGlobRequest{} }
+func (m *GetGlobRequest) String() string { return proto.CompactTextString(m) }
+func (*GetGlobRequest) ProtoMessage()    {}
+func (*GetGlobRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{61}
+}
+
+func (m *GetGlobRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetGlobRequest.Unmarshal(m, b)
+}
+func (m *GetGlobRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetGlobRequest.Marshal(b, m, deterministic)
+}
+func (m *GetGlobRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetGlobRequest.Merge(m, src)
+}
+func (m *GetGlobRequest) XXX_Size() int {
+	return xxx_messageInfo_GetGlobRequest.Size(m)
+}
+func (m *GetGlobRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetGlobRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetGlobRequest proto.InternalMessageInfo
+
+func (m *GetGlobRequest) GetPattern() string {
+	if m != nil {
+		return m.Pattern
+	}
+	return ""
+}
+
+func (m *GetGlobRequest) GetMetadataSelector() map[string]string {
+	if m != nil {
+		return m.MetadataSelector
+	}
+	return nil
+}
+
+func (m *GetGlobRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+type GetGlobResponse struct {
+	Objects              map[string]*ObjectDetail `protobuf:"bytes,1,rep,name=objects,proto3" json:"objects,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *GetGlobResponse) Reset()         { *m = GetGlobResponse{} }
+func (m *GetGlobResponse) String() string { return proto.CompactTextString(m) }
+func (*GetGlobResponse) ProtoMessage()    {}
+func (*GetGlobResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{62}
+}
+
+func (m *GetGlobResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetGlobResponse.Unmarshal(m, b)
+}
+func (m *GetGlobResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetGlobResponse.Marshal(b, m, deterministic)
+}
+func (m *GetGlobResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetGlobResponse.Merge(m, src)
+}
+func (m *GetGlobResponse) XXX_Size() int {
+	return xxx_messageInfo_GetGlobResponse.Size(m)
+}
+func (m *GetGlobResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetGlobResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetGlobResponse proto.InternalMessageInfo
+
+func (m *GetGlobResponse) GetObjects() map[string]*ObjectDetail {
+	if m != nil {
+		return m.Objects
+	}
+	return nil
+}
+
+type GetTaggedRequest struct {
+	Filter               *TagFilter `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	Namespace            string     `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *GetTaggedRequest) Reset()         { *m = GetTaggedRequest{} }
+func (m *GetTaggedRequest) String() string { return proto.CompactTextString(m) }
+func (*GetTaggedRequest) ProtoMessage()    {}
+func (*GetTaggedRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{63}
+}
+
+func (m *GetTaggedRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetTaggedRequest.Unmarshal(m, b)
+}
+func (m *GetTaggedRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetTaggedRequest.Marshal(b, m, deterministic)
+}
+func (m *GetTaggedRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTaggedRequest.Merge(m, src)
+}
+func (m *GetTaggedRequest) XXX_Size() int {
+	return xxx_messageInfo_GetTaggedRequest.Size(m)
+}
+func (m *GetTaggedRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTaggedRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTaggedRequest proto.InternalMessageInfo
+
+func (m *GetTaggedRequest) GetFilter() *TagFilter {
+	if m != nil {
+		return m.Filter
+	}
+	return nil
+}
+
+func (m *GetTaggedRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+type GetTaggedResponse struct {
+	Objects              map[string]*ObjectDetail `protobuf:"bytes,1,rep,name=objects,proto3" json:"objects,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *GetTaggedResponse) Reset()         { *m = GetTaggedResponse{} }
+func (m *GetTaggedResponse) String() string { return proto.CompactTextString(m) }
+func (*GetTaggedResponse) ProtoMessage()    {}
+func (*GetTaggedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{64}
+}
+
+func (m *GetTaggedResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetTaggedResponse.Unmarshal(m, b)
+}
+func (m *GetTaggedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetTaggedResponse.Marshal(b, m, deterministic)
+}
+func (m *GetTaggedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTaggedResponse.Merge(m, src)
+}
+func (m *GetTaggedResponse) XXX_Size() int {
+	return xxx_messageInfo_GetTaggedResponse.Size(m)
+}
+func (m *GetTaggedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTaggedResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTaggedResponse proto.InternalMessageInfo
+
+func (m *GetTaggedResponse) GetObjects() map[string]*ObjectDetail {
+	if m != nil {
+		return m.Objects
+	}
+	return nil
+}
+
+type DeleteRequest struct {
+	Keys                 []string `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+	Namespace            string   `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteRequest) Reset()         { *m = DeleteRequest{} }
+func (m *DeleteRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteRequest) ProtoMessage()    {}
+func (*DeleteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{65}
+}
+
+func (m *DeleteRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteRequest.Unmarshal(m, b)
+}
+func (m *DeleteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteRequest.Marshal(b, m, deterministic)
+}
+func (m *DeleteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteRequest.Merge(m, src)
+}
+func (m *DeleteRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteRequest.Size(m)
+}
+func (m *DeleteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteRequest proto.InternalMessageInfo
+
+func (m *DeleteRequest) GetKeys() []string {
+	if m != nil {
+		return m.Keys
+	}
+	return nil
+}
+
+func (m *DeleteRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+type DeleteResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteResponse) Reset()         { *m = DeleteResponse{} }
+func (m *DeleteResponse) String() string { return proto.CompactTextString(m) }
+func (*DeleteResponse) ProtoMessage()    {}
+func (*DeleteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{66}
+}
+
+func (m *DeleteResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteResponse.Unmarshal(m, b)
+}
+func (m *DeleteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteResponse.Marshal(b, m, deterministic)
+}
+func (m *DeleteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteResponse.Merge(m, src)
+}
+func (m *DeleteResponse) XXX_Size() int {
+	return xxx_messageInfo_DeleteResponse.Size(m)
+}
+func (m *DeleteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteResponse proto.InternalMessageInfo
+
+type DeletePrefixRequest struct {
+	Prefix               string   `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	Namespace            string   `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeletePrefixRequest) Reset()         { *m = DeletePrefixRequest{} }
+func (m *DeletePrefixRequest) String() string { return proto.CompactTextString(m) }
+func (*DeletePrefixRequest) ProtoMessage()    {}
+func (*DeletePrefixRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{67}
+}
+
+func (m *DeletePrefixRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeletePrefixRequest.Unmarshal(m, b)
+}
+func (m *DeletePrefixRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeletePrefixRequest.Marshal(b, m, deterministic)
+}
+func (m *DeletePrefixRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeletePrefixRequest.Merge(m, src)
+}
+func (m *DeletePrefixRequest) XXX_Size() int {
+	return xxx_messageInfo_DeletePrefixRequest.Size(m)
+}
+func (m *DeletePrefixRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeletePrefixRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeletePrefixRequest proto.InternalMessageInfo
+
+func (m *DeletePrefixRequest) GetPrefix() string {
+	if m != nil {
+		return m.Prefix
+	}
+	return ""
+}
+
+func (m *DeletePrefixRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+type DeletePrefixResponse struct {
+	Deleted              int64    `protobuf:"varint,1,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeletePrefixResponse) Reset()         { *m = DeletePrefixResponse{} }
+func (m *DeletePrefixResponse) String() string { return proto.CompactTextString(m) }
+func (*DeletePrefixResponse) ProtoMessage()    {}
+func (*DeletePrefixResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{68}
+}
+
+func (m *DeletePrefixResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeletePrefixResponse.Unmarshal(m, b)
+}
+func (m *DeletePrefixResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeletePrefixResponse.Marshal(b, m, deterministic)
+}
+func (m *DeletePrefixResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeletePrefixResponse.Merge(m, src)
+}
+func (m *DeletePrefixResponse) XXX_Size() int {
+	return xxx_messageInfo_DeletePrefixResponse.Size(m)
+}
+func (m *DeletePrefixResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeletePrefixResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeletePrefixResponse proto.InternalMessageInfo
+
+func (m *DeletePrefixResponse) GetDeleted() int64 {
+	if m != nil {
+		return m.Deleted
+	}
+	return 0
+}
+
+type DeleteRegexRequest struct {
+	Regex                string   `protobuf:"bytes,1,opt,name=regex,proto3" json:"regex,omitempty"`
+	Namespace            string   `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteRegexRequest) Reset()         { *m = DeleteRegexRequest{} }
+func (m *DeleteRegexRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteRegexRequest) ProtoMessage()    {}
+func (*DeleteRegexRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{69}
+}
+
+func (m *DeleteRegexRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteRegexRequest.Unmarshal(m, b)
+}
+func (m *DeleteRegexRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteRegexRequest.Marshal(b, m, deterministic)
+}
+func (m *DeleteRegexRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteRegexRequest.Merge(m, src)
+}
+func (m *DeleteRegexRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteRegexRequest.Size(m)
+}
+func (m *DeleteRegexRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteRegexRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteRegexRequest proto.InternalMessageInfo
+
+func (m *DeleteRegexRequest) GetRegex() string {
+	if m != nil {
+		return m.Regex
+	}
+	return ""
+}
+
+func (m *DeleteRegexRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+type DeleteRegexResponse struct {
+	Deleted              int64    `protobuf:"varint,1,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteRegexResponse) Reset()         { *m = DeleteRegexResponse{} }
+func (m *DeleteRegexResponse) String() string { return proto.CompactTextString(m) }
+func (*DeleteRegexResponse) ProtoMessage()    {}
+func (*DeleteRegexResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{70}
+}
+
+func (m *DeleteRegexResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteRegexResponse.Unmarshal(m, b)
+}
+func (m *DeleteRegexResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteRegexResponse.Marshal(b, m, deterministic)
+}
+func (m *DeleteRegexResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteRegexResponse.Merge(m, src)
+}
+func (m *DeleteRegexResponse) XXX_Size() int {
+	return xxx_messageInfo_DeleteRegexResponse.Size(m)
+}
+func (m *DeleteRegexResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteRegexResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteRegexResponse proto.InternalMessageInfo
+
+func (m *DeleteRegexResponse) GetDeleted() int64 {
+	if m != nil {
+		return m.Deleted
+	}
+	return 0
+}
+
+type DeleteExpiredRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteExpiredRequest) Reset()         { *m = DeleteExpiredRequest{} }
+func (m *DeleteExpiredRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteExpiredRequest) ProtoMessage()    {}
+func (*DeleteExpiredRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{71}
+}
+
+func (m *DeleteExpiredRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteExpiredRequest.Unmarshal(m, b)
+}
+func (m *DeleteExpiredRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteExpiredRequest.Marshal(b, m, deterministic)
+}
+func (m *DeleteExpiredRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteExpiredRequest.Merge(m, src)
+}
+func (m *DeleteExpiredRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteExpiredRequest.Size(m)
+}
+func (m *DeleteExpiredRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteExpiredRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteExpiredRequest proto.InternalMessageInfo
+
+type DeleteExpiredResponse struct {
+	Deleted              int64    `protobuf:"varint,1,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteExpiredResponse) Reset()         { *m = DeleteExpiredResponse{} }
+func (m *DeleteExpiredResponse) String() string { return proto.CompactTextString(m) }
+func (*DeleteExpiredResponse) ProtoMessage()    {}
+func (*DeleteExpiredResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{72}
+}
+
+func (m *DeleteExpiredResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteExpiredResponse.Unmarshal(m, b)
+}
+func (m *DeleteExpiredResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteExpiredResponse.Marshal(b, m, deterministic)
+}
+func (m *DeleteExpiredResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteExpiredResponse.Merge(m, src)
+}
+func (m *DeleteExpiredResponse) XXX_Size() int {
+	return xxx_messageInfo_DeleteExpiredResponse.Size(m)
+}
+func (m *DeleteExpiredResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteExpiredResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteExpiredResponse proto.InternalMessageInfo
+
+func (m *DeleteExpiredResponse) GetDeleted() int64 {
+	if m != nil {
+		return m.Deleted
+	}
+	return 0
+}
+
+type ScanObjectsRequest struct {
+	Prefix               string   `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	Regex                string   `protobuf:"bytes,2,opt,name=regex,proto3" json:"regex,omitempty"`
+	Namespace            string   `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ScanObjectsRequest) Reset()         { *m = ScanObjectsRequest{} }
+func (m *ScanObjectsRequest) String() string { return proto.CompactTextString(m) }
+func (*ScanObjectsRequest) ProtoMessage()    {}
+func (*ScanObjectsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{73}
+}
+
+func (m *ScanObjectsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ScanObjectsRequest.Unmarshal(m, b)
+}
+func (m *ScanObjectsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ScanObjectsRequest.Marshal(b, m, deterministic)
+}
+func (m *ScanObjectsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScanObjectsRequest.Merge(m, src)
+}
+func (m *ScanObjectsRequest) XXX_Size() int {
+	return xxx_messageInfo_ScanObjectsRequest.Size(m)
+}
+func (m *ScanObjectsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScanObjectsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScanObjectsRequest proto.InternalMessageInfo
+
+func (m *ScanObjectsRequest) GetPrefix() string {
+	if m != nil {
+		return m.Prefix
+	}
+	return ""
+}
+
+func (m *ScanObjectsRequest) GetRegex() string {
+	if m != nil {
+		return m.Regex
+	}
+	return ""
+}
+
+func (m *ScanObjectsRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+type ScanObjectsResponse struct {
+	Object               *ObjectDetail `protobuf:"bytes,1,opt,name=object,proto3" json:"object,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *ScanObjectsResponse) Reset()         { *m = ScanObjectsResponse{} }
+func (m *ScanObjectsResponse) String() string { return proto.CompactTextString(m) }
+func (*ScanObjectsResponse) ProtoMessage()    {}
+func (*ScanObjectsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{74}
+}
+
+func (m *ScanObjectsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ScanObjectsResponse.Unmarshal(m, b)
+}
+func (m *ScanObjectsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ScanObjectsResponse.Marshal(b, m, deterministic)
+}
+func (m *ScanObjectsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScanObjectsResponse.Merge(m, src)
+}
+func (m *ScanObjectsResponse) XXX_Size() int {
+	return xxx_messageInfo_ScanObjectsResponse.Size(m)
+}
+func (m *ScanObjectsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScanObjectsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScanObjectsResponse proto.InternalMessageInfo
+
+func (m *ScanObjectsResponse) GetObject() *ObjectDetail {
+	if m != nil {
+		return m.Object
+	}
+	return nil
+}
+
+type ScanBoundRequest struct {
+	Bound                *Bound     `protobuf:"bytes,1,opt,name=bound,proto3" json:"bound,omitempty"`
+	Keys                 []string   `protobuf:"bytes,2,rep,name=keys,proto3" json:"keys,omitempty"`
+	Tags                 *TagFilter `protobuf:"bytes,3,opt,name=tags,proto3" json:"tags,omitempty"`
+	Namespace            string     `protobuf:"bytes,4,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *ScanBoundRequest) Reset()         { *m = ScanBoundRequest{} }
+func (m *ScanBoundRequest) String() string { return proto.CompactTextString(m) }
+func (*ScanBoundRequest) ProtoMessage()    {}
+func (*ScanBoundRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{75}
+}
+
+func (m *ScanBoundRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ScanBoundRequest.Unmarshal(m, b)
+}
+func (m *ScanBoundRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ScanBoundRequest.Marshal(b, m, deterministic)
+}
+func (m *ScanBoundRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScanBoundRequest.Merge(m, src)
+}
+func (m *ScanBoundRequest) XXX_Size() int {
+	return xxx_messageInfo_ScanBoundRequest.Size(m)
+}
+func (m *ScanBoundRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScanBoundRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScanBoundRequest proto.InternalMessageInfo
+
+func (m *ScanBoundRequest) GetBound() *Bound {
+	if m != nil {
+		return m.Bound
+	}
+	return nil
+}
+
+func (m *ScanBoundRequest) GetKeys() []string {
+	if m != nil {
+		return m.Keys
+	}
+	return nil
+}
+
+func (m *ScanBoundRequest) GetTags() *TagFilter {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+func (m *ScanBoundRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+type ScanBoundResponse struct {
+	Objects              map[string]*ObjectDetail `protobuf:"bytes,1,rep,name=objects,proto3" json:"objects,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *ScanBoundResponse) Reset()         { *m = ScanBoundResponse{} }
+func (m *ScanBoundResponse) String() string { return proto.CompactTextString(m) }
+func (*ScanBoundResponse) ProtoMessage()    {}
+func (*ScanBoundResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{76}
+}
+
+func (m *ScanBoundResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ScanBoundResponse.Unmarshal(m, b)
+}
+func (m *ScanBoundResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ScanBoundResponse.Marshal(b, m, deterministic)
+}
+func (m *ScanBoundResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScanBoundResponse.Merge(m, src)
+}
+func (m *ScanBoundResponse) XXX_Size() int {
+	return xxx_messageInfo_ScanBoundResponse.Size(m)
+}
+func (m *ScanBoundResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScanBoundResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScanBoundResponse proto.InternalMessageInfo
+
+func (m *ScanBoundResponse) GetObjects() map[string]*ObjectDetail {
+	if m != nil {
+		return m.Objects
+	}
+	return nil
+}
+
+type ScanPrefixBoundRequest struct {
+	Bound                *Bound     `protobuf:"bytes,1,opt,name=bound,proto3" json:"bound,omitempty"`
+	Prefix               string     `protobuf:"bytes,2,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	Tags                 *TagFilter `protobuf:"bytes,3,opt,name=tags,proto3" json:"tags,omitempty"`
+	Namespace            string     `protobuf:"bytes,4,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *ScanPrefixBoundRequest) Reset()         { *m = ScanPrefixBoundRequest{} }
+func (m *ScanPrefixBoundRequest) String() string { return proto.CompactTextString(m) }
+func (*ScanPrefixBoundRequest) ProtoMessage()    {}
+func (*ScanPrefixBoundRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{77}
+}
+
+func (m *ScanPrefixBoundRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ScanPrefixBoundRequest.Unmarshal(m, b)
+}
+func (m *ScanPrefixBoundRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ScanPrefixBoundRequest.Marshal(b, m, deterministic)
+}
+func (m *ScanPrefixBoundRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScanPrefixBoundRequest.Merge(m, src)
+}
+func (m *ScanPrefixBoundRequest) XXX_Size() int {
+	return xxx_messageInfo_ScanPrefixBoundRequest.Size(m)
+}
+func (m *ScanPrefixBoundRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScanPrefixBoundRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScanPrefixBoundRequest proto.InternalMessageInfo
+
+func (m *ScanPrefixBoundRequest) GetBound() *Bound {
+	if m != nil {
+		return m.Bound
+	}
+	return nil
+}
+
+func (m *ScanPrefixBoundRequest) GetPrefix() string {
+	if m != nil {
+		return m.Prefix
+	}
+	return ""
+}
+
+func (m *ScanPrefixBoundRequest) GetTags() *TagFilter {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+func (m *ScanPrefixBoundRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+type ScanPrefixBoundResponse struct {
+	Objects              map[string]*ObjectDetail `protobuf:"bytes,1,rep,name=objects,proto3" json:"objects,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *ScanPrefixBoundResponse) Reset()         { *m = ScanPrefixBoundResponse{} }
+func (m *ScanPrefixBoundResponse) String() string { return proto.CompactTextString(m) }
+func (*ScanPrefixBoundResponse) ProtoMessage()    {}
+func (*ScanPrefixBoundResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{78}
+}
+
+func (m *ScanPrefixBoundResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ScanPrefixBoundResponse.Unmarshal(m, b)
+}
+func (m *ScanPrefixBoundResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ScanPrefixBoundResponse.Marshal(b, m, deterministic)
+}
+func (m *ScanPrefixBoundResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScanPrefixBoundResponse.Merge(m, src)
+}
+func (m *ScanPrefixBoundResponse) XXX_Size() int {
+	return xxx_messageInfo_ScanPrefixBoundResponse.Size(m)
+}
+func (m *ScanPrefixBoundResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScanPrefixBoundResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScanPrefixBoundResponse proto.InternalMessageInfo
+
+func (m *ScanPrefixBoundResponse) GetObjects() map[string]*ObjectDetail {
+	if m != nil {
+		return m.Objects
+	}
+	return nil
+}
+
+type ScanRegexBoundRequest struct {
+	Bound                *Bound     `protobuf:"bytes,1,opt,name=bound,proto3" json:"bound,omitempty"`
+	Regex                string     `protobuf:"bytes,2,opt,name=regex,proto3" json:"regex,omitempty"`
+	Tags                 *TagFilter `protobuf:"bytes,3,opt,name=tags,proto3" json:"tags,omitempty"`
+	Namespace            string     `protobuf:"bytes,4,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *ScanRegexBoundRequest) Reset()         { *m = ScanRegexBoundRequest{} }
+func (m *ScanRegexBoundRequest) String() string { return proto.CompactTextString(m) }
+func (*ScanRegexBoundRequest) ProtoMessage()    {}
+func (*ScanRegexBoundRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{79}
+}
+
+func (m *ScanRegexBoundRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ScanRegexBoundRequest.Unmarshal(m, b)
+}
+func (m *ScanRegexBoundRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ScanRegexBoundRequest.Marshal(b, m, deterministic)
+}
+func (m *ScanRegexBoundRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScanRegexBoundRequest.Merge(m, src)
+}
+func (m *ScanRegexBoundRequest) XXX_Size() int {
+	return xxx_messageInfo_ScanRegexBoundRequest.Size(m)
+}
+func (m *ScanRegexBoundRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScanRegexBoundRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScanRegexBoundRequest proto.InternalMessageInfo
+
+func (m *ScanRegexBoundRequest) GetBound() *Bound {
+	if m != nil {
+		return m.Bound
+	}
+	return nil
+}
+
+func (m *ScanRegexBoundRequest) GetRegex() string {
+	if m != nil {
+		return m.Regex
+	}
+	return ""
+}
+
+func (m *ScanRegexBoundRequest) GetTags() *TagFilter {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+func (m *ScanRegexBoundRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+type ScanRegexBoundResponse struct {
+	Objects              map[string]*ObjectDetail `protobuf:"bytes,1,rep,name=objects,proto3" json:"objects,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *ScanRegexBoundResponse) Reset()         { *m = ScanRegexBoundResponse{} }
+func (m *ScanRegexBoundResponse) String() string { return proto.CompactTextString(m) }
+func (*ScanRegexBoundResponse) ProtoMessage()    {}
+func (*ScanRegexBoundResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{80}
+}
+
+func (m *ScanRegexBoundResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ScanRegexBoundResponse.Unmarshal(m, b)
+}
+func (m *ScanRegexBoundResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ScanRegexBoundResponse.Marshal(b, m, deterministic)
+}
+func (m *ScanRegexBoundResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScanRegexBoundResponse.Merge(m, src)
+}
+func (m *ScanRegexBoundResponse) XXX_Size() int {
+	return xxx_messageInfo_ScanRegexBoundResponse.Size(m)
+}
+func (m *ScanRegexBoundResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScanRegexBoundResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScanRegexBoundResponse proto.InternalMessageInfo
+
+func (m *ScanRegexBoundResponse) GetObjects() map[string]*ObjectDetail {
+	if m != nil {
+		return m.Objects
+	}
+	return nil
+}
+
+type ScanIsochroneRequest struct {
+	Polygon              []*Point   `protobuf:"bytes,1,rep,name=polygon,proto3" json:"polygon,omitempty"`
+	Center               *Point     `protobuf:"bytes,2,opt,name=center,proto3" json:"center,omitempty"`
+	TravelSeconds        int64      `protobuf:"varint,3,opt,name=travel_seconds,json=travelSeconds,proto3" json:"travel_seconds,omitempty"`
+	TravelMode           TravelMode `protobuf:"varint,4,opt,name=travel_mode,json=travelMode,proto3,enum=api.TravelMode" json:"travel_mode,omitempty"`
+	Tags                 *TagFilter `protobuf:"bytes,5,opt,name=tags,proto3" json:"tags,omitempty"`
+	Namespace            string     `protobuf:"bytes,6,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *ScanIsochroneRequest) Reset()         { *m = ScanIsochroneRequest{} }
+func (m *ScanIsochroneRequest) String() string { return proto.CompactTextString(m) }
+func (*ScanIsochroneRequest) ProtoMessage()    {}
+func (*ScanIsochroneRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{81}
+}
+
+func (m *ScanIsochroneRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ScanIsochroneRequest.Unmarshal(m, b)
+}
+func (m *ScanIsochroneRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ScanIsochroneRequest.Marshal(b, m, deterministic)
+}
+func (m *ScanIsochroneRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScanIsochroneRequest.Merge(m, src)
+}
+func (m *ScanIsochroneRequest) XXX_Size() int {
+	return xxx_messageInfo_ScanIsochroneRequest.Size(m)
+}
+func (m *ScanIsochroneRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScanIsochroneRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScanIsochroneRequest proto.InternalMessageInfo
+
+func (m *ScanIsochroneRequest) GetPolygon() []*Point {
+	if m != nil {
+		return m.Polygon
+	}
+	return nil
+}
+
+func (m *ScanIsochroneRequest) GetCenter() *Point {
+	if m != nil {
+		return m.Center
+	}
+	return nil
+}
+
+func (m *ScanIsochroneRequest) GetTravelSeconds() int64 {
+	if m != nil {
+		return m.TravelSeconds
+	}
+	return 0
+}
+
+func (m *ScanIsochroneRequest) GetTravelMode() TravelMode {
+	if m != nil {
+		return m.TravelMode
+	}
+	return TravelMode_Driving
+}
+
+func (m *ScanIsochroneRequest) GetTags() *TagFilter {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+func (m *ScanIsochroneRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+type ScanIsochroneResponse struct {
+	Objects              map[string]*ObjectDetail `protobuf:"bytes,1,rep,name=objects,proto3" json:"objects,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Polygon              []*Point                 `protobuf:"bytes,2,rep,name=polygon,proto3" json:"polygon,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *ScanIsochroneResponse) Reset()         { *m = ScanIsochroneResponse{} }
+func (m *ScanIsochroneResponse) String() string { return proto.CompactTextString(m) }
+func (*ScanIsochroneResponse) ProtoMessage()    {}
+func (*ScanIsochroneResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{82}
+}
+
+func (m *ScanIsochroneResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ScanIsochroneResponse.Unmarshal(m, b)
+}
+func (m *ScanIsochroneResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ScanIsochroneResponse.Marshal(b, m, deterministic)
+}
+func (m *ScanIsochroneResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScanIsochroneResponse.Merge(m, src)
+}
+func (m *ScanIsochroneResponse) XXX_Size() int {
+	return xxx_messageInfo_ScanIsochroneResponse.Size(m)
+}
+func (m *ScanIsochroneResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScanIsochroneResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScanIsochroneResponse proto.InternalMessageInfo
+
+func (m *ScanIsochroneResponse) GetObjects() map[string]*ObjectDetail {
+	if m != nil {
+		return m.Objects
+	}
+	return nil
+}
+
+func (m *ScanIsochroneResponse) GetPolygon() []*Point {
+	if m != nil {
+		return m.Polygon
+	}
+	return nil
+}
+
+type WithinCorridorRequest struct {
+	Route                []*Point     `protobuf:"bytes,1,rep,name=route,proto3" json:"route,omitempty"`
+	Buffer               float64      `protobuf:"fixed64,2,opt,name=buffer,proto3" json:"buffer,omitempty"`
+	Tags                 *TagFilter   `protobuf:"bytes,3,opt,name=tags,proto3" json:"tags,omitempty"`
+	Unit                 DistanceUnit `protobuf:"varint,4,opt,name=unit,proto3,enum=api.DistanceUnit" json:"unit,omitempty"`
+	Namespace            string       `protobuf:"bytes,5,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *WithinCorridorRequest) Reset()         { *m = WithinCorridorRequest{} }
+func (m *WithinCorridorRequest) String() string { return proto.CompactTextString(m) }
+func (*WithinCorridorRequest) ProtoMessage()    {}
+func (*WithinCorridorRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{83}
+}
+
+func (m *WithinCorridorRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WithinCorridorRequest.Unmarshal(m, b)
+}
+func (m *WithinCorridorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WithinCorridorRequest.Marshal(b, m, deterministic)
+}
+func (m *WithinCorridorRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WithinCorridorRequest.Merge(m, src)
+}
+func (m *WithinCorridorRequest) XXX_Size() int {
+	return xxx_messageInfo_WithinCorridorRequest.Size(m)
+}
+func (m *WithinCorridorRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WithinCorridorRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WithinCorridorRequest proto.InternalMessageInfo
+
+func (m *WithinCorridorRequest) GetRoute() []*Point {
+	if m != nil {
+		return m.Route
+	}
+	return nil
+}
+
+func (m *WithinCorridorRequest) GetBuffer() float64 {
+	if m != nil {
+		return m.Buffer
+	}
+	return 0
+}
+
+func (m *WithinCorridorRequest) GetTags() *TagFilter {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+func (m *WithinCorridorRequest) GetUnit() DistanceUnit {
+	if m != nil {
+		return m.Unit
+	}
+	return DistanceUnit_Meters
+}
+
+func (m *WithinCorridorRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+type WithinCorridorResponse struct {
+	Objects              map[string]*ObjectDetail `protobuf:"bytes,1,rep,name=objects,proto3" json:"objects,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *WithinCorridorResponse) Reset()         { *m = WithinCorridorResponse{} }
+func (m *WithinCorridorResponse) String() string { return proto.CompactTextString(m) }
+func (*WithinCorridorResponse) ProtoMessage()    {}
+func (*WithinCorridorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{84}
+}
+
+func (m *WithinCorridorResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WithinCorridorResponse.Unmarshal(m, b)
+}
+func (m *WithinCorridorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WithinCorridorResponse.Marshal(b, m, deterministic)
+}
+func (m *WithinCorridorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WithinCorridorResponse.Merge(m, src)
+}
+func (m *WithinCorridorResponse) XXX_Size() int {
+	return xxx_messageInfo_WithinCorridorResponse.Size(m)
+}
+func (m *WithinCorridorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_WithinCorridorResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WithinCorridorResponse proto.InternalMessageInfo
+
+func (m *WithinCorridorResponse) GetObjects() map[string]*ObjectDetail {
+	if m != nil {
+		return m.Objects
+	}
+	return nil
+}
+
+type PolylineRequest struct {
+	Route                []*Point     `protobuf:"bytes,1,rep,name=route,proto3" json:"route,omitempty"`
+	Point                *Point       `protobuf:"bytes,2,opt,name=point,proto3" json:"point,omitempty"`
+	Unit                 DistanceUnit `protobuf:"varint,3,opt,name=unit,proto3,enum=api.DistanceUnit" json:"unit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *PolylineRequest) Reset()         { *m = PolylineRequest{} }
+func (m *PolylineRequest) String() string { return proto.CompactTextString(m) }
+func (*PolylineRequest) ProtoMessage()    {}
+func (*PolylineRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{85}
+}
+
+func (m *PolylineRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PolylineRequest.Unmarshal(m, b)
+}
+func (m *PolylineRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PolylineRequest.Marshal(b, m, deterministic)
+}
+func (m *PolylineRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PolylineRequest.Merge(m, src)
+}
+func (m *PolylineRequest) XXX_Size() int {
+	return xxx_messageInfo_PolylineRequest.Size(m)
+}
+func (m *PolylineRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PolylineRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PolylineRequest proto.InternalMessageInfo
+
+func (m *PolylineRequest) GetRoute() []*Point {
+	if m != nil {
+		return m.Route
+	}
+	return nil
+}
+
+func (m *PolylineRequest) GetPoint() *Point {
+	if m != nil {
+		return m.Point
+	}
+	return nil
+}
+
+func (m *PolylineRequest) GetUnit() DistanceUnit {
+	if m != nil {
+		return m.Unit
+	}
+	return DistanceUnit_Meters
+}
+
+type PolylineResponse struct {
+	Distance             float64  `protobuf:"fixed64,1,opt,name=distance,proto3" json:"distance,omitempty"`
+	Nearest              *Point   `protobuf:"bytes,2,opt,name=nearest,proto3" json:"nearest,omitempty"`
+	Segment              int64    `protobuf:"varint,3,opt,name=segment,proto3" json:"segment,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PolylineResponse) Reset()         { *m = PolylineResponse{} }
+func (m *PolylineResponse) String() string { return proto.CompactTextString(m) }
+func (*PolylineResponse) ProtoMessage()    {}
+func (*PolylineResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{86}
+}
+
+func (m *PolylineResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PolylineResponse.Unmarshal(m, b)
+}
+func (m *PolylineResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PolylineResponse.Marshal(b, m, deterministic)
+}
+func (m *PolylineResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PolylineResponse.Merge(m, src)
+}
+func (m *PolylineResponse) XXX_Size() int {
+	return xxx_messageInfo_PolylineResponse.Size(m)
+}
+func (m *PolylineResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PolylineResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PolylineResponse proto.InternalMessageInfo
+
+func (m *PolylineResponse) GetDistance() float64 {
+	if m != nil {
+		return m.Distance
+	}
+	return 0
+}
+
+func (m *PolylineResponse) GetNearest() *Point {
+	if m != nil {
+		return m.Nearest
+	}
+	return nil
+}
+
+func (m *PolylineResponse) GetSegment() int64 {
+	if m != nil {
+		return m.Segment
+	}
+	return 0
+}
+
+type BoundsRequest struct {
+	MinLat               float64    `protobuf:"fixed64,1,opt,name=min_lat,json=minLat,proto3" json:"min_lat,omitempty"`
+	MinLon               float64    `protobuf:"fixed64,2,opt,name=min_lon,json=minLon,proto3" json:"min_lon,omitempty"`
+	MaxLat               float64    `protobuf:"fixed64,3,opt,name=max_lat,json=maxLat,proto3" json:"max_lat,omitempty"`
+	MaxLon               float64    `protobuf:"fixed64,4,opt,name=max_lon,json=maxLon,proto3" json:"max_lon,omitempty"`
+	Tags                 *TagFilter `protobuf:"bytes,5,opt,name=tags,proto3" json:"tags,omitempty"`
+	Namespace            string     `protobuf:"bytes,6,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *BoundsRequest) Reset()         { *m = BoundsRequest{} }
+func (m *BoundsRequest) String() string { return proto.CompactTextString(m) }
+func (*BoundsRequest) ProtoMessage()    {}
+func (*BoundsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{87}
+}
+
+func (m *BoundsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BoundsRequest.Unmarshal(m, b)
+}
+func (m *BoundsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BoundsRequest.Marshal(b, m, deterministic)
+}
+func (m *BoundsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BoundsRequest.Merge(m, src)
+}
+func (m *BoundsRequest) XXX_Size() int {
+	return xxx_messageInfo_BoundsRequest.Size(m)
+}
+func (m *BoundsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BoundsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BoundsRequest proto.InternalMessageInfo
+
+func (m *BoundsRequest) GetMinLat() float64 {
+	if m != nil {
+		return m.MinLat
+	}
+	return 0
+}
+
+func (m *BoundsRequest) GetMinLon() float64 {
+	if m != nil {
+		return m.MinLon
+	}
+	return 0
+}
+
+func (m *BoundsRequest) GetMaxLat() float64 {
+	if m != nil {
+		return m.MaxLat
+	}
+	return 0
+}
+
+func (m *BoundsRequest) GetMaxLon() float64 {
+	if m != nil {
+		return m.MaxLon
+	}
+	return 0
+}
+
+func (m *BoundsRequest) GetTags() *TagFilter {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+func (m *BoundsRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+type BoundsResponse struct {
+	Objects              map[string]*ObjectDetail `protobuf:"bytes,1,rep,name=objects,proto3" json:"objects,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *BoundsResponse) Reset()         { *m = BoundsResponse{} }
+func (m *BoundsResponse) String() string { return proto.CompactTextString(m) }
+func (*BoundsResponse) ProtoMessage()    {}
+func (*BoundsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{88}
+}
+
+func (m *BoundsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BoundsResponse.Unmarshal(m, b)
+}
+func (m *BoundsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BoundsResponse.Marshal(b, m, deterministic)
+}
+func (m *BoundsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BoundsResponse.Merge(m, src)
+}
+func (m *BoundsResponse) XXX_Size() int {
+	return xxx_messageInfo_BoundsResponse.Size(m)
+}
+func (m *BoundsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BoundsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BoundsResponse proto.InternalMessageInfo
+
+func (m *BoundsResponse) GetObjects() map[string]*ObjectDetail {
+	if m != nil {
+		return m.Objects
+	}
+	return nil
+}
+
+type NearestRequest struct {
+	Center               *Point            `protobuf:"bytes,1,opt,name=center,proto3" json:"center,omitempty"`
+	K                    int64             `protobuf:"varint,2,opt,name=k,proto3" json:"k,omitempty"`
+	Tags                 *TagFilter        `protobuf:"bytes,3,opt,name=tags,proto3" json:"tags,omitempty"`
+	Unit                 DistanceUnit      `protobuf:"varint,4,opt,name=unit,proto3,enum=api.DistanceUnit" json:"unit,omitempty"`
+	MetadataSelector     map[string]string `protobuf:"bytes,5,rep,name=metadata_selector,json=metadataSelector,proto3" json:"metadata_selector,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Namespace            string            `protobuf:"bytes,6,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *NearestRequest) Reset()         { *m = NearestRequest{} }
+func (m *NearestRequest) String() string { return proto.CompactTextString(m) }
+func (*NearestRequest) ProtoMessage()    {}
+func (*NearestRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{89}
+}
+
+func (m *NearestRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NearestRequest.Unmarshal(m, b)
+}
+func (m *NearestRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NearestRequest.Marshal(b, m, deterministic)
+}
+func (m *NearestRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NearestRequest.Merge(m, src)
+}
+func (m *NearestRequest) XXX_Size() int {
+	return xxx_messageInfo_NearestRequest.Size(m)
+}
+func (m *NearestRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_NearestRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NearestRequest proto.InternalMessageInfo
+
+func (m *NearestRequest) GetCenter() *Point {
+	if m != nil {
+		return m.Center
+	}
+	return nil
+}
+
+func (m *NearestRequest) GetK() int64 {
+	if m != nil {
+		return m.K
+	}
+	return 0
+}
+
+func (m *NearestRequest) GetTags() *TagFilter {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+func (m *NearestRequest) GetUnit() DistanceUnit {
+	if m != nil {
+		return m.Unit
+	}
+	return DistanceUnit_Meters
+}
+
+func (m *NearestRequest) GetMetadataSelector() map[string]string {
+	if m != nil {
+		return m.MetadataSelector
+	}
+	return nil
+}
+
+func (m *NearestRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+//NearestObject is an object detail and its distance from the center of a Nearest or GetWithinRadius query
+type NearestObject struct {
+	Object               *ObjectDetail `protobuf:"bytes,1,opt,name=object,proto3" json:"object,omitempty"`
+	Distance             float64       `protobuf:"fixed64,2,opt,name=distance,proto3" json:"distance,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *NearestObject) Reset()         { *m = NearestObject{} }
+func (m *NearestObject) String() string { return proto.CompactTextString(m) }
+func (*NearestObject) ProtoMessage()    {}
+func (*NearestObject) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{90}
+}
+
+func (m *NearestObject) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NearestObject.Unmarshal(m, b)
+}
+func (m *NearestObject) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NearestObject.Marshal(b, m, deterministic)
+}
+func (m *NearestObject) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NearestObject.Merge(m, src)
+}
+func (m *NearestObject) XXX_Size() int {
+	return xxx_messageInfo_NearestObject.Size(m)
+}
+func (m *NearestObject) XXX_DiscardUnknown() {
+	xxx_messageInfo_NearestObject.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NearestObject proto.InternalMessageInfo
+
+func (m *NearestObject) GetObject() *ObjectDetail {
+	if m != nil {
+		return m.Object
+	}
+	return nil
+}
+
+func (m *NearestObject) GetDistance() float64 {
+	if m != nil {
+		return m.Distance
+	}
+	return 0
+}
+
+type NearestResponse struct {
+	Objects              []*NearestObject `protobuf:"bytes,1,rep,name=objects,proto3" json:"objects,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *NearestResponse) Reset()         { *m = NearestResponse{} }
+func (m *NearestResponse) String() string { return proto.CompactTextString(m) }
+func (*NearestResponse) ProtoMessage()    {}
+func (*NearestResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{91}
+}
+
+func (m *NearestResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NearestResponse.Unmarshal(m, b)
+}
+func (m *NearestResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NearestResponse.Marshal(b, m, deterministic)
+}
+func (m *NearestResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NearestResponse.Merge(m, src)
+}
+func (m *NearestResponse) XXX_Size() int {
+	return xxx_messageInfo_NearestResponse.Size(m)
+}
+func (m *NearestResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_NearestResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NearestResponse proto.InternalMessageInfo
+
+func (m *NearestResponse) GetObjects() []*NearestObject {
+	if m != nil {
+		return m.Objects
+	}
+	return nil
+}
+
+type GetPointRequest struct {
+	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetPointRequest) Reset()         { *m = GetPointRequest{} }
+func (m *GetPointRequest) String() string { return proto.CompactTextString(m) }
+func (*GetPointRequest) ProtoMessage()    {}
+func (*GetPointRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{92}
+}
+
+func (m *GetPointRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetPointRequest.Unmarshal(m, b)
+}
+func (m *GetPointRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetPointRequest.Marshal(b, m, deterministic)
+}
+func (m *GetPointRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPointRequest.Merge(m, src)
+}
+func (m *GetPointRequest) XXX_Size() int {
+	return xxx_messageInfo_GetPointRequest.Size(m)
+}
+func (m *GetPointRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPointRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetPointRequest proto.InternalMessageInfo
+
+func (m *GetPointRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+type GetPointResponse struct {
+	Point                *Point   `protobuf:"bytes,1,opt,name=point,proto3" json:"point,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetPointResponse) Reset()         { *m = GetPointResponse{} }
+func (m *GetPointResponse) String() string { return proto.CompactTextString(m) }
+func (*GetPointResponse) ProtoMessage()    {}
+func (*GetPointResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{93}
+}
+
+func (m *GetPointResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetPointResponse.Unmarshal(m, b)
+}
+func (m *GetPointResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetPointResponse.Marshal(b, m, deterministic)
+}
+func (m *GetPointResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPointResponse.Merge(m, src)
+}
+func (m *GetPointResponse) XXX_Size() int {
+	return xxx_messageInfo_GetPointResponse.Size(m)
+}
+func (m *GetPointResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPointResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetPointResponse proto.InternalMessageInfo
+
+func (m *GetPointResponse) GetPoint() *Point {
+	if m != nil {
+		return m.Point
+	}
+	return nil
+}
+
+type RadiusRequest struct {
+	Center               *Point            `protobuf:"bytes,1,opt,name=center,proto3" json:"center,omitempty"`
+	Meters               float64           `protobuf:"fixed64,2,opt,name=meters,proto3" json:"meters,omitempty"`
+	Tags                 *TagFilter        `protobuf:"bytes,3,opt,name=tags,proto3" json:"tags,omitempty"`
+	MetadataSelector     map[string]string `protobuf:"bytes,4,rep,name=metadata_selector,json=metadataSelector,proto3" json:"metadata_selector,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Namespace            string            `protobuf:"bytes,5,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *RadiusRequest) Reset()         { *m = RadiusRequest{} }
+func (m *RadiusRequest) String() string { return proto.CompactTextString(m) }
+func (*RadiusRequest) ProtoMessage()    {}
+func (*RadiusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{94}
+}
+
+func (m *RadiusRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RadiusRequest.Unmarshal(m, b)
+}
+func (m *RadiusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RadiusRequest.Marshal(b, m, deterministic)
+}
+func (m *RadiusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RadiusRequest.Merge(m, src)
+}
+func (m *RadiusRequest) XXX_Size() int {
+	return xxx_messageInfo_RadiusRequest.Size(m)
+}
+func (m *RadiusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RadiusRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RadiusRequest proto.InternalMessageInfo
+
+func (m *RadiusRequest) GetCenter() *Point {
+	if m != nil {
+		return m.Center
+	}
+	return nil
+}
+
+func (m *RadiusRequest) GetMeters() float64 {
+	if m != nil {
+		return m.Meters
+	}
+	return 0
+}
+
+func (m *RadiusRequest) GetTags() *TagFilter {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+func (m *RadiusRequest) GetMetadataSelector() map[string]string {
+	if m != nil {
+		return m.MetadataSelector
+	}
+	return nil
+}
+
+func (m *RadiusRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+type RadiusResponse struct {
+	Objects              []*NearestObject `protobuf:"bytes,1,rep,name=objects,proto3" json:"objects,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *RadiusResponse) Reset()         { *m = RadiusResponse{} }
+func (m *RadiusResponse) String() string { return proto.CompactTextString(m) }
+func (*RadiusResponse) ProtoMessage()    {}
+func (*RadiusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{95}
+}
+
+func (m *RadiusResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RadiusResponse.Unmarshal(m, b)
+}
+func (m *RadiusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RadiusResponse.Marshal(b, m, deterministic)
+}
+func (m *RadiusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RadiusResponse.Merge(m, src)
+}
+func (m *RadiusResponse) XXX_Size() int {
+	return xxx_messageInfo_RadiusResponse.Size(m)
+}
+func (m *RadiusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RadiusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RadiusResponse proto.InternalMessageInfo
+
+func (m *RadiusResponse) GetObjects() []*NearestObject {
+	if m != nil {
+		return m.Objects
+	}
+	return nil
+}
+
+type GeohashRequest struct {
+	Prefix               string   `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	Namespace            string   `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GeohashRequest) Reset()         { *m = GeohashRequest{} }
+func (m *GeohashRequest) String() string { return proto.CompactTextString(m) }
+func (*GeohashRequest) ProtoMessage()    {}
+func (*GeohashRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{96}
+}
+
+func (m *GeohashRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GeohashRequest.Unmarshal(m, b)
+}
+func (m *GeohashRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GeohashRequest.Marshal(b, m, deterministic)
+}
+func (m *GeohashRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GeohashRequest.Merge(m, src)
+}
+func (m *GeohashRequest) XXX_Size() int {
+	return xxx_messageInfo_GeohashRequest.Size(m)
+}
+func (m *GeohashRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GeohashRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GeohashRequest proto.InternalMessageInfo
+
+func (m *GeohashRequest) GetPrefix() string {
+	if m != nil {
+		return m.Prefix
+	}
+	return ""
+}
+
+func (m *GeohashRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+type GeohashResponse struct {
+	Objects              map[string]*ObjectDetail `protobuf:"bytes,1,rep,name=objects,proto3" json:"objects,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *GeohashResponse) Reset()         { *m = GeohashResponse{} }
+func (m *GeohashResponse) String() string { return proto.CompactTextString(m) }
+func (*GeohashResponse) ProtoMessage()    {}
+func (*GeohashResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{97}
+}
+
+func (m *GeohashResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GeohashResponse.Unmarshal(m, b)
+}
+func (m *GeohashResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GeohashResponse.Marshal(b, m, deterministic)
+}
+func (m *GeohashResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GeohashResponse.Merge(m, src)
+}
+func (m *GeohashResponse) XXX_Size() int {
+	return xxx_messageInfo_GeohashResponse.Size(m)
+}
+func (m *GeohashResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GeohashResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GeohashResponse proto.InternalMessageInfo
+
+func (m *GeohashResponse) GetObjects() map[string]*ObjectDetail {
+	if m != nil {
+		return m.Objects
+	}
+	return nil
+}
+
+type HistoryRequest struct {
+	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Limit                int64    `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Namespace            string   `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *HistoryRequest) Reset()         { *m = HistoryRequest{} }
+func (m *HistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*HistoryRequest) ProtoMessage()    {}
+func (*HistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{98}
+}
+
+func (m *HistoryRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HistoryRequest.Unmarshal(m, b)
+}
+func (m *HistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HistoryRequest.Marshal(b, m, deterministic)
+}
+func (m *HistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HistoryRequest.Merge(m, src)
+}
+func (m *HistoryRequest) XXX_Size() int {
+	return xxx_messageInfo_HistoryRequest.Size(m)
+}
+func (m *HistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_HistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HistoryRequest proto.InternalMessageInfo
+
+func (m *HistoryRequest) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *HistoryRequest) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *HistoryRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+//HistoryPoint is a recorded position of an object
+type HistoryPoint struct {
+	Point                *Point   `protobuf:"bytes,1,opt,name=point,proto3" json:"point,omitempty"`
+	UpdatedUnix          int64    `protobuf:"varint,2,opt,name=updated_unix,json=updatedUnix,proto3" json:"updated_unix,omitempty"`
+	TimestampNanos       int64    `protobuf:"varint,3,opt,name=timestamp_nanos,json=timestampNanos,proto3" json:"timestamp_nanos,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *HistoryPoint) Reset()         { *m = HistoryPoint{} }
+func (m *HistoryPoint) String() string { return proto.CompactTextString(m) }
+func (*HistoryPoint) ProtoMessage()    {}
+func (*HistoryPoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{99}
+}
+
+func (m *HistoryPoint) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HistoryPoint.Unmarshal(m, b)
+}
+func (m *HistoryPoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HistoryPoint.Marshal(b, m, deterministic)
+}
+func (m *HistoryPoint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HistoryPoint.Merge(m, src)
+}
+func (m *HistoryPoint) XXX_Size() int {
+	return xxx_messageInfo_HistoryPoint.Size(m)
+}
+func (m *HistoryPoint) XXX_DiscardUnknown() {
+	xxx_messageInfo_HistoryPoint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HistoryPoint proto.InternalMessageInfo
+
+func (m *HistoryPoint) GetPoint() *Point {
+	if m != nil {
+		return m.Point
+	}
+	return nil
+}
+
+func (m *HistoryPoint) GetUpdatedUnix() int64 {
+	if m != nil {
+		return m.UpdatedUnix
+	}
+	return 0
+}
+
+func (m *HistoryPoint) GetTimestampNanos() int64 {
+	if m != nil {
+		return m.TimestampNanos
+	}
+	return 0
+}
+
+type HistoryResponse struct {
+	Points               []*HistoryPoint `protobuf:"bytes,1,rep,name=points,proto3" json:"points,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *HistoryResponse) Reset()         { *m = HistoryResponse{} }
+func (m *HistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*HistoryResponse) ProtoMessage()    {}
+func (*HistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{100}
+}
+
+func (m *HistoryResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HistoryResponse.Unmarshal(m, b)
+}
+func (m *HistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HistoryResponse.Marshal(b, m, deterministic)
+}
+func (m *HistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HistoryResponse.Merge(m, src)
+}
+func (m *HistoryResponse) XXX_Size() int {
+	return xxx_messageInfo_HistoryResponse.Size(m)
+}
+func (m *HistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_HistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HistoryResponse proto.InternalMessageInfo
+
+func (m *HistoryResponse) GetPoints() []*HistoryPoint {
+	if m != nil {
+		return m.Points
+	}
+	return nil
+}
+
+type PolygonRequest struct {
+	Vertices             []*Point   `protobuf:"bytes,1,rep,name=vertices,proto3" json:"vertices,omitempty"`
+	Tags                 *TagFilter `protobuf:"bytes,2,opt,name=tags,proto3" json:"tags,omitempty"`
+	Namespace            string     `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *PolygonRequest) Reset()         { *m = PolygonRequest{} }
+func (m *PolygonRequest) String() string { return proto.CompactTextString(m) }
+func (*PolygonRequest) ProtoMessage()    {}
+func (*PolygonRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{101}
+}
+
+func (m *PolygonRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PolygonRequest.Unmarshal(m, b)
+}
+func (m *PolygonRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PolygonRequest.Marshal(b, m, deterministic)
+}
+func (m *PolygonRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PolygonRequest.Merge(m, src)
+}
+func (m *PolygonRequest) XXX_Size() int {
+	return xxx_messageInfo_PolygonRequest.Size(m)
+}
+func (m *PolygonRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PolygonRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PolygonRequest proto.InternalMessageInfo
+
+func (m *PolygonRequest) GetVertices() []*Point {
+	if m != nil {
+		return m.Vertices
+	}
+	return nil
+}
+
+func (m *PolygonRequest) GetTags() *TagFilter {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+func (m *PolygonRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+type PolygonResponse struct {
+	Objects              map[string]*ObjectDetail `protobuf:"bytes,1,rep,name=objects,proto3" json:"objects,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *PolygonResponse) Reset()         { *m = PolygonResponse{} }
+func (m *PolygonResponse) String() string { return proto.CompactTextString(m) }
+func (*PolygonResponse) ProtoMessage()    {}
+func (*PolygonResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{102}
+}
+
+func (m *PolygonResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PolygonResponse.Unmarshal(m, b)
+}
+func (m *PolygonResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PolygonResponse.Marshal(b, m, deterministic)
+}
+func (m *PolygonResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PolygonResponse.Merge(m, src)
+}
+func (m *PolygonResponse) XXX_Size() int {
+	return xxx_messageInfo_PolygonResponse.Size(m)
+}
+func (m *PolygonResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PolygonResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PolygonResponse proto.InternalMessageInfo
+
+func (m *PolygonResponse) GetObjects() map[string]*ObjectDetail {
+	if m != nil {
+		return m.Objects
+	}
+	return nil
+}
+
+type ProximityMatrixRequest struct {
+	Keys                 []string     `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+	Unit                 DistanceUnit `protobuf:"varint,2,opt,name=unit,proto3,enum=api.DistanceUnit" json:"unit,omitempty"`
+	Namespace            string       `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *ProximityMatrixRequest) Reset()         { *m = ProximityMatrixRequest{} }
+func (m *ProximityMatrixRequest) String() string { return proto.CompactTextString(m) }
+func (*ProximityMatrixRequest) ProtoMessage()    {}
+func (*ProximityMatrixRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{103}
+}
+
+func (m *ProximityMatrixRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ProximityMatrixRequest.Unmarshal(m, b)
+}
+func (m *ProximityMatrixRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ProximityMatrixRequest.Marshal(b, m, deterministic)
+}
+func (m *ProximityMatrixRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProximityMatrixRequest.Merge(m, src)
+}
+func (m *ProximityMatrixRequest) XXX_Size() int {
+	return xxx_messageInfo_ProximityMatrixRequest.Size(m)
+}
+func (m *ProximityMatrixRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProximityMatrixRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProximityMatrixRequest proto.InternalMessageInfo
+
+func (m *ProximityMatrixRequest) GetKeys() []string {
+	if m != nil {
+		return m.Keys
+	}
+	return nil
+}
+
+func (m *ProximityMatrixRequest) GetUnit() DistanceUnit {
+	if m != nil {
+		return m.Unit
+	}
+	return DistanceUnit_Meters
+}
+
+func (m *ProximityMatrixRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+//ProximityRow is a single row of a proximity matrix
+type ProximityRow struct {
+	Distances            []float64 `protobuf:"fixed64,1,rep,packed,name=distances,proto3" json:"distances,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *ProximityRow) Reset()         { *m = ProximityRow{} }
+func (m *ProximityRow) String() string { return proto.CompactTextString(m) }
+func (*ProximityRow) ProtoMessage()    {}
+func (*ProximityRow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{104}
+}
+
+func (m *ProximityRow) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ProximityRow.Unmarshal(m, b)
+}
+func (m *ProximityRow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ProximityRow.Marshal(b, m, deterministic)
+}
+func (m *ProximityRow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProximityRow.Merge(m, src)
+}
+func (m *ProximityRow) XXX_Size() int {
+	return xxx_messageInfo_ProximityRow.Size(m)
+}
+func (m *ProximityRow) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProximityRow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProximityRow proto.InternalMessageInfo
+
+func (m *ProximityRow) GetDistances() []float64 {
+	if m != nil {
+		return m.Distances
+	}
+	return nil
+}
+
+type ProximityMatrixResponse struct {
+	Keys                 []string        `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+	Rows                 []*ProximityRow `protobuf:"bytes,2,rep,name=rows,proto3" json:"rows,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *ProximityMatrixResponse) Reset()         { *m = ProximityMatrixResponse{} }
+func (m *ProximityMatrixResponse) String() string { return proto.CompactTextString(m) }
+func (*ProximityMatrixResponse) ProtoMessage()    {}
+func (*ProximityMatrixResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{105}
+}
+
+func (m *ProximityMatrixResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ProximityMatrixResponse.Unmarshal(m, b)
+}
+func (m *ProximityMatrixResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ProximityMatrixResponse.Marshal(b, m, deterministic)
+}
+func (m *ProximityMatrixResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProximityMatrixResponse.Merge(m, src)
+}
+func (m *ProximityMatrixResponse) XXX_Size() int {
+	return xxx_messageInfo_ProximityMatrixResponse.Size(m)
+}
+func (m *ProximityMatrixResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProximityMatrixResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProximityMatrixResponse proto.InternalMessageInfo
+
+func (m *ProximityMatrixResponse) GetKeys() []string {
+	if m != nil {
+		return m.Keys
+	}
+	return nil
+}
+
+func (m *ProximityMatrixResponse) GetRows() []*ProximityRow {
+	if m != nil {
+		return m.Rows
+	}
+	return nil
+}
+
+type BoundingCircleRequest struct {
+	Keys                 []string     `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+	Prefix               string       `protobuf:"bytes,2,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	Unit                 DistanceUnit `protobuf:"varint,3,opt,name=unit,proto3,enum=api.DistanceUnit" json:"unit,omitempty"`
+	Namespace            string       `protobuf:"bytes,4,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *BoundingCircleRequest) Reset()         { *m = BoundingCircleRequest{} }
+func (m *BoundingCircleRequest) String() string { return proto.CompactTextString(m) }
+func (*BoundingCircleRequest) ProtoMessage()    {}
+func (*BoundingCircleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{106}
+}
+
+func (m *BoundingCircleRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BoundingCircleRequest.Unmarshal(m, b)
+}
+func (m *BoundingCircleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BoundingCircleRequest.Marshal(b, m, deterministic)
+}
+func (m *BoundingCircleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BoundingCircleRequest.Merge(m, src)
+}
+func (m *BoundingCircleRequest) XXX_Size() int {
+	return xxx_messageInfo_BoundingCircleRequest.Size(m)
+}
+func (m *BoundingCircleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BoundingCircleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BoundingCircleRequest proto.InternalMessageInfo
+
+func (m *BoundingCircleRequest) GetKeys() []string {
+	if m != nil {
+		return m.Keys
+	}
+	return nil
+}
+
+func (m *BoundingCircleRequest) GetPrefix() string {
+	if m != nil {
+		return m.Prefix
+	}
+	return ""
+}
+
+func (m *BoundingCircleRequest) GetUnit() DistanceUnit {
+	if m != nil {
+		return m.Unit
+	}
+	return DistanceUnit_Meters
+}
+
+func (m *BoundingCircleRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+type BoundingCircleResponse struct {
+	Center               *Point   `protobuf:"bytes,1,opt,name=center,proto3" json:"center,omitempty"`
+	Radius               float64  `protobuf:"fixed64,2,opt,name=radius,proto3" json:"radius,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BoundingCircleResponse) Reset()         { *m = BoundingCircleResponse{} }
+func (m *BoundingCircleResponse) String() string { return proto.CompactTextString(m) }
+func (*BoundingCircleResponse) ProtoMessage()    {}
+func (*BoundingCircleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{107}
+}
+
+func (m *BoundingCircleResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BoundingCircleResponse.Unmarshal(m, b)
+}
+func (m *BoundingCircleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BoundingCircleResponse.Marshal(b, m, deterministic)
+}
+func (m *BoundingCircleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BoundingCircleResponse.Merge(m, src)
+}
+func (m *BoundingCircleResponse) XXX_Size() int {
+	return xxx_messageInfo_BoundingCircleResponse.Size(m)
+}
+func (m *BoundingCircleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BoundingCircleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BoundingCircleResponse proto.InternalMessageInfo
+
+func (m *BoundingCircleResponse) GetCenter() *Point {
+	if m != nil {
+		return m.Center
+	}
+	return nil
+}
+
+func (m *BoundingCircleResponse) GetRadius() float64 {
+	if m != nil {
+		return m.Radius
+	}
+	return 0
+}
+
+type AggregateRequest struct {
+	Keys                 []string          `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+	Prefix               string            `protobuf:"bytes,2,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	Regex                string            `protobuf:"bytes,3,opt,name=regex,proto3" json:"regex,omitempty"`
+	Tags                 *TagFilter        `protobuf:"bytes,4,opt,name=tags,proto3" json:"tags,omitempty"`
+	MetadataSelector     map[string]string `protobuf:"bytes,5,rep,name=metadata_selector,json=metadataSelector,proto3" json:"metadata_selector,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Namespace            string            `protobuf:"bytes,6,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *AggregateRequest) Reset()         { *m = AggregateRequest{} }
+func (m *AggregateRequest) String() string { return proto.CompactTextString(m) }
+func (*AggregateRequest) ProtoMessage()    {}
+func (*AggregateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{108}
+}
+
+func (m *AggregateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AggregateRequest.Unmarshal(m, b)
+}
+func (m *AggregateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AggregateRequest.Marshal(b, m, deterministic)
+}
+func (m *AggregateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AggregateRequest.Merge(m, src)
+}
+func (m *AggregateRequest) XXX_Size() int {
+	return xxx_messageInfo_AggregateRequest.Size(m)
+}
+func (m *AggregateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AggregateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AggregateRequest proto.InternalMessageInfo
+
+func (m *AggregateRequest) GetKeys() []string {
+	if m != nil {
+		return m.Keys
+	}
+	return nil
+}
+
+func (m *AggregateRequest) GetPrefix() string {
+	if m != nil {
+		return m.Prefix
+	}
+	return ""
+}
+
+func (m *AggregateRequest) GetRegex() string {
+	if m != nil {
+		return m.Regex
+	}
+	return ""
+}
+
+func (m *AggregateRequest) GetTags() *TagFilter {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+func (m *AggregateRequest) GetMetadataSelector() map[string]string {
+	if m != nil {
+		return m.MetadataSelector
+	}
+	return nil
+}
+
+func (m *AggregateRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+type AggregateResponse struct {
+	Count                int64    `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	Centroid             *Point   `protobuf:"bytes,2,opt,name=centroid,proto3" json:"centroid,omitempty"`
+	Bounds               *Box     `protobuf:"bytes,3,opt,name=bounds,proto3" json:"bounds,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AggregateResponse) Reset()         { *m = AggregateResponse{} }
+func (m *AggregateResponse) String() string { return proto.CompactTextString(m) }
+func (*AggregateResponse) ProtoMessage()    {}
+func (*AggregateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{109}
+}
+
+func (m *AggregateResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AggregateResponse.Unmarshal(m, b)
+}
+func (m *AggregateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AggregateResponse.Marshal(b, m, deterministic)
+}
+func (m *AggregateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AggregateResponse.Merge(m, src)
+}
+func (m *AggregateResponse) XXX_Size() int {
+	return xxx_messageInfo_AggregateResponse.Size(m)
+}
+func (m *AggregateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AggregateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AggregateResponse proto.InternalMessageInfo
+
+func (m *AggregateResponse) GetCount() int64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *AggregateResponse) GetCentroid() *Point {
+	if m != nil {
+		return m.Centroid
+	}
+	return nil
+}
+
+func (m *AggregateResponse) GetBounds() *Box {
+	if m != nil {
+		return m.Bounds
+	}
+	return nil
+}
+
+type ClusterRequest struct {
+	Precision            int32             `protobuf:"varint,1,opt,name=precision,proto3" json:"precision,omitempty"`
+	Bounds               *Box              `protobuf:"bytes,2,opt,name=bounds,proto3" json:"bounds,omitempty"`
+	Tags                 *TagFilter        `protobuf:"bytes,3,opt,name=tags,proto3" json:"tags,omitempty"`
+	MetadataSelector     map[string]string `protobuf:"bytes,4,rep,name=metadata_selector,json=metadataSelector,proto3" json:"metadata_selector,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Namespace            string            `protobuf:"bytes,5,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ClusterRequest) Reset()         { *m = ClusterRequest{} }
+func (m *ClusterRequest) String() string { return proto.CompactTextString(m) }
+func (*ClusterRequest) ProtoMessage()    {}
+func (*ClusterRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{110}
+}
+
+func (m *ClusterRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ClusterRequest.Unmarshal(m, b)
+}
+func (m *ClusterRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ClusterRequest.Marshal(b, m, deterministic)
+}
+func (m *ClusterRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClusterRequest.Merge(m, src)
+}
+func (m *ClusterRequest) XXX_Size() int {
+	return xxx_messageInfo_ClusterRequest.Size(m)
+}
+func (m *ClusterRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClusterRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClusterRequest proto.InternalMessageInfo
+
+func (m *ClusterRequest) GetPrecision() int32 {
+	if m != nil {
+		return m.Precision
+	}
+	return 0
+}
+
+func (m *ClusterRequest) GetBounds() *Box {
+	if m != nil {
+		return m.Bounds
+	}
+	return nil
+}
+
+func (m *ClusterRequest) GetTags() *TagFilter {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+func (m *ClusterRequest) GetMetadataSelector() map[string]string {
+	if m != nil {
+		return m.MetadataSelector
+	}
+	return nil
+}
+
+func (m *ClusterRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+//Cluster is the objects in one grid cell
+type Cluster struct {
+	Geohash              string   `protobuf:"bytes,1,opt,name=geohash,proto3" json:"geohash,omitempty"`
+	Cell                 *Box     `protobuf:"bytes,2,opt,name=cell,proto3" json:"cell,omitempty"`
+	Count                int64    `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	Centroid             *Point   `protobuf:"bytes,4,opt,name=centroid,proto3" json:"centroid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Cluster) Reset()         { *m = Cluster{} }
+func (m *Cluster) String() string { return proto.CompactTextString(m) }
+func (*Cluster) ProtoMessage()    {}
+func (*Cluster) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{111}
+}
+
+func (m *Cluster) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Cluster.Unmarshal(m, b)
+}
+func (m *Cluster) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Cluster.Marshal(b, m, deterministic)
+}
+func (m *Cluster) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Cluster.Merge(m, src)
+}
+func (m *Cluster) XXX_Size() int {
+	return xxx_messageInfo_Cluster.Size(m)
+}
+func (m *Cluster) XXX_DiscardUnknown() {
+	xxx_messageInfo_Cluster.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Cluster proto.InternalMessageInfo
+
+func (m *Cluster) GetGeohash() string {
+	if m != nil {
+		return m.Geohash
+	}
+	return ""
+}
+
+func (m *Cluster) GetCell() *Box {
+	if m != nil {
+		return m.Cell
+	}
+	return nil
+}
+
+func (m *Cluster) GetCount() int64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *Cluster) GetCentroid() *Point {
+	if m != nil {
+		return m.Centroid
+	}
+	return nil
+}
+
+type ClusterResponse struct {
+	Clusters             []*Cluster `protobuf:"bytes,1,rep,name=clusters,proto3" json:"clusters,omitempty"`
+	Total                int64      `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *ClusterResponse) Reset()         { *m = ClusterResponse{} }
+func (m *ClusterResponse) String() string { return proto.CompactTextString(m) }
+func (*ClusterResponse) ProtoMessage()    {}
+func (*ClusterResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{112}
+}
+
+func (m *ClusterResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ClusterResponse.Unmarshal(m, b)
+}
+func (m *ClusterResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ClusterResponse.Marshal(b, m, deterministic)
+}
+func (m *ClusterResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClusterResponse.Merge(m, src)
+}
+func (m *ClusterResponse) XXX_Size() int {
+	return xxx_messageInfo_ClusterResponse.Size(m)
+}
+func (m *ClusterResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClusterResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClusterResponse proto.InternalMessageInfo
+
+func (m *ClusterResponse) GetClusters() []*Cluster {
+	if m != nil {
+		return m.Clusters
+	}
+	return nil
+}
+
+func (m *ClusterResponse) GetTotal() int64 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+//DeadLetter is an object detail that couldn't be delivered to stream clients
+type DeadLetter struct {
+	Object               *ObjectDetail `protobuf:"bytes,1,opt,name=object,proto3" json:"object,omitempty"`
+	Reason               string        `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	TimestampNanos       int64         `protobuf:"varint,3,opt,name=timestamp_nanos,json=timestampNanos,proto3" json:"timestamp_nanos,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *DeadLetter) Reset()         { *m = DeadLetter{} }
+func (m *DeadLetter) String() string { return proto.CompactTextString(m) }
+func (*DeadLetter) ProtoMessage()    {}
+func (*DeadLetter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{113}
+}
+
+func (m *DeadLetter) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeadLetter.Unmarshal(m, b)
+}
+func (m *DeadLetter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeadLetter.Marshal(b, m, deterministic)
+}
+func (m *DeadLetter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeadLetter.Merge(m, src)
+}
+func (m *DeadLetter) XXX_Size() int {
+	return xxx_messageInfo_DeadLetter.Size(m)
+}
+func (m *DeadLetter) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeadLetter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeadLetter proto.InternalMessageInfo
+
+func (m *DeadLetter) GetObject() *ObjectDetail {
+	if m != nil {
+		return m.Object
+	}
+	return nil
+}
+
+func (m *DeadLetter) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *DeadLetter) GetTimestampNanos() int64 {
+	if m != nil {
+		return m.TimestampNanos
+	}
+	return 0
+}
+
+//ObjectEvent is a persisted tracker event
+type ObjectEvent struct {
+	Key                  string        `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Event                *TrackerEvent `protobuf:"bytes,2,opt,name=event,proto3" json:"event,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *ObjectEvent) Reset()         { *m = ObjectEvent{} }
+func (m *ObjectEvent) String() string { return proto.CompactTextString(m) }
+func (*ObjectEvent) ProtoMessage()    {}
+func (*ObjectEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{114}
+}
+
+func (m *ObjectEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ObjectEvent.Unmarshal(m, b)
+}
+func (m *ObjectEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ObjectEvent.Marshal(b, m, deterministic)
+}
+func (m *ObjectEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObjectEvent.Merge(m, src)
+}
+func (m *ObjectEvent) XXX_Size() int {
+	return xxx_messageInfo_ObjectEvent.Size(m)
+}
+func (m *ObjectEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObjectEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ObjectEvent proto.InternalMessageInfo
+
+func (m *ObjectEvent) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *ObjectEvent) GetEvent() *TrackerEvent {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+type GetEventsRequest struct {
+	StartNanos           int64    `protobuf:"varint,1,opt,name=start_nanos,json=startNanos,proto3" json:"start_nanos,omitempty"`
+	EndNanos             int64    `protobuf:"varint,2,opt,name=end_nanos,json=endNanos,proto3" json:"end_nanos,omitempty"`
+	Key                  string   `protobuf:"bytes,3,opt,name=key,proto3" json:"key,omitempty"`
+	Limit                int64    `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	Namespace            string   `protobuf:"bytes,5,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetEventsRequest) Reset()         { *m = GetEventsRequest{} }
+func (m *GetEventsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetEventsRequest) ProtoMessage()    {}
+func (*GetEventsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{115}
+}
+
+func (m *GetEventsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetEventsRequest.Unmarshal(m, b)
+}
+func (m *GetEventsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetEventsRequest.Marshal(b, m, deterministic)
+}
+func (m *GetEventsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetEventsRequest.Merge(m, src)
+}
+func (m *GetEventsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetEventsRequest.Size(m)
+}
+func (m *GetEventsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetEventsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetEventsRequest proto.InternalMessageInfo
+
+func (m *GetEventsRequest) GetStartNanos() int64 {
+	if m != nil {
+		return m.StartNanos
+	}
+	return 0
+}
+
+func (m *GetEventsRequest) GetEndNanos() int64 {
+	if m != nil {
+		return m.EndNanos
+	}
+	return 0
+}
+
+func (m *GetEventsRequest) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *GetEventsRequest) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *GetEventsRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+type GetEventsResponse struct {
+	Events               []*ObjectEvent `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *GetEventsResponse) Reset()         { *m = GetEventsResponse{} }
+func (m *GetEventsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetEventsResponse) ProtoMessage()    {}
+func (*GetEventsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{116}
+}
+
+func (m *GetEventsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetEventsResponse.Unmarshal(m, b)
+}
+func (m *GetEventsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetEventsResponse.Marshal(b, m, deterministic)
+}
+func (m *GetEventsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetEventsResponse.Merge(m, src)
+}
+func (m *GetEventsResponse) XXX_Size() int {
+	return xxx_messageInfo_GetEventsResponse.Size(m)
+}
+func (m *GetEventsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetEventsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetEventsResponse proto.InternalMessageInfo
+
+func (m *GetEventsResponse) GetEvents() []*ObjectEvent {
+	if m != nil {
+		return m.Events
+	}
+	return nil
+}
+
+type CreateGeofenceRequest struct {
+	Geofence             *Geofence `protobuf:"bytes,1,opt,name=geofence,proto3" json:"geofence,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *CreateGeofenceRequest) Reset()         { *m = CreateGeofenceRequest{} }
+func (m *CreateGeofenceRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateGeofenceRequest) ProtoMessage()    {}
+func (*CreateGeofenceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{117}
+}
+
+func (m *CreateGeofenceRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateGeofenceRequest.Unmarshal(m, b)
+}
+func (m *CreateGeofenceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateGeofenceRequest.Marshal(b, m, deterministic)
+}
+func (m *CreateGeofenceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateGeofenceRequest.Merge(m, src)
+}
+func (m *CreateGeofenceRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateGeofenceRequest.Size(m)
+}
+func (m *CreateGeofenceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateGeofenceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateGeofenceRequest proto.InternalMessageInfo
+
+func (m *CreateGeofenceRequest) GetGeofence() *Geofence {
+	if m != nil {
+		return m.Geofence
+	}
+	return nil
+}
+
+type CreateGeofenceResponse struct {
+	Geofence             *Geofence `protobuf:"bytes,1,opt,name=geofence,proto3" json:"geofence,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *CreateGeofenceResponse) Reset()         { *m = CreateGeofenceResponse{} }
+func (m *CreateGeofenceResponse) String() string { return proto.CompactTextString(m) }
+func (*CreateGeofenceResponse) ProtoMessage()    {}
+func (*CreateGeofenceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{118}
+}
+
+func (m *CreateGeofenceResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateGeofenceResponse.Unmarshal(m, b)
+}
+func (m *CreateGeofenceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateGeofenceResponse.Marshal(b, m, deterministic)
+}
+func (m *CreateGeofenceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateGeofenceResponse.Merge(m, src)
+}
+func (m *CreateGeofenceResponse) XXX_Size() int {
+	return xxx_messageInfo_CreateGeofenceResponse.Size(m)
+}
+func (m *CreateGeofenceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateGeofenceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateGeofenceResponse proto.InternalMessageInfo
+
+func (m *CreateGeofenceResponse) GetGeofence() *Geofence {
+	if m != nil {
+		return m.Geofence
+	}
+	return nil
+}
+
+type DeleteGeofenceRequest struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteGeofenceRequest) Reset()         { *m = DeleteGeofenceRequest{} }
+func (m *DeleteGeofenceRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteGeofenceRequest) ProtoMessage()    {}
+func (*DeleteGeofenceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{119}
+}
+
+func (m *DeleteGeofenceRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteGeofenceRequest.Unmarshal(m, b)
+}
+func (m *DeleteGeofenceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteGeofenceRequest.Marshal(b, m, deterministic)
+}
+func (m *DeleteGeofenceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteGeofenceRequest.Merge(m, src)
+}
+func (m *DeleteGeofenceRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteGeofenceRequest.Size(m)
+}
+func (m *DeleteGeofenceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteGeofenceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteGeofenceRequest proto.InternalMessageInfo
+
+func (m *DeleteGeofenceRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+type DeleteGeofenceResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteGeofenceResponse) Reset()         { *m = DeleteGeofenceResponse{} }
+func (m *DeleteGeofenceResponse) String() string { return proto.CompactTextString(m) }
+func (*DeleteGeofenceResponse) ProtoMessage()    {}
+func (*DeleteGeofenceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{120}
+}
+
+func (m *DeleteGeofenceResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteGeofenceResponse.Unmarshal(m, b)
+}
+func (m *DeleteGeofenceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteGeofenceResponse.Marshal(b, m, deterministic)
+}
+func (m *DeleteGeofenceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteGeofenceResponse.Merge(m, src)
+}
+func (m *DeleteGeofenceResponse) XXX_Size() int {
+	return xxx_messageInfo_DeleteGeofenceResponse.Size(m)
+}
+func (m *DeleteGeofenceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteGeofenceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteGeofenceResponse proto.InternalMessageInfo
+
+type ListGeofencesRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListGeofencesRequest) Reset()         { *m = ListGeofencesRequest{} }
+func (m *ListGeofencesRequest) String() string { return proto.CompactTextString(m) }
+func (*ListGeofencesRequest) ProtoMessage()    {}
+func (*ListGeofencesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{121}
+}
+
+func (m *ListGeofencesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListGeofencesRequest.Unmarshal(m, b)
+}
+func (m *ListGeofencesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListGeofencesRequest.Marshal(b, m, deterministic)
+}
+func (m *ListGeofencesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListGeofencesRequest.Merge(m, src)
+}
+func (m *ListGeofencesRequest) XXX_Size() int {
+	return xxx_messageInfo_ListGeofencesRequest.Size(m)
+}
+func (m *ListGeofencesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListGeofencesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListGeofencesRequest proto.InternalMessageInfo
+
+type ListGeofencesResponse struct {
+	Geofences            []*Geofence `protobuf:"bytes,1,rep,name=geofences,proto3" json:"geofences,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *ListGeofencesResponse) Reset()         { *m = ListGeofencesResponse{} }
+func (m *ListGeofencesResponse) String() string { return proto.CompactTextString(m) }
+func (*ListGeofencesResponse) ProtoMessage()    {}
+func (*ListGeofencesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{122}
+}
+
+func (m *ListGeofencesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListGeofencesResponse.Unmarshal(m, b)
+}
+func (m *ListGeofencesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListGeofencesResponse.Marshal(b, m, deterministic)
+}
+func (m *ListGeofencesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListGeofencesResponse.Merge(m, src)
+}
+func (m *ListGeofencesResponse) XXX_Size() int {
+	return xxx_messageInfo_ListGeofencesResponse.Size(m)
+}
+func (m *ListGeofencesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListGeofencesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListGeofencesResponse proto.InternalMessageInfo
+
+func (m *ListGeofencesResponse) GetGeofences() []*Geofence {
+	if m != nil {
+		return m.Geofences
+	}
+	return nil
+}
+
+type OpenReadSessionRequest struct {
+	TtlSeconds           int64    `protobuf:"varint,1,opt,name=ttl_seconds,json=ttlSeconds,proto3" json:"ttl_seconds,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *OpenReadSessionRequest) Reset()         { *m = OpenReadSessionRequest{} }
+func (m *OpenReadSessionRequest) String() string { return proto.CompactTextString(m) }
+func (*OpenReadSessionRequest) ProtoMessage()    {}
+func (*OpenReadSessionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{123}
+}
+
+func (m *OpenReadSessionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OpenReadSessionRequest.Unmarshal(m, b)
+}
+func (m *OpenReadSessionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OpenReadSessionRequest.Marshal(b, m, deterministic)
+}
+func (m *OpenReadSessionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OpenReadSessionRequest.Merge(m, src)
+}
+func (m *OpenReadSessionRequest) XXX_Size() int {
+	return xxx_messageInfo_OpenReadSessionRequest.Size(m)
+}
+func (m *OpenReadSessionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_OpenReadSessionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OpenReadSessionRequest proto.InternalMessageInfo
+
+func (m *OpenReadSessionRequest) GetTtlSeconds() int64 {
+	if m != nil {
+		return m.TtlSeconds
+	}
+	return 0
+}
+
+type OpenReadSessionResponse struct {
+	Token                string   `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *OpenReadSessionResponse) Reset()         { *m = OpenReadSessionResponse{} }
+func (m *OpenReadSessionResponse) String() string { return proto.CompactTextString(m) }
+func (*OpenReadSessionResponse) ProtoMessage()    {}
+func (*OpenReadSessionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{124}
+}
+
+func (m *OpenReadSessionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OpenReadSessionResponse.Unmarshal(m, b)
+}
+func (m *OpenReadSessionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OpenReadSessionResponse.Marshal(b, m, deterministic)
+}
+func (m *OpenReadSessionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OpenReadSessionResponse.Merge(m, src)
+}
+func (m *OpenReadSessionResponse) XXX_Size() int {
+	return xxx_messageInfo_OpenReadSessionResponse.Size(m)
+}
+func (m *OpenReadSessionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_OpenReadSessionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OpenReadSessionResponse proto.InternalMessageInfo
+
+func (m *OpenReadSessionResponse) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
+type CloseReadSessionRequest struct {
+	Token                string   `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CloseReadSessionRequest) Reset()         { *m = CloseReadSessionRequest{} }
+func (m *CloseReadSessionRequest) String() string { return proto.CompactTextString(m) }
+func (*CloseReadSessionRequest) ProtoMessage()    {}
+func (*CloseReadSessionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{125}
+}
+
+func (m *CloseReadSessionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CloseReadSessionRequest.Unmarshal(m, b)
+}
+func (m *CloseReadSessionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CloseReadSessionRequest.Marshal(b, m, deterministic)
+}
+func (m *CloseReadSessionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CloseReadSessionRequest.Merge(m, src)
+}
+func (m *CloseReadSessionRequest) XXX_Size() int {
+	return xxx_messageInfo_CloseReadSessionRequest.Size(m)
+}
+func (m *CloseReadSessionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CloseReadSessionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CloseReadSessionRequest proto.InternalMessageInfo
+
+func (m *CloseReadSessionRequest) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
+type CloseReadSessionResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CloseReadSessionResponse) Reset()         { *m = CloseReadSessionResponse{} }
+func (m *CloseReadSessionResponse) String() string { return proto.CompactTextString(m) }
+func (*CloseReadSessionResponse) ProtoMessage()    {}
+func (*CloseReadSessionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{126}
+}
+
+func (m *CloseReadSessionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CloseReadSessionResponse.Unmarshal(m, b)
+}
+func (m *CloseReadSessionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CloseReadSessionResponse.Marshal(b, m, deterministic)
+}
+func (m *CloseReadSessionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CloseReadSessionResponse.Merge(m, src)
+}
+func (m *CloseReadSessionResponse) XXX_Size() int {
+	return xxx_messageInfo_CloseReadSessionResponse.Size(m)
+}
+func (m *CloseReadSessionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CloseReadSessionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CloseReadSessionResponse proto.InternalMessageInfo
+
+type GetDeadLettersRequest struct {
+	Limit                int64    `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetDeadLettersRequest) Reset()         { *m = GetDeadLettersRequest{} }
+func (m *GetDeadLettersRequest) String() string { return proto.CompactTextString(m) }
+func (*GetDeadLettersRequest) ProtoMessage()    {}
+func (*GetDeadLettersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{127}
+}
+
+func (m *GetDeadLettersRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetDeadLettersRequest.Unmarshal(m, b)
+}
+func (m *GetDeadLettersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetDeadLettersRequest.Marshal(b, m, deterministic)
+}
+func (m *GetDeadLettersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetDeadLettersRequest.Merge(m, src)
+}
+func (m *GetDeadLettersRequest) XXX_Size() int {
+	return xxx_messageInfo_GetDeadLettersRequest.Size(m)
+}
+func (m *GetDeadLettersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetDeadLettersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetDeadLettersRequest proto.InternalMessageInfo
+
+func (m *GetDeadLettersRequest) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type GetDeadLettersResponse struct {
+	DeadLetters          []*DeadLetter `protobuf:"bytes,1,rep,name=dead_letters,json=deadLetters,proto3" json:"dead_letters,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *GetDeadLettersResponse) Reset()         { *m = GetDeadLettersResponse{} }
+func (m *GetDeadLettersResponse) String() string { return proto.CompactTextString(m) }
+func (*GetDeadLettersResponse) ProtoMessage()    {}
+func (*GetDeadLettersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{128}
+}
+
+func (m *GetDeadLettersResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetDeadLettersResponse.Unmarshal(m, b)
+}
+func (m *GetDeadLettersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetDeadLettersResponse.Marshal(b, m, deterministic)
+}
+func (m *GetDeadLettersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetDeadLettersResponse.Merge(m, src)
+}
+func (m *GetDeadLettersResponse) XXX_Size() int {
+	return xxx_messageInfo_GetDeadLettersResponse.Size(m)
+}
+func (m *GetDeadLettersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetDeadLettersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetDeadLettersResponse proto.InternalMessageInfo
+
+func (m *GetDeadLettersResponse) GetDeadLetters() []*DeadLetter {
+	if m != nil {
+		return m.DeadLetters
+	}
+	return nil
+}
+
+type PingRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PingRequest) Reset()         { *m = PingRequest{} }
+func (m *PingRequest) String() string { return proto.CompactTextString(m) }
+func (*PingRequest) ProtoMessage()    {}
+func (*PingRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{129}
+}
+
+func (m *PingRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PingRequest.Unmarshal(m, b)
+}
+func (m *PingRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PingRequest.Marshal(b, m, deterministic)
+}
+func (m *PingRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PingRequest.Merge(m, src)
+}
+func (m *PingRequest) XXX_Size() int {
+	return xxx_messageInfo_PingRequest.Size(m)
+}
+func (m *PingRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PingRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PingRequest proto.InternalMessageInfo
+
+type PingResponse struct {
+	Ok                   bool     `protobuf:"varint,1,opt,name=ok,proto3" json:"ok,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PingResponse) Reset()         { *m = PingResponse{} }
+func (m *PingResponse) String() string { return proto.CompactTextString(m) }
+func (*PingResponse) ProtoMessage()    {}
+func (*PingResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{130}
+}
+
+func (m *PingResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PingResponse.Unmarshal(m, b)
+}
+func (m *PingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PingResponse.Marshal(b, m, deterministic)
+}
+func (m *PingResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PingResponse.Merge(m, src)
+}
+func (m *PingResponse) XXX_Size() int {
+	return xxx_messageInfo_PingResponse.Size(m)
+}
+func (m *PingResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PingResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PingResponse proto.InternalMessageInfo
+
+func (m *PingResponse) GetOk() bool {
+	if m != nil {
+		return m.Ok
+	}
+	return false
+}
+
+type BackupRequest struct {
+	Since                uint64   `protobuf:"varint,1,opt,name=since,proto3" json:"since,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BackupRequest) Reset()         { *m = BackupRequest{} }
+func (m *BackupRequest) String() string { return proto.CompactTextString(m) }
+func (*BackupRequest) ProtoMessage()    {}
+func (*BackupRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{131}
+}
+
+func (m *BackupRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BackupRequest.Unmarshal(m, b)
+}
+func (m *BackupRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BackupRequest.Marshal(b, m, deterministic)
+}
+func (m *BackupRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BackupRequest.Merge(m, src)
+}
+func (m *BackupRequest) XXX_Size() int {
+	return xxx_messageInfo_BackupRequest.Size(m)
+}
+func (m *BackupRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BackupRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BackupRequest proto.InternalMessageInfo
+
+func (m *BackupRequest) GetSince() uint64 {
+	if m != nil {
+		return m.Since
+	}
+	return 0
+}
+
+type BackupResponse struct {
+	Data                 []byte   `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Version              uint64   `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BackupResponse) Reset()         { *m = BackupResponse{} }
+func (m *BackupResponse) String() string { return proto.CompactTextString(m) }
+func (*BackupResponse) ProtoMessage()    {}
+func (*BackupResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{132}
+}
+
+func (m *BackupResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BackupResponse.Unmarshal(m, b)
+}
+func (m *BackupResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BackupResponse.Marshal(b, m, deterministic)
+}
+func (m *BackupResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BackupResponse.Merge(m, src)
+}
+func (m *BackupResponse) XXX_Size() int {
+	return xxx_messageInfo_BackupResponse.Size(m)
+}
+func (m *BackupResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BackupResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BackupResponse proto.InternalMessageInfo
+
+func (m *BackupResponse) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *BackupResponse) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+type RestoreRequest struct {
+	Data                 []byte   `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RestoreRequest) Reset()         { *m = RestoreRequest{} }
+func (m *RestoreRequest) String() string { return proto.CompactTextString(m) }
+func (*RestoreRequest) ProtoMessage()    {}
+func (*RestoreRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{133}
+}
+
+func (m *RestoreRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RestoreRequest.Unmarshal(m, b)
+}
+func (m *RestoreRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RestoreRequest.Marshal(b, m, deterministic)
+}
+func (m *RestoreRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestoreRequest.Merge(m, src)
+}
+func (m *RestoreRequest) XXX_Size() int {
+	return xxx_messageInfo_RestoreRequest.Size(m)
+}
+func (m *RestoreRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestoreRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RestoreRequest proto.InternalMessageInfo
+
+func (m *RestoreRequest) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+type RestoreResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RestoreResponse) Reset()         { *m = RestoreResponse{} }
+func (m *RestoreResponse) String() string { return proto.CompactTextString(m) }
+func (*RestoreResponse) ProtoMessage()    {}
+func (*RestoreResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{134}
+}
+
+func (m *RestoreResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RestoreResponse.Unmarshal(m, b)
+}
+func (m *RestoreResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RestoreResponse.Marshal(b, m, deterministic)
+}
+func (m *RestoreResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestoreResponse.Merge(m, src)
+}
+func (m *RestoreResponse) XXX_Size() int {
+	return xxx_messageInfo_RestoreResponse.Size(m)
+}
+func (m *RestoreResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestoreResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RestoreResponse proto.InternalMessageInfo
+
+type GCRequest struct {
+	DiscardRatio         float64  `protobuf:"fixed64,1,opt,name=discard_ratio,json=discardRatio,proto3" json:"discard_ratio,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GCRequest) Reset()         { *m = GCRequest{} }
+func (m *GCRequest) String() string { return proto.CompactTextString(m) }
+func (*GCRequest) ProtoMessage()    {}
+func (*GCRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{135}
+}
+
+func (m *GCRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GCRequest.Unmarshal(m, b)
+}
+func (m *GCRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GCRequest.Marshal(b, m, deterministic)
+}
+func (m *GCRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GCRequest.Merge(m, src)
+}
+func (m *GCRequest) XXX_Size() int {
+	return xxx_messageInfo_GCRequest.Size(m)
+}
+func (m *GCRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GCRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GCRequest proto.InternalMessageInfo
+
+func (m *GCRequest) GetDiscardRatio() float64 {
+	if m != nil {
+		return m.DiscardRatio
+	}
+	return 0
+}
+
+type GCResponse struct {
+	Runs                 int64    `protobuf:"varint,1,opt,name=runs,proto3" json:"runs,omitempty"`
+	ReclaimedBytes       int64    `protobuf:"varint,2,opt,name=reclaimed_bytes,json=reclaimedBytes,proto3" json:"reclaimed_bytes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GCResponse) Reset()         { *m = GCResponse{} }
+func (m *GCResponse) String() string { return proto.CompactTextString(m) }
+func (*GCResponse) ProtoMessage()    {}
+func (*GCResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{136}
+}
+
+func (m *GCResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GCResponse.Unmarshal(m, b)
+}
+func (m *GCResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GCResponse.Marshal(b, m, deterministic)
+}
+func (m *GCResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GCResponse.Merge(m, src)
+}
+func (m *GCResponse) XXX_Size() int {
+	return xxx_messageInfo_GCResponse.Size(m)
+}
+func (m *GCResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GCResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GCResponse proto.InternalMessageInfo
+
+func (m *GCResponse) GetRuns() int64 {
+	if m != nil {
+		return m.Runs
+	}
+	return 0
+}
+
+func (m *GCResponse) GetReclaimedBytes() int64 {
+	if m != nil {
+		return m.ReclaimedBytes
+	}
+	return 0
+}
+
+type HealthRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *HealthRequest) Reset()         { *m = HealthRequest{} }
+func (m *HealthRequest) String() string { return proto.CompactTextString(m) }
+func (*HealthRequest) ProtoMessage()    {}
+func (*HealthRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{137}
+}
+
+func (m *HealthRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HealthRequest.Unmarshal(m, b)
+}
+func (m *HealthRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HealthRequest.Marshal(b, m, deterministic)
+}
+func (m *HealthRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HealthRequest.Merge(m, src)
+}
+func (m *HealthRequest) XXX_Size() int {
+	return xxx_messageInfo_HealthRequest.Size(m)
+}
+func (m *HealthRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_HealthRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HealthRequest proto.InternalMessageInfo
+
+type HealthResponse struct {
+	Ok                   bool     `protobuf:"varint,1,opt,name=ok,proto3" json:"ok,omitempty"`
+	LsmSize              int64    `protobuf:"varint,2,opt,name=lsm_size,json=lsmSize,proto3" json:"lsm_size,omitempty"`
+	VlogSize             int64    `protobuf:"varint,3,opt,name=vlog_size,json=vlogSize,proto3" json:"vlog_size,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *HealthResponse) Reset()         { *m = HealthResponse{} }
+func (m *HealthResponse) String() string { return proto.CompactTextString(m) }
+func (*HealthResponse) ProtoMessage()    {}
+func (*HealthResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{138}
+}
+
+func (m *HealthResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HealthResponse.Unmarshal(m, b)
+}
+func (m *HealthResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HealthResponse.Marshal(b, m, deterministic)
+}
+func (m *HealthResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HealthResponse.Merge(m, src)
+}
+func (m *HealthResponse) XXX_Size() int {
+	return xxx_messageInfo_HealthResponse.Size(m)
+}
+func (m *HealthResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_HealthResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HealthResponse proto.InternalMessageInfo
+
+func (m *HealthResponse) GetOk() bool {
+	if m != nil {
+		return m.Ok
+	}
+	return false
+}
+
+func (m *HealthResponse) GetLsmSize() int64 {
+	if m != nil {
+		return m.LsmSize
+	}
+	return 0
+}
+
+func (m *HealthResponse) GetVlogSize() int64 {
+	if m != nil {
+		return m.VlogSize
+	}
+	return 0
+}
+
+type StatsRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StatsRequest) Reset()         { *m = StatsRequest{} }
+func (m *StatsRequest) String() string { return proto.CompactTextString(m) }
+func (*StatsRequest) ProtoMessage()    {}
+func (*StatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{139}
+}
+
+func (m *StatsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StatsRequest.Unmarshal(m, b)
+}
+func (m *StatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StatsRequest.Marshal(b, m, deterministic)
+}
+func (m *StatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StatsRequest.Merge(m, src)
+}
+func (m *StatsRequest) XXX_Size() int {
+	return xxx_messageInfo_StatsRequest.Size(m)
+}
+func (m *StatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StatsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StatsRequest proto.InternalMessageInfo
+
+type StatsResponse struct {
+	LsmSize              int64    `protobuf:"varint,1,opt,name=lsm_size,json=lsmSize,proto3" json:"lsm_size,omitempty"`
+	VlogSize             int64    `protobuf:"varint,2,opt,name=vlog_size,json=vlogSize,proto3" json:"vlog_size,omitempty"`
+	EstimatedKeys        int64    `protobuf:"varint,3,opt,name=estimated_keys,json=estimatedKeys,proto3" json:"estimated_keys,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StatsResponse) Reset()         { *m = StatsResponse{} }
+func (m *StatsResponse) String() string { return proto.CompactTextString(m) }
+func (*StatsResponse) ProtoMessage()    {}
+func (*StatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{140}
+}
+
+func (m *StatsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StatsResponse.Unmarshal(m, b)
+}
+func (m *StatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StatsResponse.Marshal(b, m, deterministic)
+}
+func (m *StatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StatsResponse.Merge(m, src)
+}
+func (m *StatsResponse) XXX_Size() int {
+	return xxx_messageInfo_StatsResponse.Size(m)
+}
+func (m *StatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_StatsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StatsResponse proto.InternalMessageInfo
+
+func (m *StatsResponse) GetLsmSize() int64 {
+	if m != nil {
+		return m.LsmSize
+	}
+	return 0
+}
+
+func (m *StatsResponse) GetVlogSize() int64 {
+	if m != nil {
+		return m.VlogSize
+	}
+	return 0
+}
+
+func (m *StatsResponse) GetEstimatedKeys() int64 {
+	if m != nil {
+		return m.EstimatedKeys
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("api.DistanceUnit", DistanceUnit_name, DistanceUnit_value)
+	proto.RegisterEnum("api.TagRelation", TagRelation_name, TagRelation_value)
+	proto.RegisterEnum("api.EventType", EventType_name, EventType_value)
+	proto.RegisterEnum("api.TravelMode", TravelMode_name, TravelMode_value)
+	proto.RegisterEnum("api.StreamAction", StreamAction_name, StreamAction_value)
+	proto.RegisterEnum("api.SortBy", SortBy_name, SortBy_value)
+	proto.RegisterType((*Point)(nil), "api.Point")
+	proto.RegisterType((*Bound)(nil), "api.Bound")
+	proto.RegisterType((*Object)(nil), "api.Object")
+	proto.RegisterMapType((map[string]int64)(nil), "api.Object.CountersEntry")
+	proto.RegisterMapType((map[string]string)(nil), "api.Object.MetadataEntry")
+	proto.RegisterType((*TagFilter)(nil), "api.TagFilter")
+	proto.RegisterType((*ObjectTracking)(nil), "api.ObjectTracking")
+	proto.RegisterType((*ObjectTracker)(nil), "api.ObjectTracker")
+	proto.RegisterType((*Directions)(nil), "api.Directions")
+	proto.RegisterType((*Address)(nil), "api.Address")
+	proto.RegisterType((*TrackerEvent)(nil), "api.TrackerEvent")
+	proto.RegisterMapType((map[string]string)(nil), "api.TrackerEvent.MetadataEntry")
+	proto.RegisterType((*ObjectDetail)(nil), "api.ObjectDetail")
+	proto.RegisterType((*Geofence)(nil), "api.Geofence")
+	proto.RegisterMapType((map[string]string)(nil), "api.Geofence.MetadataEntry")
+	proto.RegisterType((*GeofenceEvent)(nil), "api.GeofenceEvent")
+	proto.RegisterType((*StreamRequest)(nil), "api.StreamRequest")
+	proto.RegisterType((*Box)(nil), "api.Box")
+	proto.RegisterType((*StreamResponse)(nil), "api.StreamResponse")
+	proto.RegisterType((*StreamRegexRequest)(nil), "api.StreamRegexRequest")
+	proto.RegisterType((*StreamRegexResponse)(nil), "api.StreamRegexResponse")
+	proto.RegisterType((*StreamPrefixRequest)(nil), "api.StreamPrefixRequest")
+	proto.RegisterType((*StreamPrefixResponse)(nil), "api.StreamPrefixResponse")
+	proto.RegisterType((*WatchKeyRequest)(nil), "api.WatchKeyRequest")
+	proto.RegisterType((*WatchKeyResponse)(nil), "api.WatchKeyResponse")
+	proto.RegisterType((*StreamControlRequest)(nil), "api.StreamControlRequest")
+	proto.RegisterType((*StreamControlResponse)(nil), "api.StreamControlResponse")
+	proto.RegisterType((*ListClientsRequest)(nil), "api.ListClientsRequest")
+	proto.RegisterType((*StreamClient)(nil), "api.StreamClient")
+	proto.RegisterType((*ListClientsResponse)(nil), "api.ListClientsResponse")
+	proto.RegisterType((*SetRequest)(nil), "api.SetRequest")
+	proto.RegisterType((*SetResponse)(nil), "api.SetResponse")
+	proto.RegisterType((*UpdateRequest)(nil), "api.UpdateRequest")
+	proto.RegisterMapType((map[string]string)(nil), "api.UpdateRequest.MetadataEntry")
+	proto.RegisterType((*UpdateResponse)(nil), "api.UpdateResponse")
+	proto.RegisterType((*IncrementRequest)(nil), "api.IncrementRequest")
+	proto.RegisterType((*IncrementResponse)(nil), "api.IncrementResponse")
+	proto.RegisterType((*SetManyRequest)(nil), "api.SetManyRequest")
+	proto.RegisterType((*SetManyResponse)(nil), "api.SetManyResponse")
+	proto.RegisterType((*PositionUpdate)(nil), "api.PositionUpdate")
+	proto.RegisterType((*BulkUpdatePositionsRequest)(nil), "api.BulkUpdatePositionsRequest")
+	proto.RegisterType((*BulkUpdatePositionsResponse)(nil), "api.BulkUpdatePositionsResponse")
+	proto.RegisterType((*CSVColumns)(nil), "api.CSVColumns")
+	proto.RegisterType((*ImportCSVRequest)(nil), "api.ImportCSVRequest")
+	proto.RegisterType((*CSVRowError)(nil), "api.CSVRowError")
+	proto.RegisterType((*ImportCSVResponse)(nil), "api.ImportCSVResponse")
+	proto.RegisterType((*GetKeysRequest)(nil), "api.GetKeysRequest")
+	proto.RegisterType((*GetKeysResponse)(nil), "api.GetKeysResponse")
+	proto.RegisterType((*GetPrefixKeysRequest)(nil), "api.GetPrefixKeysRequest")
+	proto.RegisterType((*GetPrefixKeysResponse)(nil), "api.GetPrefixKeysResponse")
+	proto.RegisterType((*GetRegexKeysRequest)(nil), "api.GetRegexKeysRequest")
+	proto.RegisterType((*GetRegexKeysResponse)(nil), "api.GetRegexKeysResponse")
+	proto.RegisterType((*CountRequest)(nil), "api.CountRequest")
+	proto.RegisterType((*CountResponse)(nil), "api.CountResponse")
+	proto.RegisterType((*ExistsRequest)(nil), "api.ExistsRequest")
+	proto.RegisterType((*ExistsResponse)(nil), "api.ExistsResponse")
+	proto.RegisterMapType((map[string]bool)(nil), "api.ExistsResponse.ExistsEntry")
+	proto.RegisterType((*TTLRequest)(nil), "api.TTLRequest")
+	proto.RegisterType((*TTLResponse)(nil), "api.TTLResponse")
+	proto.RegisterMapType((map[string]int64)(nil), "api.TTLResponse.TtlSecondsEntry")
+	proto.RegisterType((*Sort)(nil), "api.Sort")
+	proto.RegisterType((*TimeWindow)(nil), "api.TimeWindow")
+	proto.RegisterType((*GetRequest)(nil), "api.GetRequest")
+	proto.RegisterMapType((map[string]string)(nil), "api.GetRequest.MetadataSelectorEntry")
+	proto.RegisterType((*GetResponse)(nil), "api.GetResponse")
+	proto.RegisterMapType((map[string]*ObjectDetail)(nil), "api.GetResponse.ObjectsEntry")
+	proto.RegisterType((*GetRegexRequest)(nil), "api.GetRegexRequest")
+	proto.RegisterMapType((map[string]string)(nil), "api.GetRegexRequest.MetadataSelectorEntry")
+	proto.RegisterType((*GetRegexResponse)(nil), "api.GetRegexResponse")
+	proto.RegisterMapType((map[string]*ObjectDetail)(nil), "api.GetRegexResponse.ObjectsEntry")
+	proto.RegisterType((*GetPrefixRequest)(nil), "api.GetPrefixRequest")
+	proto.RegisterMapType((map[string]string)(nil), "api.GetPrefixRequest.MetadataSelectorEntry")
+	proto.RegisterType((*GetPrefixResponse)(nil), "api.GetPrefixResponse")
+	proto.RegisterMapType((map[string]*ObjectDetail)(nil), "api.GetPrefixResponse.ObjectsEntry")
+	proto.RegisterType((*GetGlobRequest)(nil), "api.GetGlobRequest")
+	proto.RegisterMapType((map[string]string)(nil), "api.GetGlobRequest.MetadataSelectorEntry")
+	proto.RegisterType((*GetGlobResponse)(nil), "api.GetGlobResponse")
+	proto.RegisterMapType((map[string]*ObjectDetail)(nil), "api.GetGlobResponse.ObjectsEntry")
+	proto.RegisterType((*GetTaggedRequest)(nil), "api.GetTaggedRequest")
+	proto.RegisterType((*GetTaggedResponse)(nil), "api.GetTaggedResponse")
+	proto.RegisterMapType((map[string]*ObjectDetail)(nil), "api.GetTaggedResponse.ObjectsEntry")
+	proto.RegisterType((*DeleteRequest)(nil), "api.DeleteRequest")
+	proto.RegisterType((*DeleteResponse)(nil), "api.DeleteResponse")
+	proto.RegisterType((*DeletePrefixRequest)(nil), "api.DeletePrefixRequest")
+	proto.RegisterType((*DeletePrefixResponse)(nil), "api.DeletePrefixResponse")
+	proto.RegisterType((*DeleteRegexRequest)(nil), "api.DeleteRegexRequest")
+	proto.RegisterType((*DeleteRegexResponse)(nil), "api.DeleteRegexResponse")
+	proto.RegisterType((*DeleteExpiredRequest)(nil), "api.DeleteExpiredRequest")
+	proto.RegisterType((*DeleteExpiredResponse)(nil), "api.DeleteExpiredResponse")
+	proto.RegisterType((*ScanObjectsRequest)(nil), "api.ScanObjectsRequest")
+	proto.RegisterType((*ScanObjectsResponse)(nil), "api.ScanObjectsResponse")
+	proto.RegisterType((*ScanBoundRequest)(nil), "api.ScanBoundRequest")
+	proto.RegisterType((*ScanBoundResponse)(nil), "api.ScanBoundResponse")
+	proto.RegisterMapType((map[string]*ObjectDetail)(nil), "api.ScanBoundResponse.ObjectsEntry")
+	proto.RegisterType((*ScanPrefixBoundRequest)(nil), "api.ScanPrefixBoundRequest")
+	proto.RegisterType((*ScanPrefixBoundResponse)(nil), "api.ScanPrefixBoundResponse")
+	proto.RegisterMapType((map[string]*ObjectDetail)(nil), "api.ScanPrefixBoundResponse.ObjectsEntry")
+	proto.RegisterType((*ScanRegexBoundRequest)(nil), "api.ScanRegexBoundRequest")
+	proto.RegisterType((*ScanRegexBoundResponse)(nil), "api.ScanRegexBoundResponse")
+	proto.RegisterMapType((map[string]*ObjectDetail)(nil), "api.ScanRegexBoundResponse.ObjectsEntry")
+	proto.RegisterType((*ScanIsochroneRequest)(nil), "api.ScanIsochroneRequest")
+	proto.RegisterType((*ScanIsochroneResponse)(nil), "api.ScanIsochroneResponse")
+	proto.RegisterMapType((map[string]*ObjectDetail)(nil), "api.ScanIsochroneResponse.ObjectsEntry")
+	proto.RegisterType((*WithinCorridorRequest)(nil), "api.WithinCorridorRequest")
+	proto.RegisterType((*WithinCorridorResponse)(nil), "api.WithinCorridorResponse")
+	proto.RegisterMapType((map[string]*ObjectDetail)(nil), "api.WithinCorridorResponse.ObjectsEntry")
+	proto.RegisterType((*PolylineRequest)(nil), "api.PolylineRequest")
+	proto.RegisterType((*PolylineResponse)(nil), "api.PolylineResponse")
+	proto.RegisterType((*BoundsRequest)(nil), "api.BoundsRequest")
+	proto.RegisterType((*BoundsResponse)(nil), "api.BoundsResponse")
+	proto.RegisterMapType((map[string]*ObjectDetail)(nil), "api.BoundsResponse.ObjectsEntry")
+	proto.RegisterType((*NearestRequest)(nil), "api.NearestRequest")
+	proto.RegisterMapType((map[string]string)(nil), "api.NearestRequest.MetadataSelectorEntry")
+	proto.RegisterType((*NearestObject)(nil), "api.NearestObject")
+	proto.RegisterType((*NearestResponse)(nil), "api.NearestResponse")
+	proto.RegisterType((*GetPointRequest)(nil), "api.GetPointRequest")
+	proto.RegisterType((*GetPointResponse)(nil), "api.GetPointResponse")
+	proto.RegisterType((*RadiusRequest)(nil), "api.RadiusRequest")
+	proto.RegisterMapType((map[string]string)(nil), "api.RadiusRequest.MetadataSelectorEntry")
+	proto.RegisterType((*RadiusResponse)(nil), "api.RadiusResponse")
+	proto.RegisterType((*GeohashRequest)(nil), "api.GeohashRequest")
+	proto.RegisterType((*GeohashResponse)(nil), "api.GeohashResponse")
+	proto.RegisterMapType((map[string]*ObjectDetail)(nil), "api.GeohashResponse.ObjectsEntry")
+	proto.RegisterType((*HistoryRequest)(nil), "api.HistoryRequest")
+	proto.RegisterType((*HistoryPoint)(nil), "api.HistoryPoint")
+	proto.RegisterType((*HistoryResponse)(nil), "api.HistoryResponse")
+	proto.RegisterType((*PolygonRequest)(nil), "api.PolygonRequest")
+	proto.RegisterType((*PolygonResponse)(nil), "api.PolygonResponse")
+	proto.RegisterMapType((map[string]*ObjectDetail)(nil), "api.PolygonResponse.ObjectsEntry")
+	proto.RegisterType((*ProximityMatrixRequest)(nil), "api.ProximityMatrixRequest")
+	proto.RegisterType((*ProximityRow)(nil), "api.ProximityRow")
+	proto.RegisterType((*ProximityMatrixResponse)(nil), "api.ProximityMatrixResponse")
+	proto.RegisterType((*BoundingCircleRequest)(nil), "api.BoundingCircleRequest")
+	proto.RegisterType((*BoundingCircleResponse)(nil), "api.BoundingCircleResponse")
+	proto.RegisterType((*AggregateRequest)(nil), "api.AggregateRequest")
+	proto.RegisterMapType((map[string]string)(nil), "api.AggregateRequest.MetadataSelectorEntry")
+	proto.RegisterType((*AggregateResponse)(nil), "api.AggregateResponse")
+	proto.RegisterType((*ClusterRequest)(nil), "api.ClusterRequest")
+	proto.RegisterMapType((map[string]string)(nil), "api.ClusterRequest.MetadataSelectorEntry")
+	proto.RegisterType((*Cluster)(nil), "api.Cluster")
+	proto.RegisterType((*ClusterResponse)(nil), "api.ClusterResponse")
+	proto.RegisterType((*DeadLetter)(nil), "api.DeadLetter")
+	proto.RegisterType((*ObjectEvent)(nil), "api.ObjectEvent")
+	proto.RegisterType((*GetEventsRequest)(nil), "api.GetEventsRequest")
+	proto.RegisterType((*GetEventsResponse)(nil), "api.GetEventsResponse")
+	proto.RegisterType((*CreateGeofenceRequest)(nil), "api.CreateGeofenceRequest")
+	proto.RegisterType((*CreateGeofenceResponse)(nil), "api.CreateGeofenceResponse")
+	proto.RegisterType((*DeleteGeofenceRequest)(nil), "api.DeleteGeofenceRequest")
+	proto.RegisterType((*DeleteGeofenceResponse)(nil), "api.DeleteGeofenceResponse")
+	proto.RegisterType((*ListGeofencesRequest)(nil), "api.ListGeofencesRequest")
+	proto.RegisterType((*ListGeofencesResponse)(nil), "api.ListGeofencesResponse")
+	proto.RegisterType((*OpenReadSessionRequest)(nil), "api.OpenReadSessionRequest")
+	proto.RegisterType((*OpenReadSessionResponse)(nil), "api.OpenReadSessionResponse")
+	proto.RegisterType((*CloseReadSessionRequest)(nil), "api.CloseReadSessionRequest")
+	proto.RegisterType((*CloseReadSessionResponse)(nil), "api.CloseReadSessionResponse")
+	proto.RegisterType((*GetDeadLettersRequest)(nil), "api.GetDeadLettersRequest")
+	proto.RegisterType((*GetDeadLettersResponse)(nil), "api.GetDeadLettersResponse")
+	proto.RegisterType((*PingRequest)(nil), "api.PingRequest")
+	proto.RegisterType((*PingResponse)(nil), "api.PingResponse")
+	proto.RegisterType((*BackupRequest)(nil), "api.BackupRequest")
+	proto.RegisterType((*BackupResponse)(nil), "api.BackupResponse")
+	proto.RegisterType((*RestoreRequest)(nil), "api.RestoreRequest")
+	proto.RegisterType((*RestoreResponse)(nil), "api.RestoreResponse")
+	proto.RegisterType((*GCRequest)(nil), "api.GCRequest")
+	proto.RegisterType((*GCResponse)(nil), "api.GCResponse")
+	proto.RegisterType((*HealthRequest)(nil), "api.HealthRequest")
+	proto.RegisterType((*HealthResponse)(nil), "api.HealthResponse")
+	proto.RegisterType((*StatsRequest)(nil), "api.StatsRequest")
+	proto.RegisterType((*StatsResponse)(nil), "api.StatsResponse")
+}
+
+func init() { proto.RegisterFile("api.proto", fileDescriptor_00212fb1f9d3bf1c) }
+
+var fileDescriptor_00212fb1f9d3bf1c = []byte{
+	// 5942 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x7c, 0x4d, 0x8c, 0x1c, 0xc7,
+	0x75, 0x30, 0x7b, 0x66, 0x67, 0x76, 0xe6, 0xcd, 0xce, 0xcf, 0xd6, 0xfe, 0x70, 0xd8, 0xa4, 0xcc,
+	0x75, 0x5b, 0x94, 0x28, 0x4a, 0x4b, 0x52, 0xb4, 0xf5, 0x67, 0x52, 0x96, 0xb9, 0x4b, 0x6a, 0x49,
+	0x8b, 0x94, 0xe8, 0xde, 0x15, 0xa5, 0xcf, 0x86, 0x35, 0xee, 0x9d, 0xae, 0x9d, 0x6d, 0xef, 0x4c,
+	0xf7, 0xb8, 0xbb, 0x87, 0xdc, 0x95, 0x3e, 0x3b, 0xbf, 0xc8, 0x25, 0x86, 0x85, 0x1c, 0x82, 0xc0,
+	0xf9, 0x01, 0x12, 0x04, 0x08, 0x02, 0x04, 0x89, 0x91, 0x9f, 0x4b, 0x80, 0x1c, 0x7c, 0xcd, 0x25,
+	0x39, 0xe4, 0x90, 0x5b, 0x88, 0xf0, 0xe2, 0x00, 0x41, 0x90, 0xe4, 0x92, 0x6b, 0x82, 0xfa, 0xed,
+	0xaa, 0x9e, 0x9e, 0xd9, 0x99, 0x5d, 0x8a, 0x86, 0xbd, 0x87, 0xc5, 0xd4, 0xab, 0x57, 0x55, 0xaf,
+	0x5e, 0xbd, 0x7a, 0xf5, 0xea, 0xd5, 0x7b, 0x0d, 0x65, 0xa7, 0xef, 0x5d, 0xec, 0x87, 0x41, 0x1c,
+	0xa0, 0xbc, 0xd3, 0xf7, 0xcc, 0x57, 0x3b, 0x5e, 0xbc, 0x3b, 0xd8, 0xbe, 0xd8, 0x0e, 0x7a, 0x97,
+	0x7a, 0x0f, 0xbd, 0x78, 0x2f, 0x78, 0x78, 0xa9, 0x13, 0xac, 0x52, 0x8c, 0xd5, 0x07, 0x4e, 0xd7,
+	0x73, 0x9d, 0x38, 0x08, 0xa3, 0x4b, 0xf2, 0x27, 0x6b, 0x6c, 0x7d, 0x13, 0x0a, 0xf7, 0x02, 0xcf,
+	0x8f, 0xd1, 0x79, 0xc8, 0x77, 0x9d, 0xb8, 0x69, 0xac, 0x18, 0xe7, 0x8d, 0xb5, 0xe5, 0xc7, 0x8f,
+	0xce, 0xa2, 0xdb, 0x27, 0xc8, 0xdf, 0x2f, 0xdf, 0xff, 0xc9, 0xd7, 0xf9, 0x8f, 0xaf, 0xda, 0x04,
+	0x85, 0x62, 0x06, 0x7e, 0x33, 0x37, 0x84, 0xb9, 0x23, 0x30, 0x77, 0x08, 0x66, 0xe0, 0x5b, 0xdf,
+	0x81, 0xc2, 0x5a, 0x30, 0xf0, 0x5d, 0x64, 0x41, 0xb1, 0x8d, 0xfd, 0x18, 0x87, 0xb4, 0xff, 0xca,
+	0x15, 0xb8, 0x48, 0xc8, 0xa7, 0x03, 0xdb, 0xbc, 0x06, 0x2d, 0x43, 0x31, 0x74, 0x5c, 0x6f, 0x10,
+	0xb1, 0x9e, 0x6d, 0x5e, 0x42, 0xe7, 0x60, 0x66, 0xe0, 0x7b, 0x71, 0x33, 0xbf, 0x62, 0x9c, 0xaf,
+	0x5d, 0x99, 0xa7, 0x2d, 0x6f, 0x78, 0x51, 0xec, 0xf8, 0x6d, 0xfc, 0xbe, 0xef, 0xc5, 0x36, 0xad,
+	0xb6, 0xfe, 0xad, 0x08, 0xc5, 0xf7, 0xb6, 0xbf, 0x83, 0xdb, 0x31, 0xb2, 0x20, 0xbf, 0x87, 0x0f,
+	0xe8, 0x50, 0xe5, 0xb5, 0xc6, 0xe3, 0x47, 0x67, 0xe7, 0x00, 0x3e, 0xba, 0xf8, 0xc9, 0xcb, 0x2f,
+	0x5d, 0xb9, 0xf2, 0xca, 0xf7, 0x9e, 0xb5, 0x49, 0x25, 0x3a, 0x0f, 0x85, 0x3e, 0x19, 0xbe, 0x99,
+	0x4b, 0x13, 0xb4, 0x56, 0x7c, 0xfc, 0xe8, 0x6c, 0x6e, 0xc5, 0xb0, 0x19, 0x02, 0xfa, 0x9c, 0xa4,
+	0x8b, 0x50, 0x90, 0x67, 0xd5, 0x8d, 0x13, 0x92, 0xbe, 0x4b, 0x50, 0x8a, 0x43, 0xa7, 0xbd, 0xe7,
+	0xf9, 0x9d, 0xe6, 0x0c, 0xed, 0x6c, 0x81, 0x76, 0xc6, 0x88, 0xd9, 0xe2, 0x55, 0xb6, 0x44, 0x42,
+	0xaf, 0x40, 0xa9, 0x87, 0x63, 0xc7, 0x75, 0x62, 0xa7, 0x59, 0x58, 0xc9, 0x9f, 0xaf, 0x5c, 0x39,
+	0xa5, 0x34, 0xb8, 0x78, 0x97, 0xd7, 0xdd, 0xf4, 0xe3, 0xf0, 0xc0, 0x96, 0xa8, 0xe8, 0x2c, 0x54,
+	0x3a, 0x38, 0x6e, 0x39, 0xae, 0x1b, 0xe2, 0x28, 0x6a, 0x16, 0x57, 0x8c, 0xf3, 0x25, 0x1b, 0x3a,
+	0x38, 0xbe, 0xce, 0x20, 0xe8, 0xf3, 0x30, 0x47, 0x10, 0x62, 0xaf, 0x87, 0x3f, 0x0e, 0x7c, 0xdc,
+	0x9c, 0xa5, 0x18, 0xa4, 0xd1, 0x16, 0x07, 0x11, 0x14, 0xbc, 0xdf, 0xf7, 0x42, 0x1c, 0xb5, 0x06,
+	0xbe, 0xb7, 0xdf, 0x2c, 0x91, 0x19, 0xd9, 0x15, 0x0e, 0x7b, 0xdf, 0xf7, 0xf6, 0x09, 0xca, 0xa0,
+	0xef, 0x3a, 0x31, 0x76, 0x19, 0x4a, 0x99, 0xa1, 0x70, 0x18, 0x45, 0x41, 0x30, 0x13, 0x3b, 0x9d,
+	0xa8, 0x09, 0x2b, 0xf9, 0xf3, 0x65, 0x9b, 0xfe, 0x46, 0x97, 0xa1, 0x12, 0xc7, 0xdd, 0x56, 0x84,
+	0xdb, 0x81, 0xef, 0x46, 0xcd, 0x0a, 0x65, 0x55, 0xfd, 0xf1, 0xa3, 0xb3, 0x95, 0xc6, 0xff, 0x8a,
+	0x3f, 0xc3, 0x86, 0x38, 0xee, 0x6e, 0x32, 0x14, 0xd4, 0x84, 0xd9, 0x0e, 0x0e, 0x76, 0x9d, 0x68,
+	0xb7, 0x39, 0x47, 0x56, 0xca, 0x16, 0x45, 0x42, 0xc2, 0x1e, 0xc6, 0xfd, 0xd6, 0xae, 0x17, 0xc5,
+	0x41, 0x78, 0xd0, 0xac, 0xb2, 0x89, 0x10, 0xd8, 0x2d, 0x06, 0x22, 0x8d, 0x1f, 0xe0, 0x30, 0xf2,
+	0x02, 0xbf, 0x59, 0xa3, 0x04, 0x8a, 0x22, 0x3a, 0x07, 0x35, 0xca, 0xe9, 0x56, 0xe0, 0x06, 0x3d,
+	0x4c, 0x44, 0xae, 0x4e, 0x9b, 0x57, 0x29, 0xf4, 0x3d, 0x0e, 0x44, 0xcf, 0x43, 0x5d, 0x20, 0xb4,
+	0xe8, 0xff, 0xa8, 0xd9, 0xa0, 0x62, 0x57, 0x13, 0xe0, 0xbb, 0x14, 0x8a, 0x9e, 0x83, 0x52, 0x3f,
+	0xe8, 0x1e, 0x74, 0x3d, 0x1f, 0x37, 0xe7, 0x57, 0xf2, 0xba, 0xac, 0xd8, 0xb2, 0x0e, 0x3d, 0x0b,
+	0xb3, 0xe4, 0x77, 0x27, 0xf0, 0x9b, 0x68, 0x08, 0x4d, 0x54, 0x11, 0xd6, 0x85, 0x41, 0x17, 0x37,
+	0x17, 0xe8, 0x8c, 0xe9, 0x6f, 0x22, 0x0f, 0xed, 0x60, 0xe0, 0x53, 0x1a, 0x16, 0x87, 0xe5, 0x61,
+	0x9d, 0xd7, 0x71, 0x79, 0x10, 0xa8, 0xe6, 0x55, 0xa8, 0x6a, 0xa2, 0x82, 0x1a, 0x8a, 0xd8, 0x33,
+	0x21, 0x5f, 0x84, 0xc2, 0x03, 0xa7, 0x3b, 0xc0, 0x54, 0xc8, 0xcb, 0x36, 0x2b, 0x7c, 0x39, 0xf7,
+	0xba, 0x41, 0x1a, 0x6b, 0xfd, 0x1e, 0xd6, 0x38, 0xaf, 0x34, 0xb6, 0xd6, 0xa1, 0xbc, 0xe5, 0x74,
+	0xde, 0xf6, 0xba, 0x84, 0x91, 0x0d, 0xc8, 0x3b, 0x3e, 0x69, 0x48, 0x64, 0x81, 0xfc, 0xa4, 0x90,
+	0x6e, 0xb7, 0x99, 0xe3, 0x90, 0x6e, 0x97, 0xcc, 0xda, 0x27, 0x12, 0x99, 0x67, 0x02, 0x43, 0x7e,
+	0x5b, 0x8f, 0x0c, 0xa8, 0xe9, 0x5b, 0x84, 0xca, 0x50, 0xe8, 0x3c, 0xc0, 0xdd, 0x56, 0x2f, 0x70,
+	0x31, 0xa5, 0xa5, 0x76, 0xa5, 0x4e, 0x79, 0xb1, 0x45, 0xe1, 0x77, 0x03, 0x17, 0xdb, 0x10, 0xcb,
+	0xdf, 0xe8, 0x22, 0xdf, 0x7b, 0x84, 0x75, 0x39, 0xca, 0x3a, 0x94, 0xde, 0x7b, 0x38, 0xb4, 0x25,
+	0x0e, 0xfa, 0x22, 0xcc, 0xc5, 0x4e, 0xa7, 0x15, 0xe2, 0xae, 0x13, 0x13, 0xd9, 0x61, 0x3a, 0xa5,
+	0xc1, 0x86, 0x70, 0x3a, 0x36, 0x87, 0xdb, 0x95, 0x38, 0x29, 0xa0, 0x57, 0xa1, 0xea, 0x72, 0x7d,
+	0xd3, 0xa2, 0x9a, 0x68, 0x66, 0x94, 0x26, 0x9a, 0x73, 0x95, 0x92, 0xf5, 0x1f, 0x06, 0x54, 0x35,
+	0x42, 0xd0, 0x35, 0x98, 0x8f, 0x9d, 0x90, 0x6c, 0xd2, 0x80, 0xc2, 0x5b, 0xe3, 0xd4, 0x54, 0x9d,
+	0xa1, 0xb2, 0x1e, 0xde, 0xc1, 0x07, 0xe8, 0x05, 0x68, 0x30, 0xc9, 0x76, 0xbd, 0x10, 0xb7, 0x09,
+	0x69, 0x4c, 0x55, 0x96, 0xec, 0x3a, 0x85, 0xdf, 0x90, 0xe0, 0x64, 0x13, 0x08, 0x82, 0x9a, 0x79,
+	0x65, 0x13, 0x08, 0x9a, 0xd1, 0x69, 0x28, 0x33, 0x34, 0x1c, 0x3b, 0x74, 0x56, 0x25, 0xce, 0xab,
+	0x9b, 0xb1, 0x83, 0x2e, 0x41, 0x85, 0x13, 0x4b, 0x37, 0x7b, 0x81, 0xaa, 0xb6, 0x9a, 0x60, 0x15,
+	0x5b, 0x7d, 0x1b, 0x18, 0xca, 0x96, 0xd3, 0x89, 0xac, 0x5d, 0x00, 0x85, 0x84, 0xe7, 0xa1, 0xbe,
+	0x1b, 0xf7, 0xba, 0x2a, 0xb1, 0x4c, 0xb8, 0x6a, 0x04, 0xac, 0x20, 0x36, 0x20, 0x4f, 0x86, 0x67,
+	0x52, 0x96, 0xc7, 0x4c, 0xd3, 0x71, 0x39, 0x20, 0xe4, 0x33, 0xb5, 0x2b, 0x96, 0x9d, 0xd0, 0x6e,
+	0xfd, 0x96, 0x01, 0xb3, 0x42, 0xeb, 0x2d, 0x42, 0x21, 0x8a, 0x9d, 0x18, 0xf3, 0xde, 0x59, 0x81,
+	0xe8, 0x07, 0xa1, 0x28, 0x99, 0xec, 0x8b, 0x22, 0xa9, 0xa1, 0x5b, 0x28, 0x3c, 0xa0, 0x1d, 0x97,
+	0x6d, 0x51, 0x24, 0x84, 0x7c, 0xec, 0xf5, 0x29, 0x1f, 0xca, 0x36, 0xf9, 0x49, 0x8e, 0x24, 0x5a,
+	0x79, 0x40, 0x67, 0x5f, 0xb6, 0x79, 0x89, 0xc8, 0x73, 0xdb, 0x8b, 0x0f, 0xa8, 0x0e, 0x2e, 0xdb,
+	0xf4, 0xb7, 0xf5, 0x69, 0x1e, 0xe6, 0xf8, 0x3a, 0xdf, 0x7c, 0x80, 0xfd, 0x18, 0x7d, 0x01, 0x8a,
+	0x6c, 0x95, 0xf9, 0x99, 0x57, 0x51, 0x24, 0xd3, 0xe6, 0x55, 0xc8, 0x84, 0x92, 0x5c, 0x22, 0x76,
+	0xec, 0xc9, 0x32, 0x19, 0xdd, 0xf3, 0x23, 0xcf, 0x15, 0x8b, 0xc7, 0x4b, 0x68, 0x15, 0xca, 0x92,
+	0xa9, 0xfc, 0xc4, 0xa9, 0x73, 0x59, 0x14, 0x4c, 0xb5, 0x13, 0x0c, 0x2a, 0x0b, 0x5e, 0x0f, 0x47,
+	0xb1, 0xd3, 0xeb, 0x33, 0x95, 0x5e, 0xa0, 0x0c, 0xad, 0x4a, 0x28, 0x55, 0xea, 0x57, 0x95, 0x53,
+	0xa9, 0x48, 0xb7, 0xd2, 0x59, 0xb1, 0xf3, 0xe4, 0x9c, 0x46, 0x9e, 0x4d, 0xcf, 0x43, 0x3d, 0x19,
+	0xc3, 0x77, 0xfc, 0x20, 0xa2, 0xa7, 0x4f, 0xde, 0x4e, 0x86, 0x7e, 0x97, 0x40, 0xd1, 0x2a, 0x00,
+	0x26, 0x3d, 0xb5, 0xe2, 0x83, 0x3e, 0xa6, 0xc7, 0x4f, 0x8d, 0xcb, 0x14, 0x1d, 0x60, 0xeb, 0xa0,
+	0x8f, 0xed, 0x32, 0x16, 0x3f, 0x8f, 0xa5, 0xe3, 0xac, 0x1f, 0xe7, 0x60, 0x8e, 0xb1, 0xfb, 0x06,
+	0x8e, 0x1d, 0xaf, 0x3b, 0xd9, 0x8a, 0x3c, 0xa7, 0x4b, 0x4e, 0xe5, 0xca, 0x1c, 0xc5, 0xe2, 0xe2,
+	0x96, 0xc8, 0x91, 0x09, 0x25, 0x79, 0xd2, 0x32, 0x41, 0x92, 0x65, 0xf4, 0x3a, 0xdf, 0x7e, 0x38,
+	0x6c, 0xd1, 0xb9, 0x44, 0xcd, 0x19, 0xca, 0xd1, 0xf9, 0x21, 0x8e, 0xf2, 0x1d, 0xc9, 0x4b, 0x54,
+	0x3a, 0x5d, 0xdc, 0xc5, 0x31, 0x76, 0xe9, 0x2a, 0x95, 0x6c, 0x51, 0x44, 0x57, 0xa1, 0xde, 0xc1,
+	0xc1, 0x0e, 0x26, 0x5a, 0x88, 0x77, 0x5a, 0x54, 0x34, 0xde, 0x06, 0xaf, 0x63, 0xbd, 0xd6, 0x3a,
+	0x6a, 0x91, 0xea, 0x03, 0x26, 0x3c, 0x2d, 0xb6, 0x5f, 0xc9, 0xf2, 0x10, 0x55, 0x5c, 0x65, 0xd0,
+	0x2d, 0x06, 0xb4, 0x7e, 0x94, 0x83, 0x92, 0xe8, 0x08, 0x3d, 0x0b, 0x33, 0xbe, 0xd3, 0xc3, 0x23,
+	0xf5, 0x13, 0xad, 0x55, 0x2c, 0xbb, 0xdc, 0x48, 0xcb, 0xee, 0xf9, 0x94, 0x05, 0x35, 0x64, 0x16,
+	0xf0, 0x6a, 0xf5, 0x0c, 0x9d, 0x19, 0x7d, 0x86, 0xbe, 0x36, 0x64, 0x3f, 0x9d, 0xd6, 0x58, 0x30,
+	0x4a, 0x4a, 0x8f, 0x27, 0x4d, 0x7f, 0x69, 0x40, 0x55, 0x63, 0x32, 0xc1, 0xa5, 0x25, 0xa1, 0x79,
+	0x68, 0x21, 0x25, 0xe1, 0xb9, 0x43, 0x24, 0x7c, 0xe4, 0x26, 0x57, 0x15, 0xc3, 0x4c, 0x4a, 0x31,
+	0x64, 0xec, 0xb6, 0x42, 0xd6, 0x6e, 0xb3, 0x7e, 0x98, 0x83, 0xea, 0x66, 0x1c, 0x62, 0xa7, 0x67,
+	0xe3, 0xef, 0x0e, 0x70, 0x14, 0x13, 0x8d, 0xdf, 0xee, 0x7a, 0x84, 0x3c, 0xcf, 0xe5, 0x74, 0x97,
+	0x18, 0xe0, 0xb6, 0x4b, 0xd4, 0xda, 0x1e, 0x3e, 0x88, 0xf8, 0xc9, 0x4d, 0x7f, 0x23, 0x8b, 0xdb,
+	0x7a, 0xf9, 0x4c, 0xf5, 0x4f, 0xeb, 0x90, 0x09, 0xf9, 0xed, 0x60, 0x9f, 0xab, 0xa2, 0x12, 0x45,
+	0x59, 0x0b, 0xf6, 0x6d, 0x02, 0x44, 0x2b, 0x50, 0xd8, 0x26, 0x57, 0x00, 0x7e, 0x7e, 0x00, 0xaf,
+	0x1d, 0xf8, 0xae, 0xcd, 0x2a, 0xd0, 0x97, 0xa1, 0x4c, 0x24, 0x29, 0xea, 0x3b, 0x6d, 0xcc, 0x34,
+	0xea, 0xda, 0x99, 0xc7, 0x8f, 0xce, 0x36, 0x61, 0xf9, 0xa3, 0x6f, 0x5e, 0x5f, 0xfd, 0x86, 0xb3,
+	0xfa, 0xf1, 0xe5, 0xd5, 0x37, 0x5a, 0x17, 0x57, 0xbf, 0xf5, 0xc9, 0xe5, 0x97, 0x5e, 0xfd, 0xd2,
+	0xf7, 0x9e, 0xb5, 0x13, 0x74, 0x74, 0x11, 0x20, 0xf2, 0xf8, 0xb9, 0xbc, 0xdf, 0x9c, 0xcd, 0x96,
+	0xae, 0x32, 0x45, 0x21, 0x4a, 0xce, 0xfa, 0x7b, 0x03, 0xf2, 0x6b, 0xc1, 0x3e, 0xba, 0x04, 0xb3,
+	0x3d, 0xcf, 0x6f, 0x1d, 0x7e, 0xe1, 0x29, 0xf6, 0x3c, 0xff, 0x8e, 0x13, 0xcb, 0x06, 0x87, 0xde,
+	0x7b, 0x68, 0x83, 0xc0, 0xa7, 0x0d, 0x9c, 0x7d, 0x3a, 0x42, 0xfe, 0x90, 0x11, 0x9c, 0x7d, 0x31,
+	0x02, 0x69, 0xc0, 0x75, 0xfa, 0xb8, 0x11, 0x9c, 0xfd, 0x3b, 0x81, 0x6f, 0x5d, 0x85, 0x9a, 0x58,
+	0xdb, 0xa8, 0x1f, 0xf8, 0x11, 0x46, 0x2f, 0xa4, 0xf4, 0xdb, 0xbc, 0xa2, 0xdf, 0x98, 0x0a, 0x14,
+	0x5a, 0xce, 0xfa, 0x5b, 0x03, 0x90, 0x68, 0xdd, 0xc1, 0xfb, 0x13, 0x89, 0xc7, 0x73, 0x50, 0x08,
+	0x09, 0x72, 0x33, 0x37, 0x42, 0x23, 0xb0, 0xea, 0x89, 0x44, 0x46, 0x5b, 0xf4, 0x99, 0xa9, 0x16,
+	0xdd, 0xfa, 0x2a, 0x2c, 0x68, 0xa4, 0x4f, 0x3f, 0xfb, 0xbf, 0x33, 0x44, 0x17, 0xf7, 0x42, 0xbc,
+	0xe3, 0x4d, 0x36, 0xfd, 0xf3, 0x50, 0xec, 0x53, 0xec, 0x91, 0xf3, 0xe7, 0xf5, 0x9f, 0x39, 0x03,
+	0xae, 0xc3, 0xa2, 0x4e, 0xfd, 0xf4, 0x1c, 0xf8, 0xa1, 0x01, 0xf5, 0x0f, 0x9c, 0xb8, 0xbd, 0xfb,
+	0x0e, 0x3e, 0x98, 0x68, 0xf6, 0xfc, 0x4e, 0x9d, 0x1b, 0x77, 0xa7, 0xd6, 0xe6, 0x94, 0x9f, 0x6e,
+	0x4e, 0x6f, 0x42, 0x23, 0xa1, 0x67, 0xfa, 0xf9, 0xfc, 0x85, 0x21, 0x78, 0xb2, 0x1e, 0xf8, 0x71,
+	0x18, 0x74, 0x8f, 0xac, 0xf0, 0x5e, 0x80, 0xa2, 0xd3, 0x56, 0x2e, 0x07, 0x6c, 0x50, 0xd6, 0xf7,
+	0x75, 0x5a, 0x61, 0x73, 0x84, 0x63, 0xad, 0xe1, 0x1a, 0x2c, 0xa5, 0xe8, 0x9d, 0x7e, 0xd2, 0x8b,
+	0x80, 0xee, 0x78, 0x51, 0xbc, 0x4e, 0xa7, 0x13, 0xf1, 0x19, 0x5b, 0xbf, 0x6f, 0xc0, 0x1c, 0xef,
+	0x9a, 0x56, 0x8c, 0x67, 0xc1, 0x39, 0xa8, 0xb5, 0x03, 0xdf, 0xc7, 0x6d, 0x79, 0xe1, 0x67, 0x86,
+	0x78, 0x55, 0x42, 0xa9, 0x75, 0xb8, 0x0c, 0xc5, 0xef, 0x0e, 0xf0, 0x00, 0xbb, 0xdc, 0x1a, 0xe7,
+	0x25, 0x6a, 0xaf, 0x84, 0x41, 0xbf, 0x8f, 0x5d, 0xca, 0x80, 0x19, 0x5b, 0x14, 0x49, 0x8b, 0xbe,
+	0x33, 0x88, 0xa4, 0x21, 0xc3, 0x4b, 0xd6, 0x1a, 0x2c, 0x68, 0x44, 0xf3, 0x69, 0xbf, 0x08, 0xb3,
+	0x8c, 0xa6, 0x88, 0x5e, 0x25, 0x2b, 0x1a, 0xdf, 0x19, 0xb2, 0x2d, 0x30, 0xac, 0xdf, 0xc8, 0x01,
+	0x6c, 0xe2, 0x58, 0xac, 0xf1, 0x8b, 0x63, 0xec, 0x3a, 0xe9, 0xcd, 0xe1, 0x28, 0xfa, 0xa2, 0xe5,
+	0xa6, 0x3e, 0x6e, 0xbc, 0x9d, 0x96, 0x70, 0x3c, 0x8c, 0x30, 0x66, 0xca, 0xde, 0xce, 0x7d, 0x86,
+	0x81, 0x4e, 0x12, 0xee, 0x1c, 0xb4, 0xc2, 0x81, 0xcf, 0x6f, 0x57, 0x45, 0x37, 0x3c, 0xb0, 0x07,
+	0xd4, 0x26, 0xef, 0xe1, 0xb0, 0x83, 0x5b, 0x8a, 0x21, 0x43, 0xef, 0x67, 0x14, 0x7a, 0x57, 0x71,
+	0xf9, 0x84, 0x78, 0x27, 0xc4, 0xd1, 0x6e, 0x2b, 0x8e, 0xbb, 0xc2, 0xe5, 0xc3, 0x41, 0x5b, 0x71,
+	0xd7, 0x7a, 0x1d, 0x2a, 0x94, 0x0f, 0xd3, 0xcb, 0xce, 0x7f, 0xe7, 0xa1, 0xfa, 0x3e, 0xf5, 0xe9,
+	0x08, 0x2e, 0x4e, 0xe2, 0x35, 0x5b, 0x19, 0xe9, 0x35, 0x13, 0xde, 0xb2, 0x65, 0xdd, 0xd6, 0x3b,
+	0xba, 0x97, 0xec, 0xda, 0x90, 0x95, 0xb7, 0x42, 0x1b, 0x68, 0x44, 0xff, 0xac, 0x9d, 0x65, 0xc2,
+	0x13, 0x56, 0x56, 0x3c, 0x61, 0x67, 0x81, 0x3b, 0xcb, 0x5a, 0x3d, 0x27, 0xda, 0xe3, 0x4e, 0x32,
+	0x60, 0xa0, 0xbb, 0x4e, 0xb4, 0xa7, 0x4b, 0x60, 0x65, 0x2a, 0x09, 0x3c, 0x9e, 0x09, 0x7b, 0x15,
+	0x6a, 0x82, 0x7b, 0xd3, 0x0b, 0xcc, 0xdf, 0x18, 0xd0, 0xb8, 0xed, 0xb7, 0x43, 0xdc, 0x23, 0x5b,
+	0x71, 0x0a, 0x99, 0xb9, 0xc0, 0x2f, 0xdc, 0xfc, 0x8a, 0x90, 0x85, 0x27, 0x10, 0x08, 0xed, 0x2e,
+	0xee, 0xc6, 0x0e, 0x17, 0x1e, 0x56, 0x38, 0x96, 0x9e, 0xdd, 0x82, 0x79, 0x85, 0x6a, 0x3e, 0x6d,
+	0xc9, 0x22, 0x43, 0x71, 0x6d, 0x29, 0xcc, 0xc8, 0x1d, 0xc6, 0x8c, 0x9f, 0x18, 0x50, 0xdb, 0xc4,
+	0xf1, 0x5d, 0xc7, 0x97, 0xa7, 0xe7, 0x2a, 0xcc, 0xb2, 0x4a, 0xa1, 0xc0, 0x86, 0xb5, 0xd0, 0xb7,
+	0x0d, 0x5b, 0xe0, 0xa0, 0x17, 0x61, 0x3e, 0xc4, 0xe4, 0x67, 0xcb, 0x1d, 0xf4, 0xbb, 0x5e, 0xdb,
+	0x89, 0xb1, 0xf0, 0xe6, 0x34, 0x58, 0xc5, 0x0d, 0x09, 0x27, 0x9b, 0xca, 0x89, 0x83, 0x9e, 0xd7,
+	0x16, 0x97, 0x04, 0x56, 0x3a, 0x16, 0x63, 0xbe, 0x02, 0x75, 0x39, 0x83, 0x44, 0x07, 0xeb, 0x53,
+	0xc8, 0xe0, 0x80, 0xc0, 0xb0, 0x3e, 0x82, 0xda, 0xbd, 0x20, 0xf2, 0xc8, 0x41, 0xc8, 0x84, 0xea,
+	0xc9, 0xba, 0xdd, 0xad, 0x4f, 0x0d, 0x30, 0xd7, 0x06, 0xdd, 0x3d, 0xd6, 0xb9, 0x18, 0x4a, 0x9c,
+	0x72, 0xe8, 0x15, 0x98, 0x65, 0x5b, 0x4a, 0xd0, 0xba, 0xc0, 0xbb, 0x52, 0x49, 0x4a, 0xd8, 0xce,
+	0x71, 0x8f, 0xa3, 0xfd, 0xad, 0x0e, 0x9c, 0xce, 0x24, 0xe8, 0x08, 0xdc, 0x23, 0x67, 0xb2, 0x1f,
+	0xc4, 0xad, 0x1d, 0x7a, 0x35, 0x62, 0xe6, 0x47, 0xc9, 0x0f, 0xe2, 0xb7, 0x49, 0xd9, 0x7a, 0x00,
+	0xb0, 0xbe, 0x79, 0x7f, 0x3d, 0xe8, 0x0e, 0x7a, 0xcc, 0x3f, 0x96, 0xda, 0xe1, 0x0d, 0xf6, 0x54,
+	0xc3, 0xf6, 0x37, 0xf9, 0x49, 0x21, 0xfc, 0x44, 0x2a, 0xd3, 0xa7, 0x17, 0x45, 0x0f, 0x33, 0x7f,
+	0x16, 0x2f, 0x91, 0x7b, 0xa5, 0xa6, 0x56, 0xcb, 0x89, 0xd2, 0xb4, 0xfe, 0x9d, 0x6c, 0xf1, 0x5e,
+	0x3f, 0x08, 0xe3, 0xf5, 0xcd, 0xfb, 0x82, 0xd1, 0x4d, 0xc8, 0xb7, 0xa3, 0x07, 0x7c, 0x55, 0x29,
+	0x3f, 0x3f, 0x34, 0x6c, 0x02, 0x22, 0x43, 0xec, 0x62, 0xc7, 0xe5, 0xfb, 0xba, 0x64, 0xf3, 0x12,
+	0x7a, 0x81, 0x6c, 0x78, 0x4a, 0x7b, 0x33, 0xaf, 0x78, 0xa7, 0x92, 0x29, 0xd9, 0xa2, 0x9e, 0x9c,
+	0x83, 0x2e, 0xde, 0x71, 0x06, 0xdd, 0xb8, 0xa5, 0x50, 0x9b, 0xb7, 0xab, 0x1c, 0x6a, 0x33, 0xa2,
+	0x95, 0x73, 0xb4, 0xa0, 0x9d, 0xa3, 0xc7, 0xb8, 0x3b, 0x5a, 0xaf, 0x41, 0x85, 0x4c, 0x33, 0x78,
+	0x78, 0x33, 0x0c, 0x83, 0x90, 0xa8, 0x72, 0xea, 0xe3, 0x67, 0x2a, 0x81, 0xfe, 0x26, 0x7a, 0x02,
+	0x93, 0x4a, 0xa1, 0x4a, 0x69, 0xc1, 0xfa, 0x7f, 0x30, 0xaf, 0x70, 0x89, 0xaf, 0xbe, 0x09, 0x25,
+	0x8f, 0x02, 0xb1, 0xcb, 0xbb, 0x90, 0x65, 0x72, 0x73, 0xa0, 0x2d, 0x85, 0x8f, 0xba, 0x21, 0xf8,
+	0x21, 0x06, 0xb7, 0x79, 0xbd, 0xf5, 0xaf, 0x06, 0xd4, 0x36, 0x30, 0xf1, 0xf6, 0x4a, 0x41, 0x3f,
+	0x07, 0x85, 0xae, 0xd7, 0xf3, 0x98, 0x86, 0xce, 0x30, 0x37, 0x58, 0x2d, 0x75, 0x55, 0x0e, 0xc2,
+	0x48, 0xd2, 0xca, 0x4b, 0xc7, 0xb1, 0xc9, 0x89, 0x71, 0x17, 0x62, 0x62, 0xed, 0x60, 0x6e, 0xbe,
+	0x88, 0x22, 0x59, 0x10, 0xec, 0xbb, 0xd4, 0x7d, 0xcd, 0x3d, 0xa3, 0xd8, 0x77, 0x89, 0x8f, 0xfa,
+	0xf3, 0x30, 0x17, 0x62, 0xc7, 0x6d, 0x45, 0x38, 0xa2, 0x36, 0x12, 0xf3, 0x90, 0x56, 0x08, 0x6c,
+	0x93, 0x81, 0xac, 0xb7, 0xa1, 0x2e, 0xa7, 0xc8, 0x99, 0x27, 0xec, 0x70, 0x43, 0xb1, 0xc3, 0xcf,
+	0x42, 0xc5, 0xc7, 0xfb, 0x71, 0x4b, 0x9b, 0x15, 0x10, 0xd0, 0x3a, 0x85, 0x58, 0x7f, 0x6c, 0xc0,
+	0xe2, 0x06, 0x8e, 0xd9, 0x1d, 0x48, 0xe5, 0x58, 0x72, 0x51, 0x33, 0x0e, 0xb9, 0xa8, 0x1d, 0xc7,
+	0x16, 0x94, 0xeb, 0x92, 0x1f, 0xb7, 0x2e, 0xd6, 0x8b, 0xb0, 0x94, 0x22, 0x72, 0xf4, 0x9c, 0xad,
+	0x03, 0x58, 0xd8, 0xc0, 0x31, 0xbd, 0xd6, 0xaa, 0x13, 0x92, 0x17, 0x6f, 0x63, 0xfc, 0xc5, 0xfb,
+	0x38, 0xca, 0xed, 0x02, 0x2c, 0xea, 0x43, 0x8f, 0x21, 0x73, 0x1f, 0xe6, 0xe8, 0xe3, 0x91, 0xa0,
+	0x6f, 0x51, 0xa3, 0x4f, 0x50, 0xb3, 0xac, 0xdf, 0x97, 0xb3, 0x99, 0x3e, 0xe5, 0x2d, 0xf1, 0x1c,
+	0x7f, 0xb6, 0x52, 0x4f, 0x72, 0x6a, 0x3b, 0x88, 0x93, 0x9c, 0x16, 0xac, 0x0e, 0x54, 0x6f, 0xee,
+	0x7b, 0x91, 0xbc, 0x13, 0x21, 0x53, 0x9d, 0x85, 0x3c, 0x15, 0x28, 0xec, 0x58, 0x5c, 0xfb, 0x75,
+	0x03, 0x6a, 0x62, 0x24, 0x4e, 0xd1, 0x6b, 0x50, 0xc4, 0x14, 0xd2, 0x34, 0x14, 0x2f, 0xba, 0x8e,
+	0xc4, 0x8b, 0xcc, 0x68, 0xe5, 0xe8, 0xe6, 0x1b, 0x50, 0x51, 0xc0, 0x87, 0x19, 0x76, 0x25, 0xd5,
+	0xb0, 0x73, 0x01, 0xb6, 0xb6, 0xee, 0x7c, 0xd6, 0x93, 0xfd, 0xd4, 0x80, 0x0a, 0x1d, 0x86, 0xcf,
+	0xf4, 0xba, 0xfe, 0xe4, 0x6b, 0x28, 0x46, 0xba, 0x82, 0x76, 0x71, 0x4b, 0x3e, 0xf9, 0xb2, 0xf9,
+	0x2a, 0x6f, 0xc0, 0xe6, 0x9b, 0x50, 0x4f, 0x55, 0x4f, 0xf5, 0x10, 0x89, 0x61, 0x66, 0x33, 0x08,
+	0xc9, 0x0d, 0x37, 0xb7, 0x7d, 0xc0, 0xdf, 0x0b, 0x99, 0xd9, 0x45, 0xc0, 0x6b, 0x07, 0x76, 0x6e,
+	0xfb, 0x00, 0x9d, 0x81, 0xb2, 0x13, 0xb5, 0xb1, 0xef, 0x92, 0xab, 0x07, 0x63, 0x5d, 0x02, 0x20,
+	0xfe, 0x6b, 0xc7, 0x6f, 0xef, 0x06, 0x61, 0x33, 0x9f, 0xb6, 0x48, 0x6c, 0x5e, 0x63, 0xfd, 0xc0,
+	0x00, 0x20, 0xb7, 0x82, 0x0f, 0x3c, 0xdf, 0x0d, 0x1e, 0xa2, 0x37, 0x01, 0x89, 0x17, 0x72, 0x67,
+	0x87, 0xbc, 0x1f, 0xd3, 0xdb, 0xc1, 0x08, 0xf5, 0xdc, 0xe0, 0xa8, 0xd7, 0x09, 0x26, 0xbd, 0x33,
+	0xbc, 0x05, 0x0b, 0xa2, 0xf9, 0x36, 0xde, 0x09, 0x42, 0xac, 0x5c, 0xbb, 0x87, 0xdb, 0xcf, 0x73,
+	0xdc, 0x35, 0x8a, 0x4a, 0x9d, 0x98, 0xbf, 0x92, 0x07, 0xd8, 0x48, 0x6e, 0xbf, 0x59, 0xca, 0xd3,
+	0x86, 0x79, 0x71, 0xaa, 0xb7, 0x22, 0xdc, 0xc5, 0xed, 0x98, 0xaa, 0x50, 0xb2, 0x40, 0xe7, 0xb8,
+	0xaf, 0x3c, 0x4e, 0x5f, 0xa1, 0x36, 0x39, 0x1e, 0x5b, 0xa5, 0x46, 0x2f, 0x05, 0x3e, 0xd6, 0x49,
+	0xf2, 0x0c, 0xcc, 0x44, 0x41, 0x18, 0xf3, 0x9b, 0x5f, 0x59, 0x2e, 0x91, 0x4d, 0xc1, 0x43, 0xa7,
+	0x46, 0x61, 0xe8, 0xd4, 0x20, 0x6f, 0x08, 0x0f, 0x29, 0xfb, 0x9b, 0x45, 0xc5, 0xa6, 0x48, 0x56,
+	0xc5, 0xe6, 0xd5, 0xe9, 0x3b, 0xf3, 0x6c, 0xfa, 0xce, 0x6c, 0xae, 0xc3, 0x52, 0xe6, 0x94, 0xa7,
+	0xba, 0x4a, 0x3d, 0x32, 0xa0, 0xb2, 0xa1, 0xdc, 0xbc, 0x5f, 0x4b, 0x1b, 0x7f, 0xcf, 0x24, 0x6c,
+	0xe6, 0xfb, 0x80, 0x19, 0x82, 0x7c, 0x13, 0x4c, 0x64, 0x08, 0x52, 0x93, 0x32, 0x74, 0x71, 0x48,
+	0xdd, 0x2e, 0x23, 0x4d, 0x4a, 0x86, 0x61, 0xde, 0x85, 0x39, 0x75, 0x88, 0x8c, 0xe9, 0x3c, 0xaf,
+	0x4e, 0x27, 0xb3, 0x33, 0x65, 0x86, 0x3f, 0xcd, 0x43, 0x5d, 0x9c, 0x08, 0xd3, 0x1e, 0x44, 0xf2,
+	0x6c, 0xcc, 0x4d, 0x68, 0xb3, 0xe4, 0x35, 0x9b, 0xe5, 0x83, 0x2c, 0xe9, 0x65, 0x0f, 0x42, 0x17,
+	0x12, 0xb6, 0x26, 0x74, 0x1d, 0x4d, 0x84, 0x0b, 0x47, 0x13, 0xe1, 0xe2, 0x64, 0x22, 0x3c, 0x3b,
+	0x4e, 0x84, 0x4b, 0xe3, 0x45, 0x38, 0x31, 0x60, 0xca, 0x29, 0x3e, 0x5f, 0xd6, 0x0d, 0x98, 0x27,
+	0x23, 0xcb, 0xbf, 0x99, 0x83, 0x46, 0xc2, 0x51, 0x2e, 0xd0, 0xd7, 0xd2, 0x02, 0x6d, 0xa5, 0x38,
+	0x3f, 0x56, 0xaa, 0x0f, 0x33, 0xde, 0xa6, 0x92, 0x6c, 0xa2, 0xc1, 0xe3, 0x70, 0xe0, 0x93, 0xbb,
+	0xb0, 0xcb, 0x2d, 0xd1, 0x04, 0xf0, 0xa4, 0xe5, 0xfe, 0x57, 0xf3, 0xd0, 0x90, 0x16, 0xdb, 0xf4,
+	0x26, 0xe5, 0x87, 0xa3, 0x35, 0xef, 0x8b, 0x82, 0x83, 0x5a, 0xdf, 0xbf, 0x60, 0xfa, 0xf7, 0xc9,
+	0x88, 0xe4, 0x3f, 0x18, 0x30, 0xaf, 0x30, 0x8a, 0xcb, 0xe4, 0x9b, 0x69, 0x99, 0xfc, 0x42, 0x9a,
+	0xa3, 0x63, 0x85, 0x52, 0x91, 0xb9, 0xdc, 0xd3, 0xd6, 0xa6, 0xbf, 0x9d, 0xa3, 0x17, 0xbb, 0x8d,
+	0x6e, 0xb0, 0x2d, 0x64, 0xea, 0x02, 0xcc, 0xf6, 0x9d, 0x38, 0xc6, 0xa1, 0x3f, 0x52, 0xa8, 0x04,
+	0x02, 0xba, 0x3f, 0x5a, 0xaa, 0x5e, 0x10, 0x3c, 0x50, 0xfa, 0x7e, 0x1a, 0x32, 0xf5, 0x64, 0x16,
+	0xfa, 0x0f, 0x0c, 0xa8, 0x4b, 0xda, 0xf9, 0x32, 0x5f, 0x4d, 0x2f, 0xf3, 0xe7, 0xf5, 0x29, 0x8e,
+	0x5b, 0xe4, 0x27, 0xbd, 0x6e, 0xdf, 0xa7, 0xca, 0x60, 0xcb, 0xe9, 0x74, 0xb0, 0x2b, 0x16, 0xee,
+	0x22, 0x14, 0x77, 0xe8, 0x53, 0x5e, 0xd3, 0xc8, 0x7a, 0xe0, 0x4b, 0x5e, 0x1c, 0x18, 0xd6, 0xb1,
+	0x6c, 0xee, 0x3f, 0x62, 0x1b, 0x41, 0x10, 0x70, 0xe8, 0x46, 0xd0, 0x11, 0x9f, 0x0e, 0x8f, 0x5a,
+	0x50, 0xbd, 0x81, 0xbb, 0x38, 0xc6, 0xe3, 0x2c, 0xd2, 0xe3, 0x30, 0xa1, 0x01, 0x35, 0x31, 0x00,
+	0x9b, 0x97, 0xf5, 0x09, 0x2c, 0x30, 0xc8, 0x51, 0xd5, 0xf4, 0x71, 0xc8, 0xb9, 0x0c, 0x8b, 0xfa,
+	0xe0, 0x7c, 0x55, 0x94, 0xd8, 0x1d, 0x76, 0x1b, 0x15, 0x45, 0x6b, 0x1f, 0x90, 0x98, 0xc0, 0x11,
+	0xac, 0xa9, 0xe3, 0xd0, 0x7a, 0x09, 0x16, 0xb4, 0x91, 0x0f, 0x25, 0x75, 0x59, 0x4c, 0xee, 0x26,
+	0x7d, 0xe6, 0x10, 0x42, 0x6f, 0xbd, 0x0c, 0x4b, 0x29, 0xf8, 0xa1, 0x5d, 0x7d, 0x1f, 0xd0, 0x66,
+	0xdb, 0xf1, 0xb9, 0xa8, 0x89, 0x59, 0x2f, 0xeb, 0x6b, 0x24, 0x57, 0x64, 0x51, 0x8b, 0x2e, 0xc8,
+	0x9c, 0x7b, 0x7e, 0xfa, 0x38, 0x01, 0x75, 0xfc, 0xe9, 0xdf, 0x3c, 0xfe, 0xd4, 0x80, 0x06, 0xe9,
+	0x82, 0xc5, 0xab, 0xf0, 0x09, 0xc8, 0x88, 0x16, 0x63, 0x54, 0x44, 0xcb, 0x51, 0xe3, 0x68, 0x8e,
+	0xe3, 0xce, 0x27, 0x8a, 0x42, 0x21, 0x75, 0xbc, 0xa2, 0x18, 0x42, 0x7c, 0x3a, 0x8a, 0xe2, 0xaf,
+	0x0c, 0x58, 0x26, 0x43, 0xb3, 0x7d, 0x33, 0x25, 0x53, 0x47, 0xb9, 0x93, 0x3e, 0x6b, 0xc6, 0xfe,
+	0xb9, 0x01, 0x27, 0x87, 0x88, 0xe6, 0xec, 0x5d, 0x4f, 0xb3, 0xf7, 0x05, 0xc9, 0xde, 0x0c, 0xf4,
+	0xa7, 0xc3, 0xe4, 0x1f, 0x1b, 0xb0, 0x44, 0x08, 0xa0, 0x1b, 0x7e, 0x4a, 0x1e, 0x67, 0xef, 0xc1,
+	0xcf, 0x9a, 0xc3, 0x7f, 0xc6, 0xc5, 0x42, 0xa5, 0x98, 0x33, 0x78, 0x2d, 0xcd, 0xe0, 0xf3, 0x92,
+	0xc1, 0xc3, 0xd8, 0x4f, 0x87, 0xbf, 0x3f, 0xca, 0xc1, 0x22, 0x19, 0xff, 0x76, 0x14, 0xb4, 0x77,
+	0xc3, 0xc0, 0x97, 0xa7, 0x9e, 0x12, 0xbc, 0x68, 0x8c, 0x0e, 0x5e, 0x9c, 0x24, 0x5e, 0x92, 0x45,
+	0x6f, 0x3f, 0xc0, 0x89, 0x6f, 0x2d, 0xcf, 0x23, 0x76, 0x29, 0x54, 0x24, 0x50, 0xa4, 0xc2, 0xe5,
+	0x67, 0x0e, 0x0f, 0x97, 0x17, 0x2b, 0x59, 0x98, 0x74, 0x25, 0xa7, 0x7c, 0x52, 0xf9, 0x47, 0x2e,
+	0x7b, 0x0a, 0x6f, 0xa4, 0xaf, 0x30, 0xb5, 0x90, 0xcf, 0xcb, 0x85, 0x1c, 0x42, 0x1e, 0x61, 0xbe,
+	0x2b, 0xfc, 0xcd, 0x8d, 0xe4, 0xef, 0x93, 0x5e, 0xed, 0xff, 0x34, 0x60, 0xe9, 0x03, 0x2f, 0xde,
+	0xf5, 0xfc, 0xf5, 0x20, 0x0c, 0x3d, 0x37, 0x08, 0x13, 0x5b, 0xa3, 0x10, 0x06, 0x03, 0x1a, 0x77,
+	0x9e, 0xcf, 0x7a, 0xc9, 0xfc, 0x76, 0xce, 0x66, 0x08, 0xe8, 0x1c, 0x14, 0xb7, 0x07, 0x3b, 0x3b,
+	0x7c, 0xc9, 0x8d, 0xb5, 0xea, 0xe3, 0x47, 0x67, 0xcb, 0x2f, 0x9f, 0xe0, 0x7f, 0x36, 0xaf, 0x9c,
+	0x68, 0x9b, 0x89, 0x5c, 0xa8, 0x99, 0xb1, 0xb9, 0x50, 0xc7, 0xf1, 0x73, 0xd0, 0xdd, 0x98, 0x9e,
+	0xf1, 0xf8, 0xdd, 0x98, 0x8d, 0xfd, 0x74, 0x76, 0xe3, 0x0f, 0x0c, 0xa8, 0xdf, 0xe3, 0x29, 0x38,
+	0xd3, 0xaf, 0xcc, 0xe4, 0x49, 0x60, 0x13, 0x26, 0xa1, 0xf9, 0xd0, 0x48, 0xa8, 0x49, 0x5e, 0x06,
+	0x65, 0x24, 0xaf, 0x91, 0x8a, 0xe4, 0x7d, 0x16, 0x66, 0x7d, 0xec, 0x84, 0x38, 0xca, 0x20, 0xc1,
+	0x16, 0x55, 0xc4, 0xc4, 0x8a, 0x70, 0xa7, 0x87, 0x7d, 0x91, 0x0b, 0x21, 0x8a, 0xd6, 0x5f, 0xe7,
+	0xa0, 0x4a, 0x75, 0xa0, 0x34, 0xaf, 0x7e, 0x01, 0x22, 0x5b, 0x3f, 0x73, 0x35, 0xf5, 0x7b, 0x06,
+	0xd4, 0x04, 0xd7, 0xf8, 0x22, 0x7d, 0x39, 0x2d, 0xda, 0x2b, 0xc9, 0xe9, 0x18, 0x3d, 0x5d, 0x91,
+	0xfe, 0x9f, 0x1c, 0xd4, 0xde, 0x65, 0x2b, 0x9f, 0xb8, 0x0a, 0x46, 0xa6, 0x4f, 0x26, 0xb7, 0x4d,
+	0x86, 0x81, 0x16, 0xc1, 0xd8, 0xe3, 0x7e, 0x57, 0x91, 0xa9, 0x68, 0xec, 0x3d, 0x49, 0xe5, 0x92,
+	0xe9, 0x8b, 0x28, 0x28, 0xe6, 0x8f, 0x4e, 0xfc, 0xd1, 0x7c, 0x11, 0xc5, 0x9f, 0x81, 0x2f, 0xe2,
+	0x3e, 0x54, 0x39, 0xe9, 0x6c, 0x69, 0xa6, 0xb8, 0x29, 0x8c, 0xcb, 0xe3, 0xb1, 0xde, 0x82, 0xba,
+	0x64, 0x09, 0x17, 0xb7, 0x97, 0xd2, 0xe2, 0x86, 0x54, 0xce, 0xb1, 0x11, 0x92, 0x50, 0x9b, 0x17,
+	0xa9, 0x8f, 0x84, 0x29, 0x05, 0x19, 0x95, 0x21, 0xb3, 0x54, 0x0c, 0x2d, 0xbf, 0xc9, 0xfa, 0x12,
+	0x34, 0x12, 0x64, 0x3e, 0x9c, 0x0c, 0xdb, 0x33, 0x46, 0x84, 0xed, 0x59, 0xff, 0x92, 0x83, 0x2a,
+	0x0b, 0xb6, 0x38, 0x8a, 0xcc, 0x9d, 0x83, 0x22, 0xcf, 0xa1, 0x54, 0x4e, 0xb8, 0xdb, 0xc9, 0x09,
+	0xc7, 0x2a, 0x27, 0x12, 0xc2, 0xf7, 0x47, 0xfb, 0xfe, 0xd9, 0x69, 0xa3, 0x51, 0xf9, 0x34, 0x3c,
+	0xff, 0x4f, 0x46, 0xb8, 0xbe, 0x02, 0x35, 0x41, 0xf9, 0x91, 0x64, 0xe0, 0xd7, 0x68, 0x64, 0x08,
+	0xcd, 0x8f, 0x4d, 0x42, 0xa0, 0x74, 0x6f, 0xc7, 0x33, 0x8f, 0x1f, 0x9d, 0x3d, 0x05, 0x27, 0x3f,
+	0xfa, 0xe6, 0xe5, 0xd5, 0x37, 0xb6, 0x57, 0x77, 0xbf, 0xb3, 0xd7, 0xf3, 0xfb, 0xab, 0x1f, 0x7f,
+	0xeb, 0x93, 0x97, 0x5f, 0x7a, 0xf9, 0xca, 0x13, 0x72, 0x7d, 0x30, 0x77, 0x1d, 0xa7, 0xe2, 0x30,
+	0x77, 0x9d, 0x86, 0xf6, 0x74, 0x74, 0xe7, 0xef, 0x18, 0x50, 0xe3, 0x29, 0xc2, 0xd3, 0x84, 0x28,
+	0x4e, 0xf8, 0x5e, 0x75, 0x1c, 0x67, 0xc4, 0xff, 0x87, 0x39, 0x4e, 0x18, 0x4b, 0xb7, 0x3f, 0x74,
+	0x4b, 0x0e, 0x25, 0x62, 0xe7, 0x86, 0x13, 0xb1, 0x33, 0x12, 0x81, 0xf2, 0x99, 0x89, 0x40, 0xd7,
+	0xa0, 0x2e, 0xd9, 0x92, 0xb8, 0x41, 0xe8, 0x38, 0x7a, 0xb4, 0x9a, 0x4a, 0xa3, 0xcd, 0x11, 0xac,
+	0x3f, 0x34, 0x48, 0xac, 0x1f, 0xb5, 0xaf, 0x13, 0x1f, 0x68, 0xe9, 0x01, 0x0e, 0x63, 0xaf, 0x2d,
+	0xe3, 0xef, 0x86, 0xcd, 0xac, 0xbc, 0x2d, 0x71, 0xe4, 0xd6, 0xcf, 0x4d, 0x7a, 0xa4, 0xe7, 0xa7,
+	0x17, 0x4c, 0x49, 0xe2, 0x78, 0xc1, 0x4c, 0xa1, 0x3d, 0x35, 0xc1, 0x5c, 0xbe, 0x17, 0x06, 0xfb,
+	0x44, 0x8e, 0x0e, 0xee, 0x3a, 0x71, 0xe8, 0xed, 0x4f, 0x12, 0xae, 0x21, 0x8e, 0xe4, 0xdc, 0x14,
+	0xf6, 0xfe, 0x94, 0x9c, 0x7b, 0x09, 0xe6, 0x24, 0x61, 0x76, 0xf0, 0x90, 0x3c, 0xb6, 0x89, 0x93,
+	0x8b, 0xd1, 0x64, 0xd8, 0x09, 0xc0, 0xda, 0x82, 0x93, 0x43, 0xd3, 0x18, 0x13, 0xc4, 0x75, 0x8e,
+	0xa4, 0xbb, 0x3f, 0x8c, 0xb4, 0xf7, 0x16, 0x75, 0x34, 0x9b, 0x56, 0x5b, 0x7f, 0x62, 0xc0, 0x12,
+	0x35, 0xb5, 0x3c, 0xbf, 0xb3, 0xee, 0x85, 0xed, 0xee, 0x58, 0x57, 0xf2, 0x28, 0x4f, 0xd0, 0x64,
+	0x36, 0xfa, 0x31, 0xa3, 0x89, 0x97, 0xd3, 0x74, 0xf2, 0xd9, 0x1f, 0xe3, 0x0b, 0x17, 0xd6, 0x3f,
+	0xe5, 0xa0, 0x71, 0xbd, 0xd3, 0x09, 0x71, 0xc7, 0x89, 0x8f, 0x34, 0x73, 0xe9, 0xb7, 0xc9, 0x67,
+	0xf9, 0x6d, 0x66, 0xc6, 0xec, 0xb9, 0x0f, 0x47, 0x1b, 0x73, 0xec, 0xb9, 0x32, 0x4d, 0xd7, 0xcf,
+	0x8f, 0x39, 0x17, 0xc1, 0xbc, 0x42, 0xfc, 0xb8, 0x78, 0x31, 0xf2, 0x8d, 0x07, 0xb2, 0x44, 0x61,
+	0xe0, 0xb9, 0x19, 0xf7, 0x30, 0x59, 0x87, 0x56, 0xa0, 0x48, 0x1d, 0x65, 0xc2, 0x84, 0x49, 0x72,
+	0x1d, 0x39, 0xdc, 0xfa, 0xe7, 0x1c, 0xd4, 0xd6, 0xbb, 0x83, 0x88, 0x70, 0x58, 0xba, 0xf9, 0xcb,
+	0xfd, 0x10, 0xb7, 0x3d, 0xfa, 0xb2, 0x4a, 0x86, 0x2d, 0xac, 0x95, 0x1e, 0x3f, 0x3a, 0x3b, 0xd3,
+	0x38, 0xd1, 0xac, 0xda, 0x49, 0x95, 0xd2, 0x79, 0x2e, 0xbb, 0xf3, 0x89, 0xec, 0xa7, 0xfb, 0xa3,
+	0xed, 0x27, 0x66, 0x9d, 0xeb, 0xd4, 0xfd, 0xfc, 0x18, 0x50, 0xbf, 0x04, 0xb3, 0x9c, 0x74, 0xf5,
+	0xcb, 0x21, 0x86, 0xfe, 0xe5, 0x90, 0x33, 0x30, 0xd3, 0xc6, 0xf4, 0xdb, 0x13, 0x3a, 0x07, 0x29,
+	0x34, 0x59, 0xfc, 0xfc, 0xa8, 0xc5, 0x9f, 0x19, 0xbd, 0xf8, 0xd6, 0xd7, 0xa1, 0x2e, 0x79, 0xc7,
+	0xa5, 0xe9, 0x3c, 0x94, 0xda, 0x0c, 0x24, 0x8e, 0x98, 0x39, 0x8d, 0xc7, 0xb2, 0x96, 0x0c, 0x1d,
+	0x07, 0xb1, 0xd3, 0x15, 0x31, 0x6c, 0xb4, 0x60, 0xed, 0x03, 0xdc, 0xc0, 0x8e, 0x7b, 0x07, 0xc7,
+	0x31, 0x8d, 0x9b, 0x9e, 0xf8, 0xba, 0x41, 0x34, 0x09, 0x76, 0x22, 0x7e, 0x67, 0x2f, 0xdb, 0xbc,
+	0x34, 0xb9, 0x41, 0x70, 0x0b, 0x2a, 0xac, 0x63, 0x96, 0xca, 0x9c, 0x79, 0xba, 0xd1, 0x24, 0x65,
+	0xed, 0x74, 0xd3, 0x32, 0xd7, 0x59, 0xbd, 0xf5, 0x53, 0x83, 0x5e, 0x38, 0x28, 0x4c, 0x5e, 0x1e,
+	0x2e, 0x43, 0x25, 0x8a, 0x9d, 0x30, 0xe6, 0x34, 0x8c, 0x88, 0x8d, 0x03, 0x8a, 0x43, 0x09, 0x42,
+	0x2f, 0x41, 0x99, 0x44, 0x14, 0x33, 0xfc, 0x11, 0x66, 0x58, 0x09, 0xfb, 0x2e, 0xc3, 0xe6, 0xf4,
+	0xe6, 0x13, 0x7a, 0xa5, 0x09, 0x37, 0x33, 0xb9, 0x09, 0x57, 0x98, 0x36, 0x45, 0x71, 0x5e, 0x99,
+	0xa8, 0x14, 0x81, 0x22, 0xcf, 0xc6, 0x37, 0x94, 0xd8, 0x6e, 0x85, 0xb7, 0x36, 0xaf, 0xb7, 0xbe,
+	0x06, 0x4b, 0xeb, 0x21, 0x76, 0x62, 0x2c, 0xb2, 0xc8, 0x05, 0xb3, 0x5e, 0x86, 0x92, 0x48, 0xd7,
+	0xe7, 0x2b, 0x5f, 0xd5, 0xf2, 0xd9, 0xe5, 0x75, 0x4b, 0xa2, 0x59, 0xeb, 0xb0, 0x9c, 0xee, 0x4b,
+	0x9a, 0x75, 0xe3, 0x3b, 0x53, 0x3a, 0x79, 0x53, 0x3c, 0xe9, 0xa5, 0x09, 0x9a, 0x28, 0xf3, 0xdf,
+	0x6a, 0xc2, 0x72, 0xba, 0x39, 0x7f, 0x9d, 0x5d, 0x86, 0x45, 0x92, 0xe2, 0x27, 0xe0, 0x32, 0x33,
+	0xf1, 0x06, 0x2c, 0xa5, 0xe0, 0x32, 0x75, 0xa2, 0x2c, 0xa8, 0x12, 0x7c, 0x4c, 0x51, 0x9d, 0xd4,
+	0x5b, 0x5f, 0x83, 0xe5, 0xf7, 0xfa, 0xd8, 0xb7, 0x93, 0xe0, 0x14, 0x45, 0xea, 0xf4, 0x80, 0xd4,
+	0xc3, 0xbe, 0x41, 0x64, 0x5d, 0x82, 0x93, 0x43, 0x7d, 0x25, 0x27, 0x45, 0x1c, 0xec, 0x61, 0x5f,
+	0x04, 0x35, 0xd3, 0x82, 0x75, 0x1d, 0x4e, 0xae, 0x77, 0x83, 0x08, 0x67, 0x8c, 0xfe, 0x9c, 0xd6,
+	0x20, 0xeb, 0x39, 0x97, 0x75, 0x61, 0x42, 0x73, 0xb8, 0x0b, 0xce, 0xb9, 0x55, 0x1a, 0x2d, 0x9e,
+	0xe8, 0x84, 0x48, 0x09, 0xb1, 0x56, 0xb2, 0x00, 0x44, 0x70, 0xf9, 0x1d, 0x58, 0x4e, 0xa3, 0x73,
+	0xea, 0xaf, 0xc0, 0x9c, 0x4b, 0x22, 0x7a, 0xba, 0x0c, 0xce, 0x99, 0xca, 0x3f, 0x13, 0x22, 0xf1,
+	0xed, 0x8a, 0x9b, 0xb4, 0xb5, 0xaa, 0x50, 0xb9, 0x47, 0x72, 0xf0, 0xf8, 0x6a, 0x7d, 0x0e, 0xe6,
+	0x58, 0x91, 0x77, 0x59, 0x83, 0x5c, 0xb0, 0x47, 0xc7, 0x2f, 0xd9, 0xb9, 0x60, 0x8f, 0xc4, 0x62,
+	0xaf, 0x39, 0xed, 0xbd, 0x41, 0x5f, 0xa1, 0x91, 0x66, 0xda, 0x53, 0x9c, 0x19, 0x9b, 0x15, 0xc8,
+	0xc5, 0x57, 0xa0, 0x25, 0x86, 0x22, 0x4d, 0x3f, 0x21, 0x68, 0x73, 0x36, 0xfd, 0xad, 0x7e, 0xcf,
+	0x29, 0x47, 0x5b, 0x8b, 0xa2, 0xf5, 0x2c, 0xd4, 0x6c, 0x4c, 0x2e, 0x25, 0xaa, 0x65, 0x94, 0x6e,
+	0x6f, 0xcd, 0x43, 0x5d, 0x62, 0x71, 0x5e, 0xde, 0x82, 0xf2, 0xc6, 0xba, 0x68, 0x73, 0x95, 0x7e,
+	0xc3, 0xa7, 0xed, 0x84, 0x6e, 0x2b, 0x74, 0x62, 0x2f, 0x50, 0x9d, 0xa3, 0x6f, 0x30, 0x37, 0xc5,
+	0x7f, 0xbd, 0x95, 0x78, 0x2c, 0xe6, 0x38, 0xb2, 0x4d, 0x70, 0xad, 0xdb, 0x00, 0x1b, 0xeb, 0xa2,
+	0x5f, 0x32, 0x7c, 0x38, 0xe0, 0x5f, 0xb3, 0xc9, 0xdb, 0xf4, 0x37, 0xd1, 0xbb, 0x21, 0x6e, 0x77,
+	0x1d, 0xaf, 0x47, 0xa2, 0x7a, 0x0f, 0x44, 0x2e, 0x57, 0xde, 0xae, 0x49, 0xf0, 0x1a, 0x81, 0x5a,
+	0x75, 0xa8, 0xde, 0xc2, 0x4e, 0x37, 0x16, 0x97, 0x78, 0xeb, 0x43, 0xa8, 0x09, 0x40, 0x36, 0x9f,
+	0xd1, 0x29, 0x28, 0x75, 0xa3, 0x5e, 0x2b, 0xf2, 0x3e, 0x16, 0x11, 0xd0, 0xb3, 0xdd, 0xa8, 0xb7,
+	0xe9, 0x7d, 0x4c, 0xbf, 0xdf, 0xf3, 0xa0, 0x1b, 0x74, 0x58, 0x1d, 0x53, 0xf4, 0x25, 0x02, 0x20,
+	0x95, 0x56, 0x8d, 0xa4, 0x01, 0x3b, 0x49, 0x5e, 0xb0, 0x0f, 0x55, 0x5e, 0xe6, 0x03, 0xa9, 0x1d,
+	0x1b, 0x63, 0x3a, 0xce, 0xe9, 0x1d, 0x93, 0xe7, 0x29, 0x1c, 0xc5, 0x5e, 0x8f, 0x5e, 0x4d, 0xa9,
+	0x8d, 0xca, 0x9f, 0xa7, 0x24, 0x94, 0x64, 0x10, 0x5c, 0xb8, 0x05, 0x73, 0xaa, 0xf5, 0x8d, 0x00,
+	0x8a, 0xec, 0x93, 0x5a, 0x8d, 0x13, 0xa8, 0x06, 0xf0, 0x8e, 0xd7, 0x65, 0xdf, 0xd9, 0x8a, 0x1a,
+	0x06, 0x2a, 0x43, 0xe1, 0xae, 0xd7, 0xc5, 0x51, 0x23, 0x87, 0xe6, 0xa1, 0xfa, 0xae, 0x33, 0x88,
+	0xbd, 0xb6, 0xd3, 0x65, 0xa0, 0xfc, 0x85, 0x6b, 0x50, 0x51, 0x3e, 0xce, 0x84, 0x2a, 0x30, 0x7b,
+	0xdd, 0x3f, 0x20, 0x9f, 0x1c, 0x62, 0x3d, 0x6d, 0xee, 0x3a, 0x21, 0x76, 0x69, 0xd9, 0x40, 0x0d,
+	0x98, 0x7b, 0x37, 0x50, 0x20, 0xb9, 0x0b, 0x6f, 0x40, 0x59, 0x7e, 0x79, 0x83, 0xb4, 0x7d, 0x6f,
+	0x10, 0x47, 0x9e, 0x8b, 0x1b, 0x27, 0xc8, 0xa8, 0x37, 0xfd, 0x18, 0x87, 0x0d, 0x83, 0x10, 0x77,
+	0x9b, 0x7e, 0x78, 0xa3, 0x91, 0x43, 0x25, 0x98, 0xb9, 0xb9, 0xef, 0xc5, 0x8d, 0xfc, 0x85, 0x35,
+	0x80, 0xe4, 0x25, 0x8d, 0xb4, 0xbd, 0x11, 0x7a, 0x0f, 0x3c, 0xbf, 0xd3, 0x38, 0x41, 0x0a, 0x1f,
+	0x38, 0x5d, 0x92, 0xa9, 0xda, 0x30, 0x50, 0x15, 0xca, 0x6b, 0x5e, 0xfb, 0xa0, 0xdd, 0x25, 0xc5,
+	0x1c, 0xa9, 0xdb, 0x0a, 0x1d, 0x3f, 0xa2, 0x7d, 0x7c, 0x09, 0xe6, 0xd4, 0xe4, 0x71, 0x82, 0xbb,
+	0x39, 0xd8, 0x8e, 0xda, 0xa1, 0xb7, 0xcd, 0x69, 0xb8, 0xe7, 0x0c, 0x22, 0xcc, 0x68, 0xb0, 0x71,
+	0x34, 0xe8, 0xe1, 0x46, 0xee, 0xc2, 0xdb, 0x50, 0x64, 0x21, 0xec, 0x68, 0x0e, 0x4a, 0xef, 0xfb,
+	0x11, 0x4d, 0x24, 0x62, 0xc3, 0x12, 0xf8, 0x3b, 0xf8, 0x80, 0xcd, 0x95, 0x14, 0x04, 0x97, 0x1b,
+	0x39, 0x54, 0x87, 0x0a, 0x81, 0xb0, 0x14, 0x35, 0xb7, 0x91, 0xbf, 0xf2, 0xbb, 0xcf, 0x40, 0x61,
+	0x03, 0x07, 0x37, 0xd6, 0xd0, 0x2a, 0xcc, 0x90, 0xed, 0x8c, 0xd8, 0x01, 0xa5, 0x6c, 0x74, 0x73,
+	0x5e, 0x81, 0xf0, 0xbd, 0x73, 0x02, 0x7d, 0x11, 0x8a, 0x4c, 0x2e, 0x11, 0x73, 0x4b, 0x69, 0x52,
+	0x6b, 0x2e, 0x68, 0x30, 0xd9, 0xe8, 0x32, 0x14, 0xa8, 0x88, 0x21, 0x91, 0xbc, 0x9d, 0x88, 0x9f,
+	0x89, 0x54, 0x90, 0x6c, 0x71, 0x01, 0xf2, 0x9b, 0x38, 0x46, 0x4c, 0x31, 0x25, 0x29, 0xdd, 0x66,
+	0x23, 0x01, 0x48, 0xdc, 0x57, 0x61, 0x96, 0x67, 0x2c, 0xa2, 0x05, 0x51, 0xad, 0x64, 0x60, 0x9a,
+	0x8b, 0x3a, 0x50, 0xb6, 0xfb, 0x06, 0x2c, 0x64, 0xe4, 0xed, 0x21, 0x96, 0x98, 0x31, 0x3a, 0xc5,
+	0xd0, 0x5c, 0x19, 0x8d, 0xa0, 0xb2, 0x89, 0x55, 0x72, 0x36, 0x69, 0xd9, 0xc9, 0xe6, 0x82, 0x06,
+	0x93, 0x8d, 0xae, 0x41, 0x59, 0xe6, 0xa4, 0xa2, 0x25, 0x8a, 0x93, 0xce, 0xac, 0x35, 0x97, 0xd3,
+	0x60, 0xad, 0xb5, 0x48, 0x3f, 0x13, 0xad, 0x53, 0x49, 0x7b, 0xe6, 0x72, 0x1a, 0xac, 0x32, 0x7c,
+	0x43, 0x32, 0x7c, 0x23, 0xcd, 0xf0, 0x0d, 0x8d, 0xe1, 0x6f, 0x40, 0x49, 0xc4, 0xfb, 0xa2, 0xc5,
+	0xac, 0xc0, 0x6b, 0x73, 0x29, 0x33, 0x28, 0x98, 0x11, 0x29, 0xc3, 0x32, 0xd1, 0x52, 0x66, 0xe0,
+	0xab, 0xb9, 0x9c, 0x06, 0xab, 0x2b, 0xcd, 0xa3, 0xfd, 0xf8, 0x4a, 0xeb, 0xe1, 0x8d, 0xe6, 0x62,
+	0x56, 0x40, 0xa0, 0x1c, 0x95, 0xc5, 0xc0, 0x25, 0xa3, 0x6a, 0xd1, 0x7b, 0xe6, 0x72, 0x1a, 0x9c,
+	0x1a, 0x95, 0xe8, 0xae, 0x64, 0x54, 0x25, 0x0d, 0xcb, 0x5c, 0xd4, 0x81, 0xb2, 0xdd, 0x4d, 0x98,
+	0x53, 0x53, 0xa7, 0x50, 0x53, 0x63, 0x8a, 0xda, 0xc3, 0xa9, 0x8c, 0x1a, 0xd9, 0xcd, 0x2d, 0xa8,
+	0x4a, 0x5e, 0xd0, 0x7e, 0x4e, 0xe9, 0xfc, 0x51, 0x3b, 0x32, 0xb3, 0xaa, 0xd4, 0x6d, 0x48, 0xb3,
+	0xa4, 0xf8, 0x36, 0x54, 0x73, 0xb5, 0x4c, 0xa4, 0x82, 0x54, 0x31, 0x66, 0xb9, 0x47, 0x5c, 0x8c,
+	0xb5, 0xec, 0x29, 0x73, 0x41, 0x83, 0xc9, 0x46, 0xab, 0x50, 0x24, 0x6c, 0xdc, 0xba, 0x83, 0xea,
+	0x49, 0xd2, 0x8f, 0x2a, 0x4d, 0x4a, 0x16, 0x10, 0x1b, 0x83, 0xd9, 0x8b, 0x7c, 0x0c, 0x2d, 0x66,
+	0xd0, 0x5c, 0xd0, 0x60, 0x2a, 0x6f, 0xd5, 0x58, 0x3b, 0xce, 0xdb, 0x8c, 0xd8, 0x3f, 0xf3, 0x54,
+	0x46, 0x8d, 0xec, 0x66, 0x0d, 0x2a, 0x4a, 0x18, 0x1c, 0x3a, 0xa9, 0x0d, 0xa6, 0xc8, 0x73, 0x73,
+	0xb8, 0x42, 0x5d, 0x1f, 0x2d, 0x02, 0x0e, 0xa9, 0x23, 0xea, 0xd1, 0x72, 0xa6, 0x99, 0x55, 0x25,
+	0x7b, 0x7a, 0x05, 0x8a, 0xec, 0x48, 0x40, 0x48, 0xf9, 0xc8, 0x85, 0xce, 0x09, 0xfd, 0xd3, 0x3e,
+	0xd6, 0x89, 0xcb, 0x06, 0xba, 0x01, 0x15, 0xe5, 0xbb, 0x37, 0x7c, 0x12, 0xc3, 0x1f, 0xf1, 0x31,
+	0x9b, 0xc3, 0x15, 0x4a, 0x2f, 0x1b, 0xe2, 0x3c, 0xd2, 0x38, 0x9a, 0xf1, 0x35, 0x1c, 0xf3, 0x54,
+	0x46, 0x8d, 0xd2, 0xd1, 0x55, 0x28, 0x89, 0x2f, 0xb6, 0x70, 0xed, 0x90, 0xfa, 0xa0, 0x8c, 0xb9,
+	0x94, 0x82, 0x2a, 0x8d, 0xef, 0x40, 0x55, 0xfb, 0xfc, 0x09, 0x52, 0x07, 0xd3, 0x3f, 0xe1, 0x62,
+	0x9a, 0x59, 0x55, 0xa2, 0xaf, 0xf3, 0xc6, 0x65, 0x03, 0xdd, 0x82, 0x79, 0x72, 0xb1, 0x50, 0x3f,
+	0x16, 0x12, 0x71, 0xfe, 0x0c, 0x7f, 0x20, 0xc5, 0x6c, 0x0e, 0x57, 0xc8, 0xa5, 0x21, 0x3c, 0x4e,
+	0x62, 0x06, 0x05, 0x8f, 0x87, 0xa2, 0x18, 0xcd, 0xe6, 0x70, 0x85, 0x32, 0xbb, 0x6b, 0x50, 0x96,
+	0x21, 0x76, 0x5c, 0x0f, 0xa5, 0xc3, 0x08, 0xcd, 0xe5, 0x34, 0x58, 0xd2, 0xf0, 0x0e, 0xd4, 0xf4,
+	0x00, 0x27, 0x64, 0x66, 0x46, 0x3d, 0xb1, 0x7e, 0x4e, 0x8f, 0x89, 0x88, 0xb2, 0x4e, 0xa0, 0x77,
+	0xa1, 0x9e, 0x0a, 0x47, 0x43, 0xa7, 0xb3, 0x83, 0xd4, 0x58, 0x77, 0x67, 0xc6, 0x45, 0xb0, 0xb1,
+	0x5d, 0xa0, 0x05, 0xed, 0x88, 0x85, 0xcb, 0x88, 0x88, 0x32, 0xcd, 0xd1, 0x31, 0x3e, 0x6c, 0x9a,
+	0x7a, 0xe4, 0x08, 0x9f, 0x66, 0x66, 0xb8, 0x8d, 0x79, 0x3a, 0xb3, 0x4e, 0x76, 0xb6, 0x0e, 0x48,
+	0x98, 0x41, 0x5b, 0x81, 0x08, 0xc1, 0xe0, 0x62, 0x99, 0x8a, 0x0f, 0x31, 0x97, 0x52, 0x50, 0xe5,
+	0xf8, 0x20, 0xef, 0xac, 0x6c, 0x8c, 0x35, 0xe6, 0x72, 0x43, 0x5a, 0x18, 0x80, 0xba, 0x41, 0xf5,
+	0xd0, 0x00, 0x76, 0x7c, 0xf0, 0xb7, 0x3b, 0x7e, 0x7c, 0xe8, 0xef, 0xe0, 0xe6, 0xa2, 0x0e, 0xcc,
+	0x1c, 0x95, 0xe7, 0xbb, 0xa3, 0xe1, 0x97, 0x4e, 0x73, 0x41, 0x83, 0xc9, 0xd6, 0xd7, 0x01, 0x6d,
+	0xe0, 0x78, 0xed, 0x80, 0x3f, 0xb7, 0xf1, 0x4d, 0xbd, 0xa0, 0x3f, 0xc1, 0xe9, 0xe7, 0x97, 0xf6,
+	0x2e, 0x47, 0x8f, 0x79, 0x92, 0x4e, 0x28, 0xbe, 0xac, 0xbb, 0xa0, 0xbe, 0x03, 0xe9, 0x4d, 0x53,
+	0x4f, 0x48, 0xd6, 0x09, 0xf4, 0x16, 0x34, 0x24, 0xed, 0xfc, 0x5d, 0x05, 0x2d, 0xe8, 0xaf, 0x2c,
+	0x6a, 0x07, 0xa9, 0xa7, 0x17, 0x69, 0x62, 0xb0, 0x27, 0x31, 0x79, 0xbe, 0xaa, 0x2f, 0xdd, 0xe6,
+	0x52, 0x0a, 0xaa, 0x4a, 0x76, 0xea, 0x29, 0x82, 0x4b, 0x76, 0xf6, 0x3b, 0x8b, 0x79, 0x26, 0xbb,
+	0x52, 0x95, 0x47, 0xdd, 0xb7, 0xcf, 0xe5, 0x31, 0xf3, 0x61, 0xc2, 0x3c, 0x9d, 0x59, 0xa7, 0x5a,
+	0x22, 0xd2, 0xf9, 0xcc, 0x35, 0x40, 0xda, 0x93, 0x6e, 0x2e, 0xa7, 0xc1, 0xaa, 0x28, 0x09, 0x5f,
+	0xe7, 0x42, 0x86, 0xd3, 0xd6, 0x5c, 0xd4, 0x81, 0xea, 0x14, 0x74, 0x7f, 0x00, 0x92, 0x86, 0xc2,
+	0xb0, 0x4f, 0xc1, 0x3c, 0x9d, 0x59, 0x97, 0x32, 0xa6, 0xf8, 0x07, 0x24, 0xe5, 0x2a, 0x68, 0x7e,
+	0x3e, 0x73, 0x39, 0x0d, 0x56, 0x57, 0x27, 0xe5, 0x59, 0xe1, 0xab, 0x93, 0xed, 0xbb, 0x31, 0xcf,
+	0x64, 0x57, 0xca, 0xfe, 0xbe, 0x0e, 0x8d, 0xb4, 0xd7, 0x04, 0x9d, 0xe1, 0x6c, 0xc8, 0xf4, 0xc7,
+	0x98, 0xcf, 0x8c, 0xa8, 0x55, 0xb9, 0xa5, 0x3b, 0xd1, 0x38, 0xb7, 0x32, 0xbd, 0x74, 0xe6, 0xe9,
+	0xcc, 0x3a, 0xb5, 0x33, 0xdd, 0x1b, 0x86, 0x54, 0x1b, 0x20, 0xbb, 0xb3, 0x11, 0xee, 0x33, 0xaa,
+	0x64, 0x35, 0x47, 0x19, 0x57, 0xb2, 0x59, 0x4e, 0x35, 0xd3, 0xcc, 0xaa, 0x52, 0x4d, 0x0d, 0xe6,
+	0x7d, 0x11, 0x9a, 0x4c, 0xf5, 0xd8, 0x98, 0x0b, 0x1a, 0x4c, 0x39, 0xc0, 0x5e, 0x87, 0x59, 0xee,
+	0x4e, 0xe1, 0x02, 0xa8, 0xbb, 0x60, 0xcc, 0x45, 0x1d, 0x98, 0x1c, 0xc6, 0xe8, 0x02, 0x14, 0xec,
+	0x81, 0xbf, 0xb1, 0x8e, 0xd8, 0xf3, 0x84, 0xf4, 0xc0, 0x98, 0x75, 0x59, 0x16, 0xd8, 0x6b, 0x85,
+	0x6f, 0x90, 0x4f, 0xd7, 0x6f, 0x17, 0xe9, 0x97, 0xe8, 0xbf, 0xf8, 0x7f, 0x03, 0x00, 0x96, 0xc7,
+	0xaf, 0xef, 0xd3, 0x5e, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// GeoDBClient is the client API for GeoDB service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type GeoDBClient interface {
+	//Ping - input: empty, output: returns ok if server is healthy.
+	Ping(ctx context.Context, in *PingRequest, opts ...grpc.CallOption) (*PingResponse, error)
+	//Health - input: empty, output: returns database size stats if the database is readable & writable(readiness check). returns UNAVAILABLE otherwise
+	Health(ctx context.Context, in *HealthRequest, opts ...grpc.CallOption) (*HealthResponse, error)
+	//Stats - input: none, output: returns the database's current disk usage & an estimated key count without scanning the keyspace
+	Stats(ctx context.Context, in *StatsRequest, opts ...grpc.CallOption) (*StatsResponse, error)
+	//Set - input: an object output: an object detail. Object details are enhanced when the google maps integration is active. returns FAILED_PRECONDITION if if_version doesn't match the stored object's version
+	Set(ctx context.Context, in *SetRequest, opts ...grpc.CallOption) (*SetResponse, error)
+	//SetMany - input: an ordered array of objects output: an ordered array of object details. Objects are written in order, so when a key is repeated the last object wins
+	SetMany(ctx context.Context, in *SetManyRequest, opts ...grpc.CallOption) (*SetManyResponse, error)
+	//BulkUpdatePositions - input: an array of key/point pairs output: the moved object details & the keys that don't exist. only the points of existing objects change.
+	//the moves are written in batched transactions & tracker events are computed against the batch's new positions without the google maps integration
+	BulkUpdatePositions(ctx context.Context, in *BulkUpdatePositionsRequest, opts ...grpc.CallOption) (*BulkUpdatePositionsResponse, error)
+	//Update - input: an object key, the fields to change and an update mask, output: returns the merged object details. fields not in the mask are left intact
+	Update(ctx context.Context, in *UpdateRequest, opts ...grpc.CallOption) (*UpdateResponse, error)
+	//Increment - input: an object key, a counter name & a delta, output: returns the counter's new value & the object details. concurrent increments of the same object are all applied
+	Increment(ctx context.Context, in *IncrementRequest, opts ...grpc.CallOption) (*IncrementResponse, error)
+	//ImportCSV - input: csv data and a column mapping, output: the number of imported objects and any row level errors. Objects are written with Set
+	ImportCSV(ctx context.Context, in *ImportCSVRequest, opts ...grpc.CallOption) (*ImportCSVResponse, error)
+	//Get - input: an array of object keys, output: returns an array of current object details and the requested keys that weren't found
+	Get(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*GetResponse, error)
+	//GetRegex - input: a regex string, an optional key prefix to narrow the scan and an optional limit/cursor, output: returns current object details with keys that match the regex pattern and a cursor to the next page
+	GetRegex(ctx context.Context, in *GetRegexRequest, opts ...grpc.CallOption) (*GetRegexResponse, error)
+	//GetPrefix - input: a prefix string, output: returns an array of current object details with keys that have the given prefix
+	GetPrefix(ctx context.Context, in *GetPrefixRequest, opts ...grpc.CallOption) (*GetPrefixResponse, error)
+	//GetGlob - input: a glob pattern(* matches any characters, ? matches a single character), output: returns an array of current object details with keys that match the pattern.
+	//the characters before the first wildcard are used as a prefix seek, so only keys within that prefix are matched(ex: truck-* only scans keys starting with truck-)
+	GetGlob(ctx context.Context, in *GetGlobRequest, opts ...grpc.CallOption) (*GetGlobResponse, error)
+	//GetTagged - input: a tag filter, output: returns an array of current object details whose tags match the filter. requires at least one "any" or "all" tag
+	GetTagged(ctx context.Context, in *GetTaggedRequest, opts ...grpc.CallOption) (*GetTaggedResponse, error)
+	//GetKeys -  input: an optional limit/cursor/end key & direction, output: returns keys in database in key order(or reverse key order) and a cursor to the next page
+	GetKeys(ctx context.Context, in *GetKeysRequest, opts ...grpc.CallOption) (*GetKeysResponse, error)
+	//GetRegexKeys -  input: a regex string, output: returns all keys in database that match the regex pattern
+	GetRegexKeys(ctx context.Context, in *GetRegexKeysRequest, opts ...grpc.CallOption) (*GetRegexKeysResponse, error)
+	//GetPrefixKeys - input: a prefix string & a limit(optional), output: returns an array of of keys that have the given prefix without reading the objects
+	GetPrefixKeys(ctx context.Context, in *GetPrefixKeysRequest, opts ...grpc.CallOption) (*GetPrefixKeysResponse, error)
+	//Count - input: a regex or prefix string(optional), output: returns the number of objects whose keys match. counts all objects if neither is set
+	Count(ctx context.Context, in *CountRequest, opts ...grpc.CallOption) (*CountResponse, error)
+	//Exists - input: an array of object keys, output: whether an object is stored under each key(values aren't read)
+	Exists(ctx context.Context, in *ExistsRequest, opts ...grpc.CallOption) (*ExistsResponse, error)
+	//GetTTL - input: an array of object keys, output: the seconds until each object expires. -1 if it doesn't expire, -2 if it doesn't exist(or already expired)
+	GetTTL(ctx context.Context, in *TTLRequest, opts ...grpc.CallOption) (*TTLResponse, error)
+	//Delete -  input: an array of object key strings to delete, output: none. a tombstone(deleted object detail) is streamed for each deleted object unless every object is dropped with "*"
+	Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*DeleteResponse, error)
+	//DeletePrefix -  input: a prefix string, output: deletes every object whose key has the prefix & returns the number deleted
+	DeletePrefix(ctx context.Context, in *DeletePrefixRequest, opts ...grpc.CallOption) (*DeletePrefixResponse, error)
+	//DeleteRegex -  input: a regex string, output: deletes every object whose key matches the regex pattern & returns the number deleted
+	DeleteRegex(ctx context.Context, in *DeleteRegexRequest, opts ...grpc.CallOption) (*DeleteRegexResponse, error)
+	//DeleteExpired -  input: none, output: deletes every object whose ttl has passed(along with its history) & returns the number deleted. reads never return expired objects
+	DeleteExpired(ctx context.Context, in *DeleteExpiredRequest, opts ...grpc.CallOption) (*DeleteExpiredResponse, error)
+	//Stream -  input: a clientID(optional) and an array of object keys(optional),
+	//output: a stream of object details for realtime, targetted object geolocation updates
+	Stream(ctx context.Context, in *StreamRequest, opts ...grpc.CallOption) (GeoDB_StreamClient, error)
+	//StreamRegex -  input: a clientID(optional) a regex string,
+	//output: a stream of object details for realtime, targetted object geolocation updates that match the regex pattern
+	StreamRegex(ctx context.Context, in *StreamRegexRequest, opts ...grpc.CallOption) (GeoDB_StreamRegexClient, error)
+	//StreamPrefix -  input: a clientID(optional) a prefix string,
+	//output: a stream of object details for realtime, targetted object geolocation updates that match the prefix pattern
+	StreamPrefix(ctx context.Context, in *StreamPrefixRequest, opts ...grpc.CallOption) (GeoDB_StreamPrefixClient, error)
+	//WatchKey -  input: a clientID(optional) an object key,
+	//output: a stream of object details for realtime geolocation updates of the object with exactly that key
+	WatchKey(ctx context.Context, in *WatchKeyRequest, opts ...grpc.CallOption) (GeoDB_WatchKeyClient, error)
+	//StreamControl -  input: a stream of control messages. the first message carries a clientID(optional) and an array of object keys(optional), following messages pause or resume delivery
+	//output: a stream of object details for realtime, targetted object geolocation updates. updates are buffered(up to a limit) while paused and delivered on resume
+	StreamControl(ctx context.Context, opts ...grpc.CallOption) (GeoDB_StreamControlClient, error)
+	//ListStreamClients -  input: empty, output: the connected stream clients with their connect time & queued/dropped object counts(for diagnosing slow consumers)
+	ListStreamClients(ctx context.Context, in *ListClientsRequest, opts ...grpc.CallOption) (*ListClientsResponse, error)
+	//ScanObjects -  input: a prefix and/or regex string(optional), output: streams every stored object detail that matches in key order, for exporting large datasets
+	ScanObjects(ctx context.Context, in *ScanObjectsRequest, opts ...grpc.CallOption) (GeoDB_ScanObjectsClient, error)
+	//ScanBound -  input: a geolocation boundary, output: returns an array of current object details that are within the boundary
+	ScanBound(ctx context.Context, in *ScanBoundRequest, opts ...grpc.CallOption) (*ScanBoundResponse, error)
+	//ScanRegexBound -  input: a geolocation boundary, string-array of unique object ids(optional), output: returns an array of current object details that have keys that match the regex and are within the boundary and
+	ScanRegexBound(ctx context.Context, in *ScanRegexBoundRequest, opts ...grpc.CallOption) (*ScanRegexBoundResponse, error)
+	//ScanPrefexBound -  input: a geolocation boundary, output: returns an array of current object details that have keys that match the prefix and are within the boundary and
+	ScanPrefixBound(ctx context.Context, in *ScanPrefixBoundRequest, opts ...grpc.CallOption) (*ScanPrefixBoundResponse, error)
+	//ScanIsochrone -  input: a polygon(ex: a drive time isochrone computed by a routing engine) or a center & travel time budget used to generate one from the registered isochrone provider,
+	//output: returns an array of current object details that are within the polygon
+	ScanIsochrone(ctx context.Context, in *ScanIsochroneRequest, opts ...grpc.CallOption) (*ScanIsochroneResponse, error)
+	//WithinCorridor -  input: an ordered array of points representing a route & a buffer distance(meters), output: returns an array of current object details within the buffer distance of the route
+	WithinCorridor(ctx context.Context, in *WithinCorridorRequest, opts ...grpc.CallOption) (*WithinCorridorResponse, error)
+	//DistanceToPolyline - input: an ordered array of points representing a route & a point, output: returns the great circle distance from the point to the nearest segment of the route & the nearest point on the route
+	DistanceToPolyline(ctx context.Context, in *PolylineRequest, opts ...grpc.CallOption) (*PolylineResponse, error)
+	//GetWithinBounds -  input: a rectangular lat/lon bounding box(ex: a map viewport), output: returns an array of current object details within the box.
+	//if min_lon > max_lon the box crosses the antimeridian
+	GetWithinBounds(ctx context.Context, in *BoundsRequest, opts ...grpc.CallOption) (*BoundsResponse, error)
+	//Nearest -  input: a center point & a count(k), output: returns the k closest object details ordered by ascending distance(ties are ordered by key)
+	Nearest(ctx context.Context, in *NearestRequest, opts ...grpc.CallOption) (*NearestResponse, error)
+	//GetWithinRadius -  input: a center point & a radius in meters, output: returns the object details within the radius(inclusive) ordered by ascending distance(ties are ordered by key). read only
+	GetWithinRadius(ctx context.Context, in *RadiusRequest, opts ...grpc.CallOption) (*RadiusResponse, error)
+	//GetByGeohashPrefix -  input: a geohash prefix, output: returns the object details whose geohash starts with the prefix(approximate proximity without a full scan)
+	GetByGeohashPrefix(ctx context.Context, in *GeohashRequest, opts ...grpc.CallOption) (*GeohashResponse, error)
+	//GetHistory -  input: an object key, output: returns the object's recorded positions oldest first(see Object.keep_history)
+	GetHistory(ctx context.Context, in *HistoryRequest, opts ...grpc.CallOption) (*HistoryResponse, error)
+	//GetWithinPolygon -  input: the ordered vertices of a polygon(may be concave), output: returns the object details inside the polygon. points on its boundary are inside
+	GetWithinPolygon(ctx context.Context, in *PolygonRequest, opts ...grpc.CallOption) (*PolygonResponse, error)
+	//GetPoint can be used to get an addresses latitude/longitude - google maps integration is required.
+	GetPoint(ctx context.Context, in *GetPointRequest, opts ...grpc.CallOption) (*GetPointResponse, error)
+	//ProximityMatrix - input: an array of object keys, output: returns an NxN matrix of the distance(meters) between each pair of objects
+	ProximityMatrix(ctx context.Context, in *ProximityMatrixRequest, opts ...grpc.CallOption) (*ProximityMatrixResponse, error)
+	//BoundingCircle - input: an array of object keys(optional) or a prefix(optional), output: returns the smallest circle containing every matching object
+	BoundingCircle(ctx context.Context, in *BoundingCircleRequest, opts ...grpc.CallOption) (*BoundingCircleResponse, error)
+	//Aggregate - input: object keys(optional) or a prefix/regex(optional) & tag/metadata filters(optional), output: returns the number of matching objects, their centroid & bounding box
+	Aggregate(ctx context.Context, in *AggregateRequest, opts ...grpc.CallOption) (*AggregateResponse, error)
+	//Cluster - input: a geohash precision, a bounding box(optional) & tag/metadata filters(optional), output: returns the number of objects & their centroid in each non-empty geohash cell(ex: map marker clustering)
+	Cluster(ctx context.Context, in *ClusterRequest, opts ...grpc.CallOption) (*ClusterResponse, error)
+	//GetDeadLetters - input: a limit(optional), output: returns the most recent object details that couldn't be delivered to stream clients and why. requires GEODB_DEAD_LETTER_MAX
+	GetDeadLetters(ctx context.Context, in *GetDeadLettersRequest, opts ...grpc.CallOption) (*GetDeadLettersResponse, error)
+	//GetEvents - input: a time range(optional), an object key(optional) & a limit(optional), output: returns the persisted tracker events oldest first. requires GEODB_EVENT_RETENTION
+	GetEvents(ctx context.Context, in *GetEventsRequest, opts ...grpc.CallOption) (*GetEventsResponse, error)
+	//OpenReadSession - input: a ttl(optional), output: a read session token. Get, GetRegex, GetPrefix & GetKeys requests with the token read the same snapshot of the database(ex: consistent pagination & exports)
+	OpenReadSession(ctx context.Context, in *OpenReadSessionRequest, opts ...grpc.CallOption) (*OpenReadSessionResponse, error)
+	//CloseReadSession - input: a read session token, output: none. releases the session's snapshot
+	CloseReadSession(ctx context.Context, in *CloseReadSessionRequest, opts ...grpc.CallOption) (*CloseReadSessionResponse, error)
+	//CreateGeofence - input: a named circular or polygon geofence, output: the geofence. objects that are written are checked against every geofence, producing geofence events
+	CreateGeofence(ctx context.Context, in *CreateGeofenceRequest, opts ...grpc.CallOption) (*CreateGeofenceResponse, error)
+	//DeleteGeofence - input: a geofence name, output: none
+	DeleteGeofence(ctx context.Context, in *DeleteGeofenceRequest, opts ...grpc.CallOption) (*DeleteGeofenceResponse, error)
+	//ListGeofences - input: none, output: returns every geofence ordered by name
+	ListGeofences(ctx context.Context, in *ListGeofencesRequest, opts ...grpc.CallOption) (*ListGeofencesResponse, error)
+	//Backup - input: a version to back up from(0 for a full backup), output: a stream of backup chunks. the last message contains the version to use for the next incremental backup
+	Backup(ctx context.Context, in *BackupRequest, opts ...grpc.CallOption) (GeoDB_BackupClient, error)
+	//Restore - input: a stream of backup chunks(from Backup), output: none. loads the backup into the database
+	Restore(ctx context.Context, opts ...grpc.CallOption) (GeoDB_RestoreClient, error)
+	//RunGC - input: a discard ratio(optional), output: the number of value log files rewritten & the disk space reclaimed. runs badger's value log garbage collection until no more files can be rewritten
+	RunGC(ctx context.Context, in *GCRequest, opts ...grpc.CallOption) (*GCResponse, error)
+}
+
+type geoDBClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewGeoDBClient(cc *grpc.ClientConn) GeoDBClient {
+	return &geoDBClient{cc}
+}
+
+func (c *geoDBClient) Ping(ctx context.Context, in *PingRequest, opts ...grpc.CallOption) (*PingResponse, error) {
+	out := new(PingResponse)
+	err := c.cc.Invoke(ctx, "/api.GeoDB/Ping", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *geoDBClient) Health(ctx context.Context, in *HealthRequest, opts ...grpc.CallOption) (*HealthResponse, error) {
+	out := new(HealthResponse)
+	err := c.cc.Invoke(ctx, "/api.GeoDB/Health", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *geoDBClient) Stats(ctx context.Context, in *StatsRequest, opts ...grpc.CallOption) (*StatsResponse, error) {
+	out := new(StatsResponse)
+	err := c.cc.Invoke(ctx, "/api.GeoDB/Stats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *geoDBClient) Set(ctx context.Context, in *SetRequest, opts ...grpc.CallOption) (*SetResponse, error) {
+	out := new(SetResponse)
+	err := c.cc.Invoke(ctx, "/api.GeoDB/Set", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *geoDBClient) SetMany(ctx context.Context, in *SetManyRequest, opts ...grpc.CallOption) (*SetManyResponse, error) {
+	out := new(SetManyResponse)
+	err := c.cc.Invoke(ctx, "/api.GeoDB/SetMany", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *geoDBClient) BulkUpdatePositions(ctx context.Context, in *BulkUpdatePositionsRequest, opts ...grpc.CallOption) (*BulkUpdatePositionsResponse, error) {
+	out := new(BulkUpdatePositionsResponse)
+	err := c.cc.Invoke(ctx, "/api.GeoDB/BulkUpdatePositions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *geoDBClient) Update(ctx context.Context, in *UpdateRequest, opts ...grpc.CallOption) (*UpdateResponse, error) {
+	out := new(UpdateResponse)
+	err := c.cc.Invoke(ctx, "/api.GeoDB/Update", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *geoDBClient) Increment(ctx context.Context, in *IncrementRequest, opts ...grpc.CallOption) (*IncrementResponse, error) {
+	out := new(IncrementResponse)
+	err := c.cc.Invoke(ctx, "/api.GeoDB/Increment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *geoDBClient) ImportCSV(ctx context.Context, in *ImportCSVRequest, opts ...grpc.CallOption) (*ImportCSVResponse, error) {
+	out := new(ImportCSVResponse)
+	err := c.cc.Invoke(ctx, "/api.GeoDB/ImportCSV", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *geoDBClient) Get(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*GetResponse, error) {
+	out := new(GetResponse)
+	err := c.cc.Invoke(ctx, "/api.GeoDB/Get", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *geoDBClient) GetRegex(ctx context.Context, in *GetRegexRequest, opts ...grpc.CallOption) (*GetRegexResponse, error) {
+	out := new(GetRegexResponse)
+	err := c.cc.Invoke(ctx, "/api.GeoDB/GetRegex", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *geoDBClient) GetPrefix(ctx context.Context, in *GetPrefixRequest, opts ...grpc.CallOption) (*GetPrefixResponse, error) {
+	out := new(GetPrefixResponse)
+	err := c.cc.Invoke(ctx, "/api.GeoDB/GetPrefix", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *geoDBClient) GetGlob(ctx context.Context, in *GetGlobRequest, opts ...grpc.CallOption) (*GetGlobResponse, error) {
+	out := new(GetGlobResponse)
+	err := c.cc.Invoke(ctx, "/api.GeoDB/GetGlob", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *geoDBClient) GetTagged(ctx context.Context, in *GetTaggedRequest, opts ...grpc.CallOption) (*GetTaggedResponse, error) {
+	out := new(GetTaggedResponse)
+	err := c.cc.Invoke(ctx, "/api.GeoDB/GetTagged", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *geoDBClient) GetKeys(ctx context.Context, in *GetKeysRequest, opts ...grpc.CallOption) (*GetKeysResponse, error) {
+	out := new(GetKeysResponse)
+	err := c.cc.Invoke(ctx, "/api.GeoDB/GetKeys", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *geoDBClient) GetRegexKeys(ctx context.Context, in *GetRegexKeysRequest, opts ...grpc.CallOption) (*GetRegexKeysResponse, error) {
+	out := new(GetRegexKeysResponse)
+	err := c.cc.Invoke(ctx, "/api.GeoDB/GetRegexKeys", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *geoDBClient) GetPrefixKeys(ctx context.Context, in *GetPrefixKeysRequest, opts ...grpc.CallOption) (*GetPrefixKeysResponse, error) {
+	out := new(GetPrefixKeysResponse)
+	err := c.cc.Invoke(ctx, "/api.GeoDB/GetPrefixKeys", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *geoDBClient) Count(ctx context.Context, in *CountRequest, opts ...grpc.CallOption) (*CountResponse, error) {
+	out := new(CountResponse)
+	err := c.cc.Invoke(ctx, "/api.GeoDB/Count", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *geoDBClient) Exists(ctx context.Context, in *ExistsRequest, opts ...grpc.CallOption) (*ExistsResponse, error) {
+	out := new(ExistsResponse)
+	err := c.cc.Invoke(ctx, "/api.GeoDB/Exists", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *geoDBClient) GetTTL(ctx context.Context, in *TTLRequest, opts ...grpc.CallOption) (*TTLResponse, error) {
+	out := new(TTLResponse)
+	err := c.cc.Invoke(ctx, "/api.GeoDB/GetTTL", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *geoDBClient) Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*DeleteResponse, error) {
+	out := new(DeleteResponse)
+	err := c.cc.Invoke(ctx, "/api.GeoDB/Delete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *geoDBClient) DeletePrefix(ctx context.Context, in *DeletePrefixRequest, opts ...grpc.CallOption) (*DeletePrefixResponse, error) {
+	out := new(DeletePrefixResponse)
+	err := c.cc.Invoke(ctx, "/api.GeoDB/DeletePrefix", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *geoDBClient) DeleteRegex(ctx context.Context, in *DeleteRegexRequest, opts ...grpc.CallOption) (*DeleteRegexResponse, error) {
+	out := new(DeleteRegexResponse)
+	err := c.cc.Invoke(ctx, "/api.GeoDB/DeleteRegex", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *geoDBClient) DeleteExpired(ctx context.Context, in *DeleteExpiredRequest, opts ...grpc.CallOption) (*DeleteExpiredResponse, error) {
+	out := new(DeleteExpiredResponse)
+	err := c.cc.Invoke(ctx, "/api.GeoDB/DeleteExpired", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *geoDBClient) Stream(ctx context.Context, in *StreamRequest, opts ...grpc.CallOption) (GeoDB_StreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_GeoDB_serviceDesc.Streams[0], "/api.GeoDB/Stream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &geoDBStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GeoDB_StreamClient interface {
+	Recv() (*StreamResponse, error)
+	grpc.ClientStream
+}
+
+type geoDBStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *geoDBStreamClient) Recv() (*StreamResponse, error) {
+	m := new(StreamResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *geoDBClient) StreamRegex(ctx context.Context, in *StreamRegexRequest, opts ...grpc.CallOption) (GeoDB_StreamRegexClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_GeoDB_serviceDesc.Streams[1], "/api.GeoDB/StreamRegex", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &geoDBStreamRegexClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GeoDB_StreamRegexClient interface {
+	Recv() (*StreamRegexResponse, error)
+	grpc.ClientStream
+}
+
+type geoDBStreamRegexClient struct {
+	grpc.ClientStream
+}
+
+func (x *geoDBStreamRegexClient) Recv() (*StreamRegexResponse, error) {
+	m := new(StreamRegexResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *geoDBClient) StreamPrefix(ctx context.Context, in *StreamPrefixRequest, opts ...grpc.CallOption) (GeoDB_StreamPrefixClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_GeoDB_serviceDesc.Streams[2], "/api.GeoDB/StreamPrefix", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &geoDBStreamPrefixClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GeoDB_StreamPrefixClient interface {
+	Recv() (*StreamPrefixResponse, error)
+	grpc.ClientStream
+}
+
+type geoDBStreamPrefixClient struct {
+	grpc.ClientStream
+}
+
+func (x *geoDBStreamPrefixClient) Recv() (*StreamPrefixResponse, error) {
+	m := new(StreamPrefixResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *geoDBClient) WatchKey(ctx context.Context, in *WatchKeyRequest, opts ...grpc.CallOption) (GeoDB_WatchKeyClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_GeoDB_serviceDesc.Streams[3], "/api.GeoDB/WatchKey", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &geoDBWatchKeyClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GeoDB_WatchKeyClient interface {
+	Recv() (*WatchKeyResponse, error)
+	grpc.ClientStream
+}
+
+type geoDBWatchKeyClient struct {
+	grpc.ClientStream
+}
+
+func (x *geoDBWatchKeyClient) Recv() (*WatchKeyResponse, error) {
+	m := new(WatchKeyResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *geoDBClient) StreamControl(ctx context.Context, opts ...grpc.CallOption) (GeoDB_StreamControlClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_GeoDB_serviceDesc.Streams[4], "/api.GeoDB/StreamControl", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &geoDBStreamControlClient{stream}
+	return x, nil
+}
+
+type GeoDB_StreamControlClient interface {
+	Send(*StreamControlRequest) error
+	Recv() (*StreamControlResponse, error)
+	grpc.ClientStream
+}
+
+type geoDBStreamControlClient struct {
+	grpc.ClientStream
+}
+
+func (x *geoDBStreamControlClient) Send(m *StreamControlRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *geoDBStreamControlClient) Recv() (*StreamControlResponse, error) {
+	m := new(StreamControlResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *geoDBClient) ListStreamClients(ctx context.Context, in *ListClientsRequest, opts ...grpc.CallOption) (*ListClientsResponse, error) {
+	out := new(ListClientsResponse)
+	err := c.cc.Invoke(ctx, "/api.GeoDB/ListStreamClients", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *geoDBClient) ScanObjects(ctx context.Context, in *ScanObjectsRequest, opts ...grpc.CallOption) (GeoDB_ScanObjectsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_GeoDB_serviceDesc.Streams[5], "/api.GeoDB/ScanObjects", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &geoDBScanObjectsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GeoDB_ScanObjectsClient interface {
+	Recv() (*ScanObjectsResponse, error)
+	grpc.ClientStream
+}
+
+type geoDBScanObjectsClient struct {
+	grpc.ClientStream
+}
+
+func (x *geoDBScanObjectsClient) Recv() (*ScanObjectsResponse, error) {
+	m := new(ScanObjectsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *geoDBClient) ScanBound(ctx context.Context, in *ScanBoundRequest, opts ...grpc.CallOption) (*ScanBoundResponse, error) {
+	out := new(ScanBoundResponse)
+	err := c.cc.Invoke(ctx, "/api.GeoDB/ScanBound", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *geoDBClient) ScanRegexBound(ctx context.Context, in *ScanRegexBoundRequest, opts ...grpc.CallOption) (*ScanRegexBoundResponse, error) {
+	out := new(ScanRegexBoundResponse)
+	err := c.cc.Invoke(ctx, "/api.GeoDB/ScanRegexBound", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *geoDBClient) ScanPrefixBound(ctx context.Context, in *ScanPrefixBoundRequest, opts ...grpc.CallOption) (*ScanPrefixBoundResponse, error) {
+	out := new(ScanPrefixBoundResponse)
+	err := c.cc.Invoke(ctx, "/api.GeoDB/ScanPrefixBound", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *geoDBClient) ScanIsochrone(ctx context.Context, in *ScanIsochroneRequest, opts ...grpc.CallOption) (*ScanIsochroneResponse, error) {
+	out := new(ScanIsochroneResponse)
+	err := c.cc.Invoke(ctx, "/api.GeoDB/ScanIsochrone", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *geoDBClient) WithinCorridor(ctx context.Context, in *WithinCorridorRequest, opts ...grpc.CallOption) (*WithinCorridorResponse, error) {
+	out := new(WithinCorridorResponse)
+	err := c.cc.Invoke(ctx, "/api.GeoDB/WithinCorridor", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *geoDBClient) DistanceToPolyline(ctx context.Context, in *PolylineRequest, opts ...grpc.CallOption) (*PolylineResponse, error) {
+	out := new(PolylineResponse)
+	err := c.cc.Invoke(ctx, "/api.GeoDB/DistanceToPolyline", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *geoDBClient) GetWithinBounds(ctx context.Context, in *BoundsRequest, opts ...grpc.CallOption) (*BoundsResponse, error) {
+	out := new(BoundsResponse)
+	err := c.cc.Invoke(ctx, "/api.GeoDB/GetWithinBounds", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *geoDBClient) Nearest(ctx context.Context, in *NearestRequest, opts ...grpc.CallOption) (*NearestResponse, error) {
+	out := new(NearestResponse)
+	err := c.cc.Invoke(ctx, "/api.GeoDB/Nearest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *geoDBClient) GetWithinRadius(ctx context.Context, in *RadiusRequest, opts ...grpc.CallOption) (*RadiusResponse, error) {
+	out := new(RadiusResponse)
+	err := c.cc.Invoke(ctx, "/api.GeoDB/GetWithinRadius", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *geoDBClient) GetByGeohashPrefix(ctx context.Context, in *GeohashRequest, opts ...grpc.CallOption) (*GeohashResponse, error) {
+	out := new(GeohashResponse)
+	err := c.cc.Invoke(ctx, "/api.GeoDB/GetByGeohashPrefix", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *geoDBClient) GetHistory(ctx context.Context, in *HistoryRequest, opts ...grpc.CallOption) (*HistoryResponse, error) {
+	out := new(HistoryResponse)
+	err := c.cc.Invoke(ctx, "/api.GeoDB/GetHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *geoDBClient) GetWithinPolygon(ctx context.Context, in *PolygonRequest, opts ...grpc.CallOption) (*PolygonResponse, error) {
+	out := new(PolygonResponse)
+	err := c.cc.Invoke(ctx, "/api.GeoDB/GetWithinPolygon", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *geoDBClient) GetPoint(ctx context.Context, in *GetPointRequest, opts ...grpc.CallOption) (*GetPointResponse, error) {
+	out := new(GetPointResponse)
 	err := c.cc.Invoke(ctx, "/api.GeoDB/GetPoint", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return out, nil
+	return out, nil
+}
+
+func (c *geoDBClient) ProximityMatrix(ctx context.Context, in *ProximityMatrixRequest, opts ...grpc.CallOption) (*ProximityMatrixResponse, error) {
+	out := new(ProximityMatrixResponse)
+	err := c.cc.Invoke(ctx, "/api.GeoDB/ProximityMatrix", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *geoDBClient) BoundingCircle(ctx context.Context, in *BoundingCircleRequest, opts ...grpc.CallOption) (*BoundingCircleResponse, error) {
+	out := new(BoundingCircleResponse)
+	err := c.cc.Invoke(ctx, "/api.GeoDB/BoundingCircle", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *geoDBClient) Aggregate(ctx context.Context, in *AggregateRequest, opts ...grpc.CallOption) (*AggregateResponse, error) {
+	out := new(AggregateResponse)
+	err := c.cc.Invoke(ctx, "/api.GeoDB/Aggregate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *geoDBClient) Cluster(ctx context.Context, in *ClusterRequest, opts ...grpc.CallOption) (*ClusterResponse, error) {
+	out := new(ClusterResponse)
+	err := c.cc.Invoke(ctx, "/api.GeoDB/Cluster", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *geoDBClient) GetDeadLetters(ctx context.Context, in *GetDeadLettersRequest, opts ...grpc.CallOption) (*GetDeadLettersResponse, error) {
+	out := new(GetDeadLettersResponse)
+	err := c.cc.Invoke(ctx, "/api.GeoDB/GetDeadLetters", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *geoDBClient) GetEvents(ctx context.Context, in *GetEventsRequest, opts ...grpc.CallOption) (*GetEventsResponse, error) {
+	out := new(GetEventsResponse)
+	err := c.cc.Invoke(ctx, "/api.GeoDB/GetEvents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *geoDBClient) OpenReadSession(ctx context.Context, in *OpenReadSessionRequest, opts ...grpc.CallOption) (*OpenReadSessionResponse, error) {
+	out := new(OpenReadSessionResponse)
+	err := c.cc.Invoke(ctx, "/api.GeoDB/OpenReadSession", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *geoDBClient) CloseReadSession(ctx context.Context, in *CloseReadSessionRequest, opts ...grpc.CallOption) (*CloseReadSessionResponse, error) {
+	out := new(CloseReadSessionResponse)
+	err := c.cc.Invoke(ctx, "/api.GeoDB/CloseReadSession", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *geoDBClient) CreateGeofence(ctx context.Context, in *CreateGeofenceRequest, opts ...grpc.CallOption) (*CreateGeofenceResponse, error) {
+	out := new(CreateGeofenceResponse)
+	err := c.cc.Invoke(ctx, "/api.GeoDB/CreateGeofence", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *geoDBClient) DeleteGeofence(ctx context.Context, in *DeleteGeofenceRequest, opts ...grpc.CallOption) (*DeleteGeofenceResponse, error) {
+	out := new(DeleteGeofenceResponse)
+	err := c.cc.Invoke(ctx, "/api.GeoDB/DeleteGeofence", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *geoDBClient) ListGeofences(ctx context.Context, in *ListGeofencesRequest, opts ...grpc.CallOption) (*ListGeofencesResponse, error) {
+	out := new(ListGeofencesResponse)
+	err := c.cc.Invoke(ctx, "/api.GeoDB/ListGeofences", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *geoDBClient) Backup(ctx context.Context, in *BackupRequest, opts ...grpc.CallOption) (GeoDB_BackupClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_GeoDB_serviceDesc.Streams[6], "/api.GeoDB/Backup", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &geoDBBackupClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GeoDB_BackupClient interface {
+	Recv() (*BackupResponse, error)
+	grpc.ClientStream
+}
+
+type geoDBBackupClient struct {
+	grpc.ClientStream
+}
+
+func (x *geoDBBackupClient) Recv() (*BackupResponse, error) {
+	m := new(BackupResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *geoDBClient) Restore(ctx context.Context, opts ...grpc.CallOption) (GeoDB_RestoreClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_GeoDB_serviceDesc.Streams[7], "/api.GeoDB/Restore", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &geoDBRestoreClient{stream}
+	return x, nil
+}
+
+type GeoDB_RestoreClient interface {
+	Send(*RestoreRequest) error
+	CloseAndRecv() (*RestoreResponse, error)
+	grpc.ClientStream
+}
+
+type geoDBRestoreClient struct {
+	grpc.ClientStream
+}
+
+func (x *geoDBRestoreClient) Send(m *RestoreRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *geoDBRestoreClient) CloseAndRecv() (*RestoreResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(RestoreResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *geoDBClient) RunGC(ctx context.Context, in *GCRequest, opts ...grpc.CallOption) (*GCResponse, error) {
+	out := new(GCResponse)
+	err := c.cc.Invoke(ctx, "/api.GeoDB/RunGC", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GeoDBServer is the server API for GeoDB service.
+type GeoDBServer interface {
+	//Ping - input: empty, output: returns ok if server is healthy.
+	Ping(context.Context, *PingRequest) (*PingResponse, error)
+	//Health - input: empty, output: returns database size stats if the database is readable & writable(readiness check). returns UNAVAILABLE otherwise
+	Health(context.Context, *HealthRequest) (*HealthResponse, error)
+	//Stats - input: none, output: returns the database's current disk usage & an estimated key count without scanning the keyspace
+	Stats(context.Context, *StatsRequest) (*StatsResponse, error)
+	//Set - input: an object output: an object detail. Object details are enhanced when the google maps integration is active. returns FAILED_PRECONDITION if if_version doesn't match the stored object's version
+	Set(context.Context, *SetRequest) (*SetResponse, error)
+	//SetMany - input: an ordered array of objects output: an ordered array of object details. Objects are written in order, so when a key is repeated the last object wins
+	SetMany(context.Context, *SetManyRequest) (*SetManyResponse, error)
+	//BulkUpdatePositions - input: an array of key/point pairs output: the moved object details & the keys that don't exist. only the points of existing objects change.
+	//the moves are written in batched transactions & tracker events are computed against the batch's new positions without the google maps integration
+	BulkUpdatePositions(context.Context, *BulkUpdatePositionsRequest) (*BulkUpdatePositionsResponse, error)
+	//Update - input: an object key, the fields to change and an update mask, output: returns the merged object details. fields not in the mask are left intact
+	Update(context.Context, *UpdateRequest) (*UpdateResponse, error)
+	//Increment - input: an object key, a counter name & a delta, output: returns the counter's new value & the object details. concurrent increments of the same object are all applied
+	Increment(context.Context, *IncrementRequest) (*IncrementResponse, error)
+	//ImportCSV - input: csv data and a column mapping, output: the number of imported objects and any row level errors. Objects are written with Set
+	ImportCSV(context.Context, *ImportCSVRequest) (*ImportCSVResponse, error)
+	//Get - input: an array of object keys, output: returns an array of current object details and the requested keys that weren't found
+	Get(context.Context, *GetRequest) (*GetResponse, error)
+	//GetRegex - input: a regex string, an optional key prefix to narrow the scan and an optional limit/cursor, output: returns current object details with keys that match the regex pattern and a cursor to the next page
+	GetRegex(context.Context, *GetRegexRequest) (*GetRegexResponse, error)
+	//GetPrefix - input: a prefix string, output: returns an array of current object details with keys that have the given prefix
+	GetPrefix(context.Context, *GetPrefixRequest) (*GetPrefixResponse, error)
+	//GetGlob - input: a glob pattern(* matches any characters, ? matches a single character), output: returns an array of current object details with keys that match the pattern.
+	//the characters before the first wildcard are used as a prefix seek, so only keys within that prefix are matched(ex: truck-* only scans keys starting with truck-)
+	GetGlob(context.Context, *GetGlobRequest) (*GetGlobResponse, error)
+	//GetTagged - input: a tag filter, output: returns an array of current object details whose tags match the filter. requires at least one "any" or "all" tag
+	GetTagged(context.Context, *GetTaggedRequest) (*GetTaggedResponse, error)
+	//GetKeys -  input: an optional limit/cursor/end key & direction, output: returns keys in database in key order(or reverse key order) and a cursor to the next page
+	GetKeys(context.Context, *GetKeysRequest) (*GetKeysResponse, error)
+	//GetRegexKeys -  input: a regex string, output: returns all keys in database that match the regex pattern
+	GetRegexKeys(context.Context, *GetRegexKeysRequest) (*GetRegexKeysResponse, error)
+	//GetPrefixKeys - input: a prefix string & a limit(optional), output: returns an array of of keys that have the given prefix without reading the objects
+	GetPrefixKeys(context.Context, *GetPrefixKeysRequest) (*GetPrefixKeysResponse, error)
+	//Count - input: a regex or prefix string(optional), output: returns the number of objects whose keys match. counts all objects if neither is set
+	Count(context.Context, *CountRequest) (*CountResponse, error)
+	//Exists - input: an array of object keys, output: whether an object is stored under each key(values aren't read)
+	Exists(context.Context, *ExistsRequest) (*ExistsResponse, error)
+	//GetTTL - input: an array of object keys, output: the seconds until each object expires. -1 if it doesn't expire, -2 if it doesn't exist(or already expired)
+	GetTTL(context.Context, *TTLRequest) (*TTLResponse, error)
+	//Delete -  input: an array of object key strings to delete, output: none. a tombstone(deleted object detail) is streamed for each deleted object unless every object is dropped with "*"
+	Delete(context.Context, *DeleteRequest) (*DeleteResponse, error)
+	//DeletePrefix -  input: a prefix string, output: deletes every object whose key has the prefix & returns the number deleted
+	DeletePrefix(context.Context, *DeletePrefixRequest) (*DeletePrefixResponse, error)
+	//DeleteRegex -  input: a regex string, output: deletes every object whose key matches the regex pattern & returns the number deleted
+	DeleteRegex(context.Context, *DeleteRegexRequest) (*DeleteRegexResponse, error)
+	//DeleteExpired -  input: none, output: deletes every object whose ttl has passed(along with its history) & returns the number deleted. reads never return expired objects
+	DeleteExpired(context.Context, *DeleteExpiredRequest) (*DeleteExpiredResponse, error)
+	//Stream -  input: a clientID(optional) and an array of object keys(optional),
+	//output: a stream of object details for realtime, targetted object geolocation updates
+	Stream(*StreamRequest, GeoDB_StreamServer) error
+	//StreamRegex -  input: a clientID(optional) a regex string,
+	//output: a stream of object details for realtime, targetted object geolocation updates that match the regex pattern
+	StreamRegex(*StreamRegexRequest, GeoDB_StreamRegexServer) error
+	//StreamPrefix -  input: a clientID(optional) a prefix string,
+	//output: a stream of object details for realtime, targetted object geolocation updates that match the prefix pattern
+	StreamPrefix(*StreamPrefixRequest, GeoDB_StreamPrefixServer) error
+	//WatchKey -  input: a clientID(optional) an object key,
+	//output: a stream of object details for realtime geolocation updates of the object with exactly that key
+	WatchKey(*WatchKeyRequest, GeoDB_WatchKeyServer) error
+	//StreamControl -  input: a stream of control messages. the first message carries a clientID(optional) and an array of object keys(optional), following messages pause or resume delivery
+	//output: a stream of object details for realtime, targetted object geolocation updates. updates are buffered(up to a limit) while paused and delivered on resume
+	StreamControl(GeoDB_StreamControlServer) error
+	//ListStreamClients -  input: empty, output: the connected stream clients with their connect time & queued/dropped object counts(for diagnosing slow consumers)
+	ListStreamClients(context.Context, *ListClientsRequest) (*ListClientsResponse, error)
+	//ScanObjects -  input: a prefix and/or regex string(optional), output: streams every stored object detail that matches in key order, for exporting large datasets
+	ScanObjects(*ScanObjectsRequest, GeoDB_ScanObjectsServer) error
+	//ScanBound -  input: a geolocation boundary, output: returns an array of current object details that are within the boundary
+	ScanBound(context.Context, *ScanBoundRequest) (*ScanBoundResponse, error)
+	//ScanRegexBound -  input: a geolocation boundary, string-array of unique object ids(optional), output: returns an array of current object details that have keys that match the regex and are within the boundary and
+	ScanRegexBound(context.Context, *ScanRegexBoundRequest) (*ScanRegexBoundResponse, error)
+	//ScanPrefexBound -  input: a geolocation boundary, output: returns an array of current object details that have keys that match the prefix and are within the boundary and
+	ScanPrefixBound(context.Context, *ScanPrefixBoundRequest) (*ScanPrefixBoundResponse, error)
+	//ScanIsochrone -  input: a polygon(ex: a drive time isochrone computed by a routing engine) or a center & travel time budget used to generate one from the registered isochrone provider,
+	//output: returns an array of current object details that are within the polygon
+	ScanIsochrone(context.Context, *ScanIsochroneRequest) (*ScanIsochroneResponse, error)
+	//WithinCorridor -  input: an ordered array of points representing a route & a buffer distance(meters), output: returns an array of current object details within the buffer distance of the route
+	WithinCorridor(context.Context, *WithinCorridorRequest) (*WithinCorridorResponse, error)
+	//DistanceToPolyline - input: an ordered array of points representing a route & a point, output: returns the great circle distance from the point to the nearest segment of the route & the nearest point on the route
+	DistanceToPolyline(context.Context, *PolylineRequest) (*PolylineResponse, error)
+	//GetWithinBounds -  input: a rectangular lat/lon bounding box(ex: a map viewport), output: returns an array of current object details within the box.
+	//if min_lon > max_lon the box crosses the antimeridian
+	GetWithinBounds(context.Context, *BoundsRequest) (*BoundsResponse, error)
+	//Nearest -  input: a center point & a count(k), output: returns the k closest object details ordered by ascending distance(ties are ordered by key)
+	Nearest(context.Context, *NearestRequest) (*NearestResponse, error)
+	//GetWithinRadius -  input: a center point & a radius in meters, output: returns the object details within the radius(inclusive) ordered by ascending distance(ties are ordered by key). read only
+	GetWithinRadius(context.Context, *RadiusRequest) (*RadiusResponse, error)
+	//GetByGeohashPrefix -  input: a geohash prefix, output: returns the object details whose geohash starts with the prefix(approximate proximity without a full scan)
+	GetByGeohashPrefix(context.Context, *GeohashRequest) (*GeohashResponse, error)
+	//GetHistory -  input: an object key, output: returns the object's recorded positions oldest first(see Object.keep_history)
+	GetHistory(context.Context, *HistoryRequest) (*HistoryResponse, error)
+	//GetWithinPolygon -  input: the ordered vertices of a polygon(may be concave), output: returns the object details inside the polygon. points on its boundary are inside
+	GetWithinPolygon(context.Context, *PolygonRequest) (*PolygonResponse, error)
+	//GetPoint can be used to get an addresses latitude/longitude - google maps integration is required.
+	GetPoint(context.Context, *GetPointRequest) (*GetPointResponse, error)
+	//ProximityMatrix - input: an array of object keys, output: returns an NxN matrix of the distance(meters) between each pair of objects
+	ProximityMatrix(context.Context, *ProximityMatrixRequest) (*ProximityMatrixResponse, error)
+	//BoundingCircle - input: an array of object keys(optional) or a prefix(optional), output: returns the smallest circle containing every matching object
+	BoundingCircle(context.Context, *BoundingCircleRequest) (*BoundingCircleResponse, error)
+	//Aggregate - input: object keys(optional) or a prefix/regex(optional) & tag/metadata filters(optional), output: returns the number of matching objects, their centroid & bounding box
+	Aggregate(context.Context, *AggregateRequest) (*AggregateResponse, error)
+	//Cluster - input: a geohash precision, a bounding box(optional) & tag/metadata filters(optional), output: returns the number of objects & their centroid in each non-empty geohash cell(ex: map marker clustering)
+	Cluster(context.Context, *ClusterRequest) (*ClusterResponse, error)
+	//GetDeadLetters - input: a limit(optional), output: returns the most recent object details that couldn't be delivered to stream clients and why. requires GEODB_DEAD_LETTER_MAX
+	GetDeadLetters(context.Context, *GetDeadLettersRequest) (*GetDeadLettersResponse, error)
+	//GetEvents - input: a time range(optional), an object key(optional) & a limit(optional), output: returns the persisted tracker events oldest first. requires GEODB_EVENT_RETENTION
+	GetEvents(context.Context, *GetEventsRequest) (*GetEventsResponse, error)
+	//OpenReadSession - input: a ttl(optional), output: a read session token. Get, GetRegex, GetPrefix & GetKeys requests with the token read the same snapshot of the database(ex: consistent pagination & exports)
+	OpenReadSession(context.Context, *OpenReadSessionRequest) (*OpenReadSessionResponse, error)
+	//CloseReadSession - input: a read session token, output: none. releases the session's snapshot
+	CloseReadSession(context.Context, *CloseReadSessionRequest) (*CloseReadSessionResponse, error)
+	//CreateGeofence - input: a named circular or polygon geofence, output: the geofence. objects that are written are checked against every geofence, producing geofence events
+	CreateGeofence(context.Context, *CreateGeofenceRequest) (*CreateGeofenceResponse, error)
+	//DeleteGeofence - input: a geofence name, output: none
+	DeleteGeofence(context.Context, *DeleteGeofenceRequest) (*DeleteGeofenceResponse, error)
+	//ListGeofences - input: none, output: returns every geofence ordered by name
+	ListGeofences(context.Context, *ListGeofencesRequest) (*ListGeofencesResponse, error)
+	//Backup - input: a version to back up from(0 for a full backup), output: a stream of backup chunks. the last message contains the version to use for the next incremental backup
+	Backup(*BackupRequest, GeoDB_BackupServer) error
+	//Restore - input: a stream of backup chunks(from Backup), output: none. loads the backup into the database
+	Restore(GeoDB_RestoreServer) error
+	//RunGC - input: a discard ratio(optional), output: the number of value log files rewritten & the disk space reclaimed. runs badger's value log garbage collection until no more files can be rewritten
+	RunGC(context.Context, *GCRequest) (*GCResponse, error)
+}
+
+// UnimplementedGeoDBServer can be embedded to have forward compatible implementations.
+type UnimplementedGeoDBServer struct {
+}
+
+func (*UnimplementedGeoDBServer) Ping(ctx context.Context, req *PingRequest) (*PingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Ping not implemented")
+}
+func (*UnimplementedGeoDBServer) Health(ctx context.Context, req *HealthRequest) (*HealthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Health not implemented")
+}
+func (*UnimplementedGeoDBServer) Stats(ctx context.Context, req *StatsRequest) (*StatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Stats not implemented")
+}
+func (*UnimplementedGeoDBServer) Set(ctx context.Context, req *SetRequest) (*SetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Set not implemented")
+}
+func (*UnimplementedGeoDBServer) SetMany(ctx context.Context, req *SetManyRequest) (*SetManyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMany not implemented")
+}
+func (*UnimplementedGeoDBServer) BulkUpdatePositions(ctx context.Context, req *BulkUpdatePositionsRequest) (*BulkUpdatePositionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BulkUpdatePositions not implemented")
+}
+func (*UnimplementedGeoDBServer) Update(ctx context.Context, req *UpdateRequest) (*UpdateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
+}
+func (*UnimplementedGeoDBServer) Increment(ctx context.Context, req *IncrementRequest) (*IncrementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Increment not implemented")
+}
+func (*UnimplementedGeoDBServer) ImportCSV(ctx context.Context, req *ImportCSVRequest) (*ImportCSVResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportCSV not implemented")
+}
+func (*UnimplementedGeoDBServer) Get(ctx context.Context, req *GetRequest) (*GetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (*UnimplementedGeoDBServer) GetRegex(ctx context.Context, req *GetRegexRequest) (*GetRegexResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRegex not implemented")
+}
+func (*UnimplementedGeoDBServer) GetPrefix(ctx context.Context, req *GetPrefixRequest) (*GetPrefixResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPrefix not implemented")
+}
+func (*UnimplementedGeoDBServer) GetGlob(ctx context.Context, req *GetGlobRequest) (*GetGlobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGlob not implemented")
+}
+func (*UnimplementedGeoDBServer) GetTagged(ctx context.Context, req *GetTaggedRequest) (*GetTaggedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTagged not implemented")
+}
+func (*UnimplementedGeoDBServer) GetKeys(ctx context.Context, req *GetKeysRequest) (*GetKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetKeys not implemented")
+}
+func (*UnimplementedGeoDBServer) GetRegexKeys(ctx context.Context, req *GetRegexKeysRequest) (*GetRegexKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRegexKeys not implemented")
+}
+func (*UnimplementedGeoDBServer) GetPrefixKeys(ctx context.Context, req *GetPrefixKeysRequest) (*GetPrefixKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPrefixKeys not implemented")
+}
+func (*UnimplementedGeoDBServer) Count(ctx context.Context, req *CountRequest) (*CountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Count not implemented")
+}
+func (*UnimplementedGeoDBServer) Exists(ctx context.Context, req *ExistsRequest) (*ExistsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Exists not implemented")
+}
+func (*UnimplementedGeoDBServer) GetTTL(ctx context.Context, req *TTLRequest) (*TTLResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTTL not implemented")
+}
+func (*UnimplementedGeoDBServer) Delete(ctx context.Context, req *DeleteRequest) (*DeleteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (*UnimplementedGeoDBServer) DeletePrefix(ctx context.Context, req *DeletePrefixRequest) (*DeletePrefixResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePrefix not implemented")
+}
+func (*UnimplementedGeoDBServer) DeleteRegex(ctx context.Context, req *DeleteRegexRequest) (*DeleteRegexResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRegex not implemented")
+}
+func (*UnimplementedGeoDBServer) DeleteExpired(ctx context.Context, req *DeleteExpiredRequest) (*DeleteExpiredResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteExpired not implemented")
+}
+func (*UnimplementedGeoDBServer) Stream(req *StreamRequest, srv GeoDB_StreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method Stream not implemented")
+}
+func (*UnimplementedGeoDBServer) StreamRegex(req *StreamRegexRequest, srv GeoDB_StreamRegexServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamRegex not implemented")
+}
+func (*UnimplementedGeoDBServer) StreamPrefix(req *StreamPrefixRequest, srv GeoDB_StreamPrefixServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamPrefix not implemented")
+}
+func (*UnimplementedGeoDBServer) WatchKey(req *WatchKeyRequest, srv GeoDB_WatchKeyServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchKey not implemented")
+}
+func (*UnimplementedGeoDBServer) StreamControl(srv GeoDB_StreamControlServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamControl not implemented")
+}
+func (*UnimplementedGeoDBServer) ListStreamClients(ctx context.Context, req *ListClientsRequest) (*ListClientsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListStreamClients not implemented")
+}
+func (*UnimplementedGeoDBServer) ScanObjects(req *ScanObjectsRequest, srv GeoDB_ScanObjectsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ScanObjects not implemented")
+}
+func (*UnimplementedGeoDBServer) ScanBound(ctx context.Context, req *ScanBoundRequest) (*ScanBoundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScanBound not implemented")
+}
+func (*UnimplementedGeoDBServer) ScanRegexBound(ctx context.Context, req *ScanRegexBoundRequest) (*ScanRegexBoundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScanRegexBound not implemented")
+}
+func (*UnimplementedGeoDBServer) ScanPrefixBound(ctx context.Context, req *ScanPrefixBoundRequest) (*ScanPrefixBoundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScanPrefixBound not implemented")
+}
+func (*UnimplementedGeoDBServer) ScanIsochrone(ctx context.Context, req *ScanIsochroneRequest) (*ScanIsochroneResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScanIsochrone not implemented")
+}
+func (*UnimplementedGeoDBServer) WithinCorridor(ctx context.Context, req *WithinCorridorRequest) (*WithinCorridorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithinCorridor not implemented")
+}
+func (*UnimplementedGeoDBServer) DistanceToPolyline(ctx context.Context, req *PolylineRequest) (*PolylineResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DistanceToPolyline not implemented")
+}
+func (*UnimplementedGeoDBServer) GetWithinBounds(ctx context.Context, req *BoundsRequest) (*BoundsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWithinBounds not implemented")
+}
+func (*UnimplementedGeoDBServer) Nearest(ctx context.Context, req *NearestRequest) (*NearestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Nearest not implemented")
+}
+func (*UnimplementedGeoDBServer) GetWithinRadius(ctx context.Context, req *RadiusRequest) (*RadiusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWithinRadius not implemented")
+}
+func (*UnimplementedGeoDBServer) GetByGeohashPrefix(ctx context.Context, req *GeohashRequest) (*GeohashResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetByGeohashPrefix not implemented")
+}
+func (*UnimplementedGeoDBServer) GetHistory(ctx context.Context, req *HistoryRequest) (*HistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHistory not implemented")
+}
+func (*UnimplementedGeoDBServer) GetWithinPolygon(ctx context.Context, req *PolygonRequest) (*PolygonResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWithinPolygon not implemented")
+}
+func (*UnimplementedGeoDBServer) GetPoint(ctx context.Context, req *GetPointRequest) (*GetPointResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPoint not implemented")
+}
+func (*UnimplementedGeoDBServer) ProximityMatrix(ctx context.Context, req *ProximityMatrixRequest) (*ProximityMatrixResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProximityMatrix not implemented")
+}
+func (*UnimplementedGeoDBServer) BoundingCircle(ctx context.Context, req *BoundingCircleRequest) (*BoundingCircleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BoundingCircle not implemented")
+}
+func (*UnimplementedGeoDBServer) Aggregate(ctx context.Context, req *AggregateRequest) (*AggregateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Aggregate not implemented")
+}
+func (*UnimplementedGeoDBServer) Cluster(ctx context.Context, req *ClusterRequest) (*ClusterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Cluster not implemented")
+}
+func (*UnimplementedGeoDBServer) GetDeadLetters(ctx context.Context, req *GetDeadLettersRequest) (*GetDeadLettersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDeadLetters not implemented")
+}
+func (*UnimplementedGeoDBServer) GetEvents(ctx context.Context, req *GetEventsRequest) (*GetEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEvents not implemented")
+}
+func (*UnimplementedGeoDBServer) OpenReadSession(ctx context.Context, req *OpenReadSessionRequest) (*OpenReadSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OpenReadSession not implemented")
+}
+func (*UnimplementedGeoDBServer) CloseReadSession(ctx context.Context, req *CloseReadSessionRequest) (*CloseReadSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloseReadSession not implemented")
+}
+func (*UnimplementedGeoDBServer) CreateGeofence(ctx context.Context, req *CreateGeofenceRequest) (*CreateGeofenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateGeofence not implemented")
+}
+func (*UnimplementedGeoDBServer) DeleteGeofence(ctx context.Context, req *DeleteGeofenceRequest) (*DeleteGeofenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteGeofence not implemented")
+}
+func (*UnimplementedGeoDBServer) ListGeofences(ctx context.Context, req *ListGeofencesRequest) (*ListGeofencesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListGeofences not implemented")
+}
+func (*UnimplementedGeoDBServer) Backup(req *BackupRequest, srv GeoDB_BackupServer) error {
+	return status.Errorf(codes.Unimplemented, "method Backup not implemented")
+}
+func (*UnimplementedGeoDBServer) Restore(srv GeoDB_RestoreServer) error {
+	return status.Errorf(codes.Unimplemented, "method Restore not implemented")
+}
+func (*UnimplementedGeoDBServer) RunGC(ctx context.Context, req *GCRequest) (*GCResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RunGC not implemented")
+}
+
+func RegisterGeoDBServer(s *grpc.Server, srv GeoDBServer) {
+	s.RegisterService(&_GeoDB_serviceDesc, srv)
+}
+
+func _GeoDB_Ping_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GeoDBServer).Ping(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.GeoDB/Ping",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GeoDBServer).Ping(ctx, req.(*PingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GeoDB_Health_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HealthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GeoDBServer).Health(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.GeoDB/Health",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GeoDBServer).Health(ctx, req.(*HealthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GeoDB_Stats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GeoDBServer).Stats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.GeoDB/Stats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GeoDBServer).Stats(ctx, req.(*StatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GeoDB_Set_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GeoDBServer).Set(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.GeoDB/Set",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GeoDBServer).Set(ctx, req.(*SetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GeoDB_SetMany_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetManyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GeoDBServer).SetMany(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.GeoDB/SetMany",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GeoDBServer).SetMany(ctx, req.(*SetManyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GeoDB_BulkUpdatePositions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkUpdatePositionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GeoDBServer).BulkUpdatePositions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.GeoDB/BulkUpdatePositions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GeoDBServer).BulkUpdatePositions(ctx, req.(*BulkUpdatePositionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GeoDB_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GeoDBServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.GeoDB/Update",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GeoDBServer).Update(ctx, req.(*UpdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GeoDB_Increment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IncrementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GeoDBServer).Increment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.GeoDB/Increment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GeoDBServer).Increment(ctx, req.(*IncrementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GeoDB_ImportCSV_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportCSVRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GeoDBServer).ImportCSV(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.GeoDB/ImportCSV",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GeoDBServer).ImportCSV(ctx, req.(*ImportCSVRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GeoDB_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GeoDBServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.GeoDB/Get",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GeoDBServer).Get(ctx, req.(*GetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GeoDB_GetRegex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRegexRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GeoDBServer).GetRegex(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.GeoDB/GetRegex",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GeoDBServer).GetRegex(ctx, req.(*GetRegexRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GeoDB_GetPrefix_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPrefixRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GeoDBServer).GetPrefix(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.GeoDB/GetPrefix",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GeoDBServer).GetPrefix(ctx, req.(*GetPrefixRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GeoDB_GetGlob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGlobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GeoDBServer).GetGlob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.GeoDB/GetGlob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GeoDBServer).GetGlob(ctx, req.(*GetGlobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GeoDB_GetTagged_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTaggedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GeoDBServer).GetTagged(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.GeoDB/GetTagged",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GeoDBServer).GetTagged(ctx, req.(*GetTaggedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GeoDB_GetKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GeoDBServer).GetKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.GeoDB/GetKeys",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GeoDBServer).GetKeys(ctx, req.(*GetKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GeoDB_GetRegexKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRegexKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GeoDBServer).GetRegexKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.GeoDB/GetRegexKeys",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GeoDBServer).GetRegexKeys(ctx, req.(*GetRegexKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GeoDB_GetPrefixKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPrefixKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GeoDBServer).GetPrefixKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.GeoDB/GetPrefixKeys",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GeoDBServer).GetPrefixKeys(ctx, req.(*GetPrefixKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GeoDB_Count_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GeoDBServer).Count(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.GeoDB/Count",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GeoDBServer).Count(ctx, req.(*CountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GeoDB_Exists_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExistsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GeoDBServer).Exists(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.GeoDB/Exists",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GeoDBServer).Exists(ctx, req.(*ExistsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GeoDB_GetTTL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TTLRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GeoDBServer).GetTTL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.GeoDB/GetTTL",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GeoDBServer).GetTTL(ctx, req.(*TTLRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GeoDB_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GeoDBServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.GeoDB/Delete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GeoDBServer).Delete(ctx, req.(*DeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GeoDB_DeletePrefix_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePrefixRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GeoDBServer).DeletePrefix(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.GeoDB/DeletePrefix",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GeoDBServer).DeletePrefix(ctx, req.(*DeletePrefixRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GeoDB_DeleteRegex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRegexRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GeoDBServer).DeleteRegex(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.GeoDB/DeleteRegex",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GeoDBServer).DeleteRegex(ctx, req.(*DeleteRegexRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GeoDB_DeleteExpired_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteExpiredRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GeoDBServer).DeleteExpired(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.GeoDB/DeleteExpired",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GeoDBServer).DeleteExpired(ctx, req.(*DeleteExpiredRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GeoDB_Stream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GeoDBServer).Stream(m, &geoDBStreamServer{stream})
+}
+
+type GeoDB_StreamServer interface {
+	Send(*StreamResponse) error
+	grpc.ServerStream
+}
+
+type geoDBStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *geoDBStreamServer) Send(m *StreamResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _GeoDB_StreamRegex_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamRegexRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GeoDBServer).StreamRegex(m, &geoDBStreamRegexServer{stream})
+}
+
+type GeoDB_StreamRegexServer interface {
+	Send(*StreamRegexResponse) error
+	grpc.ServerStream
+}
+
+type geoDBStreamRegexServer struct {
+	grpc.ServerStream
+}
+
+func (x *geoDBStreamRegexServer) Send(m *StreamRegexResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _GeoDB_StreamPrefix_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamPrefixRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GeoDBServer).StreamPrefix(m, &geoDBStreamPrefixServer{stream})
+}
+
+type GeoDB_StreamPrefixServer interface {
+	Send(*StreamPrefixResponse) error
+	grpc.ServerStream
+}
+
+type geoDBStreamPrefixServer struct {
+	grpc.ServerStream
+}
+
+func (x *geoDBStreamPrefixServer) Send(m *StreamPrefixResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _GeoDB_WatchKey_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchKeyRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GeoDBServer).WatchKey(m, &geoDBWatchKeyServer{stream})
 }
 
-// GeoDBServer is the server API for GeoDB service.
-type GeoDBServer interface {
-	//Ping - input: empty, output: returns ok if server is healthy.
-	Ping(context.Context, *PingRequest) (*PingResponse, error)
-	//Set - input: an object output: an object detail. Object details are enhanced when the google maps integration is active
-	Set(context.Context, *SetRequest) (*SetResponse, error)
-	//Get - input: an array of object keys, output: returns an array of current object details
-	Get(context.Context, *GetRequest) (*GetResponse, error)
-	//GetRegex - input: a regex string, output: returns an array of current object details with keys that match the regex pattern
-	GetRegex(context.Context, *GetRegexRequest) (*GetRegexResponse, error)
-	//GetPrefix - input: a prefix string, output: returns an array of current object details with keys that have the given prefix
-	GetPrefix(context.Context, *GetPrefixRequest) (*GetPrefixResponse, error)
-	//GetKeys -  input: none, output: returns all keys in database
-	GetKeys(context.Context, *GetKeysRequest) (*GetKeysResponse, error)
-	//GetRegexKeys -  input: a regex string, output: returns all keys in database that match the regex pattern
-	GetRegexKeys(context.Context, *GetRegexKeysRequest) (*GetRegexKeysResponse, error)
-	//GetPrefixKeys - input: a prefix string, output: returns an array of of keys that have the given prefix
-	GetPrefixKeys(context.Context, *GetPrefixKeysRequest) (*GetPrefixKeysResponse, error)
-	//Delete -  input: an array of object key strings to delete, output: none
-	Delete(context.Context, *DeleteRequest) (*DeleteResponse, error)
-	//Stream -  input: a clientID(optional) and an array of object keys(optional),
-	//output: a stream of object details for realtime, targetted object geolocation updates
-	Stream(*StreamRequest, GeoDB_StreamServer) error
-	//StreamRegex -  input: a clientID(optional) a regex string,
-	//output: a stream of object details for realtime, targetted object geolocation updates that match the regex pattern
-	StreamRegex(*StreamRegexRequest, GeoDB_StreamRegexServer) error
-	//StreamPrefix -  input: a clientID(optional) a prefix string,
-	//output: a stream of object details for realtime, targetted object geolocation updates that match the prefix pattern
-	StreamPrefix(*StreamPrefixRequest, GeoDB_StreamPrefixServer) error
-	//ScanBound -  input: a geolocation boundary, output: returns an array of current object details that are within the boundary
-	ScanBound(context.Context, *ScanBoundRequest) (*ScanBoundResponse, error)
-	//ScanRegexBound -  input: a geolocation boundary, string-array of unique object ids(optional), output: returns an array of current object details that have keys that match the regex and are within the boundary and
-	ScanRegexBound(context.Context, *ScanRegexBoundRequest) (*ScanRegexBoundResponse, error)
-	//ScanPrefexBound -  input: a geolocation boundary, output: returns an array of current object details that have keys that match the prefix and are within the boundary and
-	ScanPrefixBound(context.Context, *ScanPrefixBoundRequest) (*ScanPrefixBoundResponse, error)
-	//GetPoint can be used to get an addresses latitude/longitude - google maps integration is required.
-	GetPoint(context.Context, *GetPointRequest) (*GetPointResponse, error)
+type GeoDB_WatchKeyServer interface {
+	Send(*WatchKeyResponse) error
+	grpc.ServerStream
 }
 
-// UnimplementedGeoDBServer can be embedded to have forward compatible implementations.
-type UnimplementedGeoDBServer struct {
+type geoDBWatchKeyServer struct {
+	grpc.ServerStream
 }
 
-func (*UnimplementedGeoDBServer) Ping(ctx context.Context, req *PingRequest) (*PingResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Ping not implemented")
+func (x *geoDBWatchKeyServer) Send(m *WatchKeyResponse) error {
+	return x.ServerStream.SendMsg(m)
 }
-func (*UnimplementedGeoDBServer) Set(ctx context.Context, req *SetRequest) (*SetResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Set not implemented")
+
+func _GeoDB_StreamControl_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(GeoDBServer).StreamControl(&geoDBStreamControlServer{stream})
 }
-func (*UnimplementedGeoDBServer) Get(ctx context.Context, req *GetRequest) (*GetResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+
+type GeoDB_StreamControlServer interface {
+	Send(*StreamControlResponse) error
+	Recv() (*StreamControlRequest, error)
+	grpc.ServerStream
 }
-func (*UnimplementedGeoDBServer) GetRegex(ctx context.Context, req *GetRegexRequest) (*GetRegexResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetRegex not implemented")
+
+type geoDBStreamControlServer struct {
+	grpc.ServerStream
 }
-func (*UnimplementedGeoDBServer) GetPrefix(ctx context.Context, req *GetPrefixRequest) (*GetPrefixResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetPrefix not implemented")
+
+func (x *geoDBStreamControlServer) Send(m *StreamControlResponse) error {
+	return x.ServerStream.SendMsg(m)
 }
-func (*UnimplementedGeoDBServer) GetKeys(ctx context.Context, req *GetKeysRequest) (*GetKeysResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetKeys not implemented")
+
+func (x *geoDBStreamControlServer) Recv() (*StreamControlRequest, error) {
+	m := new(StreamControlRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
 }
-func (*UnimplementedGeoDBServer) GetRegexKeys(ctx context.Context, req *GetRegexKeysRequest) (*GetRegexKeysResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetRegexKeys not implemented")
+
+func _GeoDB_ListStreamClients_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListClientsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GeoDBServer).ListStreamClients(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.GeoDB/ListStreamClients",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GeoDBServer).ListStreamClients(ctx, req.(*ListClientsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedGeoDBServer) GetPrefixKeys(ctx context.Context, req *GetPrefixKeysRequest) (*GetPrefixKeysResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetPrefixKeys not implemented")
+
+func _GeoDB_ScanObjects_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ScanObjectsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GeoDBServer).ScanObjects(m, &geoDBScanObjectsServer{stream})
 }
-func (*UnimplementedGeoDBServer) Delete(ctx context.Context, req *DeleteRequest) (*DeleteResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+
+type GeoDB_ScanObjectsServer interface {
+	Send(*ScanObjectsResponse) error
+	grpc.ServerStream
 }
-func (*UnimplementedGeoDBServer) Stream(req *StreamRequest, srv GeoDB_StreamServer) error {
-	return status.Errorf(codes.Unimplemented, "method Stream not implemented")
+
+type geoDBScanObjectsServer struct {
+	grpc.ServerStream
 }
-func (*UnimplementedGeoDBServer) StreamRegex(req *StreamRegexRequest, srv GeoDB_StreamRegexServer) error {
-	return status.Errorf(codes.Unimplemented, "method StreamRegex not implemented")
+
+func (x *geoDBScanObjectsServer) Send(m *ScanObjectsResponse) error {
+	return x.ServerStream.SendMsg(m)
 }
-func (*UnimplementedGeoDBServer) StreamPrefix(req *StreamPrefixRequest, srv GeoDB_StreamPrefixServer) error {
-	return status.Errorf(codes.Unimplemented, "method StreamPrefix not implemented")
+
+func _GeoDB_ScanBound_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScanBoundRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GeoDBServer).ScanBound(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.GeoDB/ScanBound",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GeoDBServer).ScanBound(ctx, req.(*ScanBoundRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedGeoDBServer) ScanBound(ctx context.Context, req *ScanBoundRequest) (*ScanBoundResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ScanBound not implemented")
+
+func _GeoDB_ScanRegexBound_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScanRegexBoundRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GeoDBServer).ScanRegexBound(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.GeoDB/ScanRegexBound",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GeoDBServer).ScanRegexBound(ctx, req.(*ScanRegexBoundRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedGeoDBServer) ScanRegexBound(ctx context.Context, req *ScanRegexBoundRequest) (*ScanRegexBoundResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ScanRegexBound not implemented")
+
+func _GeoDB_ScanPrefixBound_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScanPrefixBoundRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GeoDBServer).ScanPrefixBound(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.GeoDB/ScanPrefixBound",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GeoDBServer).ScanPrefixBound(ctx, req.(*ScanPrefixBoundRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedGeoDBServer) ScanPrefixBound(ctx context.Context, req *ScanPrefixBoundRequest) (*ScanPrefixBoundResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ScanPrefixBound not implemented")
+
+func _GeoDB_ScanIsochrone_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScanIsochroneRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GeoDBServer).ScanIsochrone(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.GeoDB/ScanIsochrone",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GeoDBServer).ScanIsochrone(ctx, req.(*ScanIsochroneRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedGeoDBServer) GetPoint(ctx context.Context, req *GetPointRequest) (*GetPointResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetPoint not implemented")
+
+func _GeoDB_WithinCorridor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WithinCorridorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GeoDBServer).WithinCorridor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.GeoDB/WithinCorridor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GeoDBServer).WithinCorridor(ctx, req.(*WithinCorridorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterGeoDBServer(s *grpc.Server, srv GeoDBServer) {
-	s.RegisterService(&_GeoDB_serviceDesc, srv)
+func _GeoDB_DistanceToPolyline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PolylineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GeoDBServer).DistanceToPolyline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.GeoDB/DistanceToPolyline",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GeoDBServer).DistanceToPolyline(ctx, req.(*PolylineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GeoDB_GetWithinBounds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BoundsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GeoDBServer).GetWithinBounds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.GeoDB/GetWithinBounds",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GeoDBServer).GetWithinBounds(ctx, req.(*BoundsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GeoDB_Nearest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NearestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GeoDBServer).Nearest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.GeoDB/Nearest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GeoDBServer).Nearest(ctx, req.(*NearestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GeoDB_GetWithinRadius_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RadiusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GeoDBServer).GetWithinRadius(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.GeoDB/GetWithinRadius",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GeoDBServer).GetWithinRadius(ctx, req.(*RadiusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GeoDB_GetByGeohashPrefix_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GeohashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GeoDBServer).GetByGeohashPrefix(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.GeoDB/GetByGeohashPrefix",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GeoDBServer).GetByGeohashPrefix(ctx, req.(*GeohashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GeoDB_GetHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GeoDBServer).GetHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.GeoDB/GetHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GeoDBServer).GetHistory(ctx, req.(*HistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _GeoDB_Ping_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PingRequest)
+func _GeoDB_GetWithinPolygon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PolygonRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GeoDBServer).Ping(ctx, in)
+		return srv.(GeoDBServer).GetWithinPolygon(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.GeoDB/Ping",
+		FullMethod: "/api.GeoDB/GetWithinPolygon",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GeoDBServer).Ping(ctx, req.(*PingRequest))
+		return srv.(GeoDBServer).GetWithinPolygon(ctx, req.(*PolygonRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GeoDB_Set_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetRequest)
+func _GeoDB_GetPoint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPointRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GeoDBServer).Set(ctx, in)
+		return srv.(GeoDBServer).GetPoint(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.GeoDB/Set",
+		FullMethod: "/api.GeoDB/GetPoint",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GeoDBServer).Set(ctx, req.(*SetRequest))
+		return srv.(GeoDBServer).GetPoint(ctx, req.(*GetPointRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GeoDB_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetRequest)
+func _GeoDB_ProximityMatrix_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProximityMatrixRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GeoDBServer).Get(ctx, in)
+		return srv.(GeoDBServer).ProximityMatrix(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.GeoDB/Get",
+		FullMethod: "/api.GeoDB/ProximityMatrix",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GeoDBServer).Get(ctx, req.(*GetRequest))
+		return srv.(GeoDBServer).ProximityMatrix(ctx, req.(*ProximityMatrixRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GeoDB_GetRegex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetRegexRequest)
+func _GeoDB_BoundingCircle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BoundingCircleRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GeoDBServer).GetRegex(ctx, in)
+		return srv.(GeoDBServer).BoundingCircle(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.GeoDB/GetRegex",
+		FullMethod: "/api.GeoDB/BoundingCircle",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GeoDBServer).GetRegex(ctx, req.(*GetRegexRequest))
+		return srv.(GeoDBServer).BoundingCircle(ctx, req.(*BoundingCircleRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GeoDB_GetPrefix_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetPrefixRequest)
+func _GeoDB_Aggregate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AggregateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GeoDBServer).GetPrefix(ctx, in)
+		return srv.(GeoDBServer).Aggregate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.GeoDB/GetPrefix",
+		FullMethod: "/api.GeoDB/Aggregate",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GeoDBServer).GetPrefix(ctx, req.(*GetPrefixRequest))
+		return srv.(GeoDBServer).Aggregate(ctx, req.(*AggregateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GeoDB_GetKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetKeysRequest)
+func _GeoDB_Cluster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClusterRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GeoDBServer).GetKeys(ctx, in)
+		return srv.(GeoDBServer).Cluster(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.GeoDB/GetKeys",
+		FullMethod: "/api.GeoDB/Cluster",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GeoDBServer).GetKeys(ctx, req.(*GetKeysRequest))
+		return srv.(GeoDBServer).Cluster(ctx, req.(*ClusterRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GeoDB_GetRegexKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetRegexKeysRequest)
+func _GeoDB_GetDeadLetters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDeadLettersRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GeoDBServer).GetRegexKeys(ctx, in)
+		return srv.(GeoDBServer).GetDeadLetters(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.GeoDB/GetRegexKeys",
+		FullMethod: "/api.GeoDB/GetDeadLetters",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GeoDBServer).GetRegexKeys(ctx, req.(*GetRegexKeysRequest))
+		return srv.(GeoDBServer).GetDeadLetters(ctx, req.(*GetDeadLettersRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GeoDB_GetPrefixKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetPrefixKeysRequest)
+func _GeoDB_GetEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEventsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GeoDBServer).GetPrefixKeys(ctx, in)
+		return srv.(GeoDBServer).GetEvents(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.GeoDB/GetPrefixKeys",
+		FullMethod: "/api.GeoDB/GetEvents",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GeoDBServer).GetPrefixKeys(ctx, req.(*GetPrefixKeysRequest))
+		return srv.(GeoDBServer).GetEvents(ctx, req.(*GetEventsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GeoDB_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteRequest)
+func _GeoDB_OpenReadSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OpenReadSessionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GeoDBServer).Delete(ctx, in)
+		return srv.(GeoDBServer).OpenReadSession(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.GeoDB/Delete",
+		FullMethod: "/api.GeoDB/OpenReadSession",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GeoDBServer).Delete(ctx, req.(*DeleteRequest))
+		return srv.(GeoDBServer).OpenReadSession(ctx, req.(*OpenReadSessionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GeoDB_Stream_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(StreamRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func _GeoDB_CloseReadSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloseReadSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return srv.(GeoDBServer).Stream(m, &geoDBStreamServer{stream})
-}
-
-type GeoDB_StreamServer interface {
-	Send(*StreamResponse) error
-	grpc.ServerStream
-}
-
-type geoDBStreamServer struct {
-	grpc.ServerStream
-}
-
-func (x *geoDBStreamServer) Send(m *StreamResponse) error {
-	return x.ServerStream.SendMsg(m)
-}
-
-func _GeoDB_StreamRegex_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(StreamRegexRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+	if interceptor == nil {
+		return srv.(GeoDBServer).CloseReadSession(ctx, in)
 	}
-	return srv.(GeoDBServer).StreamRegex(m, &geoDBStreamRegexServer{stream})
-}
-
-type GeoDB_StreamRegexServer interface {
-	Send(*StreamRegexResponse) error
-	grpc.ServerStream
-}
-
-type geoDBStreamRegexServer struct {
-	grpc.ServerStream
-}
-
-func (x *geoDBStreamRegexServer) Send(m *StreamRegexResponse) error {
-	return x.ServerStream.SendMsg(m)
-}
-
-func _GeoDB_StreamPrefix_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(StreamPrefixRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.GeoDB/CloseReadSession",
 	}
-	return srv.(GeoDBServer).StreamPrefix(m, &geoDBStreamPrefixServer{stream})
-}
-
-type GeoDB_StreamPrefixServer interface {
-	Send(*StreamPrefixResponse) error
-	grpc.ServerStream
-}
-
-type geoDBStreamPrefixServer struct {
-	grpc.ServerStream
-}
-
-func (x *geoDBStreamPrefixServer) Send(m *StreamPrefixResponse) error {
-	return x.ServerStream.SendMsg(m)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GeoDBServer).CloseReadSession(ctx, req.(*CloseReadSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _GeoDB_ScanBound_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ScanBoundRequest)
+func _GeoDB_CreateGeofence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateGeofenceRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GeoDBServer).ScanBound(ctx, in)
+		return srv.(GeoDBServer).CreateGeofence(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.GeoDB/ScanBound",
+		FullMethod: "/api.GeoDB/CreateGeofence",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GeoDBServer).ScanBound(ctx, req.(*ScanBoundRequest))
+		return srv.(GeoDBServer).CreateGeofence(ctx, req.(*CreateGeofenceRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GeoDB_ScanRegexBound_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ScanRegexBoundRequest)
+func _GeoDB_DeleteGeofence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteGeofenceRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GeoDBServer).ScanRegexBound(ctx, in)
+		return srv.(GeoDBServer).DeleteGeofence(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.GeoDB/ScanRegexBound",
+		FullMethod: "/api.GeoDB/DeleteGeofence",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GeoDBServer).ScanRegexBound(ctx, req.(*ScanRegexBoundRequest))
+		return srv.(GeoDBServer).DeleteGeofence(ctx, req.(*DeleteGeofenceRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GeoDB_ScanPrefixBound_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ScanPrefixBoundRequest)
+func _GeoDB_ListGeofences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListGeofencesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GeoDBServer).ScanPrefixBound(ctx, in)
+		return srv.(GeoDBServer).ListGeofences(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.GeoDB/ScanPrefixBound",
+		FullMethod: "/api.GeoDB/ListGeofences",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GeoDBServer).ScanPrefixBound(ctx, req.(*ScanPrefixBoundRequest))
+		return srv.(GeoDBServer).ListGeofences(ctx, req.(*ListGeofencesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GeoDB_GetPoint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetPointRequest)
+func _GeoDB_Backup_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(BackupRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GeoDBServer).Backup(m, &geoDBBackupServer{stream})
+}
+
+type GeoDB_BackupServer interface {
+	Send(*BackupResponse) error
+	grpc.ServerStream
+}
+
+type geoDBBackupServer struct {
+	grpc.ServerStream
+}
+
+func (x *geoDBBackupServer) Send(m *BackupResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _GeoDB_Restore_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(GeoDBServer).Restore(&geoDBRestoreServer{stream})
+}
+
+type GeoDB_RestoreServer interface {
+	SendAndClose(*RestoreResponse) error
+	Recv() (*RestoreRequest, error)
+	grpc.ServerStream
+}
+
+type geoDBRestoreServer struct {
+	grpc.ServerStream
+}
+
+func (x *geoDBRestoreServer) SendAndClose(m *RestoreResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *geoDBRestoreServer) Recv() (*RestoreRequest, error) {
+	m := new(RestoreRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _GeoDB_RunGC_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GCRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GeoDBServer).GetPoint(ctx, in)
+		return srv.(GeoDBServer).RunGC(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.GeoDB/GetPoint",
+		FullMethod: "/api.GeoDB/RunGC",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GeoDBServer).GetPoint(ctx, req.(*GetPointRequest))
+		return srv.(GeoDBServer).RunGC(ctx, req.(*GCRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -2742,10 +10450,38 @@ var _GeoDB_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Ping",
 			Handler:    _GeoDB_Ping_Handler,
 		},
+		{
+			MethodName: "Health",
+			Handler:    _GeoDB_Health_Handler,
+		},
+		{
+			MethodName: "Stats",
+			Handler:    _GeoDB_Stats_Handler,
+		},
 		{
 			MethodName: "Set",
 			Handler:    _GeoDB_Set_Handler,
 		},
+		{
+			MethodName: "SetMany",
+			Handler:    _GeoDB_SetMany_Handler,
+		},
+		{
+			MethodName: "BulkUpdatePositions",
+			Handler:    _GeoDB_BulkUpdatePositions_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _GeoDB_Update_Handler,
+		},
+		{
+			MethodName: "Increment",
+			Handler:    _GeoDB_Increment_Handler,
+		},
+		{
+			MethodName: "ImportCSV",
+			Handler:    _GeoDB_ImportCSV_Handler,
+		},
 		{
 			MethodName: "Get",
 			Handler:    _GeoDB_Get_Handler,
@@ -2758,6 +10494,14 @@ var _GeoDB_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetPrefix",
 			Handler:    _GeoDB_GetPrefix_Handler,
 		},
+		{
+			MethodName: "GetGlob",
+			Handler:    _GeoDB_GetGlob_Handler,
+		},
+		{
+			MethodName: "GetTagged",
+			Handler:    _GeoDB_GetTagged_Handler,
+		},
 		{
 			MethodName: "GetKeys",
 			Handler:    _GeoDB_GetKeys_Handler,
@@ -2770,10 +10514,38 @@ var _GeoDB_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetPrefixKeys",
 			Handler:    _GeoDB_GetPrefixKeys_Handler,
 		},
+		{
+			MethodName: "Count",
+			Handler:    _GeoDB_Count_Handler,
+		},
+		{
+			MethodName: "Exists",
+			Handler:    _GeoDB_Exists_Handler,
+		},
+		{
+			MethodName: "GetTTL",
+			Handler:    _GeoDB_GetTTL_Handler,
+		},
 		{
 			MethodName: "Delete",
 			Handler:    _GeoDB_Delete_Handler,
 		},
+		{
+			MethodName: "DeletePrefix",
+			Handler:    _GeoDB_DeletePrefix_Handler,
+		},
+		{
+			MethodName: "DeleteRegex",
+			Handler:    _GeoDB_DeleteRegex_Handler,
+		},
+		{
+			MethodName: "DeleteExpired",
+			Handler:    _GeoDB_DeleteExpired_Handler,
+		},
+		{
+			MethodName: "ListStreamClients",
+			Handler:    _GeoDB_ListStreamClients_Handler,
+		},
 		{
 			MethodName: "ScanBound",
 			Handler:    _GeoDB_ScanBound_Handler,
@@ -2786,10 +10558,94 @@ var _GeoDB_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ScanPrefixBound",
 			Handler:    _GeoDB_ScanPrefixBound_Handler,
 		},
+		{
+			MethodName: "ScanIsochrone",
+			Handler:    _GeoDB_ScanIsochrone_Handler,
+		},
+		{
+			MethodName: "WithinCorridor",
+			Handler:    _GeoDB_WithinCorridor_Handler,
+		},
+		{
+			MethodName: "DistanceToPolyline",
+			Handler:    _GeoDB_DistanceToPolyline_Handler,
+		},
+		{
+			MethodName: "GetWithinBounds",
+			Handler:    _GeoDB_GetWithinBounds_Handler,
+		},
+		{
+			MethodName: "Nearest",
+			Handler:    _GeoDB_Nearest_Handler,
+		},
+		{
+			MethodName: "GetWithinRadius",
+			Handler:    _GeoDB_GetWithinRadius_Handler,
+		},
+		{
+			MethodName: "GetByGeohashPrefix",
+			Handler:    _GeoDB_GetByGeohashPrefix_Handler,
+		},
+		{
+			MethodName: "GetHistory",
+			Handler:    _GeoDB_GetHistory_Handler,
+		},
+		{
+			MethodName: "GetWithinPolygon",
+			Handler:    _GeoDB_GetWithinPolygon_Handler,
+		},
 		{
 			MethodName: "GetPoint",
 			Handler:    _GeoDB_GetPoint_Handler,
 		},
+		{
+			MethodName: "ProximityMatrix",
+			Handler:    _GeoDB_ProximityMatrix_Handler,
+		},
+		{
+			MethodName: "BoundingCircle",
+			Handler:    _GeoDB_BoundingCircle_Handler,
+		},
+		{
+			MethodName: "Aggregate",
+			Handler:    _GeoDB_Aggregate_Handler,
+		},
+		{
+			MethodName: "Cluster",
+			Handler:    _GeoDB_Cluster_Handler,
+		},
+		{
+			MethodName: "GetDeadLetters",
+			Handler:    _GeoDB_GetDeadLetters_Handler,
+		},
+		{
+			MethodName: "GetEvents",
+			Handler:    _GeoDB_GetEvents_Handler,
+		},
+		{
+			MethodName: "OpenReadSession",
+			Handler:    _GeoDB_OpenReadSession_Handler,
+		},
+		{
+			MethodName: "CloseReadSession",
+			Handler:    _GeoDB_CloseReadSession_Handler,
+		},
+		{
+			MethodName: "CreateGeofence",
+			Handler:    _GeoDB_CreateGeofence_Handler,
+		},
+		{
+			MethodName: "DeleteGeofence",
+			Handler:    _GeoDB_DeleteGeofence_Handler,
+		},
+		{
+			MethodName: "ListGeofences",
+			Handler:    _GeoDB_ListGeofences_Handler,
+		},
+		{
+			MethodName: "RunGC",
+			Handler:    _GeoDB_RunGC_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -2807,6 +10663,32 @@ var _GeoDB_serviceDesc = grpc.ServiceDesc{
 			Handler:       _GeoDB_StreamPrefix_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "WatchKey",
+			Handler:       _GeoDB_WatchKey_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamControl",
+			Handler:       _GeoDB_StreamControl_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "ScanObjects",
+			Handler:       _GeoDB_ScanObjects_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Backup",
+			Handler:       _GeoDB_Backup_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Restore",
+			Handler:       _GeoDB_Restore_Handler,
+			ClientStreams: true,
+		},
 	},
 	Metadata: "api.proto",
 }
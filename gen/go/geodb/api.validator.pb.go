@@ -18,6 +18,18 @@ var _ = fmt.Errorf
 var _ = math.Inf
 
 func (this *Point) Validate() error {
+	if !(this.Lat >= -90) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Lat", fmt.Errorf(`value '%v' must be greater than or equal to '-90'`, this.Lat))
+	}
+	if !(this.Lat <= 90) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Lat", fmt.Errorf(`value '%v' must be lower than or equal to '90'`, this.Lat))
+	}
+	if !(this.Lon >= -180) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Lon", fmt.Errorf(`value '%v' must be greater than or equal to '-180'`, this.Lon))
+	}
+	if !(this.Lon <= 180) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Lon", fmt.Errorf(`value '%v' must be lower than or equal to '180'`, this.Lon))
+	}
 	return nil
 }
 func (this *Bound) Validate() error {
@@ -52,6 +64,27 @@ func (this *Object) Validate() error {
 		}
 	}
 	// Validation of proto3 map<> fields is unsupported.
+	if !(this.TtlSeconds > -1) {
+		return github_com_mwitkow_go_proto_validators.FieldError("TtlSeconds", fmt.Errorf(`value '%v' must be greater than '-1'`, this.TtlSeconds))
+	}
+	for _, item := range this.Polyline {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Polyline", err)
+			}
+		}
+	}
+	for _, item := range this.Polygon {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Polygon", err)
+			}
+		}
+	}
+	// Validation of proto3 map<> fields is unsupported.
+	return nil
+}
+func (this *TagFilter) Validate() error {
 	return nil
 }
 func (this *ObjectTracking) Validate() error {
@@ -71,6 +104,11 @@ func (this *ObjectTracker) Validate() error {
 	if !_regex_ObjectTracker_TargetObjectKey.MatchString(this.TargetObjectKey) {
 		return github_com_mwitkow_go_proto_validators.FieldError("TargetObjectKey", fmt.Errorf(`value '%v' must be a string conforming to regex "^.{1,225}$"`, this.TargetObjectKey))
 	}
+	if this.TargetTags != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.TargetTags); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("TargetTags", err)
+		}
+	}
 	return nil
 }
 func (this *Directions) Validate() error {
@@ -90,6 +128,7 @@ func (this *TrackerEvent) Validate() error {
 			return github_com_mwitkow_go_proto_validators.FieldError("Direction", err)
 		}
 	}
+	// Validation of proto3 map<> fields is unsupported.
 	return nil
 }
 func (this *ObjectDetail) Validate() error {
@@ -110,9 +149,95 @@ func (this *ObjectDetail) Validate() error {
 			}
 		}
 	}
+	for _, item := range this.GeofenceEvents {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("GeofenceEvents", err)
+			}
+		}
+	}
+	return nil
+}
+
+var _regex_Geofence_Name = regexp.MustCompile(`^.{1,225}$`)
+
+func (this *Geofence) Validate() error {
+	if !_regex_Geofence_Name.MatchString(this.Name) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Name", fmt.Errorf(`value '%v' must be a string conforming to regex "^.{1,225}$"`, this.Name))
+	}
+	if this.Center != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Center); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Center", err)
+		}
+	}
+	if !(this.Radius > -1) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Radius", fmt.Errorf(`value '%v' must be greater than '-1'`, this.Radius))
+	}
+	for _, item := range this.Polygon {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Polygon", err)
+			}
+		}
+	}
+	// Validation of proto3 map<> fields is unsupported.
+	return nil
+}
+func (this *GeofenceEvent) Validate() error {
 	return nil
 }
+
+var _regex_StreamRequest_Namespace = regexp.MustCompile(`^[A-Za-z0-9_.-]{0,64}$`)
+
 func (this *StreamRequest) Validate() error {
+	if this.Tags != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Tags); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Tags", err)
+		}
+	}
+	if this.Box != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Box); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Box", err)
+		}
+	}
+	if this.Bound != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Bound); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Bound", err)
+		}
+	}
+	if !_regex_StreamRequest_Namespace.MatchString(this.Namespace) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Namespace", fmt.Errorf(`value '%v' must be a string conforming to regex "^[A-Za-z0-9_.-]{0,64}$"`, this.Namespace))
+	}
+	if !(this.SinceUnix > -1) {
+		return github_com_mwitkow_go_proto_validators.FieldError("SinceUnix", fmt.Errorf(`value '%v' must be greater than '-1'`, this.SinceUnix))
+	}
+	return nil
+}
+func (this *Box) Validate() error {
+	if !(this.MinLat >= -90) {
+		return github_com_mwitkow_go_proto_validators.FieldError("MinLat", fmt.Errorf(`value '%v' must be greater than or equal to '-90'`, this.MinLat))
+	}
+	if !(this.MinLat <= 90) {
+		return github_com_mwitkow_go_proto_validators.FieldError("MinLat", fmt.Errorf(`value '%v' must be lower than or equal to '90'`, this.MinLat))
+	}
+	if !(this.MinLon >= -180) {
+		return github_com_mwitkow_go_proto_validators.FieldError("MinLon", fmt.Errorf(`value '%v' must be greater than or equal to '-180'`, this.MinLon))
+	}
+	if !(this.MinLon <= 180) {
+		return github_com_mwitkow_go_proto_validators.FieldError("MinLon", fmt.Errorf(`value '%v' must be lower than or equal to '180'`, this.MinLon))
+	}
+	if !(this.MaxLat >= -90) {
+		return github_com_mwitkow_go_proto_validators.FieldError("MaxLat", fmt.Errorf(`value '%v' must be greater than or equal to '-90'`, this.MaxLat))
+	}
+	if !(this.MaxLat <= 90) {
+		return github_com_mwitkow_go_proto_validators.FieldError("MaxLat", fmt.Errorf(`value '%v' must be lower than or equal to '90'`, this.MaxLat))
+	}
+	if !(this.MaxLon >= -180) {
+		return github_com_mwitkow_go_proto_validators.FieldError("MaxLon", fmt.Errorf(`value '%v' must be greater than or equal to '-180'`, this.MaxLon))
+	}
+	if !(this.MaxLon <= 180) {
+		return github_com_mwitkow_go_proto_validators.FieldError("MaxLon", fmt.Errorf(`value '%v' must be lower than or equal to '180'`, this.MaxLon))
+	}
 	return nil
 }
 func (this *StreamResponse) Validate() error {
@@ -125,11 +250,20 @@ func (this *StreamResponse) Validate() error {
 }
 
 var _regex_StreamRegexRequest_Regex = regexp.MustCompile(`^.{1,225}$`)
+var _regex_StreamRegexRequest_Namespace = regexp.MustCompile(`^[A-Za-z0-9_.-]{0,64}$`)
 
 func (this *StreamRegexRequest) Validate() error {
 	if !_regex_StreamRegexRequest_Regex.MatchString(this.Regex) {
 		return github_com_mwitkow_go_proto_validators.FieldError("Regex", fmt.Errorf(`value '%v' must be a string conforming to regex "^.{1,225}$"`, this.Regex))
 	}
+	if this.Tags != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Tags); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Tags", err)
+		}
+	}
+	if !_regex_StreamRegexRequest_Namespace.MatchString(this.Namespace) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Namespace", fmt.Errorf(`value '%v' must be a string conforming to regex "^[A-Za-z0-9_.-]{0,64}$"`, this.Namespace))
+	}
 	return nil
 }
 func (this *StreamRegexResponse) Validate() error {
@@ -142,11 +276,20 @@ func (this *StreamRegexResponse) Validate() error {
 }
 
 var _regex_StreamPrefixRequest_Prefix = regexp.MustCompile(`^.{1,225}$`)
+var _regex_StreamPrefixRequest_Namespace = regexp.MustCompile(`^[A-Za-z0-9_.-]{0,64}$`)
 
 func (this *StreamPrefixRequest) Validate() error {
 	if !_regex_StreamPrefixRequest_Prefix.MatchString(this.Prefix) {
 		return github_com_mwitkow_go_proto_validators.FieldError("Prefix", fmt.Errorf(`value '%v' must be a string conforming to regex "^.{1,225}$"`, this.Prefix))
 	}
+	if this.Tags != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Tags); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Tags", err)
+		}
+	}
+	if !_regex_StreamPrefixRequest_Namespace.MatchString(this.Namespace) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Namespace", fmt.Errorf(`value '%v' must be a string conforming to regex "^[A-Za-z0-9_.-]{0,64}$"`, this.Namespace))
+	}
 	return nil
 }
 func (this *StreamPrefixResponse) Validate() error {
@@ -157,10 +300,20 @@ func (this *StreamPrefixResponse) Validate() error {
 	}
 	return nil
 }
-func (this *SetRequest) Validate() error {
-	if nil == this.Object {
-		return github_com_mwitkow_go_proto_validators.FieldError("Object", fmt.Errorf("message must exist"))
+
+var _regex_WatchKeyRequest_Key = regexp.MustCompile(`^.{1,225}$`)
+var _regex_WatchKeyRequest_Namespace = regexp.MustCompile(`^[A-Za-z0-9_.-]{0,64}$`)
+
+func (this *WatchKeyRequest) Validate() error {
+	if !_regex_WatchKeyRequest_Key.MatchString(this.Key) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Key", fmt.Errorf(`value '%v' must be a string conforming to regex "^.{1,225}$"`, this.Key))
 	}
+	if !_regex_WatchKeyRequest_Namespace.MatchString(this.Namespace) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Namespace", fmt.Errorf(`value '%v' must be a string conforming to regex "^[A-Za-z0-9_.-]{0,64}$"`, this.Namespace))
+	}
+	return nil
+}
+func (this *WatchKeyResponse) Validate() error {
 	if this.Object != nil {
 		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Object); err != nil {
 			return github_com_mwitkow_go_proto_validators.FieldError("Object", err)
@@ -168,7 +321,16 @@ func (this *SetRequest) Validate() error {
 	}
 	return nil
 }
-func (this *SetResponse) Validate() error {
+
+var _regex_StreamControlRequest_Namespace = regexp.MustCompile(`^[A-Za-z0-9_.-]{0,64}$`)
+
+func (this *StreamControlRequest) Validate() error {
+	if !_regex_StreamControlRequest_Namespace.MatchString(this.Namespace) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Namespace", fmt.Errorf(`value '%v' must be a string conforming to regex "^[A-Za-z0-9_.-]{0,64}$"`, this.Namespace))
+	}
+	return nil
+}
+func (this *StreamControlResponse) Validate() error {
 	if this.Object != nil {
 		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Object); err != nil {
 			return github_com_mwitkow_go_proto_validators.FieldError("Object", err)
@@ -176,125 +338,1198 @@ func (this *SetResponse) Validate() error {
 	}
 	return nil
 }
-func (this *GetKeysRequest) Validate() error {
+func (this *ListClientsRequest) Validate() error {
 	return nil
 }
-func (this *GetKeysResponse) Validate() error {
+func (this *StreamClient) Validate() error {
 	return nil
 }
-
-var _regex_GetPrefixKeysRequest_Prefix = regexp.MustCompile(`^.{1,225}$`)
-
-func (this *GetPrefixKeysRequest) Validate() error {
-	if !_regex_GetPrefixKeysRequest_Prefix.MatchString(this.Prefix) {
-		return github_com_mwitkow_go_proto_validators.FieldError("Prefix", fmt.Errorf(`value '%v' must be a string conforming to regex "^.{1,225}$"`, this.Prefix))
+func (this *ListClientsResponse) Validate() error {
+	for _, item := range this.Clients {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Clients", err)
+			}
+		}
 	}
 	return nil
 }
-func (this *GetPrefixKeysResponse) Validate() error {
-	return nil
-}
 
-var _regex_GetRegexKeysRequest_Regex = regexp.MustCompile(`^.{1,225}$`)
+var _regex_SetRequest_Namespace = regexp.MustCompile(`^[A-Za-z0-9_.-]{0,64}$`)
 
-func (this *GetRegexKeysRequest) Validate() error {
-	if !_regex_GetRegexKeysRequest_Regex.MatchString(this.Regex) {
-		return github_com_mwitkow_go_proto_validators.FieldError("Regex", fmt.Errorf(`value '%v' must be a string conforming to regex "^.{1,225}$"`, this.Regex))
+func (this *SetRequest) Validate() error {
+	if nil == this.Object {
+		return github_com_mwitkow_go_proto_validators.FieldError("Object", fmt.Errorf("message must exist"))
+	}
+	if this.Object != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Object); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Object", err)
+		}
+	}
+	if !_regex_SetRequest_Namespace.MatchString(this.Namespace) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Namespace", fmt.Errorf(`value '%v' must be a string conforming to regex "^[A-Za-z0-9_.-]{0,64}$"`, this.Namespace))
+	}
+	if !(this.IfVersion > -1) {
+		return github_com_mwitkow_go_proto_validators.FieldError("IfVersion", fmt.Errorf(`value '%v' must be greater than '-1'`, this.IfVersion))
 	}
 	return nil
 }
-func (this *GetRegexKeysResponse) Validate() error {
+func (this *SetResponse) Validate() error {
+	if this.Object != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Object); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Object", err)
+		}
+	}
 	return nil
 }
-func (this *GetRequest) Validate() error {
+
+var _regex_UpdateRequest_Key = regexp.MustCompile(`^.{1,225}$`)
+var _regex_UpdateRequest_Namespace = regexp.MustCompile(`^[A-Za-z0-9_.-]{0,64}$`)
+
+func (this *UpdateRequest) Validate() error {
+	if !_regex_UpdateRequest_Key.MatchString(this.Key) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Key", fmt.Errorf(`value '%v' must be a string conforming to regex "^.{1,225}$"`, this.Key))
+	}
+	if this.Point != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Point); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Point", err)
+		}
+	}
+	if this.Tracking != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Tracking); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Tracking", err)
+		}
+	}
+	// Validation of proto3 map<> fields is unsupported.
+	if !_regex_UpdateRequest_Namespace.MatchString(this.Namespace) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Namespace", fmt.Errorf(`value '%v' must be a string conforming to regex "^[A-Za-z0-9_.-]{0,64}$"`, this.Namespace))
+	}
 	return nil
 }
-func (this *GetResponse) Validate() error {
-	// Validation of proto3 map<> fields is unsupported.
+func (this *UpdateResponse) Validate() error {
+	if this.Object != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Object); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Object", err)
+		}
+	}
 	return nil
 }
 
-var _regex_GetRegexRequest_Regex = regexp.MustCompile(`^.{1,225}$`)
+var _regex_IncrementRequest_Key = regexp.MustCompile(`^.{1,225}$`)
+var _regex_IncrementRequest_Counter = regexp.MustCompile(`^.{1,225}$`)
+var _regex_IncrementRequest_Namespace = regexp.MustCompile(`^[A-Za-z0-9_.-]{0,64}$`)
 
-func (this *GetRegexRequest) Validate() error {
-	if !_regex_GetRegexRequest_Regex.MatchString(this.Regex) {
-		return github_com_mwitkow_go_proto_validators.FieldError("Regex", fmt.Errorf(`value '%v' must be a string conforming to regex "^.{1,225}$"`, this.Regex))
+func (this *IncrementRequest) Validate() error {
+	if !_regex_IncrementRequest_Key.MatchString(this.Key) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Key", fmt.Errorf(`value '%v' must be a string conforming to regex "^.{1,225}$"`, this.Key))
+	}
+	if !_regex_IncrementRequest_Counter.MatchString(this.Counter) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Counter", fmt.Errorf(`value '%v' must be a string conforming to regex "^.{1,225}$"`, this.Counter))
+	}
+	if !_regex_IncrementRequest_Namespace.MatchString(this.Namespace) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Namespace", fmt.Errorf(`value '%v' must be a string conforming to regex "^[A-Za-z0-9_.-]{0,64}$"`, this.Namespace))
 	}
 	return nil
 }
-func (this *GetRegexResponse) Validate() error {
-	// Validation of proto3 map<> fields is unsupported.
+func (this *IncrementResponse) Validate() error {
+	if this.Object != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Object); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Object", err)
+		}
+	}
 	return nil
 }
 
-var _regex_GetPrefixRequest_Prefix = regexp.MustCompile(`^.{1,225}$`)
+var _regex_SetManyRequest_Namespace = regexp.MustCompile(`^[A-Za-z0-9_.-]{0,64}$`)
 
-func (this *GetPrefixRequest) Validate() error {
-	if !_regex_GetPrefixRequest_Prefix.MatchString(this.Prefix) {
-		return github_com_mwitkow_go_proto_validators.FieldError("Prefix", fmt.Errorf(`value '%v' must be a string conforming to regex "^.{1,225}$"`, this.Prefix))
+func (this *SetManyRequest) Validate() error {
+	if len(this.Objects) < 1 {
+		return github_com_mwitkow_go_proto_validators.FieldError("Objects", fmt.Errorf(`value '%v' must contain at least 1 elements`, this.Objects))
+	}
+	for _, item := range this.Objects {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Objects", err)
+			}
+		}
+	}
+	if !_regex_SetManyRequest_Namespace.MatchString(this.Namespace) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Namespace", fmt.Errorf(`value '%v' must be a string conforming to regex "^[A-Za-z0-9_.-]{0,64}$"`, this.Namespace))
 	}
 	return nil
 }
-func (this *GetPrefixResponse) Validate() error {
-	// Validation of proto3 map<> fields is unsupported.
+func (this *SetManyResponse) Validate() error {
+	for _, item := range this.Objects {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Objects", err)
+			}
+		}
+	}
 	return nil
 }
-func (this *DeleteRequest) Validate() error {
+
+var _regex_PositionUpdate_Key = regexp.MustCompile(`^.{1,225}$`)
+
+func (this *PositionUpdate) Validate() error {
+	if !_regex_PositionUpdate_Key.MatchString(this.Key) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Key", fmt.Errorf(`value '%v' must be a string conforming to regex "^.{1,225}$"`, this.Key))
+	}
+	if nil == this.Point {
+		return github_com_mwitkow_go_proto_validators.FieldError("Point", fmt.Errorf("message must exist"))
+	}
+	if this.Point != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Point); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Point", err)
+		}
+	}
 	return nil
 }
-func (this *DeleteResponse) Validate() error {
+
+var _regex_BulkUpdatePositionsRequest_Namespace = regexp.MustCompile(`^[A-Za-z0-9_.-]{0,64}$`)
+
+func (this *BulkUpdatePositionsRequest) Validate() error {
+	if len(this.Updates) < 1 {
+		return github_com_mwitkow_go_proto_validators.FieldError("Updates", fmt.Errorf(`value '%v' must contain at least 1 elements`, this.Updates))
+	}
+	for _, item := range this.Updates {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Updates", err)
+			}
+		}
+	}
+	if !_regex_BulkUpdatePositionsRequest_Namespace.MatchString(this.Namespace) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Namespace", fmt.Errorf(`value '%v' must be a string conforming to regex "^[A-Za-z0-9_.-]{0,64}$"`, this.Namespace))
+	}
 	return nil
 }
-func (this *ScanBoundRequest) Validate() error {
-	if this.Bound != nil {
-		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Bound); err != nil {
-			return github_com_mwitkow_go_proto_validators.FieldError("Bound", err)
+func (this *BulkUpdatePositionsResponse) Validate() error {
+	for _, item := range this.Objects {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Objects", err)
+			}
 		}
 	}
 	return nil
 }
-func (this *ScanBoundResponse) Validate() error {
-	// Validation of proto3 map<> fields is unsupported.
+func (this *CSVColumns) Validate() error {
 	return nil
 }
-func (this *ScanPrefixBoundRequest) Validate() error {
-	if this.Bound != nil {
-		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Bound); err != nil {
-			return github_com_mwitkow_go_proto_validators.FieldError("Bound", err)
+
+var _regex_ImportCSVRequest_Namespace = regexp.MustCompile(`^[A-Za-z0-9_.-]{0,64}$`)
+
+func (this *ImportCSVRequest) Validate() error {
+	if this.Csv == "" {
+		return github_com_mwitkow_go_proto_validators.FieldError("Csv", fmt.Errorf(`value '%v' must not be an empty string`, this.Csv))
+	}
+	if this.Columns != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Columns); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Columns", err)
 		}
 	}
+	if !_regex_ImportCSVRequest_Namespace.MatchString(this.Namespace) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Namespace", fmt.Errorf(`value '%v' must be a string conforming to regex "^[A-Za-z0-9_.-]{0,64}$"`, this.Namespace))
+	}
 	return nil
 }
-func (this *ScanPrefixBoundResponse) Validate() error {
-	// Validation of proto3 map<> fields is unsupported.
+func (this *CSVRowError) Validate() error {
 	return nil
 }
-func (this *ScanRegexBoundRequest) Validate() error {
-	if this.Bound != nil {
-		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Bound); err != nil {
-			return github_com_mwitkow_go_proto_validators.FieldError("Bound", err)
+func (this *ImportCSVResponse) Validate() error {
+	for _, item := range this.Errors {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Errors", err)
+			}
 		}
 	}
 	return nil
 }
-func (this *ScanRegexBoundResponse) Validate() error {
-	// Validation of proto3 map<> fields is unsupported.
+
+var _regex_GetKeysRequest_Namespace = regexp.MustCompile(`^[A-Za-z0-9_.-]{0,64}$`)
+
+func (this *GetKeysRequest) Validate() error {
+	if !(this.Limit > -1) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Limit", fmt.Errorf(`value '%v' must be greater than '-1'`, this.Limit))
+	}
+	if !_regex_GetKeysRequest_Namespace.MatchString(this.Namespace) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Namespace", fmt.Errorf(`value '%v' must be a string conforming to regex "^[A-Za-z0-9_.-]{0,64}$"`, this.Namespace))
+	}
 	return nil
 }
-func (this *GetPointRequest) Validate() error {
+func (this *GetKeysResponse) Validate() error {
 	return nil
 }
-func (this *GetPointResponse) Validate() error {
-	if this.Point != nil {
-		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Point); err != nil {
-			return github_com_mwitkow_go_proto_validators.FieldError("Point", err)
-		}
+
+var _regex_GetPrefixKeysRequest_Prefix = regexp.MustCompile(`^.{1,225}$`)
+var _regex_GetPrefixKeysRequest_Namespace = regexp.MustCompile(`^[A-Za-z0-9_.-]{0,64}$`)
+
+func (this *GetPrefixKeysRequest) Validate() error {
+	if !_regex_GetPrefixKeysRequest_Prefix.MatchString(this.Prefix) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Prefix", fmt.Errorf(`value '%v' must be a string conforming to regex "^.{1,225}$"`, this.Prefix))
+	}
+	if !_regex_GetPrefixKeysRequest_Namespace.MatchString(this.Namespace) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Namespace", fmt.Errorf(`value '%v' must be a string conforming to regex "^[A-Za-z0-9_.-]{0,64}$"`, this.Namespace))
+	}
+	if !(this.Limit > -1) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Limit", fmt.Errorf(`value '%v' must be greater than '-1'`, this.Limit))
 	}
 	return nil
 }
-func (this *PingRequest) Validate() error {
+func (this *GetPrefixKeysResponse) Validate() error {
 	return nil
 }
-func (this *PingResponse) Validate() error {
+
+var _regex_GetRegexKeysRequest_Regex = regexp.MustCompile(`^.{1,225}$`)
+var _regex_GetRegexKeysRequest_Namespace = regexp.MustCompile(`^[A-Za-z0-9_.-]{0,64}$`)
+
+func (this *GetRegexKeysRequest) Validate() error {
+	if !_regex_GetRegexKeysRequest_Regex.MatchString(this.Regex) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Regex", fmt.Errorf(`value '%v' must be a string conforming to regex "^.{1,225}$"`, this.Regex))
+	}
+	if !_regex_GetRegexKeysRequest_Namespace.MatchString(this.Namespace) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Namespace", fmt.Errorf(`value '%v' must be a string conforming to regex "^[A-Za-z0-9_.-]{0,64}$"`, this.Namespace))
+	}
+	return nil
+}
+func (this *GetRegexKeysResponse) Validate() error {
+	return nil
+}
+
+var _regex_CountRequest_Namespace = regexp.MustCompile(`^[A-Za-z0-9_.-]{0,64}$`)
+
+func (this *CountRequest) Validate() error {
+	if !_regex_CountRequest_Namespace.MatchString(this.Namespace) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Namespace", fmt.Errorf(`value '%v' must be a string conforming to regex "^[A-Za-z0-9_.-]{0,64}$"`, this.Namespace))
+	}
+	return nil
+}
+func (this *CountResponse) Validate() error {
+	return nil
+}
+
+var _regex_ExistsRequest_Namespace = regexp.MustCompile(`^[A-Za-z0-9_.-]{0,64}$`)
+
+func (this *ExistsRequest) Validate() error {
+	if len(this.Keys) < 1 {
+		return github_com_mwitkow_go_proto_validators.FieldError("Keys", fmt.Errorf(`value '%v' must contain at least 1 elements`, this.Keys))
+	}
+	if !_regex_ExistsRequest_Namespace.MatchString(this.Namespace) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Namespace", fmt.Errorf(`value '%v' must be a string conforming to regex "^[A-Za-z0-9_.-]{0,64}$"`, this.Namespace))
+	}
+	return nil
+}
+func (this *ExistsResponse) Validate() error {
+	// Validation of proto3 map<> fields is unsupported.
+	return nil
+}
+
+var _regex_TTLRequest_Namespace = regexp.MustCompile(`^[A-Za-z0-9_.-]{0,64}$`)
+
+func (this *TTLRequest) Validate() error {
+	if len(this.Keys) < 1 {
+		return github_com_mwitkow_go_proto_validators.FieldError("Keys", fmt.Errorf(`value '%v' must contain at least 1 elements`, this.Keys))
+	}
+	if !_regex_TTLRequest_Namespace.MatchString(this.Namespace) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Namespace", fmt.Errorf(`value '%v' must be a string conforming to regex "^[A-Za-z0-9_.-]{0,64}$"`, this.Namespace))
+	}
+	return nil
+}
+func (this *TTLResponse) Validate() error {
+	// Validation of proto3 map<> fields is unsupported.
+	return nil
+}
+func (this *Sort) Validate() error {
+	if this.Anchor != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Anchor); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Anchor", err)
+		}
+	}
+	return nil
+}
+func (this *TimeWindow) Validate() error {
+	if !(this.UpdatedAfterUnix > -1) {
+		return github_com_mwitkow_go_proto_validators.FieldError("UpdatedAfterUnix", fmt.Errorf(`value '%v' must be greater than '-1'`, this.UpdatedAfterUnix))
+	}
+	if !(this.UpdatedBeforeUnix > -1) {
+		return github_com_mwitkow_go_proto_validators.FieldError("UpdatedBeforeUnix", fmt.Errorf(`value '%v' must be greater than '-1'`, this.UpdatedBeforeUnix))
+	}
+	return nil
+}
+
+var _regex_GetRequest_Namespace = regexp.MustCompile(`^[A-Za-z0-9_.-]{0,64}$`)
+
+func (this *GetRequest) Validate() error {
+	// Validation of proto3 map<> fields is unsupported.
+	if !_regex_GetRequest_Namespace.MatchString(this.Namespace) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Namespace", fmt.Errorf(`value '%v' must be a string conforming to regex "^[A-Za-z0-9_.-]{0,64}$"`, this.Namespace))
+	}
+	if this.Sort != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Sort); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Sort", err)
+		}
+	}
+	if this.Window != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Window); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Window", err)
+		}
+	}
+	return nil
+}
+func (this *GetResponse) Validate() error {
+	// Validation of proto3 map<> fields is unsupported.
+	for _, item := range this.Ordered {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Ordered", err)
+			}
+		}
+	}
+	return nil
+}
+
+var _regex_GetRegexRequest_Regex = regexp.MustCompile(`^.{1,225}$`)
+var _regex_GetRegexRequest_Namespace = regexp.MustCompile(`^[A-Za-z0-9_.-]{0,64}$`)
+var _regex_GetRegexRequest_Prefix = regexp.MustCompile(`^.{0,225}$`)
+
+func (this *GetRegexRequest) Validate() error {
+	if !_regex_GetRegexRequest_Regex.MatchString(this.Regex) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Regex", fmt.Errorf(`value '%v' must be a string conforming to regex "^.{1,225}$"`, this.Regex))
+	}
+	if !(this.Limit > -1) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Limit", fmt.Errorf(`value '%v' must be greater than '-1'`, this.Limit))
+	}
+	// Validation of proto3 map<> fields is unsupported.
+	if !_regex_GetRegexRequest_Namespace.MatchString(this.Namespace) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Namespace", fmt.Errorf(`value '%v' must be a string conforming to regex "^[A-Za-z0-9_.-]{0,64}$"`, this.Namespace))
+	}
+	if this.Sort != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Sort); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Sort", err)
+		}
+	}
+	if this.Window != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Window); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Window", err)
+		}
+	}
+	if !_regex_GetRegexRequest_Prefix.MatchString(this.Prefix) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Prefix", fmt.Errorf(`value '%v' must be a string conforming to regex "^.{0,225}$"`, this.Prefix))
+	}
+	return nil
+}
+func (this *GetRegexResponse) Validate() error {
+	// Validation of proto3 map<> fields is unsupported.
+	for _, item := range this.Ordered {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Ordered", err)
+			}
+		}
+	}
+	return nil
+}
+
+var _regex_GetPrefixRequest_Prefix = regexp.MustCompile(`^.{1,225}$`)
+var _regex_GetPrefixRequest_Namespace = regexp.MustCompile(`^[A-Za-z0-9_.-]{0,64}$`)
+
+func (this *GetPrefixRequest) Validate() error {
+	if !_regex_GetPrefixRequest_Prefix.MatchString(this.Prefix) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Prefix", fmt.Errorf(`value '%v' must be a string conforming to regex "^.{1,225}$"`, this.Prefix))
+	}
+	// Validation of proto3 map<> fields is unsupported.
+	if !_regex_GetPrefixRequest_Namespace.MatchString(this.Namespace) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Namespace", fmt.Errorf(`value '%v' must be a string conforming to regex "^[A-Za-z0-9_.-]{0,64}$"`, this.Namespace))
+	}
+	if this.Sort != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Sort); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Sort", err)
+		}
+	}
+	if this.Window != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Window); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Window", err)
+		}
+	}
+	return nil
+}
+func (this *GetPrefixResponse) Validate() error {
+	// Validation of proto3 map<> fields is unsupported.
+	for _, item := range this.Ordered {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Ordered", err)
+			}
+		}
+	}
+	return nil
+}
+
+var _regex_GetGlobRequest_Pattern = regexp.MustCompile(`^.{1,225}$`)
+var _regex_GetGlobRequest_Namespace = regexp.MustCompile(`^[A-Za-z0-9_.-]{0,64}$`)
+
+func (this *GetGlobRequest) Validate() error {
+	if !_regex_GetGlobRequest_Pattern.MatchString(this.Pattern) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Pattern", fmt.Errorf(`value '%v' must be a string conforming to regex "^.{1,225}$"`, this.Pattern))
+	}
+	// Validation of proto3 map<> fields is unsupported.
+	if !_regex_GetGlobRequest_Namespace.MatchString(this.Namespace) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Namespace", fmt.Errorf(`value '%v' must be a string conforming to regex "^[A-Za-z0-9_.-]{0,64}$"`, this.Namespace))
+	}
+	return nil
+}
+func (this *GetGlobResponse) Validate() error {
+	// Validation of proto3 map<> fields is unsupported.
+	return nil
+}
+
+var _regex_GetTaggedRequest_Namespace = regexp.MustCompile(`^[A-Za-z0-9_.-]{0,64}$`)
+
+func (this *GetTaggedRequest) Validate() error {
+	if nil == this.Filter {
+		return github_com_mwitkow_go_proto_validators.FieldError("Filter", fmt.Errorf("message must exist"))
+	}
+	if this.Filter != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Filter); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Filter", err)
+		}
+	}
+	if !_regex_GetTaggedRequest_Namespace.MatchString(this.Namespace) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Namespace", fmt.Errorf(`value '%v' must be a string conforming to regex "^[A-Za-z0-9_.-]{0,64}$"`, this.Namespace))
+	}
+	return nil
+}
+func (this *GetTaggedResponse) Validate() error {
+	// Validation of proto3 map<> fields is unsupported.
+	return nil
+}
+
+var _regex_DeleteRequest_Namespace = regexp.MustCompile(`^[A-Za-z0-9_.-]{0,64}$`)
+
+func (this *DeleteRequest) Validate() error {
+	if !_regex_DeleteRequest_Namespace.MatchString(this.Namespace) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Namespace", fmt.Errorf(`value '%v' must be a string conforming to regex "^[A-Za-z0-9_.-]{0,64}$"`, this.Namespace))
+	}
+	return nil
+}
+func (this *DeleteResponse) Validate() error {
+	return nil
+}
+
+var _regex_DeletePrefixRequest_Prefix = regexp.MustCompile(`^.{1,225}$`)
+var _regex_DeletePrefixRequest_Namespace = regexp.MustCompile(`^[A-Za-z0-9_.-]{0,64}$`)
+
+func (this *DeletePrefixRequest) Validate() error {
+	if !_regex_DeletePrefixRequest_Prefix.MatchString(this.Prefix) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Prefix", fmt.Errorf(`value '%v' must be a string conforming to regex "^.{1,225}$"`, this.Prefix))
+	}
+	if !_regex_DeletePrefixRequest_Namespace.MatchString(this.Namespace) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Namespace", fmt.Errorf(`value '%v' must be a string conforming to regex "^[A-Za-z0-9_.-]{0,64}$"`, this.Namespace))
+	}
+	return nil
+}
+func (this *DeletePrefixResponse) Validate() error {
+	return nil
+}
+
+var _regex_DeleteRegexRequest_Regex = regexp.MustCompile(`^.{1,225}$`)
+var _regex_DeleteRegexRequest_Namespace = regexp.MustCompile(`^[A-Za-z0-9_.-]{0,64}$`)
+
+func (this *DeleteRegexRequest) Validate() error {
+	if !_regex_DeleteRegexRequest_Regex.MatchString(this.Regex) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Regex", fmt.Errorf(`value '%v' must be a string conforming to regex "^.{1,225}$"`, this.Regex))
+	}
+	if !_regex_DeleteRegexRequest_Namespace.MatchString(this.Namespace) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Namespace", fmt.Errorf(`value '%v' must be a string conforming to regex "^[A-Za-z0-9_.-]{0,64}$"`, this.Namespace))
+	}
+	return nil
+}
+func (this *DeleteRegexResponse) Validate() error {
+	return nil
+}
+func (this *DeleteExpiredRequest) Validate() error {
+	return nil
+}
+func (this *DeleteExpiredResponse) Validate() error {
+	return nil
+}
+
+var _regex_ScanObjectsRequest_Namespace = regexp.MustCompile(`^[A-Za-z0-9_.-]{0,64}$`)
+
+func (this *ScanObjectsRequest) Validate() error {
+	if !_regex_ScanObjectsRequest_Namespace.MatchString(this.Namespace) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Namespace", fmt.Errorf(`value '%v' must be a string conforming to regex "^[A-Za-z0-9_.-]{0,64}$"`, this.Namespace))
+	}
+	return nil
+}
+func (this *ScanObjectsResponse) Validate() error {
+	if this.Object != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Object); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Object", err)
+		}
+	}
+	return nil
+}
+
+var _regex_ScanBoundRequest_Namespace = regexp.MustCompile(`^[A-Za-z0-9_.-]{0,64}$`)
+
+func (this *ScanBoundRequest) Validate() error {
+	if this.Bound != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Bound); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Bound", err)
+		}
+	}
+	if this.Tags != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Tags); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Tags", err)
+		}
+	}
+	if !_regex_ScanBoundRequest_Namespace.MatchString(this.Namespace) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Namespace", fmt.Errorf(`value '%v' must be a string conforming to regex "^[A-Za-z0-9_.-]{0,64}$"`, this.Namespace))
+	}
+	return nil
+}
+func (this *ScanBoundResponse) Validate() error {
+	// Validation of proto3 map<> fields is unsupported.
+	return nil
+}
+
+var _regex_ScanPrefixBoundRequest_Namespace = regexp.MustCompile(`^[A-Za-z0-9_.-]{0,64}$`)
+
+func (this *ScanPrefixBoundRequest) Validate() error {
+	if this.Bound != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Bound); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Bound", err)
+		}
+	}
+	if this.Tags != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Tags); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Tags", err)
+		}
+	}
+	if !_regex_ScanPrefixBoundRequest_Namespace.MatchString(this.Namespace) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Namespace", fmt.Errorf(`value '%v' must be a string conforming to regex "^[A-Za-z0-9_.-]{0,64}$"`, this.Namespace))
+	}
+	return nil
+}
+func (this *ScanPrefixBoundResponse) Validate() error {
+	// Validation of proto3 map<> fields is unsupported.
+	return nil
+}
+
+var _regex_ScanRegexBoundRequest_Namespace = regexp.MustCompile(`^[A-Za-z0-9_.-]{0,64}$`)
+
+func (this *ScanRegexBoundRequest) Validate() error {
+	if this.Bound != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Bound); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Bound", err)
+		}
+	}
+	if this.Tags != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Tags); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Tags", err)
+		}
+	}
+	if !_regex_ScanRegexBoundRequest_Namespace.MatchString(this.Namespace) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Namespace", fmt.Errorf(`value '%v' must be a string conforming to regex "^[A-Za-z0-9_.-]{0,64}$"`, this.Namespace))
+	}
+	return nil
+}
+func (this *ScanRegexBoundResponse) Validate() error {
+	// Validation of proto3 map<> fields is unsupported.
+	return nil
+}
+
+var _regex_ScanIsochroneRequest_Namespace = regexp.MustCompile(`^[A-Za-z0-9_.-]{0,64}$`)
+
+func (this *ScanIsochroneRequest) Validate() error {
+	for _, item := range this.Polygon {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Polygon", err)
+			}
+		}
+	}
+	if this.Center != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Center); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Center", err)
+		}
+	}
+	if this.Tags != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Tags); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Tags", err)
+		}
+	}
+	if !_regex_ScanIsochroneRequest_Namespace.MatchString(this.Namespace) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Namespace", fmt.Errorf(`value '%v' must be a string conforming to regex "^[A-Za-z0-9_.-]{0,64}$"`, this.Namespace))
+	}
+	return nil
+}
+func (this *ScanIsochroneResponse) Validate() error {
+	// Validation of proto3 map<> fields is unsupported.
+	for _, item := range this.Polygon {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Polygon", err)
+			}
+		}
+	}
+	return nil
+}
+
+var _regex_WithinCorridorRequest_Namespace = regexp.MustCompile(`^[A-Za-z0-9_.-]{0,64}$`)
+
+func (this *WithinCorridorRequest) Validate() error {
+	if len(this.Route) < 2 {
+		return github_com_mwitkow_go_proto_validators.FieldError("Route", fmt.Errorf(`value '%v' must contain at least 2 elements`, this.Route))
+	}
+	for _, item := range this.Route {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Route", err)
+			}
+		}
+	}
+	if !(this.Buffer > 0) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Buffer", fmt.Errorf(`value '%v' must be strictly greater than '0'`, this.Buffer))
+	}
+	if this.Tags != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Tags); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Tags", err)
+		}
+	}
+	if !_regex_WithinCorridorRequest_Namespace.MatchString(this.Namespace) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Namespace", fmt.Errorf(`value '%v' must be a string conforming to regex "^[A-Za-z0-9_.-]{0,64}$"`, this.Namespace))
+	}
+	return nil
+}
+func (this *WithinCorridorResponse) Validate() error {
+	// Validation of proto3 map<> fields is unsupported.
+	return nil
+}
+func (this *PolylineRequest) Validate() error {
+	if len(this.Route) < 2 {
+		return github_com_mwitkow_go_proto_validators.FieldError("Route", fmt.Errorf(`value '%v' must contain at least 2 elements`, this.Route))
+	}
+	for _, item := range this.Route {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Route", err)
+			}
+		}
+	}
+	if nil == this.Point {
+		return github_com_mwitkow_go_proto_validators.FieldError("Point", fmt.Errorf("message must exist"))
+	}
+	if this.Point != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Point); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Point", err)
+		}
+	}
+	return nil
+}
+func (this *PolylineResponse) Validate() error {
+	if this.Nearest != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Nearest); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Nearest", err)
+		}
+	}
+	return nil
+}
+
+var _regex_BoundsRequest_Namespace = regexp.MustCompile(`^[A-Za-z0-9_.-]{0,64}$`)
+
+func (this *BoundsRequest) Validate() error {
+	if !(this.MinLat >= -90) {
+		return github_com_mwitkow_go_proto_validators.FieldError("MinLat", fmt.Errorf(`value '%v' must be greater than or equal to '-90'`, this.MinLat))
+	}
+	if !(this.MinLat <= 90) {
+		return github_com_mwitkow_go_proto_validators.FieldError("MinLat", fmt.Errorf(`value '%v' must be lower than or equal to '90'`, this.MinLat))
+	}
+	if !(this.MinLon >= -180) {
+		return github_com_mwitkow_go_proto_validators.FieldError("MinLon", fmt.Errorf(`value '%v' must be greater than or equal to '-180'`, this.MinLon))
+	}
+	if !(this.MinLon <= 180) {
+		return github_com_mwitkow_go_proto_validators.FieldError("MinLon", fmt.Errorf(`value '%v' must be lower than or equal to '180'`, this.MinLon))
+	}
+	if !(this.MaxLat >= -90) {
+		return github_com_mwitkow_go_proto_validators.FieldError("MaxLat", fmt.Errorf(`value '%v' must be greater than or equal to '-90'`, this.MaxLat))
+	}
+	if !(this.MaxLat <= 90) {
+		return github_com_mwitkow_go_proto_validators.FieldError("MaxLat", fmt.Errorf(`value '%v' must be lower than or equal to '90'`, this.MaxLat))
+	}
+	if !(this.MaxLon >= -180) {
+		return github_com_mwitkow_go_proto_validators.FieldError("MaxLon", fmt.Errorf(`value '%v' must be greater than or equal to '-180'`, this.MaxLon))
+	}
+	if !(this.MaxLon <= 180) {
+		return github_com_mwitkow_go_proto_validators.FieldError("MaxLon", fmt.Errorf(`value '%v' must be lower than or equal to '180'`, this.MaxLon))
+	}
+	if this.Tags != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Tags); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Tags", err)
+		}
+	}
+	if !_regex_BoundsRequest_Namespace.MatchString(this.Namespace) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Namespace", fmt.Errorf(`value '%v' must be a string conforming to regex "^[A-Za-z0-9_.-]{0,64}$"`, this.Namespace))
+	}
+	return nil
+}
+func (this *BoundsResponse) Validate() error {
+	// Validation of proto3 map<> fields is unsupported.
+	return nil
+}
+
+var _regex_NearestRequest_Namespace = regexp.MustCompile(`^[A-Za-z0-9_.-]{0,64}$`)
+
+func (this *NearestRequest) Validate() error {
+	if nil == this.Center {
+		return github_com_mwitkow_go_proto_validators.FieldError("Center", fmt.Errorf("message must exist"))
+	}
+	if this.Center != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Center); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Center", err)
+		}
+	}
+	if !(this.K > 0) {
+		return github_com_mwitkow_go_proto_validators.FieldError("K", fmt.Errorf(`value '%v' must be greater than '0'`, this.K))
+	}
+	if this.Tags != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Tags); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Tags", err)
+		}
+	}
+	// Validation of proto3 map<> fields is unsupported.
+	if !_regex_NearestRequest_Namespace.MatchString(this.Namespace) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Namespace", fmt.Errorf(`value '%v' must be a string conforming to regex "^[A-Za-z0-9_.-]{0,64}$"`, this.Namespace))
+	}
+	return nil
+}
+func (this *NearestObject) Validate() error {
+	if this.Object != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Object); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Object", err)
+		}
+	}
+	return nil
+}
+func (this *NearestResponse) Validate() error {
+	for _, item := range this.Objects {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Objects", err)
+			}
+		}
+	}
+	return nil
+}
+func (this *GetPointRequest) Validate() error {
+	return nil
+}
+func (this *GetPointResponse) Validate() error {
+	if this.Point != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Point); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Point", err)
+		}
+	}
+	return nil
+}
+
+var _regex_RadiusRequest_Namespace = regexp.MustCompile(`^[A-Za-z0-9_.-]{0,64}$`)
+
+func (this *RadiusRequest) Validate() error {
+	if nil == this.Center {
+		return github_com_mwitkow_go_proto_validators.FieldError("Center", fmt.Errorf("message must exist"))
+	}
+	if this.Center != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Center); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Center", err)
+		}
+	}
+	if !(this.Meters >= 0) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Meters", fmt.Errorf(`value '%v' must be greater than or equal to '0'`, this.Meters))
+	}
+	if this.Tags != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Tags); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Tags", err)
+		}
+	}
+	// Validation of proto3 map<> fields is unsupported.
+	if !_regex_RadiusRequest_Namespace.MatchString(this.Namespace) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Namespace", fmt.Errorf(`value '%v' must be a string conforming to regex "^[A-Za-z0-9_.-]{0,64}$"`, this.Namespace))
+	}
+	return nil
+}
+func (this *RadiusResponse) Validate() error {
+	for _, item := range this.Objects {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Objects", err)
+			}
+		}
+	}
+	return nil
+}
+
+var _regex_GeohashRequest_Prefix = regexp.MustCompile(`^[0-9b-hjkmnp-z]{1,12}$`)
+var _regex_GeohashRequest_Namespace = regexp.MustCompile(`^[A-Za-z0-9_.-]{0,64}$`)
+
+func (this *GeohashRequest) Validate() error {
+	if !_regex_GeohashRequest_Prefix.MatchString(this.Prefix) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Prefix", fmt.Errorf(`value '%v' must be a string conforming to regex "^[0-9b-hjkmnp-z]{1,12}$"`, this.Prefix))
+	}
+	if !_regex_GeohashRequest_Namespace.MatchString(this.Namespace) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Namespace", fmt.Errorf(`value '%v' must be a string conforming to regex "^[A-Za-z0-9_.-]{0,64}$"`, this.Namespace))
+	}
+	return nil
+}
+func (this *GeohashResponse) Validate() error {
+	// Validation of proto3 map<> fields is unsupported.
+	return nil
+}
+
+var _regex_HistoryRequest_Key = regexp.MustCompile(`^.{1,225}$`)
+var _regex_HistoryRequest_Namespace = regexp.MustCompile(`^[A-Za-z0-9_.-]{0,64}$`)
+
+func (this *HistoryRequest) Validate() error {
+	if !_regex_HistoryRequest_Key.MatchString(this.Key) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Key", fmt.Errorf(`value '%v' must be a string conforming to regex "^.{1,225}$"`, this.Key))
+	}
+	if !(this.Limit > -1) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Limit", fmt.Errorf(`value '%v' must be greater than '-1'`, this.Limit))
+	}
+	if !_regex_HistoryRequest_Namespace.MatchString(this.Namespace) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Namespace", fmt.Errorf(`value '%v' must be a string conforming to regex "^[A-Za-z0-9_.-]{0,64}$"`, this.Namespace))
+	}
+	return nil
+}
+func (this *HistoryPoint) Validate() error {
+	if this.Point != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Point); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Point", err)
+		}
+	}
+	return nil
+}
+func (this *HistoryResponse) Validate() error {
+	for _, item := range this.Points {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Points", err)
+			}
+		}
+	}
+	return nil
+}
+
+var _regex_PolygonRequest_Namespace = regexp.MustCompile(`^[A-Za-z0-9_.-]{0,64}$`)
+
+func (this *PolygonRequest) Validate() error {
+	if len(this.Vertices) < 3 {
+		return github_com_mwitkow_go_proto_validators.FieldError("Vertices", fmt.Errorf(`value '%v' must contain at least 3 elements`, this.Vertices))
+	}
+	for _, item := range this.Vertices {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Vertices", err)
+			}
+		}
+	}
+	if this.Tags != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Tags); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Tags", err)
+		}
+	}
+	if !_regex_PolygonRequest_Namespace.MatchString(this.Namespace) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Namespace", fmt.Errorf(`value '%v' must be a string conforming to regex "^[A-Za-z0-9_.-]{0,64}$"`, this.Namespace))
+	}
+	return nil
+}
+func (this *PolygonResponse) Validate() error {
+	// Validation of proto3 map<> fields is unsupported.
+	return nil
+}
+
+var _regex_ProximityMatrixRequest_Namespace = regexp.MustCompile(`^[A-Za-z0-9_.-]{0,64}$`)
+
+func (this *ProximityMatrixRequest) Validate() error {
+	if len(this.Keys) < 1 {
+		return github_com_mwitkow_go_proto_validators.FieldError("Keys", fmt.Errorf(`value '%v' must contain at least 1 elements`, this.Keys))
+	}
+	if !_regex_ProximityMatrixRequest_Namespace.MatchString(this.Namespace) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Namespace", fmt.Errorf(`value '%v' must be a string conforming to regex "^[A-Za-z0-9_.-]{0,64}$"`, this.Namespace))
+	}
+	return nil
+}
+func (this *ProximityRow) Validate() error {
+	return nil
+}
+func (this *ProximityMatrixResponse) Validate() error {
+	for _, item := range this.Rows {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Rows", err)
+			}
+		}
+	}
+	return nil
+}
+
+var _regex_BoundingCircleRequest_Namespace = regexp.MustCompile(`^[A-Za-z0-9_.-]{0,64}$`)
+
+func (this *BoundingCircleRequest) Validate() error {
+	if !_regex_BoundingCircleRequest_Namespace.MatchString(this.Namespace) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Namespace", fmt.Errorf(`value '%v' must be a string conforming to regex "^[A-Za-z0-9_.-]{0,64}$"`, this.Namespace))
+	}
+	return nil
+}
+func (this *BoundingCircleResponse) Validate() error {
+	if this.Center != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Center); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Center", err)
+		}
+	}
+	return nil
+}
+
+var _regex_AggregateRequest_Namespace = regexp.MustCompile(`^[A-Za-z0-9_.-]{0,64}$`)
+
+func (this *AggregateRequest) Validate() error {
+	if this.Tags != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Tags); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Tags", err)
+		}
+	}
+	// Validation of proto3 map<> fields is unsupported.
+	if !_regex_AggregateRequest_Namespace.MatchString(this.Namespace) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Namespace", fmt.Errorf(`value '%v' must be a string conforming to regex "^[A-Za-z0-9_.-]{0,64}$"`, this.Namespace))
+	}
+	return nil
+}
+func (this *AggregateResponse) Validate() error {
+	if this.Centroid != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Centroid); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Centroid", err)
+		}
+	}
+	if this.Bounds != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Bounds); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Bounds", err)
+		}
+	}
+	return nil
+}
+
+var _regex_ClusterRequest_Namespace = regexp.MustCompile(`^[A-Za-z0-9_.-]{0,64}$`)
+
+func (this *ClusterRequest) Validate() error {
+	if !(this.Precision > 0) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Precision", fmt.Errorf(`value '%v' must be greater than '0'`, this.Precision))
+	}
+	if !(this.Precision < 13) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Precision", fmt.Errorf(`value '%v' must be less than '13'`, this.Precision))
+	}
+	if this.Bounds != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Bounds); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Bounds", err)
+		}
+	}
+	if this.Tags != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Tags); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Tags", err)
+		}
+	}
+	// Validation of proto3 map<> fields is unsupported.
+	if !_regex_ClusterRequest_Namespace.MatchString(this.Namespace) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Namespace", fmt.Errorf(`value '%v' must be a string conforming to regex "^[A-Za-z0-9_.-]{0,64}$"`, this.Namespace))
+	}
+	return nil
+}
+func (this *Cluster) Validate() error {
+	if this.Cell != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Cell); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Cell", err)
+		}
+	}
+	if this.Centroid != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Centroid); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Centroid", err)
+		}
+	}
+	return nil
+}
+func (this *ClusterResponse) Validate() error {
+	for _, item := range this.Clusters {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Clusters", err)
+			}
+		}
+	}
+	return nil
+}
+func (this *DeadLetter) Validate() error {
+	if this.Object != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Object); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Object", err)
+		}
+	}
+	return nil
+}
+func (this *ObjectEvent) Validate() error {
+	if this.Event != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Event); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Event", err)
+		}
+	}
+	return nil
+}
+
+var _regex_GetEventsRequest_Namespace = regexp.MustCompile(`^[A-Za-z0-9_.-]{0,64}$`)
+
+func (this *GetEventsRequest) Validate() error {
+	if !(this.StartNanos > -1) {
+		return github_com_mwitkow_go_proto_validators.FieldError("StartNanos", fmt.Errorf(`value '%v' must be greater than '-1'`, this.StartNanos))
+	}
+	if !(this.EndNanos > -1) {
+		return github_com_mwitkow_go_proto_validators.FieldError("EndNanos", fmt.Errorf(`value '%v' must be greater than '-1'`, this.EndNanos))
+	}
+	if !(this.Limit > -1) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Limit", fmt.Errorf(`value '%v' must be greater than '-1'`, this.Limit))
+	}
+	if !_regex_GetEventsRequest_Namespace.MatchString(this.Namespace) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Namespace", fmt.Errorf(`value '%v' must be a string conforming to regex "^[A-Za-z0-9_.-]{0,64}$"`, this.Namespace))
+	}
+	return nil
+}
+func (this *GetEventsResponse) Validate() error {
+	for _, item := range this.Events {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Events", err)
+			}
+		}
+	}
+	return nil
+}
+func (this *CreateGeofenceRequest) Validate() error {
+	if nil == this.Geofence {
+		return github_com_mwitkow_go_proto_validators.FieldError("Geofence", fmt.Errorf("message must exist"))
+	}
+	if this.Geofence != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Geofence); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Geofence", err)
+		}
+	}
+	return nil
+}
+func (this *CreateGeofenceResponse) Validate() error {
+	if this.Geofence != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Geofence); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Geofence", err)
+		}
+	}
+	return nil
+}
+
+var _regex_DeleteGeofenceRequest_Name = regexp.MustCompile(`^.{1,225}$`)
+
+func (this *DeleteGeofenceRequest) Validate() error {
+	if !_regex_DeleteGeofenceRequest_Name.MatchString(this.Name) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Name", fmt.Errorf(`value '%v' must be a string conforming to regex "^.{1,225}$"`, this.Name))
+	}
+	return nil
+}
+func (this *DeleteGeofenceResponse) Validate() error {
+	return nil
+}
+func (this *ListGeofencesRequest) Validate() error {
+	return nil
+}
+func (this *ListGeofencesResponse) Validate() error {
+	for _, item := range this.Geofences {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Geofences", err)
+			}
+		}
+	}
+	return nil
+}
+func (this *OpenReadSessionRequest) Validate() error {
+	if !(this.TtlSeconds > -1) {
+		return github_com_mwitkow_go_proto_validators.FieldError("TtlSeconds", fmt.Errorf(`value '%v' must be greater than '-1'`, this.TtlSeconds))
+	}
+	return nil
+}
+func (this *OpenReadSessionResponse) Validate() error {
+	return nil
+}
+
+var _regex_CloseReadSessionRequest_Token = regexp.MustCompile(`^.{1,225}$`)
+
+func (this *CloseReadSessionRequest) Validate() error {
+	if !_regex_CloseReadSessionRequest_Token.MatchString(this.Token) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Token", fmt.Errorf(`value '%v' must be a string conforming to regex "^.{1,225}$"`, this.Token))
+	}
+	return nil
+}
+func (this *CloseReadSessionResponse) Validate() error {
+	return nil
+}
+func (this *GetDeadLettersRequest) Validate() error {
+	return nil
+}
+func (this *GetDeadLettersResponse) Validate() error {
+	for _, item := range this.DeadLetters {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("DeadLetters", err)
+			}
+		}
+	}
+	return nil
+}
+func (this *PingRequest) Validate() error {
+	return nil
+}
+func (this *PingResponse) Validate() error {
+	return nil
+}
+func (this *BackupRequest) Validate() error {
+	return nil
+}
+func (this *BackupResponse) Validate() error {
+	return nil
+}
+func (this *RestoreRequest) Validate() error {
+	return nil
+}
+func (this *RestoreResponse) Validate() error {
+	return nil
+}
+func (this *GCRequest) Validate() error {
+	if !(this.DiscardRatio >= 0) {
+		return github_com_mwitkow_go_proto_validators.FieldError("DiscardRatio", fmt.Errorf(`value '%v' must be greater than or equal to '0'`, this.DiscardRatio))
+	}
+	if !(this.DiscardRatio < 1) {
+		return github_com_mwitkow_go_proto_validators.FieldError("DiscardRatio", fmt.Errorf(`value '%v' must be strictly lower than '1'`, this.DiscardRatio))
+	}
+	return nil
+}
+func (this *GCResponse) Validate() error {
+	return nil
+}
+func (this *HealthRequest) Validate() error {
+	return nil
+}
+func (this *HealthResponse) Validate() error {
+	return nil
+}
+func (this *StatsRequest) Validate() error {
+	return nil
+}
+func (this *StatsResponse) Validate() error {
 	return nil
 }
@@ -0,0 +1,206 @@
+package helpers
+
+import (
+	"fmt"
+	api "github.com/autom8ter/geodb/gen/go/geodb"
+	geo "github.com/paulmach/go.geo"
+	"math"
+	"sync/atomic"
+)
+
+// distance modes selectable with SetDistanceMode
+const (
+	Haversine       = "haversine"
+	Equirectangular = "equirectangular"
+	Vincenty        = "vincenty"
+)
+
+var distanceModes = map[string]int32{
+	Haversine:       0,
+	Equirectangular: 1,
+	Vincenty:        2,
+}
+
+var distanceMode int32
+
+// SetDistanceMode selects how Distance measures the distance between two points: haversine(great-circle, the default),
+// equirectangular(a cheaper planar approximation that's accurate over small areas) or vincenty(WGS84 ellipsoid).
+func SetDistanceMode(mode string) error {
+	if mode == "" {
+		mode = Haversine
+	}
+	m, ok := distanceModes[mode]
+	if !ok {
+		return fmt.Errorf("unknown distance mode: %s", mode)
+	}
+	atomic.StoreInt32(&distanceMode, m)
+	return nil
+}
+
+// Distance returns the distance(meters) between two points using the distance mode(see SetDistanceMode). Every
+// rpc measures distances with it.
+func Distance(a, b *api.Point) float64 {
+	switch atomic.LoadInt32(&distanceMode) {
+	case 1:
+		return equirectangular(a, b)
+	case 2:
+		return vincenty(a, b)
+	}
+	return haversine(a, b)
+}
+
+// haversine returns the great-circle distance(meters) between two points. It matches
+// geo.Point.GeoDistanceFrom(point, true) without allocating a geo.Point per call.
+func haversine(a, b *api.Point) float64 {
+	dLat := deg2rad(b.Lat - a.Lat)
+	dLon := math.Abs(deg2rad(b.Lon - a.Lon))
+	dLat2Sin := math.Sin(dLat / 2)
+	dLon2Sin := math.Sin(dLon / 2)
+	h := dLat2Sin*dLat2Sin + math.Cos(deg2rad(a.Lat))*math.Cos(deg2rad(b.Lat))*dLon2Sin*dLon2Sin
+	return 2.0 * geo.EarthRadius * math.Atan2(math.Sqrt(h), math.Sqrt(1-h))
+}
+
+// equirectangular returns the planar approximation of the distance(meters) between two points
+func equirectangular(a, b *api.Point) float64 {
+	dLon := deg2rad(b.Lon - a.Lon)
+	// take the short way around the antimeridian
+	if dLon > math.Pi {
+		dLon -= 2 * math.Pi
+	} else if dLon < -math.Pi {
+		dLon += 2 * math.Pi
+	}
+	x := dLon * math.Cos(deg2rad(a.Lat+b.Lat)/2)
+	y := deg2rad(b.Lat - a.Lat)
+	return geo.EarthRadius * math.Sqrt(x*x+y*y)
+}
+
+// WGS84 ellipsoid
+const (
+	wgs84A = 6378137.0
+	wgs84F = 1 / 298.257223563
+	wgs84B = wgs84A * (1 - wgs84F)
+)
+
+// vincenty returns the distance(meters) between two points on the WGS84 ellipsoid using vincenty's inverse formula.
+// nearly antipodal points where the formula doesn't converge fall back to haversine.
+func vincenty(a, b *api.Point) float64 {
+	L := deg2rad(b.Lon - a.Lon)
+	U1 := math.Atan((1 - wgs84F) * math.Tan(deg2rad(a.Lat)))
+	U2 := math.Atan((1 - wgs84F) * math.Tan(deg2rad(b.Lat)))
+	sinU1, cosU1 := math.Sincos(U1)
+	sinU2, cosU2 := math.Sincos(U2)
+	lambda := L
+	for i := 0; i < 200; i++ {
+		sinLambda, cosLambda := math.Sincos(lambda)
+		sinSigma := math.Sqrt((cosU2*sinLambda)*(cosU2*sinLambda) + (cosU1*sinU2-sinU1*cosU2*cosLambda)*(cosU1*sinU2-sinU1*cosU2*cosLambda))
+		if sinSigma == 0 {
+			return 0
+		}
+		cosSigma := sinU1*sinU2 + cosU1*cosU2*cosLambda
+		sigma := math.Atan2(sinSigma, cosSigma)
+		sinAlpha := cosU1 * cosU2 * sinLambda / sinSigma
+		cosSqAlpha := 1 - sinAlpha*sinAlpha
+		cos2SigmaM := 0.0
+		if cosSqAlpha != 0 {
+			cos2SigmaM = cosSigma - 2*sinU1*sinU2/cosSqAlpha
+		}
+		C := wgs84F / 16 * cosSqAlpha * (4 + wgs84F*(4-3*cosSqAlpha))
+		previous := lambda
+		lambda = L + (1-C)*wgs84F*sinAlpha*(sigma+C*sinSigma*(cos2SigmaM+C*cosSigma*(-1+2*cos2SigmaM*cos2SigmaM)))
+		if math.Abs(lambda-previous) < 1e-12 {
+			uSq := cosSqAlpha * (wgs84A*wgs84A - wgs84B*wgs84B) / (wgs84B * wgs84B)
+			A := 1 + uSq/16384*(4096+uSq*(-768+uSq*(320-175*uSq)))
+			B := uSq / 1024 * (256 + uSq*(-128+uSq*(74-47*uSq)))
+			deltaSigma := B * sinSigma * (cos2SigmaM + B/4*(cosSigma*(-1+2*cos2SigmaM*cos2SigmaM)-B/6*cos2SigmaM*(-3+4*sinSigma*sinSigma)*(-3+4*cos2SigmaM*cos2SigmaM)))
+			return wgs84B * A * (sigma - deltaSigma)
+		}
+	}
+	return haversine(a, b)
+}
+
+// BoundContains reports whether the point is within the bound, matching geo.Bound.Contains.
+func BoundContains(bound *geo.Bound, p *api.Point) bool {
+	return p.Lat >= bound.South() && p.Lat <= bound.North() && p.Lon >= bound.West() && p.Lon <= bound.East()
+}
+
+func deg2rad(d float64) float64 {
+	return d * math.Pi / 180.0
+}
+
+func bearing(a, b *api.Point) float64 {
+	lat1, lat2 := deg2rad(a.Lat), deg2rad(b.Lat)
+	dLon := deg2rad(b.Lon - a.Lon)
+	y := math.Sin(dLon) * math.Cos(lat2)
+	x := math.Cos(lat1)*math.Sin(lat2) - math.Sin(lat1)*math.Cos(lat2)*math.Cos(dLon)
+	return math.Atan2(y, x)
+}
+
+// destination returns the point reached by traveling the angular distance(radians) from p along the initial bearing(radians).
+func destination(p *api.Point, bearing, angular float64) *api.Point {
+	lat1, lon1 := deg2rad(p.Lat), deg2rad(p.Lon)
+	lat2 := math.Asin(math.Sin(lat1)*math.Cos(angular) + math.Cos(lat1)*math.Sin(angular)*math.Cos(bearing))
+	lon2 := lon1 + math.Atan2(math.Sin(bearing)*math.Sin(angular)*math.Cos(lat1), math.Cos(angular)-math.Sin(lat1)*math.Sin(lat2))
+	lon := math.Mod(lon2*180/math.Pi+540, 360) - 180
+	return &api.Point{Lat: lat2 * 180 / math.Pi, Lon: lon}
+}
+
+// DistanceToSegment returns the great circle distance(meters) from p to the nearest point on the segment a-b.
+func DistanceToSegment(p, a, b *api.Point) float64 {
+	_, dist := NearestPointOnSegment(p, a, b)
+	return dist
+}
+
+// NearestPointOnSegment returns the point on the great circle segment a-b nearest to p & its distance(meters) from p.
+// the nearest point is an endpoint if p is behind a or beyond b, otherwise it's where p's cross track meets the segment.
+func NearestPointOnSegment(p, a, b *api.Point) (*api.Point, float64) {
+	// the spherical trigonometry needs great-circle angles whatever the distance mode
+	dAB := haversine(a, b) / geo.EarthRadius
+	if dAB == 0 {
+		return a, Distance(p, a)
+	}
+	dAP := haversine(a, p) / geo.EarthRadius
+	theta := bearing(a, p) - bearing(a, b)
+	if math.Cos(theta) <= 0 {
+		// p is behind a
+		return a, Distance(p, a)
+	}
+	crossTrack := math.Asin(math.Sin(dAP) * math.Sin(theta))
+	alongTrack := math.Acos(math.Max(-1, math.Min(1, math.Cos(dAP)/math.Cos(crossTrack))))
+	if alongTrack >= dAB {
+		return b, Distance(p, b)
+	}
+	return destination(a, bearing(a, b), alongTrack), math.Abs(crossTrack) * geo.EarthRadius
+}
+
+// NearestPointOnRoute returns the point on the route nearest to p, its distance(meters) from p & the index of the
+// route point that starts the nearest segment.
+func NearestPointOnRoute(p *api.Point, route []*api.Point) (*api.Point, float64, int) {
+	if len(route) == 1 {
+		return route[0], Distance(p, route[0]), 0
+	}
+	var (
+		nearest *api.Point
+		segment int
+		min     = math.Inf(1)
+	)
+	for i := 1; i < len(route); i++ {
+		if point, d := NearestPointOnSegment(p, route[i-1], route[i]); d < min {
+			nearest, min, segment = point, d, i-1
+		}
+	}
+	return nearest, min, segment
+}
+
+// DistanceToRoute returns the great circle distance(meters) from p to the nearest segment of the route.
+func DistanceToRoute(p *api.Point, route []*api.Point) float64 {
+	if len(route) == 1 {
+		return Distance(p, route[0])
+	}
+	min := math.Inf(1)
+	for i := 1; i < len(route); i++ {
+		if d := DistanceToSegment(p, route[i-1], route[i]); d < min {
+			min = d
+		}
+	}
+	return min
+}
@@ -0,0 +1,167 @@
+package helpers
+
+import (
+	api "github.com/autom8ter/geodb/gen/go/geodb"
+	geo "github.com/paulmach/go.geo"
+	"math"
+	"strings"
+)
+
+const geohashBase32 = "0123456789bcdefghjkmnpqrstuvwxyz"
+
+// Geohash encodes the point as a geohash with the given number of characters(clamped to 1-12). points that share
+// a longer geohash prefix are closer together.
+func Geohash(p *api.Point, precision int) string {
+	switch {
+	case precision < 1:
+		precision = 1
+	case precision > 12:
+		precision = 12
+	}
+	minLat, maxLat := -90.0, 90.0
+	minLon, maxLon := -180.0, 180.0
+	hash := make([]byte, 0, precision)
+	even := true
+	bit, ch := 0, 0
+	for len(hash) < precision {
+		if even {
+			mid := (minLon + maxLon) / 2
+			if p.Lon >= mid {
+				ch |= 1 << uint(4-bit)
+				minLon = mid
+			} else {
+				maxLon = mid
+			}
+		} else {
+			mid := (minLat + maxLat) / 2
+			if p.Lat >= mid {
+				ch |= 1 << uint(4-bit)
+				minLat = mid
+			} else {
+				maxLat = mid
+			}
+		}
+		even = !even
+		if bit < 4 {
+			bit++
+			continue
+		}
+		hash = append(hash, geohashBase32[ch])
+		bit, ch = 0, 0
+	}
+	return string(hash)
+}
+
+// GeohashBox returns the lat/lon box of the geohash cell. characters outside of the geohash alphabet are ignored
+func GeohashBox(hash string) *api.Box {
+	box := &api.Box{MinLat: -90, MinLon: -180, MaxLat: 90, MaxLon: 180}
+	even := true
+	for _, c := range hash {
+		ch := strings.IndexRune(geohashBase32, c)
+		if ch < 0 {
+			continue
+		}
+		for bit := 4; bit >= 0; bit-- {
+			on := ch&(1<<uint(bit)) != 0
+			if even {
+				mid := (box.MinLon + box.MaxLon) / 2
+				if on {
+					box.MinLon = mid
+				} else {
+					box.MaxLon = mid
+				}
+			} else {
+				mid := (box.MinLat + box.MaxLat) / 2
+				if on {
+					box.MinLat = mid
+				} else {
+					box.MaxLat = mid
+				}
+			}
+			even = !even
+		}
+	}
+	return box
+}
+
+// geohashCellSize returns the lat & lon span(degrees) of a geohash cell with the given number of characters
+func geohashCellSize(precision int) (float64, float64) {
+	lonBits := uint((5*precision + 1) / 2)
+	latBits := uint(5 * precision / 2)
+	return 180 / float64(uint64(1)<<latBits), 360 / float64(uint64(1)<<lonBits)
+}
+
+// GeohashCover returns the geohash cells overlapping the lat/lon box(minLon <= maxLon) at the highest precision <= maxPrecision
+// that needs no more than maxCells cells. every point inside the box has a geohash starting with one of the cells.
+func GeohashCover(minLat, minLon, maxLat, maxLon float64, maxPrecision, maxCells int) []string {
+	if maxPrecision > 12 {
+		maxPrecision = 12
+	}
+	for precision := maxPrecision; precision > 1; precision-- {
+		if cells := geohashCells(minLat, minLon, maxLat, maxLon, precision, maxCells); cells != nil {
+			return cells
+		}
+	}
+	return geohashCells(minLat, minLon, maxLat, maxLon, 1, 32)
+}
+
+// geohashCells returns the cells with the given precision overlapping the box or nil if there are more than maxCells of them
+func geohashCells(minLat, minLon, maxLat, maxLon float64, precision, maxCells int) []string {
+	latSize, lonSize := geohashCellSize(precision)
+	cellIndex := func(v, min, size float64, count int) int {
+		i := int(math.Floor((v - min) / size))
+		switch {
+		case i < 0:
+			return 0
+		case i >= count:
+			return count - 1
+		}
+		return i
+	}
+	latCount, lonCount := int(math.Round(180/latSize)), int(math.Round(360/lonSize))
+	minLatIdx, maxLatIdx := cellIndex(minLat, -90, latSize, latCount), cellIndex(maxLat, -90, latSize, latCount)
+	minLonIdx, maxLonIdx := cellIndex(minLon, -180, lonSize, lonCount), cellIndex(maxLon, -180, lonSize, lonCount)
+	if (maxLatIdx-minLatIdx+1)*(maxLonIdx-minLonIdx+1) > maxCells {
+		return nil
+	}
+	var cells []string
+	for i := minLatIdx; i <= maxLatIdx; i++ {
+		for j := minLonIdx; j <= maxLonIdx; j++ {
+			center := &api.Point{
+				Lat: -90 + (float64(i)+0.5)*latSize,
+				Lon: -180 + (float64(j)+0.5)*lonSize,
+			}
+			cells = append(cells, Geohash(center, precision))
+		}
+	}
+	return cells
+}
+
+// RadiusBox returns a lat/lon box containing every point within meters of center. If minLon > maxLon the box crosses
+// the antimeridian(see BoxContains).
+func RadiusBox(center *api.Point, meters float64) (minLat, minLon, maxLat, maxLon float64) {
+	// pad the angular distance so neither floating point error nor the difference between the spherical & ellipsoidal
+	// distance modes excludes a point at the radius
+	angular := meters / geo.EarthRadius * 1.01
+	dLat := angular * 180 / math.Pi
+	minLat, maxLat = center.Lat-dLat, center.Lat+dLat
+	if minLat <= -90 || maxLat >= 90 || angular >= math.Pi/2 {
+		return math.Max(minLat, -90), -180, math.Min(maxLat, 90), 180
+	}
+	// the widest of the spherical extent & the equirectangular extent at the box's most poleward latitude
+	dLon := math.Max(
+		math.Asin(math.Sin(angular)/math.Cos(deg2rad(center.Lat))),
+		angular/math.Cos(deg2rad(math.Max(math.Abs(minLat), math.Abs(maxLat)))),
+	) * 180 / math.Pi
+	if math.IsNaN(dLon) || dLon >= 180 {
+		return minLat, -180, maxLat, 180
+	}
+	minLon, maxLon = center.Lon-dLon, center.Lon+dLon
+	if minLon < -180 {
+		minLon += 360
+	}
+	if maxLon > 180 {
+		maxLon -= 360
+	}
+	return minLat, minLon, maxLat, maxLon
+}
@@ -0,0 +1,204 @@
+package helpers
+
+import (
+	api "github.com/autom8ter/geodb/gen/go/geodb"
+	"math"
+	"math/rand"
+	"sort"
+)
+
+type vec struct {
+	x, y float64
+}
+
+type circle struct {
+	center vec
+	radius float64
+}
+
+func (c circle) contains(v vec) bool {
+	return math.Hypot(v.x-c.center.x, v.y-c.center.y) <= c.radius*(1+1e-9)+1e-9
+}
+
+func circleFrom2(a, b vec) circle {
+	center := vec{(a.x + b.x) / 2, (a.y + b.y) / 2}
+	return circle{center, math.Hypot(a.x-center.x, a.y-center.y)}
+}
+
+func circleFrom3(a, b, c vec) circle {
+	bx, by := b.x-a.x, b.y-a.y
+	cx, cy := c.x-a.x, c.y-a.y
+	d := 2 * (bx*cy - by*cx)
+	if d == 0 {
+		// collinear points: the circle through the farthest pair covers the third
+		best := circleFrom2(a, b)
+		for _, cand := range []circle{circleFrom2(a, c), circleFrom2(b, c)} {
+			if cand.radius > best.radius {
+				best = cand
+			}
+		}
+		return best
+	}
+	ux := (cy*(bx*bx+by*by) - by*(cx*cx+cy*cy)) / d
+	uy := (bx*(cx*cx+cy*cy) - cx*(bx*bx+by*by)) / d
+	center := vec{ux + a.x, uy + a.y}
+	return circle{center, math.Hypot(ux, uy)}
+}
+
+// MinimumEnclosingCircle returns the center and radius(meters) of the smallest circle containing every point.
+// Points are projected onto a local equirectangular plane and solved with Welzl's algorithm, then the radius is
+// recomputed with great circle distances so every point is guaranteed to be inside the returned circle.
+func MinimumEnclosingCircle(points []*api.Point) (*api.Point, float64) {
+	if len(points) == 0 {
+		return nil, 0
+	}
+	ref := points[0]
+	cosLat := math.Cos(ref.Lat * math.Pi / 180)
+	project := func(p *api.Point) vec {
+		dLon := p.Lon - ref.Lon
+		if dLon > 180 {
+			dLon -= 360
+		} else if dLon < -180 {
+			dLon += 360
+		}
+		return vec{dLon * cosLat, p.Lat - ref.Lat}
+	}
+	vecs := make([]vec, len(points))
+	for i, p := range points {
+		vecs[i] = project(p)
+	}
+	rnd := rand.New(rand.NewSource(1))
+	rnd.Shuffle(len(vecs), func(i, j int) { vecs[i], vecs[j] = vecs[j], vecs[i] })
+	c := circle{vecs[0], 0}
+	for i := 1; i < len(vecs); i++ {
+		if c.contains(vecs[i]) {
+			continue
+		}
+		c = circle{vecs[i], 0}
+		for j := 0; j < i; j++ {
+			if c.contains(vecs[j]) {
+				continue
+			}
+			c = circleFrom2(vecs[i], vecs[j])
+			for k := 0; k < j; k++ {
+				if !c.contains(vecs[k]) {
+					c = circleFrom3(vecs[i], vecs[j], vecs[k])
+				}
+			}
+		}
+	}
+	lon := ref.Lon + c.center.x/cosLat
+	if lon > 180 {
+		lon -= 360
+	} else if lon < -180 {
+		lon += 360
+	}
+	center := &api.Point{
+		Lat: ref.Lat + c.center.y,
+		Lon: lon,
+	}
+	radius := 0.0
+	for _, p := range points {
+		if dist := Distance(center, p); dist > radius {
+			radius = dist
+		}
+	}
+	return center, radius
+}
+
+// PolygonContains reports whether the point is inside the polygon using ray casting. The polygon
+// is implicitly closed(the last vertex may repeat the first) and its vertices may be in either winding order.
+// Points on an edge or vertex are inside.
+func PolygonContains(polygon []*api.Point, p *api.Point) bool {
+	inside := false
+	for i, j := 0, len(polygon)-1; i < len(polygon); j, i = i, i+1 {
+		a, b := polygon[i], polygon[j]
+		if onSegment(a, b, p) {
+			return true
+		}
+		if (a.Lat > p.Lat) != (b.Lat > p.Lat) && p.Lon < (b.Lon-a.Lon)*(p.Lat-a.Lat)/(b.Lat-a.Lat)+a.Lon {
+			inside = !inside
+		}
+	}
+	return inside
+}
+
+// onSegment reports whether p lies on the segment between a & b(within floating point error)
+func onSegment(a, b, p *api.Point) bool {
+	const epsilon = 1e-12
+	cross := (b.Lon-a.Lon)*(p.Lat-a.Lat) - (b.Lat-a.Lat)*(p.Lon-a.Lon)
+	if math.Abs(cross) > epsilon {
+		return false
+	}
+	return p.Lon >= math.Min(a.Lon, b.Lon)-epsilon && p.Lon <= math.Max(a.Lon, b.Lon)+epsilon &&
+		p.Lat >= math.Min(a.Lat, b.Lat)-epsilon && p.Lat <= math.Max(a.Lat, b.Lat)+epsilon
+}
+
+// BoxContains reports whether the point is within the lat/lon box. If minLon > maxLon the box crosses the
+// antimeridian and is split into [minLon, 180] and [-180, maxLon].
+func BoxContains(minLat, minLon, maxLat, maxLon float64, p *api.Point) bool {
+	if p.Lat < minLat || p.Lat > maxLat {
+		return false
+	}
+	if minLon <= maxLon {
+		return p.Lon >= minLon && p.Lon <= maxLon
+	}
+	return p.Lon >= minLon || p.Lon <= maxLon
+}
+
+// RegionContains reports whether the point is inside the box and within the bound's radius of its center. a nil box
+// or bound matches every point.
+func RegionContains(box *api.Box, bound *api.Bound, p *api.Point) bool {
+	if p == nil {
+		return box == nil && bound == nil
+	}
+	if box != nil && !BoxContains(box.MinLat, box.MinLon, box.MaxLat, box.MaxLon, p) {
+		return false
+	}
+	if bound != nil && bound.Center != nil && Distance(bound.Center, p) > ToMeters(bound.Radius, bound.Unit) {
+		return false
+	}
+	return true
+}
+
+// Centroid returns the geographic center of the points: the mean of their unit vectors projected back onto the sphere.
+// Unlike a mean of the coordinates, points on either side of the antimeridian average to ±180 rather than 0.
+func Centroid(points []*api.Point) *api.Point {
+	var x, y, z float64
+	for _, p := range points {
+		lat, lon := deg2rad(p.Lat), deg2rad(p.Lon)
+		x += math.Cos(lat) * math.Cos(lon)
+		y += math.Cos(lat) * math.Sin(lon)
+		z += math.Sin(lat)
+	}
+	return &api.Point{
+		Lat: math.Atan2(z, math.Hypot(x, y)) * 180 / math.Pi,
+		Lon: math.Atan2(y, x) * 180 / math.Pi,
+	}
+}
+
+// BoundingBox returns the smallest lat/lon box containing every point. The box spans the longitudes around the largest
+// gap between the points, so a cluster straddling the antimeridian crosses it & has minLon > maxLon(see BoxContains).
+func BoundingBox(points []*api.Point) *api.Box {
+	if len(points) == 0 {
+		return nil
+	}
+	box := &api.Box{MinLat: 90, MaxLat: -90}
+	lons := make([]float64, 0, len(points))
+	for _, p := range points {
+		box.MinLat = math.Min(box.MinLat, p.Lat)
+		box.MaxLat = math.Max(box.MaxLat, p.Lat)
+		lons = append(lons, p.Lon)
+	}
+	sort.Float64s(lons)
+	// the gap that wraps around the antimeridian
+	gap := lons[0] + 360 - lons[len(lons)-1]
+	box.MinLon, box.MaxLon = lons[0], lons[len(lons)-1]
+	for i := 1; i < len(lons); i++ {
+		if lons[i]-lons[i-1] > gap {
+			gap = lons[i] - lons[i-1]
+			box.MinLon, box.MaxLon = lons[i], lons[i-1]
+		}
+	}
+	return box
+}
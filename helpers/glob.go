@@ -0,0 +1,43 @@
+package helpers
+
+import (
+	"strings"
+)
+
+// GlobPrefix returns the fixed portion of a glob pattern before its first wildcard.
+func GlobPrefix(pattern string) string {
+	if i := strings.IndexAny(pattern, "*?"); i >= 0 {
+		return pattern[:i]
+	}
+	return pattern
+}
+
+// GlobMatch reports whether key matches the glob pattern. '*' matches any sequence of characters(including none)
+// and '?' matches exactly one character.
+func GlobMatch(pattern, key string) bool {
+	p, k := []rune(pattern), []rune(key)
+	var (
+		pi, ki        int
+		star, starKey = -1, 0
+	)
+	for ki < len(k) {
+		switch {
+		case pi < len(p) && (p[pi] == '?' || p[pi] == k[ki]):
+			pi++
+			ki++
+		case pi < len(p) && p[pi] == '*':
+			star, starKey = pi, ki
+			pi++
+		case star >= 0:
+			// backtrack: let the last '*' consume one more character
+			starKey++
+			pi, ki = star+1, starKey
+		default:
+			return false
+		}
+	}
+	for pi < len(p) && p[pi] == '*' {
+		pi++
+	}
+	return pi == len(p)
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/gogo/protobuf/proto"
 	"github.com/golang/protobuf/jsonpb"
 	"googlemaps.github.io/maps"
+	"strings"
 )
 
 var jpb = jsonpb.Marshaler{}
@@ -27,3 +28,36 @@ func PrettyJson(msg proto.Message) string {
 	str, _ := jpb.MarshalToString(msg)
 	return fmt.Sprintln(str)
 }
+
+func SelectMetadata(metadata map[string]string, keys []string) map[string]string {
+	selected := map[string]string{}
+	for _, k := range keys {
+		k = strings.TrimSpace(k)
+		if v, ok := metadata[k]; ok {
+			selected[k] = v
+		}
+	}
+	return selected
+}
+
+// MatchMetadata reports whether metadata contains every key/value pair in selector. an empty selector matches everything
+func MatchMetadata(metadata, selector map[string]string) bool {
+	for k, v := range selector {
+		if val, ok := metadata[k]; !ok || val != v {
+			return false
+		}
+	}
+	return true
+}
+
+// MatchTimeWindow reports whether obj was updated within the window: at or after updated_after_unix & before updated_before_unix.
+// a nil or empty window matches everything
+func MatchTimeWindow(obj *api.Object, window *api.TimeWindow) bool {
+	if after := window.GetUpdatedAfterUnix(); after > 0 && obj.GetUpdatedUnix() < after {
+		return false
+	}
+	if before := window.GetUpdatedBeforeUnix(); before > 0 && obj.GetUpdatedUnix() >= before {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,570 @@
+package helpers
+
+import (
+	api "github.com/autom8ter/geodb/gen/go/geodb"
+	geo "github.com/paulmach/go.geo"
+	"math"
+	"strings"
+	"testing"
+)
+
+func distance(a, b *api.Point) float64 {
+	return geo.NewPointFromLatLng(a.Lat, a.Lon).GeoDistanceFrom(geo.NewPointFromLatLng(b.Lat, b.Lon), true)
+}
+
+func TestMinimumEnclosingCircle(t *testing.T) {
+	a := &api.Point{Lat: 39.756378173828125, Lon: -104.99414825439453}
+	b := &api.Point{Lat: 39.74863815307617, Lon: -105.00762176513672}
+	center, radius := MinimumEnclosingCircle([]*api.Point{a, b})
+	if math.Abs(radius-distance(a, b)/2) > 1 {
+		t.Fatalf("expected radius of half the distance between two points, got: %v", radius)
+	}
+	if math.Abs(distance(center, a)-distance(center, b)) > 1 {
+		t.Fatal("expected center to be equidistant from both points")
+	}
+	points := []*api.Point{
+		a,
+		b,
+		{Lat: 39.71670913696289, Lon: -104.95344543457031},
+		{Lat: 39.74626922607422, Lon: -104.97151184082031},
+		{Lat: 39.74, Lon: -104.99},
+	}
+	center, radius = MinimumEnclosingCircle(points)
+	for _, p := range points {
+		if distance(center, p) > radius+1e-6 {
+			t.Fatalf("point %v outside of enclosing circle", p)
+		}
+	}
+	center, radius = MinimumEnclosingCircle([]*api.Point{{Lat: 0, Lon: 179.9}, {Lat: 0, Lon: -179.9}})
+	if math.Abs(math.Abs(center.Lon)-180) > 1e-6 {
+		t.Fatalf("expected center on the antimeridian, got: %v", center.Lon)
+	}
+	if radius > 20000 {
+		t.Fatalf("expected a small circle across the antimeridian, got: %v", radius)
+	}
+}
+
+func TestDistance(t *testing.T) {
+	points := []*api.Point{
+		{Lat: 39.756378173828125, Lon: -104.99414825439453},
+		{Lat: 39.74863815307617, Lon: -105.00762176513672},
+		{Lat: 0, Lon: 179.9},
+		{Lat: 0, Lon: -179.9},
+		{Lat: -33.8688, Lon: 151.2093},
+	}
+	for _, a := range points {
+		for _, b := range points {
+			if got, want := Distance(a, b), distance(a, b); math.Abs(got-want) > 1e-6 {
+				t.Fatalf("expected %v, got: %v", want, got)
+			}
+		}
+	}
+	bound := geo.NewGeoBoundAroundPoint(geo.NewPointFromLatLng(points[0].Lat, points[0].Lon), 2000)
+	for _, p := range points {
+		if got, want := BoundContains(bound, p), bound.Contains(geo.NewPointFromLatLng(p.Lat, p.Lon)); got != want {
+			t.Fatalf("expected bound contains %v to be %v", p, want)
+		}
+	}
+}
+
+func TestDistanceUnits(t *testing.T) {
+	for _, tt := range []struct {
+		unit api.DistanceUnit
+		want float64
+	}{
+		{api.DistanceUnit_Meters, 1852},
+		{api.DistanceUnit_Kilometers, 1.852},
+		{api.DistanceUnit_Miles, 1.1507794480235425},
+		{api.DistanceUnit_NauticalMiles, 1},
+	} {
+		if got := FromMeters(1852, tt.unit); math.Abs(got-tt.want) > 1e-9 {
+			t.Fatalf("expected 1852 meters to be %v %s, got: %v", tt.want, tt.unit, got)
+		}
+		if got := ToMeters(tt.want, tt.unit); math.Abs(got-1852) > 1e-9 {
+			t.Fatalf("expected %v %s to be 1852 meters, got: %v", tt.want, tt.unit, got)
+		}
+	}
+}
+
+func TestMatchMetadata(t *testing.T) {
+	metadata := map[string]string{"tenant": "a", "status": "active"}
+	for _, tt := range []struct {
+		selector map[string]string
+		want     bool
+	}{
+		{nil, true},
+		{map[string]string{"tenant": "a"}, true},
+		{map[string]string{"tenant": "a", "status": "active"}, true},
+		{map[string]string{"tenant": "a", "status": "idle"}, false},
+		{map[string]string{"region": ""}, false},
+	} {
+		if got := MatchMetadata(metadata, tt.selector); got != tt.want {
+			t.Fatalf("expected selector %v to be %v", tt.selector, tt.want)
+		}
+	}
+}
+
+func TestDistanceModes(t *testing.T) {
+	defer SetDistanceMode(Haversine)
+	// reference distances from vincenty's paper & geographiclib
+	flindersPeak, buninyong := &api.Point{Lat: -37.95103341666667, Lon: 144.42486788888888}, &api.Point{Lat: -37.65282113888889, Lon: 143.92649552777777}
+	coors, union := &api.Point{Lat: 39.756378173828125, Lon: -104.99414825439453}, &api.Point{Lat: 39.75303, Lon: -105.00019}
+	for _, tc := range []struct {
+		mode      string
+		a, b      *api.Point
+		reference float64
+		tolerance float64
+	}{
+		{Vincenty, flindersPeak, buninyong, 54972.271, 0.001},
+		{Haversine, flindersPeak, buninyong, 54972.271, 0.005 * 54972.271},
+		{Equirectangular, flindersPeak, buninyong, 54972.271, 0.005 * 54972.271},
+		// a degree of longitude & latitude at the equator
+		{Vincenty, &api.Point{Lat: 0, Lon: 0}, &api.Point{Lat: 0, Lon: 1}, 111319.491, 0.001},
+		{Haversine, &api.Point{Lat: 0, Lon: 0}, &api.Point{Lat: 0, Lon: 1}, 111319.491, 0.001},
+		{Equirectangular, &api.Point{Lat: 0, Lon: 0}, &api.Point{Lat: 0, Lon: 1}, 111319.491, 0.001},
+		{Vincenty, &api.Point{Lat: 0, Lon: 0}, &api.Point{Lat: 1, Lon: 0}, 110574.389, 0.01},
+		// the planar approximation is close to great-circle over small areas
+		{Equirectangular, coors, union, haversine(coors, union), 0.001},
+		{Vincenty, &api.Point{Lat: 0, Lon: 179.9}, &api.Point{Lat: 0, Lon: -179.9}, 22263.898, 0.01},
+		{Equirectangular, &api.Point{Lat: 0, Lon: 179.9}, &api.Point{Lat: 0, Lon: -179.9}, 22263.898, 0.005 * 22263.898},
+		{Vincenty, coors, coors, 0, 0},
+	} {
+		if err := SetDistanceMode(tc.mode); err != nil {
+			t.Fatal(err.Error())
+		}
+		if got := Distance(tc.a, tc.b); math.Abs(got-tc.reference) > tc.tolerance {
+			t.Fatalf("%s: expected %v(+/- %v), got: %v", tc.mode, tc.reference, tc.tolerance, got)
+		}
+	}
+	if err := SetDistanceMode("manhattan"); err == nil {
+		t.Fatal("expected an error for an unknown distance mode")
+	}
+}
+
+var benchDistance float64
+
+func BenchmarkGeoDistanceFrom(b *testing.B) {
+	p1 := &api.Point{Lat: 39.756378173828125, Lon: -104.99414825439453}
+	p2 := &api.Point{Lat: 39.74863815307617, Lon: -105.00762176513672}
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		benchDistance = distance(p1, p2)
+	}
+}
+
+func BenchmarkDistance(b *testing.B) {
+	p1 := &api.Point{Lat: 39.756378173828125, Lon: -104.99414825439453}
+	p2 := &api.Point{Lat: 39.74863815307617, Lon: -105.00762176513672}
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		benchDistance = Distance(p1, p2)
+	}
+}
+
+func TestPolygonContains(t *testing.T) {
+	// a concave "L" shaped polygon
+	polygon := []*api.Point{
+		{Lat: 0, Lon: 0},
+		{Lat: 0, Lon: 2},
+		{Lat: 1, Lon: 2},
+		{Lat: 1, Lon: 1},
+		{Lat: 2, Lon: 1},
+		{Lat: 2, Lon: 0},
+	}
+	for _, tc := range []struct {
+		point  *api.Point
+		inside bool
+	}{
+		{&api.Point{Lat: 0.5, Lon: 0.5}, true},
+		{&api.Point{Lat: 0.5, Lon: 1.5}, true},
+		{&api.Point{Lat: 1.5, Lon: 0.5}, true},
+		{&api.Point{Lat: 1.5, Lon: 1.5}, false},
+		{&api.Point{Lat: -1, Lon: 0.5}, false},
+		{&api.Point{Lat: 0.5, Lon: 3}, false},
+		// boundary points
+		{&api.Point{Lat: 0, Lon: 1}, true},
+		{&api.Point{Lat: 1.5, Lon: 1}, true},
+		{&api.Point{Lat: 1, Lon: 1.5}, true},
+		{&api.Point{Lat: 2, Lon: 0}, true},
+		{&api.Point{Lat: 1, Lon: 1}, true},
+		{&api.Point{Lat: 2, Lon: 1.5}, false},
+	} {
+		if got := PolygonContains(polygon, tc.point); got != tc.inside {
+			t.Fatalf("expected %v inside to be %v", tc.point, tc.inside)
+		}
+		// an explicitly closed polygon is equivalent
+		if got := PolygonContains(append(polygon, polygon[0]), tc.point); got != tc.inside {
+			t.Fatalf("expected %v inside the closed polygon to be %v", tc.point, tc.inside)
+		}
+	}
+}
+
+func TestGlobMatch(t *testing.T) {
+	for _, tc := range []struct {
+		pattern, key string
+		match        bool
+	}{
+		{"truck-*", "truck-1", true},
+		{"truck-*", "truck-", true},
+		{"truck-*", "car-1", false},
+		{"truck-?", "truck-12", false},
+		{"truck-??", "truck-12", true},
+		{"*-driver-*", "east-driver-7", true},
+		{"*-driver-*", "east-rider-7", false},
+		{"a*b*c", "aXXbYYbc", true},
+		{"a*b*c", "aXXbYYbd", false},
+		{"exact", "exact", true},
+	} {
+		if got := GlobMatch(tc.pattern, tc.key); got != tc.match {
+			t.Fatalf("expected GlobMatch(%q, %q) to be %v", tc.pattern, tc.key, tc.match)
+		}
+	}
+	if prefix := GlobPrefix("truck-*-?"); prefix != "truck-" {
+		t.Fatalf("expected truck- prefix, got: %s", prefix)
+	}
+}
+
+func TestMatchTags(t *testing.T) {
+	tags := []string{"truck", "refrigerated"}
+	for _, tc := range []struct {
+		filter *api.TagFilter
+		match  bool
+	}{
+		{nil, true},
+		{&api.TagFilter{}, true},
+		{&api.TagFilter{Any: []string{"van", "truck"}}, true},
+		{&api.TagFilter{Any: []string{"van"}}, false},
+		{&api.TagFilter{All: []string{"truck", "refrigerated"}}, true},
+		{&api.TagFilter{All: []string{"truck", "hazmat"}}, false},
+		{&api.TagFilter{None: []string{"hazmat"}}, true},
+		{&api.TagFilter{Any: []string{"truck"}, None: []string{"refrigerated"}}, false},
+	} {
+		if got := MatchTags(tags, tc.filter); got != tc.match {
+			t.Fatalf("expected MatchTags(%v) to be %v", tc.filter, tc.match)
+		}
+	}
+	if !MatchTagRelation(tags, []string{"truck"}, api.TagRelation_SharedTags) {
+		t.Fatal("expected shared tag")
+	}
+	if MatchTagRelation(tags, []string{"truck"}, api.TagRelation_NoSharedTags) {
+		t.Fatal("expected no shared tags to fail")
+	}
+}
+
+func TestDistanceToSegment(t *testing.T) {
+	a := &api.Point{Lat: 0, Lon: 0}
+	b := &api.Point{Lat: 0, Lon: 1}
+	p := &api.Point{Lat: 0.001, Lon: 0.5}
+	if got, want := DistanceToSegment(p, a, b), Distance(p, &api.Point{Lat: 0, Lon: 0.5}); math.Abs(got-want) > 0.01 {
+		t.Fatalf("expected perpendicular distance %v, got: %v", want, got)
+	}
+	before := &api.Point{Lat: 0.001, Lon: -0.5}
+	if got, want := DistanceToSegment(before, a, b), Distance(before, a); math.Abs(got-want) > 1e-6 {
+		t.Fatalf("expected distance to start %v, got: %v", want, got)
+	}
+	after := &api.Point{Lat: -0.001, Lon: 1.5}
+	if got, want := DistanceToSegment(after, a, b), Distance(after, b); math.Abs(got-want) > 1e-6 {
+		t.Fatalf("expected distance to end %v, got: %v", want, got)
+	}
+	if got := DistanceToSegment(p, a, a); got != Distance(p, a) {
+		t.Fatal("expected a degenerate segment to measure distance to its point")
+	}
+	route := []*api.Point{a, b, {Lat: 1, Lon: 1}}
+	if got, want := DistanceToRoute(&api.Point{Lat: 0.5, Lon: 1.001}, route), Distance(&api.Point{Lat: 0.5, Lon: 1.001}, &api.Point{Lat: 0.5, Lon: 1}); math.Abs(got-want) > 0.01 {
+		t.Fatalf("expected distance to nearest segment %v, got: %v", want, got)
+	}
+}
+
+func TestNearestPointOnSegmentDistanceModes(t *testing.T) {
+	defer SetDistanceMode(Haversine)
+	// a degree of a meridian is ~0.7% shorter on the ellipsoid than on the sphere, so measuring the segment with the
+	// distance mode would put a point just short of b beyond the end of the segment
+	a, b := &api.Point{Lat: 0, Lon: 0}, &api.Point{Lat: 1, Lon: 0}
+	p := &api.Point{Lat: 0.998, Lon: 0.001}
+	for _, mode := range []string{Haversine, Equirectangular, Vincenty} {
+		if err := SetDistanceMode(mode); err != nil {
+			t.Fatal(err.Error())
+		}
+		nearest, dist := NearestPointOnSegment(p, a, b)
+		if math.Abs(nearest.Lat-p.Lat) > 1e-6 || math.Abs(nearest.Lon) > 1e-6 {
+			t.Fatalf("%s: expected the point due west of p, got: %v", mode, nearest)
+		}
+		if want := haversine(p, nearest); math.Abs(dist-want) > 0.01 {
+			t.Fatalf("%s: expected distance %v to the nearest point, got: %v", mode, want, dist)
+		}
+	}
+}
+
+func TestNearestPointOnRoute(t *testing.T) {
+	// meridians are great circles, so the nearest point to a point just east of one is due west of it
+	route := []*api.Point{{Lat: 0, Lon: 30}, {Lat: 10, Lon: 30}, {Lat: 20, Lon: 30}, {Lat: 20, Lon: 40}}
+	p := &api.Point{Lat: 15, Lon: 30.01}
+	nearest, dist, segment := NearestPointOnRoute(p, route)
+	if segment != 1 || math.Abs(nearest.Lat-15) > 1e-6 || math.Abs(nearest.Lon-30) > 1e-6 {
+		t.Fatalf("expected the middle of the second segment, got: %v segment: %v", nearest, segment)
+	}
+	if want := Distance(p, nearest); math.Abs(dist-want) > 0.01 {
+		t.Fatalf("expected distance %v to the nearest point, got: %v", want, dist)
+	}
+	// the nearest point of a diagonal segment is neither of its vertices
+	a, b := &api.Point{Lat: 39.70, Lon: -105.00}, &api.Point{Lat: 39.80, Lon: -104.90}
+	p = &api.Point{Lat: 39.76, Lon: -104.96}
+	nearest, dist = NearestPointOnSegment(p, a, b)
+	if dist >= Distance(p, a) || dist >= Distance(p, b) {
+		t.Fatalf("expected the nearest point to be closer than the vertices, got: %v", dist)
+	}
+	if nearest.Lat <= a.Lat || nearest.Lat >= b.Lat || nearest.Lon <= a.Lon || nearest.Lon >= b.Lon {
+		t.Fatalf("expected the nearest point to be within the segment, got: %v", nearest)
+	}
+	if want := Distance(p, nearest); math.Abs(dist-want) > 0.01 {
+		t.Fatalf("expected distance %v to the nearest point, got: %v", want, dist)
+	}
+	if along := Distance(a, nearest) + Distance(nearest, b); math.Abs(along-Distance(a, b)) > 0.01 {
+		t.Fatalf("expected the nearest point to be on the segment, got a detour of %v", along-Distance(a, b))
+	}
+	if nearest, _ := NearestPointOnSegment(&api.Point{Lat: 39.60, Lon: -105.10}, a, b); nearest != a {
+		t.Fatalf("expected a point behind the segment to be nearest its start, got: %v", nearest)
+	}
+}
+
+func TestBoxContains(t *testing.T) {
+	if !BoxContains(39, -106, 40, -104, &api.Point{Lat: 39.75, Lon: -105}) {
+		t.Fatal("expected point within box")
+	}
+	if BoxContains(39, -106, 40, -104, &api.Point{Lat: 41, Lon: -105}) {
+		t.Fatal("expected point north of box to be excluded")
+	}
+	// box crossing the antimeridian
+	for _, tc := range []struct {
+		point  *api.Point
+		inside bool
+	}{
+		{&api.Point{Lat: 0, Lon: 179.5}, true},
+		{&api.Point{Lat: 0, Lon: -179.5}, true},
+		{&api.Point{Lat: 0, Lon: 180}, true},
+		{&api.Point{Lat: 0, Lon: -180}, true},
+		{&api.Point{Lat: 0, Lon: 0}, false},
+		{&api.Point{Lat: 0, Lon: 178}, false},
+	} {
+		if got := BoxContains(-1, 179, 1, -179, tc.point); got != tc.inside {
+			t.Fatalf("expected %v inside to be %v", tc.point, tc.inside)
+		}
+	}
+}
+
+func TestGeohash(t *testing.T) {
+	if hash := Geohash(&api.Point{Lat: 57.64911, Lon: 10.40744}, 11); hash != "u4pruydqqvj" {
+		t.Fatalf("expected u4pruydqqvj, got: %s", hash)
+	}
+	if hash := Geohash(&api.Point{Lat: 57.64911, Lon: 10.40744}, 20); len(hash) != 12 {
+		t.Fatalf("expected precision to be clamped to 12, got: %s", hash)
+	}
+	a := Geohash(&api.Point{Lat: 39.7559, Lon: -104.9942}, 9)
+	b := Geohash(&api.Point{Lat: 39.7565, Lon: -104.9935}, 9)
+	far := Geohash(&api.Point{Lat: 40.7128, Lon: -74.0060}, 9)
+	if a[:5] != b[:5] {
+		t.Fatalf("expected nearby points to share a 5 character prefix, got: %s %s", a, b)
+	}
+	if a == b {
+		t.Fatalf("expected nearby points to differ at high precision, got: %s %s", a, b)
+	}
+	if a[:2] == far[:2] {
+		t.Fatalf("expected distant points not to share a prefix, got: %s %s", a, far)
+	}
+}
+
+func TestGeohashBox(t *testing.T) {
+	p := &api.Point{Lat: 57.64911, Lon: 10.40744}
+	for precision := 1; precision <= 12; precision++ {
+		box := GeohashBox(Geohash(p, precision))
+		if !BoxContains(box.MinLat, box.MinLon, box.MaxLat, box.MaxLon, p) {
+			t.Fatalf("expected the precision %v cell %v to contain %v", precision, box, p)
+		}
+		latSize, lonSize := geohashCellSize(precision)
+		if math.Abs(box.MaxLat-box.MinLat-latSize) > 1e-9 || math.Abs(box.MaxLon-box.MinLon-lonSize) > 1e-9 {
+			t.Fatalf("expected the precision %v cell to be %vx%v degrees, got: %v", precision, latSize, lonSize, box)
+		}
+	}
+	if box := GeohashBox("u"); box.MinLat != 45 || box.MaxLat != 90 || box.MinLon != 0 || box.MaxLon != 45 {
+		t.Fatalf("expected u to be lat 45-90 lon 0-45, got: %v", box)
+	}
+}
+
+func TestGeohashCover(t *testing.T) {
+	cells := GeohashCover(39.75, -105.0, 39.76, -104.99, 9, 64)
+	if len(cells) == 0 || len(cells) > 64 {
+		t.Fatalf("expected between 1 and 64 cells, got: %v", len(cells))
+	}
+	for _, p := range []*api.Point{{Lat: 39.75, Lon: -105.0}, {Lat: 39.755, Lon: -104.995}, {Lat: 39.76, Lon: -104.99}} {
+		hash := Geohash(p, 9)
+		covered := false
+		for _, cell := range cells {
+			if strings.HasPrefix(hash, cell) {
+				covered = true
+			}
+		}
+		if !covered {
+			t.Fatalf("expected %v(%s) to be covered by %v", p, hash, cells)
+		}
+	}
+	if cells := GeohashCover(-90, -180, 90, 180, 9, 64); len(cells) != 32 {
+		t.Fatalf("expected the whole world to be covered by the 32 single character cells, got: %v", len(cells))
+	}
+}
+
+func TestRadiusBox(t *testing.T) {
+	center := &api.Point{Lat: 39.7559, Lon: -104.9942}
+	minLat, minLon, maxLat, maxLon := RadiusBox(center, 1000)
+	for _, p := range []*api.Point{
+		{Lat: center.Lat + 1000/geo.EarthRadius*180/math.Pi, Lon: center.Lon},
+		{Lat: center.Lat - 1000/geo.EarthRadius*180/math.Pi, Lon: center.Lon},
+	} {
+		if !BoxContains(minLat, minLon, maxLat, maxLon, p) {
+			t.Fatalf("expected %v to be inside the radius box", p)
+		}
+	}
+	if BoxContains(minLat, minLon, maxLat, maxLon, &api.Point{Lat: center.Lat, Lon: center.Lon + 0.1}) {
+		t.Fatal("expected a point ~8.5km away to be outside the radius box")
+	}
+	minLat, minLon, maxLat, maxLon = RadiusBox(&api.Point{Lat: 0, Lon: 179.999}, 1000)
+	if minLon <= maxLon || !BoxContains(minLat, minLon, maxLat, maxLon, &api.Point{Lat: 0, Lon: -179.999}) {
+		t.Fatalf("expected the radius box to cross the antimeridian, got: %v %v", minLon, maxLon)
+	}
+	if _, minLon, _, maxLon = RadiusBox(&api.Point{Lat: 89.999, Lon: 0}, 1000); minLon != -180 || maxLon != 180 {
+		t.Fatalf("expected a radius box including the pole to span every longitude, got: %v %v", minLon, maxLon)
+	}
+}
+
+func TestCentroid(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		points   []*api.Point
+		expected *api.Point
+	}{
+		{"single", []*api.Point{{Lat: 39.75, Lon: -105}}, &api.Point{Lat: 39.75, Lon: -105}},
+		{"equator", []*api.Point{{Lat: 0, Lon: 10}, {Lat: 0, Lon: 20}}, &api.Point{Lat: 0, Lon: 15}},
+		// a naive mean of 179 & -179 would be 0, on the other side of the world
+		{"antimeridian", []*api.Point{{Lat: 0, Lon: 179}, {Lat: 0, Lon: -179}}, &api.Point{Lat: 0, Lon: 180}},
+		{"antimeridian west", []*api.Point{{Lat: 1, Lon: 179}, {Lat: -1, Lon: -177}, {Lat: 0, Lon: -178}}, &api.Point{Lat: 0, Lon: -178.667}},
+	} {
+		centroid := Centroid(tc.points)
+		lonDiff := math.Abs(centroid.Lon - tc.expected.Lon)
+		if lonDiff > 180 {
+			lonDiff = 360 - lonDiff
+		}
+		if math.Abs(centroid.Lat-tc.expected.Lat) > 0.01 || lonDiff > 0.01 {
+			t.Fatalf("%s: expected centroid %v, got: %v", tc.name, tc.expected, centroid)
+		}
+	}
+}
+
+func TestBoundingBox(t *testing.T) {
+	box := BoundingBox([]*api.Point{{Lat: 39, Lon: -106}, {Lat: 40, Lon: -104}, {Lat: 39.5, Lon: -105}})
+	if box.MinLat != 39 || box.MaxLat != 40 || box.MinLon != -106 || box.MaxLon != -104 {
+		t.Fatalf("unexpected box: %v", box)
+	}
+	// a cluster straddling 180 crosses the antimeridian instead of spanning the globe
+	box = BoundingBox([]*api.Point{{Lat: 1, Lon: 179}, {Lat: -1, Lon: -177}, {Lat: 0, Lon: 178.5}})
+	if box.MinLat != -1 || box.MaxLat != 1 || box.MinLon != 178.5 || box.MaxLon != -177 {
+		t.Fatalf("unexpected antimeridian box: %v", box)
+	}
+	for _, p := range []*api.Point{{Lat: 0, Lon: 180}, {Lat: 0, Lon: -178}} {
+		if !BoxContains(box.MinLat, box.MinLon, box.MaxLat, box.MaxLon, p) {
+			t.Fatalf("expected %v inside %v", p, box)
+		}
+	}
+	if BoundingBox(nil) != nil {
+		t.Fatal("expected no box for zero points")
+	}
+}
+
+func TestObjectDistance(t *testing.T) {
+	square := []*api.Point{{Lat: 0, Lon: 0}, {Lat: 0, Lon: 1}, {Lat: 1, Lon: 1}, {Lat: 1, Lon: 0}}
+	zone := &api.Object{Point: &api.Point{Lat: 0.5, Lon: 0.5}, Polygon: square}
+	for _, tc := range []struct {
+		name     string
+		obj      *api.Object
+		expected float64
+	}{
+		{"point inside polygon", &api.Object{Point: &api.Point{Lat: 0.25, Lon: 0.75}}, 0},
+		{"point on polygon edge", &api.Object{Point: &api.Point{Lat: 0, Lon: 0.5}}, 0},
+		// the nearest edge, not the zone's point
+		{"point outside polygon", &api.Object{Point: &api.Point{Lat: 0.5, Lon: 1.1}}, distance(&api.Point{Lat: 0.5, Lon: 1.1}, &api.Point{Lat: 0.5, Lon: 1})},
+		{"polyline crossing polygon", &api.Object{Point: &api.Point{Lat: 2, Lon: 2}, Polyline: []*api.Point{{Lat: 2, Lon: 0.5}, {Lat: -1, Lon: 0.5}}}, 0},
+		{"polygon overlapping polygon", &api.Object{Point: &api.Point{Lat: 1, Lon: 1}, Polygon: []*api.Point{{Lat: 0.9, Lon: 0.9}, {Lat: 0.9, Lon: 2}, {Lat: 2, Lon: 2}, {Lat: 2, Lon: 0.9}}}, 0},
+		{"polygon within polygon", &api.Object{Point: &api.Point{Lat: 0.5, Lon: 0.5}, Polygon: []*api.Point{{Lat: 0.4, Lon: 0.4}, {Lat: 0.4, Lon: 0.6}, {Lat: 0.6, Lon: 0.6}}}, 0},
+		{"separate polygons", &api.Object{Point: &api.Point{Lat: 0.5, Lon: 2.5}, Polygon: []*api.Point{{Lat: 0, Lon: 2}, {Lat: 0, Lon: 3}, {Lat: 1, Lon: 3}, {Lat: 1, Lon: 2}}}, DistanceToSegment(&api.Point{Lat: 1, Lon: 1}, &api.Point{Lat: 0, Lon: 2}, &api.Point{Lat: 1, Lon: 2})},
+	} {
+		if got := ObjectDistance(tc.obj, zone); math.Abs(got-tc.expected) > 1 {
+			t.Fatalf("%s: expected %v meters, got: %v", tc.name, tc.expected, got)
+		}
+		if got := ObjectDistance(zone, tc.obj); math.Abs(got-tc.expected) > 1 {
+			t.Fatalf("%s(reversed): expected %v meters, got: %v", tc.name, tc.expected, got)
+		}
+	}
+	a, b := &api.Object{Point: &api.Point{Lat: 39.75, Lon: -105}}, &api.Object{Point: &api.Point{Lat: 39.76, Lon: -104.99}}
+	if ObjectDistance(a, b) != Distance(a.Point, b.Point) {
+		t.Fatal("expected objects without geometry to be measured point to point")
+	}
+}
+
+func TestCompositeKey(t *testing.T) {
+	for _, key := range []CompositeKey{
+		{"acme", "gate/1", "temp"},
+		{"acme/west", "gate", "temp"},
+		{`acme\`, `/`, `\/`},
+		{"", "device", ""},
+		{"single"},
+	} {
+		parsed, err := ParseCompositeKey(key.String())
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		if len(parsed) != len(key) {
+			t.Fatalf("expected %q to round trip, got: %q", key, parsed)
+		}
+		for i := range key {
+			if parsed[i] != key[i] {
+				t.Fatalf("expected %q to round trip, got: %q", key, parsed)
+			}
+		}
+	}
+	if key := (CompositeKey{"acme", "gate/1"}).String(); key != `acme/gate\/1` {
+		t.Fatalf("unexpected encoding: %s", key)
+	}
+	// a prefix only matches keys whose leading components are equal
+	prefix := CompositeKey{"acme"}.Prefix()
+	for _, key := range []CompositeKey{{"acme/west", "gate"}, {"acme2", "gate"}, {`acme\`, "gate"}, {"acme"}} {
+		if strings.HasPrefix(key.String(), prefix) {
+			t.Fatalf("expected %q not to have the prefix %s", key, prefix)
+		}
+	}
+	if !strings.HasPrefix((CompositeKey{"acme", "gate/1", "temp"}).String(), prefix) {
+		t.Fatalf("expected the tenant's key to have the prefix %s", prefix)
+	}
+	for _, key := range []string{`acme\x`, `acme\`} {
+		if _, err := ParseCompositeKey(key); err == nil {
+			t.Fatalf("expected %s to be rejected", key)
+		}
+	}
+}
+
+func TestMatchTimeWindow(t *testing.T) {
+	obj := &api.Object{UpdatedUnix: 100}
+	for _, tc := range []struct {
+		window *api.TimeWindow
+		match  bool
+	}{
+		{nil, true},
+		{&api.TimeWindow{}, true},
+		{&api.TimeWindow{UpdatedAfterUnix: 100}, true},
+		{&api.TimeWindow{UpdatedAfterUnix: 101}, false},
+		{&api.TimeWindow{UpdatedBeforeUnix: 101}, true},
+		{&api.TimeWindow{UpdatedBeforeUnix: 100}, false},
+		{&api.TimeWindow{UpdatedAfterUnix: 50, UpdatedBeforeUnix: 150}, true},
+	} {
+		if got := MatchTimeWindow(obj, tc.window); got != tc.match {
+			t.Fatalf("expected %v to match: %v, got: %v", tc.window, tc.match, got)
+		}
+	}
+}
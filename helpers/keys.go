@@ -0,0 +1,72 @@
+package helpers
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	// KeySeparator separates the components of a CompositeKey
+	KeySeparator = '/'
+	keyEscape    = '\\'
+)
+
+// CompositeKey is an object key made of several components(ex: tenant, device, sensor). components are escaped so they
+// may contain the separator, making prefix queries on the leading components exact.
+type CompositeKey []string
+
+// String encodes the key: each component with '\' & '/' escaped as '\\' & '\/', joined by '/'
+func (k CompositeKey) String() string {
+	var b strings.Builder
+	for i, part := range k {
+		if i > 0 {
+			b.WriteRune(KeySeparator)
+		}
+		for _, r := range part {
+			if r == KeySeparator || r == keyEscape {
+				b.WriteRune(keyEscape)
+			}
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// Prefix returns the prefix of every encoded key whose leading components are k's components(ex: every key of a tenant).
+// unlike String, it ends with a separator, so the tenant "acme" doesn't match the keys of "acme2"
+func (k CompositeKey) Prefix() string {
+	if len(k) == 0 {
+		return ""
+	}
+	return k.String() + string(KeySeparator)
+}
+
+// ParseCompositeKey decodes a key encoded by CompositeKey.String
+func ParseCompositeKey(key string) (CompositeKey, error) {
+	var (
+		parts   CompositeKey
+		part    strings.Builder
+		escaped bool
+	)
+	for _, r := range key {
+		switch {
+		case escaped:
+			if r != KeySeparator && r != keyEscape {
+				return nil, fmt.Errorf("invalid escape sequence %c%c in key: %s", keyEscape, r, key)
+			}
+			part.WriteRune(r)
+			escaped = false
+		case r == keyEscape:
+			escaped = true
+		case r == KeySeparator:
+			parts = append(parts, part.String())
+			part.Reset()
+		default:
+			part.WriteRune(r)
+		}
+	}
+	if escaped {
+		return nil, fmt.Errorf("unterminated escape sequence in key: %s", key)
+	}
+	return append(parts, part.String()), nil
+}
@@ -0,0 +1,88 @@
+package helpers
+
+import (
+	api "github.com/autom8ter/geodb/gen/go/geodb"
+	"math"
+)
+
+// shape is the geometry of an object: its polygon, polyline or point
+type shape struct {
+	vertices []*api.Point
+	closed   bool
+}
+
+func objectShape(obj *api.Object) shape {
+	switch {
+	case len(obj.Polygon) >= 3:
+		return shape{vertices: obj.Polygon, closed: true}
+	case len(obj.Polyline) >= 2:
+		return shape{vertices: obj.Polyline}
+	default:
+		return shape{vertices: []*api.Point{obj.Point}}
+	}
+}
+
+// edges returns the shape's vertices in the order they're connected. polygons are closed by repeating the first vertex
+func (s shape) edges() []*api.Point {
+	n := len(s.vertices)
+	if !s.closed || (s.vertices[0].Lat == s.vertices[n-1].Lat && s.vertices[0].Lon == s.vertices[n-1].Lon) {
+		return s.vertices
+	}
+	return append(append([]*api.Point{}, s.vertices...), s.vertices[0])
+}
+
+// ObjectDistance returns the distance(meters) between the geometries of two objects: their polygon if set, otherwise their
+// polyline if set, otherwise their point. Geometries that touch, intersect or contain one another are 0 meters apart.
+func ObjectDistance(a, b *api.Object) float64 {
+	if len(a.Polygon) == 0 && len(a.Polyline) == 0 && len(b.Polygon) == 0 && len(b.Polyline) == 0 {
+		return Distance(a.Point, b.Point)
+	}
+	sa, sb := objectShape(a), objectShape(b)
+	if shapesIntersect(sa, sb) {
+		return 0
+	}
+	// the nearest points of two separate shapes always include a vertex of one of them
+	min := math.Inf(1)
+	for _, p := range sa.vertices {
+		min = math.Min(min, DistanceToRoute(p, sb.edges()))
+	}
+	for _, p := range sb.vertices {
+		min = math.Min(min, DistanceToRoute(p, sa.edges()))
+	}
+	return min
+}
+
+// shapesIntersect reports whether either shape has a vertex inside the other's polygon or their edges cross
+func shapesIntersect(a, b shape) bool {
+	for _, pair := range [][2]shape{{a, b}, {b, a}} {
+		if pair[1].closed {
+			for _, p := range pair[0].vertices {
+				if PolygonContains(pair[1].vertices, p) {
+					return true
+				}
+			}
+		}
+	}
+	ea, eb := a.edges(), b.edges()
+	for i := 1; i < len(ea); i++ {
+		for j := 1; j < len(eb); j++ {
+			if segmentsIntersect(ea[i-1], ea[i], eb[j-1], eb[j]) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// segmentsIntersect reports whether the segments a-b & c-d cross or touch on the lat/lon plane
+func segmentsIntersect(a, b, c, d *api.Point) bool {
+	orientation := func(p, q, r *api.Point) float64 {
+		return (q.Lon-p.Lon)*(r.Lat-p.Lat) - (q.Lat-p.Lat)*(r.Lon-p.Lon)
+	}
+	d1, d2 := orientation(c, d, a), orientation(c, d, b)
+	d3, d4 := orientation(a, b, c), orientation(a, b, d)
+	if ((d1 > 0 && d2 < 0) || (d1 < 0 && d2 > 0)) && ((d3 > 0 && d4 < 0) || (d3 < 0 && d4 > 0)) {
+		return true
+	}
+	return onSegment(c, d, a) || onSegment(c, d, b) || onSegment(a, b, c) || onSegment(a, b, d)
+}
@@ -0,0 +1,51 @@
+package helpers
+
+import (
+	api "github.com/autom8ter/geodb/gen/go/geodb"
+)
+
+// MatchTags reports whether the tags satisfy the filter. A nil filter matches any tags.
+func MatchTags(tags []string, filter *api.TagFilter) bool {
+	if filter == nil {
+		return true
+	}
+	set := map[string]struct{}{}
+	for _, t := range tags {
+		set[t] = struct{}{}
+	}
+	has := func(t string) bool {
+		_, ok := set[t]
+		return ok
+	}
+	for _, t := range filter.All {
+		if !has(t) {
+			return false
+		}
+	}
+	for _, t := range filter.None {
+		if has(t) {
+			return false
+		}
+	}
+	if len(filter.Any) == 0 {
+		return true
+	}
+	for _, t := range filter.Any {
+		if has(t) {
+			return true
+		}
+	}
+	return false
+}
+
+// MatchTagRelation reports whether two objects' tags satisfy the relation.
+func MatchTagRelation(a, b []string, relation api.TagRelation) bool {
+	switch relation {
+	case api.TagRelation_SharedTags:
+		return MatchTags(b, &api.TagFilter{Any: a})
+	case api.TagRelation_NoSharedTags:
+		return MatchTags(b, &api.TagFilter{None: a})
+	default:
+		return true
+	}
+}
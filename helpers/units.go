@@ -0,0 +1,29 @@
+package helpers
+
+import (
+	api "github.com/autom8ter/geodb/gen/go/geodb"
+)
+
+// meters per unit
+var unitMeters = map[api.DistanceUnit]float64{
+	api.DistanceUnit_Meters:        1,
+	api.DistanceUnit_Kilometers:    1000,
+	api.DistanceUnit_Miles:         1609.344,
+	api.DistanceUnit_NauticalMiles: 1852,
+}
+
+// FromMeters converts a distance in meters to the given unit. unknown units are treated as meters
+func FromMeters(meters float64, unit api.DistanceUnit) float64 {
+	if m, ok := unitMeters[unit]; ok {
+		return meters / m
+	}
+	return meters
+}
+
+// ToMeters converts a distance in the given unit to meters. unknown units are treated as meters
+func ToMeters(dist float64, unit api.DistanceUnit) float64 {
+	if m, ok := unitMeters[unit]; ok {
+		return dist * m
+	}
+	return dist
+}
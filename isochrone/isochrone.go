@@ -0,0 +1,41 @@
+package isochrone
+
+import (
+	"context"
+	api "github.com/autom8ter/geodb/gen/go/geodb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"sync"
+	"time"
+)
+
+// Provider generates an isochrone polygon - the area reachable from center within the travel time budget.
+type Provider interface {
+	Isochrone(ctx context.Context, center *api.Point, budget time.Duration, mode api.TravelMode) ([]*api.Point, error)
+}
+
+// Stub is the default Provider. It doesn't generate polygons, so isochrone scans require a precomputed polygon.
+type Stub struct{}
+
+func (s Stub) Isochrone(ctx context.Context, center *api.Point, budget time.Duration, mode api.TravelMode) ([]*api.Point, error) {
+	return nil, status.Error(codes.Unimplemented, "no isochrone provider registered")
+}
+
+var (
+	mu                = &sync.RWMutex{}
+	provider Provider = Stub{}
+)
+
+// Register sets the Provider used to generate isochrone polygons.
+func Register(p Provider) {
+	mu.Lock()
+	defer mu.Unlock()
+	provider = p
+}
+
+// Default returns the registered Provider.
+func Default() Provider {
+	mu.RLock()
+	defer mu.RUnlock()
+	return provider
+}
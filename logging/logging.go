@@ -0,0 +1,84 @@
+package logging
+
+import (
+	"context"
+	"github.com/gofrs/uuid"
+	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
+	grpc_ctxtags "github.com/grpc-ecosystem/go-grpc-middleware/tags"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// RequestIDHeader is the metadata key a request id is read from(if the client set one) & returned in
+const RequestIDHeader = "x-request-id"
+
+// RequestIDField is the log field holding the request id
+const RequestIDField = "request_id"
+
+type requestIDKey struct{}
+
+// SetLevel sets the level of the standard logger(panic, fatal, error, warn, info, debug or trace)
+func SetLevel(level string) error {
+	lvl, err := log.ParseLevel(level)
+	if err != nil {
+		return err
+	}
+	log.SetLevel(lvl)
+	return nil
+}
+
+// WithRequestID returns a copy of ctx carrying the request id
+func WithRequestID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, id)
+}
+
+// RequestID returns the request id of ctx or an empty string if it doesn't have one
+func RequestID(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDKey{}).(string)
+	return id
+}
+
+// Entry returns a log entry of the standard logger that includes the request id of ctx(if it has one)
+func Entry(ctx context.Context) *log.Entry {
+	if id := RequestID(ctx); id != "" {
+		return log.WithField(RequestIDField, id)
+	}
+	return log.NewEntry(log.StandardLogger())
+}
+
+// requestContext attaches the client's request id(or a new one) to ctx, tags it for the grpc request logs & returns it
+// to the client in the response headers
+func requestContext(ctx context.Context) context.Context {
+	var id string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(RequestIDHeader); len(values) > 0 {
+			id = values[0]
+		}
+	}
+	if id == "" {
+		uid, _ := uuid.NewV4()
+		id = uid.String()
+	}
+	grpc_ctxtags.Extract(ctx).Set(RequestIDField, id)
+	grpc.SetHeader(ctx, metadata.Pairs(RequestIDHeader, id))
+	return WithRequestID(ctx, id)
+}
+
+// UnaryServerInterceptor attaches a request id to the context of each call. it must run after the grpc_ctxtags interceptor
+// so the id is included in the grpc request logs
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		return handler(requestContext(ctx), req)
+	}
+}
+
+// StreamServerInterceptor attaches a request id to the context of each stream. it must run after the grpc_ctxtags interceptor
+// so the id is included in the grpc request logs
+func StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		wrapped := grpc_middleware.WrapServerStream(ss)
+		wrapped.WrappedContext = requestContext(ss.Context())
+		return handler(srv, wrapped)
+	}
+}
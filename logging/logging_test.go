@@ -0,0 +1,103 @@
+package logging
+
+import (
+	"context"
+	grpc_ctxtags "github.com/grpc-ecosystem/go-grpc-middleware/tags"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"testing"
+)
+
+type mockServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (m *mockServerStream) Context() context.Context {
+	return m.ctx
+}
+
+// tagged returns a context prepared by the grpc_ctxtags interceptor, which runs before the request id interceptors
+func tagged(ctx context.Context) context.Context {
+	var tagged context.Context
+	grpc_ctxtags.UnaryServerInterceptor()(ctx, nil, &grpc.UnaryServerInfo{}, func(ctx context.Context, req interface{}) (interface{}, error) {
+		tagged = ctx
+		return nil, nil
+	})
+	return tagged
+}
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	interceptor := UnaryServerInterceptor()
+	var handled context.Context
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		handled = ctx
+		return nil, nil
+	}
+	if _, err := interceptor(tagged(context.Background()), nil, &grpc.UnaryServerInfo{FullMethod: "/api.GeoDB/Ping"}, handler); err != nil {
+		t.Fatal(err.Error())
+	}
+	generated := RequestID(handled)
+	if generated == "" {
+		t.Fatal("expected a generated request id")
+	}
+	if grpc_ctxtags.Extract(handled).Values()[RequestIDField] != generated {
+		t.Fatalf("expected the request id to be tagged for the request logs, got: %v", grpc_ctxtags.Extract(handled).Values())
+	}
+	if Entry(handled).Data[RequestIDField] != generated {
+		t.Fatalf("expected log entries to include the request id, got: %v", Entry(handled).Data)
+	}
+	if _, err := interceptor(tagged(context.Background()), nil, &grpc.UnaryServerInfo{FullMethod: "/api.GeoDB/Ping"}, handler); err != nil {
+		t.Fatal(err.Error())
+	}
+	if RequestID(handled) == generated {
+		t.Fatal("expected a new request id for each call")
+	}
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(RequestIDHeader, "client_request"))
+	if _, err := interceptor(tagged(ctx), nil, &grpc.UnaryServerInfo{FullMethod: "/api.GeoDB/Ping"}, handler); err != nil {
+		t.Fatal(err.Error())
+	}
+	if RequestID(handled) != "client_request" {
+		t.Fatalf("expected the client's request id, got: %s", RequestID(handled))
+	}
+	// values derived from the call's context keep the id, like the goroutines computing an object's tracker events
+	child, cancel := context.WithCancel(handled)
+	defer cancel()
+	if Entry(child).Data[RequestIDField] != "client_request" {
+		t.Fatalf("expected the request id to propagate, got: %v", Entry(child).Data)
+	}
+}
+
+func TestStreamServerInterceptor(t *testing.T) {
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(RequestIDHeader, "stream_request"))
+	var id string
+	if err := StreamServerInterceptor()(nil, &mockServerStream{ctx: tagged(ctx)}, &grpc.StreamServerInfo{FullMethod: "/api.GeoDB/Stream"}, func(srv interface{}, ss grpc.ServerStream) error {
+		id = RequestID(ss.Context())
+		return nil
+	}); err != nil {
+		t.Fatal(err.Error())
+	}
+	if id != "stream_request" {
+		t.Fatalf("expected the client's request id, got: %s", id)
+	}
+}
+
+func TestEntryWithoutRequestID(t *testing.T) {
+	if _, ok := Entry(context.Background()).Data[RequestIDField]; ok {
+		t.Fatal("expected no request id field")
+	}
+}
+
+func TestSetLevel(t *testing.T) {
+	defer log.SetLevel(log.GetLevel())
+	if err := SetLevel("debug"); err != nil {
+		t.Fatal(err.Error())
+	}
+	if log.GetLevel() != log.DebugLevel {
+		t.Fatalf("expected debug level, got: %s", log.GetLevel())
+	}
+	if err := SetLevel("loud"); err == nil {
+		t.Fatal("expected an invalid level error")
+	}
+}
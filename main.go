@@ -13,7 +13,7 @@ func main() {
 		log.Fatal(err.Error())
 	}
 	s.Setup(func(server *server.Server) error {
-		api.RegisterGeoDBServer(s.GetGRPCServer(), services.NewGeoDB(s.GetDB(), s.GetStream(), s.GetGmaps()))
+		api.RegisterGeoDBServer(s.GetGRPCServer(), services.NewGeoDB(s.GetStore(), s.GetStream(), s.GetGmaps(), s.GetDeadLetters()))
 		return nil
 	})
 	s.Run()
@@ -1,19 +1,48 @@
 package main
 
 import (
+	"bytes"
 	"context"
+	"fmt"
+	"github.com/autom8ter/geodb/config"
+	"github.com/autom8ter/geodb/db"
+	"github.com/autom8ter/geodb/gateway"
 	api "github.com/autom8ter/geodb/gen/go/geodb"
 	"github.com/autom8ter/geodb/helpers"
+	"github.com/autom8ter/geodb/isochrone"
 	"github.com/autom8ter/geodb/server"
 	"github.com/autom8ter/geodb/services"
+	"github.com/autom8ter/geodb/stream"
+	"github.com/dgraph-io/badger/v2"
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+	"github.com/labstack/echo"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/encoding/gzip"
+	"google.golang.org/grpc/stats"
+	"google.golang.org/grpc/status"
+	"io"
+	"io/ioutil"
 	"log"
+	"math"
+	"math/rand"
+	"net"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 )
 
 var (
 	geoDB      *services.GeoDB
+	badgerDB   *badger.DB
+	streamHub  *stream.Hub
 	coorsField = &api.Point{
 		Lat: 39.756378173828125,
 		Lon: -104.99414825439453,
@@ -32,12 +61,183 @@ var (
 	}
 )
 
+type mockStreamControlServer struct {
+	grpc.ServerStream
+	ctx  context.Context
+	recv chan *api.StreamControlRequest
+	sent chan *api.ObjectDetail
+}
+
+func (m *mockStreamControlServer) Context() context.Context {
+	return m.ctx
+}
+
+func (m *mockStreamControlServer) Send(resp *api.StreamControlResponse) error {
+	m.sent <- resp.Object
+	return nil
+}
+
+func (m *mockStreamControlServer) Recv() (*api.StreamControlRequest, error) {
+	select {
+	case r, ok := <-m.recv:
+		if !ok {
+			return nil, io.EOF
+		}
+		return r, nil
+	case <-m.ctx.Done():
+		return nil, m.ctx.Err()
+	}
+}
+
+type mockStreamServer struct {
+	grpc.ServerStream
+	ctx  context.Context
+	sent chan *api.ObjectDetail
+}
+
+func (m *mockStreamServer) Context() context.Context {
+	return m.ctx
+}
+
+func (m *mockStreamServer) Send(resp *api.StreamResponse) error {
+	m.sent <- resp.Object
+	return nil
+}
+
+type mockStreamRegexServer struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (m *mockStreamRegexServer) Context() context.Context {
+	return m.ctx
+}
+
+func (m *mockStreamRegexServer) Send(resp *api.StreamRegexResponse) error {
+	return nil
+}
+
+type mockStreamPrefixServer struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (m *mockStreamPrefixServer) Context() context.Context {
+	return m.ctx
+}
+
+func (m *mockStreamPrefixServer) Send(resp *api.StreamPrefixResponse) error {
+	return nil
+}
+
+type mockScanObjectsServer struct {
+	grpc.ServerStream
+	ctx  context.Context
+	send func(resp *api.ScanObjectsResponse) error
+}
+
+func (m *mockScanObjectsServer) Context() context.Context {
+	return m.ctx
+}
+
+func (m *mockScanObjectsServer) Send(resp *api.ScanObjectsResponse) error {
+	return m.send(resp)
+}
+
+type mockWatchKeyServer struct {
+	grpc.ServerStream
+	ctx  context.Context
+	sent chan *api.ObjectDetail
+}
+
+func (m *mockWatchKeyServer) Context() context.Context {
+	return m.ctx
+}
+
+func (m *mockWatchKeyServer) Send(resp *api.WatchKeyResponse) error {
+	m.sent <- resp.Object
+	return nil
+}
+
+type mockBackupServer struct {
+	grpc.ServerStream
+	sent []*api.BackupResponse
+}
+
+func (m *mockBackupServer) Context() context.Context {
+	return context.Background()
+}
+
+func (m *mockBackupServer) Send(resp *api.BackupResponse) error {
+	m.sent = append(m.sent, &api.BackupResponse{
+		Data:    append([]byte{}, resp.Data...),
+		Version: resp.Version,
+	})
+	return nil
+}
+
+type mockRestoreServer struct {
+	grpc.ServerStream
+	recv []*api.BackupResponse
+}
+
+func (m *mockRestoreServer) Context() context.Context {
+	return context.Background()
+}
+
+func (m *mockRestoreServer) Recv() (*api.RestoreRequest, error) {
+	if len(m.recv) == 0 {
+		return nil, io.EOF
+	}
+	resp := m.recv[0]
+	m.recv = m.recv[1:]
+	return &api.RestoreRequest{Data: resp.Data}, nil
+}
+
+func (m *mockRestoreServer) SendAndClose(resp *api.RestoreResponse) error {
+	return nil
+}
+
+// newMemDB opens an in-memory badger database, configured by badgerOpts(optional), that's closed when the test finishes
+func newMemDB(tb testing.TB, badgerOpts func(badger.Options) badger.Options) *badger.DB {
+	opts := badger.DefaultOptions("").WithInMemory(true).WithLogger(nil)
+	if badgerOpts != nil {
+		opts = badgerOpts(opts)
+	}
+	memDB, err := badger.Open(opts)
+	if err != nil {
+		tb.Fatal(err.Error())
+	}
+	tb.Cleanup(func() {
+		memDB.Close()
+	})
+	return memDB
+}
+
+// newMemStore returns a store without google maps backed by newMemDB
+func newMemStore(tb testing.TB, badgerOpts func(badger.Options) badger.Options, opts ...db.StoreOption) *db.Store {
+	return db.NewStore(newMemDB(tb, badgerOpts), stream.NewHub(), nil, opts...)
+}
+
+func waitFor(t *testing.T, msg string, fn func() bool) {
+	deadline := time.Now().Add(5 * time.Second)
+	for !fn() {
+		if time.Now().After(deadline) {
+			t.Fatal(msg)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
 func TestMain(t *testing.M) {
-	db, hub, gmaps, err := server.GetDeps()
+	deps, hub, gmaps, deadLetters, err := server.GetDeps()
 	if err != nil {
 		log.Fatal(err.Error())
 	}
-	geoDB = services.NewGeoDB(db, hub, gmaps)
+	badgerDB = deps
+	streamHub = hub
+	go hub.StartObjectStream(context.Background())
+	geoDB = services.NewGeoDB(db.NewStore(deps, hub, gmaps, db.StoreOptionsFromConfig()...), hub, gmaps, deadLetters)
 	os.Exit(t.Run())
 }
 
@@ -149,6 +349,21 @@ func TestGet(t *testing.T) {
 	}
 }
 
+func TestGetMissingKeys(t *testing.T) {
+	resp, err := geoDB.Get(context.Background(), &api.GetRequest{
+		Keys: []string{"testing_coors", "testing_missing", "malls_missing", "testing_missing"},
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(resp.Objects) != 1 || resp.Objects["testing_coors"] == nil {
+		t.Fatalf("expected only testing_coors to be found, got: %v", resp.Objects)
+	}
+	if strings.Join(resp.NotFound, ",") != "testing_missing,malls_missing" {
+		t.Fatalf("expected missing keys to be reported once each, got: %v", resp.NotFound)
+	}
+}
+
 func TestGetPrefix(t *testing.T) {
 	resp, err := geoDB.GetPrefix(context.Background(), &api.GetPrefixRequest{
 		Prefix: "testing_",
@@ -211,6 +426,16 @@ func TestGetPrefixKeys(t *testing.T) {
 	if len(resp.Keys) != 2 {
 		t.Fatal("expected 2 results")
 	}
+	limited, err := geoDB.GetPrefixKeys(context.Background(), &api.GetPrefixKeysRequest{
+		Prefix: "testing_",
+		Limit:  1,
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(limited.Keys) != 1 || limited.Keys[0] != resp.Keys[0] {
+		t.Fatalf("expected the first key: %s, got: %v", resp.Keys[0], limited.Keys)
+	}
 }
 
 func TestGetRegexKeys(t *testing.T) {
@@ -223,6 +448,200 @@ func TestGetRegexKeys(t *testing.T) {
 	if len(resp.Keys) != 1 {
 		t.Fatal("expected 1 results")
 	}
+	if _, err := geoDB.GetRegexKeys(context.Background(), &api.GetRegexKeysRequest{
+		Regex: "malls_(",
+	}); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected invalid argument for a bad regex, got: %v", err)
+	}
+}
+
+func TestCount(t *testing.T) {
+	count, err := newMemStore(t, nil).Count(context.Background(), "", "")
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if count != 0 {
+		t.Fatalf("expected 0 objects in an empty database, got: %v", count)
+	}
+	keys, err := geoDB.GetKeys(context.Background(), &api.GetKeysRequest{})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	resp, err := geoDB.Count(context.Background(), &api.CountRequest{})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if resp.Count != int64(len(keys.Keys)) {
+		t.Fatalf("expected %v objects, got: %v", len(keys.Keys), resp.Count)
+	}
+	resp, err = geoDB.Count(context.Background(), &api.CountRequest{
+		Prefix: "testing_",
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if resp.Count != 2 {
+		t.Fatalf("expected 2 objects with prefix, got: %v", resp.Count)
+	}
+	resp, err = geoDB.Count(context.Background(), &api.CountRequest{
+		Regex: "^malls_",
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if resp.Count != 1 {
+		t.Fatalf("expected 1 object matching regex, got: %v", resp.Count)
+	}
+	if _, err := geoDB.Count(context.Background(), &api.CountRequest{
+		Regex: "(",
+	}); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected invalid argument for a bad regex, got: %v", err)
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	if _, err := geoDB.Set(context.Background(), &api.SetRequest{
+		Object: &api.Object{
+			Key:         "update_driver",
+			Point:       coorsField,
+			Radius:      100,
+			Metadata:    map[string]string{"vehicle": "van"},
+			ExpiresUnix: time.Now().Add(time.Hour).Unix(),
+			Tags:        []string{"driver"},
+		},
+	}); err != nil {
+		t.Fatal(err.Error())
+	}
+	defer geoDB.Delete(context.Background(), &api.DeleteRequest{Keys: []string{"update_driver"}})
+	resp, err := geoDB.Update(context.Background(), &api.UpdateRequest{
+		Key:   "update_driver",
+		Point: pepsiCenter,
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	obj := resp.Object.Object
+	if obj.Point.Lat != pepsiCenter.Lat || obj.Point.Lon != pepsiCenter.Lon {
+		t.Fatalf("expected point to be updated, got: %v", obj.Point)
+	}
+	if obj.Radius != 100 || obj.Metadata["vehicle"] != "van" || obj.ExpiresUnix == 0 || len(obj.Tags) != 1 {
+		t.Fatalf("expected fields outside the update to be left intact, got: %v", obj)
+	}
+	resp, err = geoDB.Update(context.Background(), &api.UpdateRequest{
+		Key:        "update_driver",
+		UpdateMask: []string{"metadata", "tags"},
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	obj = resp.Object.Object
+	if len(obj.Metadata) != 0 || len(obj.Tags) != 0 {
+		t.Fatalf("expected masked fields to be cleared, got: %v", obj)
+	}
+	if obj.Radius != 100 {
+		t.Fatalf("expected radius to be left intact, got: %v", obj.Radius)
+	}
+	get, err := geoDB.Get(context.Background(), &api.GetRequest{Keys: []string{"update_driver"}})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if get.Objects["update_driver"].Object.Point.Lat != pepsiCenter.Lat {
+		t.Fatal("expected the merged object to be stored")
+	}
+	if _, err := geoDB.Update(context.Background(), &api.UpdateRequest{
+		Key:        "update_driver",
+		UpdateMask: []string{"radius"},
+	}); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected invalid argument when clearing radius, got: %v", err)
+	}
+	if _, err := geoDB.Update(context.Background(), &api.UpdateRequest{
+		Key:        "update_driver",
+		UpdateMask: []string{"key"},
+	}); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected invalid argument for an unknown mask field, got: %v", err)
+	}
+	if _, err := geoDB.Update(context.Background(), &api.UpdateRequest{
+		Key:    "update_missing",
+		Radius: 10,
+	}); status.Code(err) != codes.NotFound {
+		t.Fatalf("expected not found for a missing object, got: %v", err)
+	}
+}
+
+func TestPagination(t *testing.T) {
+	var keys []string
+	for i := 0; i < 7; i++ {
+		keys = append(keys, fmt.Sprintf("page_%v", i))
+		if _, err := geoDB.Set(context.Background(), &api.SetRequest{
+			Object: &api.Object{
+				Key:    keys[i],
+				Point:  coorsField,
+				Radius: 100,
+			},
+		}); err != nil {
+			t.Fatal(err.Error())
+		}
+	}
+	defer geoDB.Delete(context.Background(), &api.DeleteRequest{Keys: keys})
+	all, err := geoDB.GetKeys(context.Background(), &api.GetKeysRequest{})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if all.NextCursor != "" {
+		t.Fatalf("expected no cursor without a limit, got: %s", all.NextCursor)
+	}
+	var paged []string
+	cursor := ""
+	for {
+		resp, err := geoDB.GetKeys(context.Background(), &api.GetKeysRequest{
+			Limit:  3,
+			Cursor: cursor,
+		})
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		if len(resp.Keys) > 3 {
+			t.Fatalf("expected at most 3 keys per page, got: %v", len(resp.Keys))
+		}
+		paged = append(paged, resp.Keys...)
+		if resp.NextCursor == "" {
+			break
+		}
+		cursor = resp.NextCursor
+	}
+	if strings.Join(paged, ",") != strings.Join(all.Keys, ",") {
+		t.Fatalf("expected paged keys %v to match %v", paged, all.Keys)
+	}
+	seen := map[string]int{}
+	cursor = ""
+	for {
+		resp, err := geoDB.GetRegex(context.Background(), &api.GetRegexRequest{
+			Regex:  "^page_",
+			Limit:  2,
+			Cursor: cursor,
+		})
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		if len(resp.Objects) > 2 {
+			t.Fatalf("expected at most 2 objects per page, got: %v", len(resp.Objects))
+		}
+		for key := range resp.Objects {
+			seen[key]++
+		}
+		if resp.NextCursor == "" {
+			break
+		}
+		cursor = resp.NextCursor
+	}
+	for _, key := range keys {
+		if seen[key] != 1 {
+			t.Fatalf("expected %s exactly once, got: %v", key, seen[key])
+		}
+	}
+	if len(seen) != len(keys) {
+		t.Fatalf("expected %v objects, got: %v", len(keys), len(seen))
+	}
 }
 
 func TestScanBounds(t *testing.T) {
@@ -238,6 +657,156 @@ func TestScanBounds(t *testing.T) {
 	}
 }
 
+// wireStats records the compression of the requests a server receives & the uncompressed & wire lengths of the
+// responses a client receives
+type wireStats struct {
+	mu         sync.Mutex
+	encoding   string
+	length     int
+	wireLength int
+}
+
+func (w *wireStats) TagRPC(ctx context.Context, _ *stats.RPCTagInfo) context.Context { return ctx }
+
+func (w *wireStats) TagConn(ctx context.Context, _ *stats.ConnTagInfo) context.Context { return ctx }
+
+func (w *wireStats) HandleConn(context.Context, stats.ConnStats) {}
+
+func (w *wireStats) HandleRPC(_ context.Context, s stats.RPCStats) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	switch s := s.(type) {
+	case *stats.InHeader:
+		if !s.Client {
+			w.encoding = s.Compression
+		}
+	case *stats.InPayload:
+		if s.Client {
+			w.length += s.Length
+			w.wireLength += s.WireLength
+		}
+	}
+}
+
+func (w *wireStats) compression() string {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.encoding
+}
+
+func (w *wireStats) received() (int, int) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.length, w.wireLength
+}
+
+func TestScanBoundsCompression(t *testing.T) {
+	var keys []string
+	for i := 0; i < 500; i++ {
+		key := fmt.Sprintf("compression_%v", i)
+		if _, err := geoDB.Set(context.Background(), &api.SetRequest{
+			Object: &api.Object{
+				Key: key,
+				Point: &api.Point{
+					Lat: coorsField.Lat + float64(i)*0.0001,
+					Lon: coorsField.Lon,
+				},
+				Radius:   100,
+				Metadata: map[string]string{"type": "driver"},
+			},
+		}); err != nil {
+			t.Fatal(err.Error())
+		}
+		keys = append(keys, key)
+	}
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	serverStats := &wireStats{}
+	grpcServer := grpc.NewServer(grpc.StatsHandler(serverStats))
+	api.RegisterGeoDBServer(grpcServer, geoDB)
+	go grpcServer.Serve(lis)
+	defer grpcServer.Stop()
+	clientStats := &wireStats{}
+	conn, err := grpc.Dial(lis.Addr().String(), grpc.WithInsecure(), grpc.WithStatsHandler(clientStats), grpc.WithDefaultCallOptions(grpc.UseCompressor(gzip.Name)))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer conn.Close()
+	resp, err := api.NewGeoDBClient(conn).ScanBound(context.Background(), &api.ScanBoundRequest{
+		Bound: &api.Bound{
+			Center: coorsField,
+			Radius: 5000,
+		},
+		Keys: keys,
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(resp.Objects) == 0 {
+		t.Fatal("expected objects within the bound")
+	}
+	if got := serverStats.compression(); got != gzip.Name {
+		t.Fatalf("expected the request to be sent with grpc-encoding: %s, got: %q", gzip.Name, got)
+	}
+	length, wireLength := clientStats.received()
+	t.Logf("bound response: %v objects %v bytes, %v bytes on the wire", len(resp.Objects), length, wireLength)
+	if wireLength == 0 || wireLength >= length {
+		t.Fatal("expected the response to be compressed on the wire")
+	}
+	if _, err := geoDB.Delete(context.Background(), &api.DeleteRequest{
+		Keys: keys,
+	}); err != nil {
+		t.Fatal(err.Error())
+	}
+}
+
+func TestProximityMatrix(t *testing.T) {
+	keys := []string{"testing_coors", "testing_pepsi_center", "malls_cherry_creek_mall"}
+	resp, err := geoDB.ProximityMatrix(context.Background(), &api.ProximityMatrixRequest{
+		Keys: keys,
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(resp.Rows) != len(keys) {
+		t.Fatalf("expected %v rows", len(keys))
+	}
+	for i, row := range resp.Rows {
+		if len(row.Distances) != len(keys) {
+			t.Fatalf("expected %v columns", len(keys))
+		}
+		if row.Distances[i] != 0 {
+			t.Fatal("expected zero distance to self")
+		}
+		for j := range row.Distances {
+			if row.Distances[j] != resp.Rows[j].Distances[i] {
+				t.Fatal("expected symmetric matrix")
+			}
+		}
+	}
+	if resp.Rows[0].Distances[1] < 1000 || resp.Rows[0].Distances[1] > 2000 {
+		t.Fatalf("unexpected distance between coors field and pepsi center: %v", resp.Rows[0].Distances[1])
+	}
+	miles, err := geoDB.ProximityMatrix(context.Background(), &api.ProximityMatrixRequest{
+		Keys: keys,
+		Unit: api.DistanceUnit_Miles,
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if want := helpers.FromMeters(resp.Rows[0].Distances[1], api.DistanceUnit_Miles); miles.Rows[0].Distances[1] != want {
+		t.Fatalf("expected %v miles, got: %v", want, miles.Rows[0].Distances[1])
+	}
+	_, err = geoDB.ProximityMatrix(context.Background(), &api.ProximityMatrixRequest{
+		Keys: []string{"testing_coors", "testing_missing"},
+	})
+	if err == nil {
+		t.Fatal("expected error for missing key")
+	}
+}
+
 func TestDelete(t *testing.T) {
 	_, err := geoDB.Delete(context.Background(), &api.DeleteRequest{
 		Keys: []string{"testing_pepsi_center"},
@@ -271,3 +840,4748 @@ func TestDeleteAll(t *testing.T) {
 		t.Fatal("expected 0 results")
 	}
 }
+
+func TestDeleteInactive(t *testing.T) {
+	objects := []*api.Object{
+		{
+			Key:         "inactive_coors",
+			Point:       coorsField,
+			Radius:      100,
+			UpdatedUnix: time.Now().Add(-2 * time.Hour).Unix(),
+		},
+		{
+			Key:    "active_pepsi_center",
+			Point:  pepsiCenter,
+			Radius: 100,
+		},
+	}
+	for _, obj := range objects {
+		if _, err := geoDB.Set(context.Background(), &api.SetRequest{
+			Object: obj,
+		}); err != nil {
+			t.Fatal(err.Error())
+		}
+	}
+	keys, err := db.NewStore(badgerDB, streamHub, nil).DeleteInactive(context.Background(), time.Hour)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(keys) != 1 || keys[0] != "inactive_coors" {
+		t.Fatalf("expected inactive_coors to be swept, got: %v", keys)
+	}
+	resp, err := geoDB.GetKeys(context.Background(), &api.GetKeysRequest{})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(resp.Keys) != 1 || resp.Keys[0] != "active_pepsi_center" {
+		t.Fatalf("expected only active_pepsi_center to remain, got: %v", resp.Keys)
+	}
+	if _, err := geoDB.Delete(context.Background(), &api.DeleteRequest{
+		Keys: []string{"active_pepsi_center"},
+	}); err != nil {
+		t.Fatal(err.Error())
+	}
+}
+
+func TestDeleteInactiveKeepsConcurrentWrites(t *testing.T) {
+	ctx := context.Background()
+	var (
+		store     *db.Store
+		sweeping  bool
+		calls     int
+		rewritten bool
+	)
+	now := time.Now()
+	store = newMemStore(t, nil, db.WithClock(func() time.Time {
+		if sweeping {
+			calls++
+		}
+		// the sweep reads the clock for its cutoff, then to check the ttl of the leased object it scanned
+		if calls == 2 && !rewritten {
+			rewritten = true
+			if _, err := store.Set(ctx, &api.Object{Key: "inactive_leased", Point: coorsField, Radius: 100, TtlSeconds: 3600}); err != nil {
+				t.Fatal(err.Error())
+			}
+		}
+		return now
+	}))
+	for _, obj := range []*api.Object{
+		{Key: "inactive_idle", Point: pepsiCenter, Radius: 100, UpdatedUnix: now.Add(-2 * time.Hour).Unix()},
+		{Key: "inactive_leased", Point: coorsField, Radius: 100, TtlSeconds: 3600, UpdatedUnix: now.Add(-2 * time.Hour).Unix()},
+	} {
+		if _, err := store.Set(ctx, obj); err != nil {
+			t.Fatal(err.Error())
+		}
+	}
+	sweeping = true
+	keys, err := store.DeleteInactive(ctx, time.Hour)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if !rewritten {
+		t.Fatal("expected the leased object to be written during the sweep")
+	}
+	if len(keys) != 1 || keys[0] != "inactive_idle" {
+		t.Fatalf("expected only inactive_idle to be swept, got: %v", keys)
+	}
+	objects, err := store.Get(ctx, []string{"inactive_idle", "inactive_leased"})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(objects) != 1 || objects["inactive_leased"] == nil {
+		t.Fatalf("expected the object written during the sweep to be kept, got: %v", objects)
+	}
+}
+
+func TestSetMany(t *testing.T) {
+	objects := []*api.Object{
+		{
+			Key:    "setmany_driver",
+			Point:  coorsField,
+			Radius: 100,
+		},
+		{
+			Key:    "setmany_hospital",
+			Point:  saintJosephHospital,
+			Radius: 100,
+		},
+		{
+			Key:    "setmany_driver",
+			Point:  pepsiCenter,
+			Radius: 100,
+		},
+	}
+	if _, err := geoDB.SetMany(context.Background(), &api.SetManyRequest{
+		Objects:          objects,
+		RejectDuplicates: true,
+	}); err == nil {
+		t.Fatal("expected duplicate keys to be rejected")
+	}
+	keys, err := geoDB.GetPrefixKeys(context.Background(), &api.GetPrefixKeysRequest{
+		Prefix: "setmany_",
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(keys.Keys) != 0 {
+		t.Fatal("expected rejected request to write nothing")
+	}
+	resp, err := geoDB.SetMany(context.Background(), &api.SetManyRequest{
+		Objects: objects,
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(resp.Objects) != len(objects) {
+		t.Fatalf("expected %v object details", len(objects))
+	}
+	for i, obj := range resp.Objects {
+		if obj.Object.Key != objects[i].Key {
+			t.Fatal("expected object details in request order")
+		}
+	}
+	get, err := geoDB.Get(context.Background(), &api.GetRequest{
+		Keys: []string{"setmany_driver"},
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if get.Objects["setmany_driver"].Object.Point.Lat != pepsiCenter.Lat {
+		t.Fatal("expected last write to win")
+	}
+	if _, err := geoDB.Delete(context.Background(), &api.DeleteRequest{
+		Keys: []string{"setmany_driver", "setmany_hospital"},
+	}); err != nil {
+		t.Fatal(err.Error())
+	}
+}
+
+func TestStreamControl(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	ss := &mockStreamControlServer{
+		ctx:  ctx,
+		recv: make(chan *api.StreamControlRequest),
+		sent: make(chan *api.ObjectDetail, 10),
+	}
+	done := make(chan error)
+	go func() {
+		done <- geoDB.StreamControl(ss)
+	}()
+	ss.recv <- &api.StreamControlRequest{
+		ClientId: "stream_control_client",
+		Keys:     []string{"stream_control_driver"},
+	}
+	waitFor(t, "expected client to be registered", func() bool {
+		return streamHub.GetClientObjectStream("stream_control_client") != nil
+	})
+	ss.recv <- &api.StreamControlRequest{Action: api.StreamAction_Pause}
+	waitFor(t, "expected client to be paused", func() bool {
+		return streamHub.IsObjectStreamClientPaused("stream_control_client")
+	})
+	for _, point := range []*api.Point{coorsField, pepsiCenter} {
+		if _, err := geoDB.Set(context.Background(), &api.SetRequest{
+			Object: &api.Object{
+				Key:    "stream_control_driver",
+				Point:  point,
+				Radius: 100,
+			},
+		}); err != nil {
+			t.Fatal(err.Error())
+		}
+	}
+	select {
+	case <-ss.sent:
+		t.Fatal("expected no delivery while paused")
+	case <-time.After(200 * time.Millisecond):
+	}
+	ss.recv <- &api.StreamControlRequest{Action: api.StreamAction_Resume}
+	for _, point := range []*api.Point{coorsField, pepsiCenter} {
+		select {
+		case msg := <-ss.sent:
+			if msg.Object.Point.Lat != point.Lat {
+				t.Fatal("expected buffered updates in order")
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatal("expected buffered updates after resume")
+		}
+	}
+	cancel()
+	<-done
+	if streamHub.GetClientObjectStream("stream_control_client") != nil {
+		t.Fatal("expected client to be removed")
+	}
+	if _, err := geoDB.Delete(context.Background(), &api.DeleteRequest{
+		Keys: []string{"stream_control_driver"},
+	}); err != nil {
+		t.Fatal(err.Error())
+	}
+}
+
+func TestTrackerEventMetadata(t *testing.T) {
+	config.Config.Set("GEODB_TRACKER_EVENT_METADATA_KEYS", "driver_name,vehicle")
+	defer config.Config.Set("GEODB_TRACKER_EVENT_METADATA_KEYS", "")
+	if _, err := geoDB.Set(context.Background(), &api.SetRequest{
+		Object: &api.Object{
+			Key:    "metadata_driver",
+			Point:  coorsField,
+			Radius: 100,
+			Metadata: map[string]string{
+				"driver_name": "colemanword",
+				"phone":       "555-555-5555",
+			},
+		},
+	}); err != nil {
+		t.Fatal(err.Error())
+	}
+	resp, err := geoDB.Set(context.Background(), &api.SetRequest{
+		Object: &api.Object{
+			Key:    "metadata_rider",
+			Point:  pepsiCenter,
+			Radius: 100,
+			Tracking: &api.ObjectTracking{
+				Trackers: []*api.ObjectTracker{
+					{
+						TargetObjectKey: "metadata_driver",
+					},
+				},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(resp.Object.TrackerEvents) != 1 {
+		t.Fatalf("expected 1 tracker event, got: %v", len(resp.Object.TrackerEvents))
+	}
+	md := resp.Object.TrackerEvents[0].Metadata
+	if len(md) != 1 || md["driver_name"] != "colemanword" {
+		t.Fatalf("expected only the driver_name metadata snapshot, got: %v", md)
+	}
+	if _, err := geoDB.Delete(context.Background(), &api.DeleteRequest{
+		Keys: []string{"metadata_driver", "metadata_rider"},
+	}); err != nil {
+		t.Fatal(err.Error())
+	}
+}
+
+func TestImportCSV(t *testing.T) {
+	data := `id,latitude,longitude,radius,driver_name
+csv_driver_1,39.756378173828125,-104.99414825439453,100,colemanword
+csv_driver_2,not-a-lat,-105.00762176513672,100,ismiyati
+csv_driver_3,39.74863815307617,-105.00762176513672,,
+`
+	r := &api.ImportCSVRequest{
+		Csv:    data,
+		Header: true,
+		Columns: &api.CSVColumns{
+			Key:      "id",
+			Lat:      "latitude",
+			Lon:      "longitude",
+			Metadata: []string{"driver_name"},
+		},
+		DefaultRadius: 50,
+		DryRun:        true,
+	}
+	resp, err := geoDB.ImportCSV(context.Background(), r)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if resp.Imported != 2 {
+		t.Fatalf("expected 2 valid rows, got: %v", resp.Imported)
+	}
+	if len(resp.Errors) != 1 || resp.Errors[0].Line != 3 {
+		t.Fatalf("expected a single error on line 3, got: %v", resp.Errors)
+	}
+	keys, err := geoDB.GetPrefixKeys(context.Background(), &api.GetPrefixKeysRequest{Prefix: "csv_driver"})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(keys.Keys) != 0 {
+		t.Fatal("expected dry run to skip writes")
+	}
+	r.DryRun = false
+	if _, err := geoDB.ImportCSV(context.Background(), r); err != nil {
+		t.Fatal(err.Error())
+	}
+	objects, err := geoDB.Get(context.Background(), &api.GetRequest{Keys: []string{"csv_driver_1", "csv_driver_3"}})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if objects.Objects["csv_driver_1"].Object.Metadata["driver_name"] != "colemanword" {
+		t.Fatal("expected driver_name metadata to be imported")
+	}
+	if objects.Objects["csv_driver_3"].Object.Radius != 50 {
+		t.Fatal("expected default radius to be applied")
+	}
+	resp, err = geoDB.ImportCSV(context.Background(), &api.ImportCSVRequest{
+		Csv:    "csv_driver_4,39.71670913696289,-104.95344543457031,100\n",
+		DryRun: true,
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if resp.Imported != 1 || len(resp.Errors) != 0 {
+		t.Fatalf("expected headerless row to be parsed by position, got: %v", resp)
+	}
+	if _, err := geoDB.Delete(context.Background(), &api.DeleteRequest{
+		Keys: []string{"csv_driver_1", "csv_driver_3"},
+	}); err != nil {
+		t.Fatal(err.Error())
+	}
+}
+
+func TestStore(t *testing.T) {
+	store := db.NewStore(badgerDB, streamHub, nil)
+	detail, err := store.Set(context.Background(), &api.Object{
+		Key:    "store_driver",
+		Point:  coorsField,
+		Radius: 100,
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if detail.Object.UpdatedUnix == 0 {
+		t.Fatal("expected updated timestamp to be set")
+	}
+	objects, err := store.ScanBound(context.Background(), &api.Bound{
+		Center: coorsField,
+		Radius: 1000,
+	}, []string{"store_driver"}, nil)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if _, ok := objects["store_driver"]; !ok {
+		t.Fatal("expected store_driver within bound")
+	}
+	if err := store.Delete(context.Background(), []string{"store_driver"}); err != nil {
+		t.Fatal(err.Error())
+	}
+}
+
+func TestSetWithoutStreamClients(t *testing.T) {
+	config.Config.Set("GEODB_STREAM_BUFFER", 1)
+	defer config.Config.Set("GEODB_STREAM_BUFFER", 5000)
+	// the hub is never started and has no clients, so nothing drains its buffer
+	store := db.NewStore(badgerDB, stream.NewHub(), nil)
+	done := make(chan error)
+	go func() {
+		for i := 0; i < 3; i++ {
+			if _, err := store.Set(context.Background(), &api.Object{
+				Key:    "unsubscribed_driver",
+				Point:  coorsField,
+				Radius: 100,
+			}); err != nil {
+				done <- err
+				return
+			}
+		}
+		done <- nil
+	}()
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected Set to return promptly without stream clients")
+	}
+	if err := store.Delete(context.Background(), []string{"unsubscribed_driver"}); err != nil {
+		t.Fatal(err.Error())
+	}
+}
+
+type squareIsochrones struct{}
+
+func (s squareIsochrones) Isochrone(ctx context.Context, center *api.Point, budget time.Duration, mode api.TravelMode) ([]*api.Point, error) {
+	d := budget.Hours()
+	return []*api.Point{
+		{Lat: center.Lat - d, Lon: center.Lon - d},
+		{Lat: center.Lat - d, Lon: center.Lon + d},
+		{Lat: center.Lat + d, Lon: center.Lon + d},
+		{Lat: center.Lat + d, Lon: center.Lon - d},
+	}, nil
+}
+
+func TestScanIsochrone(t *testing.T) {
+	if _, err := geoDB.Set(context.Background(), &api.SetRequest{
+		Object: &api.Object{
+			Key:    "isochrone_driver",
+			Point:  coorsField,
+			Radius: 100,
+		},
+	}); err != nil {
+		t.Fatal(err.Error())
+	}
+	resp, err := geoDB.ScanIsochrone(context.Background(), &api.ScanIsochroneRequest{
+		Polygon: []*api.Point{
+			{Lat: coorsField.Lat - 0.001, Lon: coorsField.Lon - 0.001},
+			{Lat: coorsField.Lat - 0.001, Lon: coorsField.Lon + 0.001},
+			{Lat: coorsField.Lat + 0.001, Lon: coorsField.Lon},
+		},
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if _, ok := resp.Objects["isochrone_driver"]; !ok {
+		t.Fatal("expected isochrone_driver within polygon")
+	}
+	r := &api.ScanIsochroneRequest{
+		Center:        coorsField,
+		TravelSeconds: 36,
+	}
+	if _, err := geoDB.ScanIsochrone(context.Background(), r); status.Code(err) != codes.Unimplemented {
+		t.Fatalf("expected stub provider to be unimplemented, got: %v", err)
+	}
+	isochrone.Register(squareIsochrones{})
+	defer isochrone.Register(isochrone.Stub{})
+	resp, err = geoDB.ScanIsochrone(context.Background(), r)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if _, ok := resp.Objects["isochrone_driver"]; !ok || len(resp.Polygon) != 4 {
+		t.Fatal("expected isochrone_driver within the provider's polygon")
+	}
+	if _, err := geoDB.Delete(context.Background(), &api.DeleteRequest{
+		Keys: []string{"isochrone_driver"},
+	}); err != nil {
+		t.Fatal(err.Error())
+	}
+}
+
+func TestExpiredByStoreClockIsHidden(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	store := newMemStore(t, nil, db.WithClock(func() time.Time {
+		return now
+	}))
+	if _, err := store.Set(ctx, &api.Object{Key: "clock_depot", Point: coorsField, Radius: 100, TtlSeconds: 60}); err != nil {
+		t.Fatal(err.Error())
+	}
+	// expired by the store's clock, but not yet by badger's
+	now = now.Add(2 * time.Minute)
+	nearby, err := store.WithinRadius(ctx, coorsField, 1000, nil, nil)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(nearby) != 0 {
+		t.Fatalf("expected the geohash index scan to skip the expired object, got: %v", len(nearby))
+	}
+	if _, _, err := store.Increment(ctx, "clock_depot", "trips", 1); status.Code(err) != codes.NotFound {
+		t.Fatalf("expected an expired object not to be incremented, got: %v", err)
+	}
+	if _, err := store.Update(ctx, &api.UpdateRequest{Key: "clock_depot", Radius: 50}); status.Code(err) != codes.NotFound {
+		t.Fatalf("expected an expired object not to be updated, got: %v", err)
+	}
+	_, notFound, err := store.BulkUpdatePositions(ctx, []*api.PositionUpdate{{Key: "clock_depot", Point: pepsiCenter}})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(notFound) != 1 {
+		t.Fatalf("expected an expired object not to be moved, got: %v", notFound)
+	}
+	detail, err := store.Set(ctx, &api.Object{
+		Key:      "clock_truck",
+		Point:    coorsField,
+		Radius:   100,
+		Tracking: &api.ObjectTracking{Trackers: []*api.ObjectTracker{{TargetObjectKey: "clock_depot"}}},
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(detail.TrackerEvents) != 0 {
+		t.Fatalf("expected no events against an expired target, got: %v", detail.TrackerEvents)
+	}
+}
+
+func TestTrackerEventTimestampNanos(t *testing.T) {
+	now := time.Unix(1000, 0)
+	store := db.NewStore(badgerDB, streamHub, nil, db.WithClock(func() time.Time {
+		// a clock that runs backwards
+		now = now.Add(-time.Second)
+		return now
+	}))
+	if _, err := store.Set(context.Background(), &api.Object{
+		Key:    "nanos_driver",
+		Point:  coorsField,
+		Radius: 100,
+	}); err != nil {
+		t.Fatal(err.Error())
+	}
+	var last int64
+	for i := 0; i < 3; i++ {
+		detail, err := store.Set(context.Background(), &api.Object{
+			Key:         "nanos_rider",
+			Point:       pepsiCenter,
+			Radius:      100,
+			UpdatedUnix: 5000,
+			Tracking: &api.ObjectTracking{
+				Trackers: []*api.ObjectTracker{
+					{
+						TargetObjectKey: "nanos_driver",
+					},
+				},
+			},
+		})
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		event := detail.TrackerEvents[0]
+		if event.TimestampUnix != 5000 {
+			t.Fatal("expected client timestamp to be preserved")
+		}
+		if event.TimestampNanos <= last {
+			t.Fatalf("expected monotonic timestamp: %v <= %v", event.TimestampNanos, last)
+		}
+		last = event.TimestampNanos
+	}
+	if err := store.Delete(context.Background(), []string{"nanos_driver", "nanos_rider"}); err != nil {
+		t.Fatal(err.Error())
+	}
+}
+
+func TestTrackerEventType(t *testing.T) {
+	if _, err := geoDB.Set(context.Background(), &api.SetRequest{
+		Object: &api.Object{
+			Key:    "fence_depot",
+			Point:  coorsField,
+			Radius: 100,
+		},
+	}); err != nil {
+		t.Fatal(err.Error())
+	}
+	defer geoDB.Delete(context.Background(), &api.DeleteRequest{Keys: []string{"fence_depot", "fence_truck"}})
+	for i, step := range []struct {
+		point *api.Point
+		want  api.EventType
+	}{
+		{pepsiCenter, api.EventType_Outside},
+		{coorsField, api.EventType_Enter},
+		{coorsField, api.EventType_Inside},
+		{pepsiCenter, api.EventType_Exit},
+		{pepsiCenter, api.EventType_Outside},
+	} {
+		resp, err := geoDB.Set(context.Background(), &api.SetRequest{
+			Object: &api.Object{
+				Key:    "fence_truck",
+				Point:  step.point,
+				Radius: 100,
+				Tracking: &api.ObjectTracking{
+					Trackers: []*api.ObjectTracker{
+						{
+							TargetObjectKey: "fence_depot",
+						},
+					},
+				},
+			},
+		})
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		if got := resp.Object.TrackerEvents[0].EventType; got != step.want {
+			t.Fatalf("step %v: expected %s event, got: %s", i, step.want, got)
+		}
+	}
+}
+
+func TestGetGlob(t *testing.T) {
+	for _, key := range []string{"glob_truck-1", "glob_truck-22", "glob_car-1"} {
+		if _, err := geoDB.Set(context.Background(), &api.SetRequest{
+			Object: &api.Object{
+				Key:    key,
+				Point:  coorsField,
+				Radius: 100,
+			},
+		}); err != nil {
+			t.Fatal(err.Error())
+		}
+	}
+	resp, err := geoDB.GetGlob(context.Background(), &api.GetGlobRequest{Pattern: "glob_truck-*"})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(resp.Objects) != 2 {
+		t.Fatalf("expected 2 trucks, got: %v", len(resp.Objects))
+	}
+	resp, err = geoDB.GetGlob(context.Background(), &api.GetGlobRequest{Pattern: "*_?ar-1"})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if _, ok := resp.Objects["glob_car-1"]; !ok || len(resp.Objects) != 1 {
+		t.Fatalf("expected only glob_car-1, got: %v", len(resp.Objects))
+	}
+	if _, err := geoDB.Delete(context.Background(), &api.DeleteRequest{
+		Keys: []string{"glob_truck-1", "glob_truck-22", "glob_car-1"},
+	}); err != nil {
+		t.Fatal(err.Error())
+	}
+}
+
+func TestTags(t *testing.T) {
+	objects := []*api.Object{
+		{Key: "tag_truck", Point: coorsField, Radius: 100, Tags: []string{"fleet_a", "truck"}},
+		{Key: "tag_van", Point: coorsField, Radius: 100, Tags: []string{"fleet_a", "van"}},
+		{Key: "tag_rival", Point: pepsiCenter, Radius: 100, Tags: []string{"fleet_b", "truck"}},
+	}
+	for _, obj := range objects {
+		if _, err := geoDB.Set(context.Background(), &api.SetRequest{Object: obj}); err != nil {
+			t.Fatal(err.Error())
+		}
+	}
+	tagged := func(filter *api.TagFilter) map[string]*api.ObjectDetail {
+		resp, err := geoDB.GetTagged(context.Background(), &api.GetTaggedRequest{Filter: filter})
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		return resp.Objects
+	}
+	if got := tagged(&api.TagFilter{Any: []string{"truck"}}); len(got) != 2 {
+		t.Fatalf("expected 2 trucks, got: %v", len(got))
+	}
+	if got := tagged(&api.TagFilter{All: []string{"fleet_a", "truck"}}); len(got) != 1 || got["tag_truck"] == nil {
+		t.Fatal("expected only tag_truck")
+	}
+	if got := tagged(&api.TagFilter{Any: []string{"fleet_a"}, None: []string{"van"}}); len(got) != 1 || got["tag_truck"] == nil {
+		t.Fatal("expected van to be excluded")
+	}
+	// retagging removes stale index entries
+	if _, err := geoDB.Set(context.Background(), &api.SetRequest{
+		Object: &api.Object{Key: "tag_van", Point: coorsField, Radius: 100, Tags: []string{"fleet_b"}},
+	}); err != nil {
+		t.Fatal(err.Error())
+	}
+	if got := tagged(&api.TagFilter{Any: []string{"fleet_a"}}); len(got) != 1 {
+		t.Fatalf("expected stale tag to be unindexed, got: %v", len(got))
+	}
+	scan, err := geoDB.ScanBound(context.Background(), &api.ScanBoundRequest{
+		Bound: &api.Bound{Center: coorsField, Radius: 100000},
+		Tags:  &api.TagFilter{All: []string{"truck"}},
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if scan.Objects["tag_truck"] == nil || scan.Objects["tag_rival"] == nil || scan.Objects["tag_van"] != nil {
+		t.Fatal("expected scan to only return trucks")
+	}
+	detail, err := geoDB.Set(context.Background(), &api.SetRequest{
+		Object: &api.Object{
+			Key:    "tag_dispatcher",
+			Point:  coorsField,
+			Radius: 100,
+			Tags:   []string{"fleet_a"},
+			Tracking: &api.ObjectTracking{
+				TagRelation: api.TagRelation_SharedTags,
+				Trackers: []*api.ObjectTracker{
+					{TargetObjectKey: "tag_truck"},
+					{TargetObjectKey: "tag_rival"},
+					{TargetObjectKey: "tag_van", TargetTags: &api.TagFilter{Any: []string{"fleet_a"}}},
+				},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(detail.Object.TrackerEvents) != 1 || detail.Object.TrackerEvents[0].Object.Key != "tag_truck" {
+		t.Fatalf("expected a single tracker event for tag_truck, got: %v", len(detail.Object.TrackerEvents))
+	}
+	if _, err := geoDB.Delete(context.Background(), &api.DeleteRequest{
+		Keys: []string{"tag_truck", "tag_van", "tag_rival", "tag_dispatcher"},
+	}); err != nil {
+		t.Fatal(err.Error())
+	}
+	if got := tagged(&api.TagFilter{Any: []string{"fleet_a", "fleet_b", "truck"}}); len(got) != 0 {
+		t.Fatalf("expected deleted objects to be unindexed, got: %v", len(got))
+	}
+	keys, err := geoDB.GetKeys(context.Background(), &api.GetKeysRequest{})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	for _, key := range keys.Keys {
+		if strings.HasPrefix(key, "\x00tag\x00") {
+			t.Fatal("expected tag index entries to be hidden from keys")
+		}
+	}
+}
+
+func TestDeadLetters(t *testing.T) {
+	if _, err := geoDB.GetDeadLetters(context.Background(), &api.GetDeadLettersRequest{}); status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("expected dead letters to be disabled by default, got: %v", err)
+	}
+	deadLetters := db.NewDeadLetters(badgerDB, 2)
+	for i := 0; i < 3; i++ {
+		deadLetters.Record(&api.ObjectDetail{
+			Object: &api.Object{Key: fmt.Sprintf("dead_letter_%v", i)},
+		}, "stream buffer full")
+	}
+	letters, err := deadLetters.List(context.Background(), 0)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(letters) != 2 {
+		t.Fatalf("expected dead letters to be capped at 2, got: %v", len(letters))
+	}
+	if letters[0].Object.Object.Key != "dead_letter_2" || letters[1].Object.Object.Key != "dead_letter_1" {
+		t.Fatal("expected newest dead letters first")
+	}
+	if letters[0].Reason != "stream buffer full" {
+		t.Fatalf("expected reason to be recorded, got: %s", letters[0].Reason)
+	}
+	keys, err := geoDB.GetKeys(context.Background(), &api.GetKeysRequest{})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	for _, key := range keys.Keys {
+		if strings.HasPrefix(key, "\x00deadletter\x00") {
+			t.Fatal("expected dead letters to be hidden from keys")
+		}
+	}
+}
+
+func TestDeadLettersSharedWithHub(t *testing.T) {
+	config.Config.Set("GEODB_IN_MEMORY", true)
+	config.Config.Set("GEODB_DEAD_LETTER_MAX", 2)
+	defer func() {
+		config.Config.Set("GEODB_IN_MEMORY", false)
+		config.Config.Set("GEODB_DEAD_LETTER_MAX", nil)
+	}()
+	memDB, hub, gmaps, deadLetters, err := server.GetDeps()
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer memDB.Close()
+	memGeoDB := services.NewGeoDB(db.NewStore(memDB, hub, gmaps), hub, gmaps, deadLetters)
+	for i := 0; i < 4; i++ {
+		hub.DeadLetter(&api.ObjectDetail{Object: &api.Object{Key: fmt.Sprintf("hub_dead_letter_%v", i)}}, "stream buffer full")
+	}
+	resp, err := memGeoDB.GetDeadLetters(context.Background(), &api.GetDeadLettersRequest{})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(resp.DeadLetters) != 2 || resp.DeadLetters[0].Object.Object.Key != "hub_dead_letter_3" || resp.DeadLetters[1].Object.Object.Key != "hub_dead_letter_2" {
+		t.Fatalf("expected the 2 newest dead letters recorded by the hub, got: %v", resp.DeadLetters)
+	}
+}
+
+func TestWithinCorridor(t *testing.T) {
+	objects := []*api.Object{
+		{Key: "corridor_near", Point: &api.Point{Lat: 39.7525, Lon: -105.0008}, Radius: 10},
+		{Key: "corridor_far", Point: &api.Point{Lat: 39.7, Lon: -105.1}, Radius: 10},
+	}
+	for _, obj := range objects {
+		if _, err := geoDB.Set(context.Background(), &api.SetRequest{Object: obj}); err != nil {
+			t.Fatal(err.Error())
+		}
+	}
+	resp, err := geoDB.WithinCorridor(context.Background(), &api.WithinCorridorRequest{
+		Route:  []*api.Point{coorsField, pepsiCenter},
+		Buffer: 500,
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if resp.Objects["corridor_near"] == nil || resp.Objects["corridor_far"] != nil {
+		t.Fatal("expected only corridor_near within the corridor")
+	}
+	if _, err := geoDB.WithinCorridor(context.Background(), &api.WithinCorridorRequest{
+		Route:  []*api.Point{coorsField},
+		Buffer: 500,
+	}); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected a single point route to be invalid, got: %v", err)
+	}
+	if _, err := geoDB.Delete(context.Background(), &api.DeleteRequest{
+		Keys: []string{"corridor_near", "corridor_far"},
+	}); err != nil {
+		t.Fatal(err.Error())
+	}
+}
+
+func TestRebuildTagIndex(t *testing.T) {
+	store := db.NewStore(badgerDB, streamHub, nil)
+	if _, err := store.Set(context.Background(), &api.Object{
+		Key:    "reindex_truck",
+		Point:  coorsField,
+		Radius: 100,
+		Tags:   []string{"reindex"},
+	}); err != nil {
+		t.Fatal(err.Error())
+	}
+	// simulate an index that drifted from the stored objects
+	if err := badgerDB.Update(func(txn *badger.Txn) error {
+		if err := txn.Delete([]byte("\x00tag\x00reindex\x00reindex_truck")); err != nil {
+			return err
+		}
+		if err := txn.Delete([]byte("\x00geohash\x00" + helpers.Geohash(coorsField, 9) + "\x00reindex_truck")); err != nil {
+			return err
+		}
+		return txn.SetEntry(&badger.Entry{
+			Key:      []byte("\x00tag\x00reindex\x00reindex_ghost"),
+			UserMeta: 6,
+		})
+	}); err != nil {
+		t.Fatal(err.Error())
+	}
+	objects, err := store.GetTagged(context.Background(), &api.TagFilter{Any: []string{"reindex"}})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(objects) != 0 {
+		t.Fatal("expected the drifted index to miss reindex_truck")
+	}
+	withinRadius := func() int {
+		nearest, err := store.WithinRadius(context.Background(), coorsField, 1, &api.TagFilter{All: []string{"reindex"}}, nil)
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		return len(nearest)
+	}
+	if withinRadius() != 0 {
+		t.Fatal("expected the drifted geohash index to miss reindex_truck")
+	}
+	var progress int
+	indexed, err := store.RebuildTagIndex(context.Background(), func(n int) {
+		progress = n
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if indexed == 0 || progress != indexed {
+		t.Fatalf("expected progress to report every indexed object, got: %v/%v", progress, indexed)
+	}
+	objects, err = store.GetTagged(context.Background(), &api.TagFilter{Any: []string{"reindex"}})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(objects) != 1 || objects["reindex_truck"] == nil {
+		t.Fatal("expected rebuilt index to find reindex_truck")
+	}
+	if withinRadius() != 1 {
+		t.Fatal("expected rebuilt geohash index to find reindex_truck")
+	}
+	if err := badgerDB.View(func(txn *badger.Txn) error {
+		_, err := txn.Get([]byte("\x00tag\x00reindex\x00reindex_ghost"))
+		return err
+	}); err != badger.ErrKeyNotFound {
+		t.Fatalf("expected stale index entry to be deleted, got: %v", err)
+	}
+	if err := store.Delete(context.Background(), []string{"reindex_truck"}); err != nil {
+		t.Fatal(err.Error())
+	}
+}
+
+func TestRebuildTagIndexKeepsConcurrentWrites(t *testing.T) {
+	memDB := newMemDB(t, nil)
+	ctx := context.Background()
+	store := db.NewStore(memDB, stream.NewHub(), nil)
+	if _, err := store.Set(ctx, &api.Object{Key: "retagged_truck", Point: coorsField, Radius: 100, Tags: []string{"idle"}}); err != nil {
+		t.Fatal(err.Error())
+	}
+	// retagged after the rebuild read the object
+	if _, err := store.RebuildTagIndex(ctx, func(indexed int) {
+		if _, err := store.Set(ctx, &api.Object{Key: "retagged_truck", Point: coorsField, Radius: 100, Tags: []string{"en_route"}}); err != nil {
+			t.Fatal(err.Error())
+		}
+	}); err != nil {
+		t.Fatal(err.Error())
+	}
+	if err := memDB.View(func(txn *badger.Txn) error {
+		_, err := txn.Get([]byte("\x00tag\x00idle\x00retagged_truck"))
+		return err
+	}); err != badger.ErrKeyNotFound {
+		t.Fatalf("expected the rebuild not to re-index the previous tag, got: %v", err)
+	}
+	enRoute, err := store.GetTagged(ctx, &api.TagFilter{Any: []string{"en_route"}})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(enRoute) != 1 {
+		t.Fatalf("expected the concurrent tag to stay indexed, got: %v", len(enRoute))
+	}
+}
+
+func TestRebuildGeohashIndexPrecision(t *testing.T) {
+	memDB := newMemDB(t, nil)
+	ctx := context.Background()
+	if _, err := db.NewStore(memDB, stream.NewHub(), nil, db.WithGeohashPrecision(9)).Set(ctx, &api.Object{Key: "precision_truck", Point: coorsField, Radius: 100}); err != nil {
+		t.Fatal(err.Error())
+	}
+	// restarted with a coarser GEODB_GEOHASH_PRECISION
+	store := db.NewStore(memDB, stream.NewHub(), nil, db.WithGeohashPrecision(5))
+	if _, err := store.RebuildTagIndex(ctx, nil); err != nil {
+		t.Fatal(err.Error())
+	}
+	objects, err := store.Get(ctx, []string{"precision_truck"})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if geohash := objects["precision_truck"].GetObject().GetGeohash(); geohash != helpers.Geohash(coorsField, 5) {
+		t.Fatalf("expected the geohash to be recomputed at the configured precision, got: %s", geohash)
+	}
+	if err := memDB.View(func(txn *badger.Txn) error {
+		_, err := txn.Get([]byte("\x00geohash\x00" + helpers.Geohash(coorsField, 9) + "\x00precision_truck"))
+		return err
+	}); err != badger.ErrKeyNotFound {
+		t.Fatalf("expected the stale geohash index entry to be deleted, got: %v", err)
+	}
+	nearest, err := store.WithinRadius(ctx, coorsField, 10, nil, nil)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(nearest) != 1 {
+		t.Fatalf("expected the rebuilt geohash index to find precision_truck, got: %v", len(nearest))
+	}
+}
+
+func TestStoreOptionsFromConfig(t *testing.T) {
+	config.Config.Set("GEODB_GEOHASH_PRECISION", 5)
+	config.Config.Set("GEODB_SET_RATE_LIMIT", 1)
+	config.Config.Set("GEODB_SET_RATE_BURST", 1)
+	defer func() {
+		config.Config.Set("GEODB_GEOHASH_PRECISION", nil)
+		config.Config.Set("GEODB_SET_RATE_LIMIT", nil)
+		config.Config.Set("GEODB_SET_RATE_BURST", nil)
+	}()
+	store := newMemStore(t, nil, db.StoreOptionsFromConfig()...)
+	detail, err := store.Set(context.Background(), &api.Object{Key: "configured_truck", Point: coorsField, Radius: 100})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if detail.Object.Geohash != helpers.Geohash(coorsField, 5) {
+		t.Fatalf("expected the configured geohash precision, got: %s", detail.Object.Geohash)
+	}
+	if _, err := store.Set(context.Background(), &api.Object{Key: "configured_truck", Point: coorsField, Radius: 100}); status.Code(err) != codes.ResourceExhausted {
+		t.Fatalf("expected the configured rate limit, got: %v", err)
+	}
+}
+
+func TestSetRateLimit(t *testing.T) {
+	now := time.Now()
+	store := db.NewStore(badgerDB, streamHub, nil, db.WithRateLimit(1, 2), db.WithClock(func() time.Time {
+		return now
+	}))
+	set := func(key string) error {
+		_, err := store.Set(context.Background(), &api.Object{
+			Key:    key,
+			Point:  coorsField,
+			Radius: 100,
+		})
+		return err
+	}
+	for i := 0; i < 2; i++ {
+		if err := set("rate_limited_device"); err != nil {
+			t.Fatal(err.Error())
+		}
+	}
+	if err := set("rate_limited_device"); status.Code(err) != codes.ResourceExhausted {
+		t.Fatalf("expected burst to be exhausted, got: %v", err)
+	}
+	if err := set("rate_limited_other"); err != nil {
+		t.Fatalf("expected other keys to be unaffected, got: %v", err)
+	}
+	now = now.Add(time.Second)
+	if err := set("rate_limited_device"); err != nil {
+		t.Fatalf("expected a token to refill, got: %v", err)
+	}
+	if err := store.Delete(context.Background(), []string{"rate_limited_device", "rate_limited_other"}); err != nil {
+		t.Fatal(err.Error())
+	}
+}
+
+func TestGetWithinBounds(t *testing.T) {
+	objects := []*api.Object{
+		{Key: "bounds_fiji_east", Point: &api.Point{Lat: -17.5, Lon: 179.9}, Radius: 100},
+		{Key: "bounds_fiji_west", Point: &api.Point{Lat: -17.5, Lon: -179.9}, Radius: 100},
+		{Key: "bounds_greenwich", Point: &api.Point{Lat: -17.5, Lon: 0}, Radius: 100},
+	}
+	for _, obj := range objects {
+		if _, err := geoDB.Set(context.Background(), &api.SetRequest{Object: obj}); err != nil {
+			t.Fatal(err.Error())
+		}
+	}
+	resp, err := geoDB.GetWithinBounds(context.Background(), &api.BoundsRequest{
+		MinLat: -18,
+		MinLon: 179,
+		MaxLat: -17,
+		MaxLon: -179,
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if resp.Objects["bounds_fiji_east"] == nil || resp.Objects["bounds_fiji_west"] == nil || resp.Objects["bounds_greenwich"] != nil {
+		t.Fatal("expected only objects straddling the antimeridian")
+	}
+	resp, err = geoDB.GetWithinBounds(context.Background(), &api.BoundsRequest{
+		MinLat: -18,
+		MinLon: -1,
+		MaxLat: -17,
+		MaxLon: 1,
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(resp.Objects) != 1 || resp.Objects["bounds_greenwich"] == nil {
+		t.Fatal("expected only bounds_greenwich")
+	}
+	if _, err := geoDB.GetWithinBounds(context.Background(), &api.BoundsRequest{MinLat: 1, MaxLat: -1}); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected inverted latitudes to be invalid, got: %v", err)
+	}
+	if _, err := geoDB.Delete(context.Background(), &api.DeleteRequest{
+		Keys: []string{"bounds_fiji_east", "bounds_fiji_west", "bounds_greenwich"},
+	}); err != nil {
+		t.Fatal(err.Error())
+	}
+}
+
+func TestNearest(t *testing.T) {
+	center := &api.Point{Lat: 10, Lon: 10}
+	objects := []*api.Object{
+		{Key: "nearest_far", Point: &api.Point{Lat: 10, Lon: 10.5}, Radius: 1, Tags: []string{"nearest_test"}},
+		{Key: "nearest_tie_b", Point: &api.Point{Lat: 10, Lon: 10.01}, Radius: 1, Tags: []string{"nearest_test"}},
+		{Key: "nearest_tie_a", Point: &api.Point{Lat: 10, Lon: 9.99}, Radius: 1, Tags: []string{"nearest_test"}},
+		{Key: "nearest_closest", Point: &api.Point{Lat: 10.001, Lon: 10}, Radius: 1, Tags: []string{"nearest_test"}},
+	}
+	for _, obj := range objects {
+		if _, err := geoDB.Set(context.Background(), &api.SetRequest{Object: obj}); err != nil {
+			t.Fatal(err.Error())
+		}
+	}
+	tags := &api.TagFilter{All: []string{"nearest_test"}}
+	resp, err := geoDB.Nearest(context.Background(), &api.NearestRequest{Center: center, K: 3, Tags: tags})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	var keys []string
+	for _, obj := range resp.Objects {
+		keys = append(keys, obj.Object.Object.Key)
+	}
+	if strings.Join(keys, ",") != "nearest_closest,nearest_tie_a,nearest_tie_b" {
+		t.Fatalf("unexpected nearest order: %v", keys)
+	}
+	if resp.Objects[1].Distance != resp.Objects[2].Distance || resp.Objects[0].Distance >= resp.Objects[1].Distance {
+		t.Fatal("expected ascending distances with a tie")
+	}
+	if want := helpers.Distance(center, objects[3].Point); resp.Objects[0].Distance != want {
+		t.Fatalf("expected distance %v, got: %v", want, resp.Objects[0].Distance)
+	}
+	resp, err = geoDB.Nearest(context.Background(), &api.NearestRequest{Center: center, K: 100, Tags: tags})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(resp.Objects) != 4 {
+		t.Fatalf("expected every object when k exceeds the object count, got: %v", len(resp.Objects))
+	}
+	if _, err := geoDB.Delete(context.Background(), &api.DeleteRequest{
+		Keys: []string{"nearest_far", "nearest_tie_b", "nearest_tie_a", "nearest_closest"},
+	}); err != nil {
+		t.Fatal(err.Error())
+	}
+}
+
+func TestGetWithinRadius(t *testing.T) {
+	tags := &api.TagFilter{All: []string{"radius_test"}}
+	edge := &api.Point{Lat: coorsField.Lat + 0.01, Lon: coorsField.Lon}
+	for key, point := range map[string]*api.Point{"radius_center": coorsField, "radius_edge": edge} {
+		if _, err := geoDB.Set(context.Background(), &api.SetRequest{
+			Object: &api.Object{
+				Key:    key,
+				Point:  point,
+				Radius: 1,
+				Tags:   []string{"radius_test"},
+			},
+		}); err != nil {
+			t.Fatal(err.Error())
+		}
+	}
+	defer geoDB.Delete(context.Background(), &api.DeleteRequest{Keys: []string{"radius_center", "radius_edge"}})
+	boundary := helpers.Distance(coorsField, edge)
+	resp, err := geoDB.GetWithinRadius(context.Background(), &api.RadiusRequest{Center: coorsField, Meters: boundary, Tags: tags})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(resp.Objects) != 2 || resp.Objects[0].Object.Object.Key != "radius_center" || resp.Objects[1].Distance != boundary {
+		t.Fatalf("expected an object at exactly the radius to be included, got: %v", resp.Objects)
+	}
+	resp, err = geoDB.GetWithinRadius(context.Background(), &api.RadiusRequest{Center: coorsField, Meters: math.Nextafter(boundary, 0), Tags: tags})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(resp.Objects) != 1 || resp.Objects[0].Object.Object.Key != "radius_center" {
+		t.Fatalf("expected an object just outside the radius to be excluded, got: %v", resp.Objects)
+	}
+	if _, err := geoDB.GetWithinRadius(context.Background(), &api.RadiusRequest{Center: coorsField, Meters: -1}); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected invalid argument for a negative radius, got: %v", err)
+	}
+}
+
+func TestGetWithinPolygon(t *testing.T) {
+	tags := &api.TagFilter{All: []string{"polygon_test"}}
+	// an "L" shaped(concave) polygon around coors field
+	lat, lon := coorsField.Lat, coorsField.Lon
+	vertices := []*api.Point{
+		{Lat: lat, Lon: lon},
+		{Lat: lat, Lon: lon + 0.02},
+		{Lat: lat + 0.01, Lon: lon + 0.02},
+		{Lat: lat + 0.01, Lon: lon + 0.01},
+		{Lat: lat + 0.02, Lon: lon + 0.01},
+		{Lat: lat + 0.02, Lon: lon},
+	}
+	points := map[string]*api.Point{
+		"polygon_inside":  {Lat: lat + 0.005, Lon: lon + 0.005},
+		"polygon_notch":   {Lat: lat + 0.015, Lon: lon + 0.015},
+		"polygon_edge":    {Lat: lat, Lon: lon + 0.005},
+		"polygon_vertex":  {Lat: lat + 0.01, Lon: lon + 0.01},
+		"polygon_outside": {Lat: lat - 0.005, Lon: lon},
+	}
+	var keys []string
+	for key, point := range points {
+		keys = append(keys, key)
+		if _, err := geoDB.Set(context.Background(), &api.SetRequest{
+			Object: &api.Object{
+				Key:    key,
+				Point:  point,
+				Radius: 1,
+				Tags:   []string{"polygon_test"},
+			},
+		}); err != nil {
+			t.Fatal(err.Error())
+		}
+	}
+	defer geoDB.Delete(context.Background(), &api.DeleteRequest{Keys: keys})
+	for _, polygon := range [][]*api.Point{vertices, append(vertices, vertices[0])} {
+		resp, err := geoDB.GetWithinPolygon(context.Background(), &api.PolygonRequest{Vertices: polygon, Tags: tags})
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		if len(resp.Objects) != 3 {
+			t.Fatalf("expected 3 objects within the polygon, got: %v", len(resp.Objects))
+		}
+		for _, key := range []string{"polygon_inside", "polygon_edge", "polygon_vertex"} {
+			if _, ok := resp.Objects[key]; !ok {
+				t.Fatalf("expected %s to be within the polygon", key)
+			}
+		}
+	}
+	if _, err := geoDB.GetWithinPolygon(context.Background(), &api.PolygonRequest{Vertices: vertices[:2]}); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected invalid argument for a polygon with less than 3 vertices, got: %v", err)
+	}
+}
+
+func TestSetManyAtomic(t *testing.T) {
+	// a small table size lowers badger's transaction size limit
+	now := time.Now()
+	store := newMemStore(t, func(opts badger.Options) badger.Options {
+		return opts.WithMaxTableSize(1 << 16)
+	}, db.WithRateLimit(1, 1), db.WithClock(func() time.Time {
+		return now
+	}))
+	if _, err := store.Set(context.Background(), &api.Object{Key: "atomic_existing", Point: coorsField, Radius: 1}); err != nil {
+		t.Fatal(err.Error())
+	}
+	now = now.Add(time.Second)
+	batch := func(n int) []*api.Object {
+		var objs []*api.Object
+		for i := 0; i < n; i++ {
+			objs = append(objs, &api.Object{
+				Key:    fmt.Sprintf("atomic_%v", i),
+				Point:  pepsiCenter,
+				Radius: 1,
+				Tags:   []string{"atomic"},
+			})
+		}
+		return append(objs, &api.Object{Key: "atomic_existing", Point: pepsiCenter, Radius: 1, Tags: []string{"atomic"}})
+	}
+	if _, err := store.SetMany(context.Background(), batch(200), false, true); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected a batch over the transaction size limit to be rejected, got: %v", err)
+	}
+	if count, err := store.Count(context.Background(), "", ""); err != nil || count != 1 {
+		t.Fatalf("expected no partial writes, got: %v objects %v", count, err)
+	}
+	objects, err := store.Get(context.Background(), []string{"atomic_existing"})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if objects["atomic_existing"].Object.Point.Lat != coorsField.Lat {
+		t.Fatal("expected the existing object to be unchanged")
+	}
+	tagged, err := store.GetTagged(context.Background(), &api.TagFilter{Any: []string{"atomic"}})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(tagged) != 0 {
+		t.Fatalf("expected nothing to be tagged, got: %v", len(tagged))
+	}
+	// the rejected batch didn't spend the rate limit tokens
+	details, err := store.SetMany(context.Background(), batch(10), false, true)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if count, err := store.Count(context.Background(), "", ""); err != nil || count != int64(len(details)) {
+		t.Fatalf("expected every object to be written, got: %v objects %v", count, err)
+	}
+	if _, err := store.SetMany(context.Background(), batch(10), false, true); status.Code(err) != codes.ResourceExhausted {
+		t.Fatalf("expected the written batch to spend the rate limit tokens, got: %v", err)
+	}
+}
+
+func TestGetByGeohashPrefix(t *testing.T) {
+	nearby := &api.Point{Lat: coorsField.Lat + 0.0005, Lon: coorsField.Lon + 0.0005}
+	far := &api.Point{Lat: coorsField.Lat + 1, Lon: coorsField.Lon + 1}
+	keys := []string{"geohash_a", "geohash_b", "geohash_far"}
+	for i, point := range []*api.Point{coorsField, nearby, far} {
+		if _, err := geoDB.Set(context.Background(), &api.SetRequest{
+			Object: &api.Object{
+				Key:    keys[i],
+				Point:  point,
+				Radius: 1,
+			},
+		}); err != nil {
+			t.Fatal(err.Error())
+		}
+	}
+	defer geoDB.Delete(context.Background(), &api.DeleteRequest{Keys: keys})
+	resp, err := geoDB.Get(context.Background(), &api.GetRequest{Keys: keys[:1]})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	hash := resp.Objects["geohash_a"].Object.Geohash
+	if len(hash) != 9 {
+		t.Fatalf("expected a geohash to be computed on set, got: %s", hash)
+	}
+	prefix, err := geoDB.GetByGeohashPrefix(context.Background(), &api.GeohashRequest{Prefix: hash[:5]})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(prefix.Objects) != 2 || prefix.Objects["geohash_a"] == nil || prefix.Objects["geohash_b"] == nil {
+		t.Fatalf("expected the 2 nearby objects to share a geohash prefix, got: %v", len(prefix.Objects))
+	}
+	// moving an object replaces its index entry
+	if _, err := geoDB.Set(context.Background(), &api.SetRequest{
+		Object: &api.Object{
+			Key:    "geohash_b",
+			Point:  far,
+			Radius: 1,
+		},
+	}); err != nil {
+		t.Fatal(err.Error())
+	}
+	prefix, err = geoDB.GetByGeohashPrefix(context.Background(), &api.GeohashRequest{Prefix: hash[:5]})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(prefix.Objects) != 1 || prefix.Objects["geohash_a"] == nil {
+		t.Fatalf("expected only geohash_a after geohash_b moved, got: %v", len(prefix.Objects))
+	}
+	if _, err := geoDB.GetByGeohashPrefix(context.Background(), &api.GeohashRequest{Prefix: "a"}); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected invalid argument for a prefix outside the geohash alphabet, got: %v", err)
+	}
+}
+
+func TestSpatialIndexMatchesScan(t *testing.T) {
+	store := newMemStore(t, nil)
+	random := rand.New(rand.NewSource(1))
+	// clusters around denver, the antimeridian & the north pole
+	clusters := []*api.Point{coorsField, {Lat: 0, Lon: 179.99}, {Lat: 0, Lon: -179.99}, {Lat: 89.99, Lon: 0}}
+	var objects []*api.Object
+	for i := 0; i < 2000; i++ {
+		cluster := clusters[i%len(clusters)]
+		obj := &api.Object{
+			Key: fmt.Sprintf("spatial_%v", i),
+			Point: &api.Point{
+				Lat: math.Min(cluster.Lat+(random.Float64()-0.5)*0.05, 90),
+				Lon: math.Mod(cluster.Lon+(random.Float64()-0.5)*0.05+540, 360) - 180,
+			},
+			Radius: 1,
+		}
+		if _, err := store.Set(context.Background(), obj); err != nil {
+			t.Fatal(err.Error())
+		}
+		objects = append(objects, obj)
+	}
+	defer helpers.SetDistanceMode(helpers.Haversine)
+	for i := 0; i < 600; i++ {
+		// exercise the index against every distance mode
+		if err := helpers.SetDistanceMode([]string{helpers.Haversine, helpers.Equirectangular, helpers.Vincenty}[i%3]); err != nil {
+			t.Fatal(err.Error())
+		}
+		center := &api.Point{
+			Lat: math.Min(clusters[i%len(clusters)].Lat+(random.Float64()-0.5)*0.05, 90),
+			Lon: math.Mod(clusters[i%len(clusters)].Lon+(random.Float64()-0.5)*0.05+540, 360) - 180,
+		}
+		meters := random.Float64() * 3000
+		nearest, err := store.WithinRadius(context.Background(), center, meters, nil, nil)
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		found := map[string]bool{}
+		for _, n := range nearest {
+			found[n.Object.Object.Key] = true
+		}
+		var expected int
+		for _, obj := range objects {
+			if helpers.Distance(center, obj.Point) <= meters {
+				expected++
+				if !found[obj.Key] {
+					t.Fatalf("expected %s within %vm of %v", obj.Key, meters, center)
+				}
+			}
+		}
+		if expected != len(found) {
+			t.Fatalf("expected %v objects within %vm of %v, got: %v", expected, meters, center, len(found))
+		}
+		minLat, minLon := center.Lat-random.Float64()*0.02, center.Lon-random.Float64()*0.02
+		maxLat, maxLon := center.Lat+random.Float64()*0.02, center.Lon+random.Float64()*0.02
+		if minLon < -180 {
+			minLon += 360
+		}
+		if maxLon > 180 {
+			maxLon -= 360
+		}
+		bounded, err := store.GetWithinBounds(context.Background(), minLat, minLon, maxLat, maxLon, nil)
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		expected = 0
+		for _, obj := range objects {
+			if helpers.BoxContains(minLat, minLon, maxLat, maxLon, obj.Point) {
+				expected++
+				if bounded[obj.Key] == nil {
+					t.Fatalf("expected %s within the bounds %v,%v %v,%v", obj.Key, minLat, minLon, maxLat, maxLon)
+				}
+			}
+		}
+		if expected != len(bounded) {
+			t.Fatalf("expected %v objects within the bounds, got: %v", expected, len(bounded))
+		}
+	}
+}
+
+func TestStreamRegionFilter(t *testing.T) {
+	defer geoDB.Delete(context.Background(), &api.DeleteRequest{Keys: []string{"region_outside", "region_inside"}})
+	box := &api.Box{MinLat: coorsField.Lat - 0.001, MinLon: coorsField.Lon - 0.001, MaxLat: coorsField.Lat + 0.001, MaxLon: coorsField.Lon + 0.001}
+	for name, r := range map[string]*api.StreamRequest{
+		"box":   {ClientId: "region_box", Box: box},
+		"bound": {ClientId: "region_bound", Bound: &api.Bound{Center: coorsField, Radius: 0.1, Unit: api.DistanceUnit_Kilometers}},
+	} {
+		ss := &mockStreamServer{ctx: context.Background(), sent: make(chan *api.ObjectDetail, 10)}
+		go geoDB.Stream(r, ss)
+		waitFor(t, "stream client to connect", func() bool {
+			return streamHub.GetClientObjectStream(r.ClientId) != nil
+		})
+		for key, point := range map[string]*api.Point{"region_outside": pepsiCenter, "region_inside": coorsField} {
+			if _, err := geoDB.Set(context.Background(), &api.SetRequest{
+				Object: &api.Object{
+					Key:    key,
+					Point:  point,
+					Radius: 1,
+				},
+			}); err != nil {
+				t.Fatal(err.Error())
+			}
+		}
+		select {
+		case obj := <-ss.sent:
+			if obj.Object.Key != "region_inside" {
+				t.Fatalf("%s: expected only updates inside the region to be streamed, got: %s", name, obj.Object.Key)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("%s: expected the update inside the region to be streamed", name)
+		}
+		select {
+		case obj := <-ss.sent:
+			t.Fatalf("%s: unexpected update outside the region: %s", name, obj.Object.Key)
+		case <-time.After(100 * time.Millisecond):
+		}
+		streamHub.RemoveObjectStreamClient(r.ClientId)
+	}
+	invalid := &api.StreamRequest{Box: &api.Box{MinLat: -91}}
+	if err := geoDB.Stream(invalid, &mockStreamServer{ctx: context.Background()}); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected invalid argument for an invalid box, got: %v", err)
+	}
+}
+
+func TestStreamExitsOnCancel(t *testing.T) {
+	for name, handler := range map[string]func(ctx context.Context) error{
+		"cancel_stream": func(ctx context.Context) error {
+			return geoDB.Stream(&api.StreamRequest{ClientId: "cancel_stream"}, &mockStreamServer{ctx: ctx, sent: make(chan *api.ObjectDetail, 100)})
+		},
+		"cancel_regex": func(ctx context.Context) error {
+			return geoDB.StreamRegex(&api.StreamRegexRequest{ClientId: "cancel_regex", Regex: ".*"}, &mockStreamRegexServer{ctx: ctx})
+		},
+		"cancel_prefix": func(ctx context.Context) error {
+			return geoDB.StreamPrefix(&api.StreamPrefixRequest{ClientId: "cancel_prefix"}, &mockStreamPrefixServer{ctx: ctx})
+		},
+	} {
+		ctx, cancel := context.WithCancel(context.Background())
+		done := make(chan error, 1)
+		go func() {
+			done <- handler(ctx)
+		}()
+		waitFor(t, name+" to connect", func() bool {
+			return streamHub.GetClientObjectStream(name) != nil
+		})
+		cancel()
+		select {
+		case err := <-done:
+			if err != nil {
+				t.Fatal(err.Error())
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("expected %s to return once its context is done", name)
+		}
+		if streamHub.GetClientObjectStream(name) != nil {
+			t.Fatalf("expected %s to be removed from the hub", name)
+		}
+	}
+}
+
+func TestStreamExitsOnHubClose(t *testing.T) {
+	hub := stream.NewHub()
+	g := services.NewGeoDB(db.NewStore(badgerDB, hub, nil, db.StoreOptionsFromConfig()...), hub, nil, nil)
+	done := make(chan error, 2)
+	go func() {
+		done <- g.Stream(&api.StreamRequest{ClientId: "close_stream"}, &mockStreamServer{ctx: context.Background(), sent: make(chan *api.ObjectDetail)})
+	}()
+	recv := make(chan *api.StreamControlRequest, 1)
+	recv <- &api.StreamControlRequest{ClientId: "close_control"}
+	go func() {
+		done <- g.StreamControl(&mockStreamControlServer{ctx: context.Background(), recv: recv, sent: make(chan *api.ObjectDetail)})
+	}()
+	waitFor(t, "stream clients to connect", func() bool {
+		return hub.GetClientObjectStream("close_stream") != nil && hub.GetClientObjectStream("close_control") != nil
+	})
+	hub.Close()
+	for i := 0; i < 2; i++ {
+		select {
+		case err := <-done:
+			if err != nil {
+				t.Fatal(err.Error())
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatal("expected stream handlers to exit when the hub is closed")
+		}
+	}
+}
+
+func TestHistory(t *testing.T) {
+	store := db.NewStore(badgerDB, streamHub, nil, db.WithHistoryMax(3))
+	var trail []*api.Point
+	for i := 0; i < 5; i++ {
+		point := &api.Point{Lat: coorsField.Lat + float64(i)*0.001, Lon: coorsField.Lon}
+		trail = append(trail, point)
+		if _, err := store.Set(context.Background(), &api.Object{
+			Key:         "history_truck",
+			Point:       point,
+			Radius:      1,
+			KeepHistory: true,
+		}); err != nil {
+			t.Fatal(err.Error())
+		}
+		if _, err := store.Set(context.Background(), &api.Object{
+			Key:    "history_untracked",
+			Point:  point,
+			Radius: 1,
+		}); err != nil {
+			t.Fatal(err.Error())
+		}
+	}
+	resp, err := geoDB.GetHistory(context.Background(), &api.HistoryRequest{Key: "history_truck"})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(resp.Points) != 3 {
+		t.Fatalf("expected the history to be trimmed to 3 positions, got: %v", len(resp.Points))
+	}
+	for i, point := range resp.Points {
+		if point.Point.Lat != trail[i+2].Lat {
+			t.Fatalf("expected position %v to be %v, got: %v", i, trail[i+2], point.Point)
+		}
+		if i > 0 && point.TimestampNanos <= resp.Points[i-1].TimestampNanos {
+			t.Fatal("expected the history oldest first")
+		}
+	}
+	resp, err = geoDB.GetHistory(context.Background(), &api.HistoryRequest{Key: "history_truck", Limit: 1})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(resp.Points) != 1 || resp.Points[0].Point.Lat != trail[4].Lat {
+		t.Fatalf("expected only the latest position, got: %v", resp.Points)
+	}
+	if resp, err := geoDB.GetHistory(context.Background(), &api.HistoryRequest{Key: "history_untracked"}); err != nil || len(resp.Points) != 0 {
+		t.Fatalf("expected no history for an object without keep_history, got: %v %v", resp, err)
+	}
+	if err := store.Delete(context.Background(), []string{"history_truck", "history_untracked"}); err != nil {
+		t.Fatal(err.Error())
+	}
+	if resp, err := geoDB.GetHistory(context.Background(), &api.HistoryRequest{Key: "history_truck"}); err != nil || len(resp.Points) != 0 {
+		t.Fatalf("expected deleting the object to delete its history, got: %v %v", resp, err)
+	}
+}
+
+func TestNamespaces(t *testing.T) {
+	ctx := context.Background()
+	namespaces := map[string]*api.Point{"tenant_a": coorsField, "tenant_b": pepsiCenter}
+	defer func() {
+		for ns := range namespaces {
+			geoDB.Delete(ctx, &api.DeleteRequest{Namespace: ns, Keys: []string{"*"}})
+		}
+	}()
+	streams := map[string]*mockStreamServer{}
+	for ns := range namespaces {
+		streams[ns] = &mockStreamServer{ctx: ctx, sent: make(chan *api.ObjectDetail, 10)}
+		r := &api.StreamRequest{ClientId: "namespace_" + ns, Namespace: ns}
+		go geoDB.Stream(r, streams[ns])
+		waitFor(t, "stream client to connect", func() bool {
+			return streamHub.GetClientObjectStream(r.ClientId) != nil
+		})
+		defer streamHub.RemoveObjectStreamClient(r.ClientId)
+	}
+	for ns, depot := range namespaces {
+		if _, err := geoDB.Set(ctx, &api.SetRequest{
+			Namespace: ns,
+			Object:    &api.Object{Key: "depot", Point: depot, Radius: 100},
+		}); err != nil {
+			t.Fatal(err.Error())
+		}
+	}
+	// both trucks are at coors field & track the depot in their own namespace
+	for ns := range namespaces {
+		resp, err := geoDB.Set(ctx, &api.SetRequest{
+			Namespace: ns,
+			Object: &api.Object{
+				Key:    "truck",
+				Point:  coorsField,
+				Radius: 100,
+				Tracking: &api.ObjectTracking{
+					Trackers: []*api.ObjectTracker{{TargetObjectKey: "depot", TrackDistance: true}},
+				},
+			},
+		})
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		if resp.Object.Object.Key != "truck" {
+			t.Fatalf("%s: expected the namespace to be stripped from the key, got: %s", ns, resp.Object.Object.Key)
+		}
+		if len(resp.Object.TrackerEvents) != 1 {
+			t.Fatalf("%s: expected 1 tracker event, got: %v", ns, len(resp.Object.TrackerEvents))
+		}
+		event := resp.Object.TrackerEvents[0]
+		if event.Object.Key != "depot" || !proto.Equal(event.Object.Point, namespaces[ns]) {
+			t.Fatalf("%s: expected an event for the namespace's own depot, got: %s", ns, helpers.PrettyJson(event))
+		}
+		if inside := ns == "tenant_a"; event.Inside != inside {
+			t.Fatalf("%s: expected inside to be %v, got: %v", ns, inside, event.Inside)
+		}
+	}
+	resp, err := geoDB.Set(ctx, &api.SetRequest{
+		Namespace: "tenant_c",
+		Object: &api.Object{
+			Key:    "truck",
+			Point:  coorsField,
+			Radius: 100,
+			Tracking: &api.ObjectTracking{
+				Trackers: []*api.ObjectTracker{{TargetObjectKey: "depot", TrackDistance: true}},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer geoDB.Delete(ctx, &api.DeleteRequest{Namespace: "tenant_c", Keys: []string{"truck"}})
+	if len(resp.Object.TrackerEvents) != 0 {
+		t.Fatalf("expected no tracker events for a target outside the namespace, got: %v", len(resp.Object.TrackerEvents))
+	}
+	for ns, ss := range streams {
+		for _, expect := range []string{"depot", "truck"} {
+			select {
+			case obj := <-ss.sent:
+				if obj.Object.Key != expect || !proto.Equal(obj.Object.Point, map[string]*api.Point{"depot": namespaces[ns], "truck": coorsField}[expect]) {
+					t.Fatalf("%s: expected to stream its own %s, got: %s", ns, expect, helpers.PrettyJson(obj))
+				}
+			case <-time.After(5 * time.Second):
+				t.Fatalf("%s: expected %s to be streamed", ns, expect)
+			}
+		}
+		select {
+		case obj := <-ss.sent:
+			t.Fatalf("%s: unexpected update from another namespace: %s", ns, obj.Object.Key)
+		case <-time.After(100 * time.Millisecond):
+		}
+	}
+	for ns, depot := range namespaces {
+		get, err := geoDB.Get(ctx, &api.GetRequest{Namespace: ns, Keys: []string{"depot", "missing"}})
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		if len(get.Objects) != 1 || !proto.Equal(get.Objects["depot"].Object.Point, depot) {
+			t.Fatalf("%s: expected the namespace's depot, got: %s", ns, helpers.PrettyJson(get))
+		}
+		if len(get.NotFound) != 1 || get.NotFound[0] != "missing" {
+			t.Fatalf("%s: expected missing to be not found, got: %v", ns, get.NotFound)
+		}
+		all, err := geoDB.Get(ctx, &api.GetRequest{Namespace: ns})
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		if len(all.Objects) != 2 {
+			t.Fatalf("%s: expected 2 objects in the namespace, got: %v", ns, len(all.Objects))
+		}
+		keys, err := geoDB.GetKeys(ctx, &api.GetKeysRequest{Namespace: ns, Limit: 1})
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		if len(keys.Keys) != 1 || keys.Keys[0] != "depot" || keys.NextCursor != "depot" {
+			t.Fatalf("%s: expected the first page of the namespace's keys, got: %v %s", ns, keys.Keys, keys.NextCursor)
+		}
+		keys, err = geoDB.GetKeys(ctx, &api.GetKeysRequest{Namespace: ns, Cursor: keys.NextCursor})
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		if len(keys.Keys) != 1 || keys.Keys[0] != "truck" || keys.NextCursor != "" {
+			t.Fatalf("%s: expected the last page of the namespace's keys, got: %v %s", ns, keys.Keys, keys.NextCursor)
+		}
+		regex, err := geoDB.GetRegex(ctx, &api.GetRegexRequest{Namespace: ns, Regex: "^depot$"})
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		if len(regex.Objects) != 1 || !proto.Equal(regex.Objects["depot"].Object.Point, depot) {
+			t.Fatalf("%s: expected the regex to match the namespace's depot, got: %s", ns, helpers.PrettyJson(regex))
+		}
+		regexKeys, err := geoDB.GetRegexKeys(ctx, &api.GetRegexKeysRequest{Namespace: ns, Regex: "^truck$"})
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		if len(regexKeys.Keys) != 1 || regexKeys.Keys[0] != "truck" {
+			t.Fatalf("%s: expected the regex to match the namespace's truck, got: %v", ns, regexKeys.Keys)
+		}
+	}
+	if _, err := geoDB.Delete(ctx, &api.DeleteRequest{Namespace: "tenant_a", Keys: []string{"*"}}); err != nil {
+		t.Fatal(err.Error())
+	}
+	for ns, expect := range map[string]int{"tenant_a": 0, "tenant_b": 2} {
+		keys, err := geoDB.GetKeys(ctx, &api.GetKeysRequest{Namespace: ns})
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		if len(keys.Keys) != expect {
+			t.Fatalf("%s: expected %v keys after deleting tenant_a, got: %v", ns, expect, keys.Keys)
+		}
+	}
+	if _, err := geoDB.GetKeys(ctx, &api.GetKeysRequest{Namespace: "tenant:a"}); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected invalid argument for an invalid namespace, got: %v", err)
+	}
+}
+
+func TestMaxResultsCapsScans(t *testing.T) {
+	store := newMemStore(t, nil)
+	for _, key := range []string{"capped_a", "capped_b", "capped_c"} {
+		if _, err := store.Set(context.Background(), &api.Object{Key: key, Point: coorsField, Radius: 100}); err != nil {
+			t.Fatal(err.Error())
+		}
+	}
+	ctx := db.WithMaxResults(context.Background(), 2)
+	if _, err := store.GetGlob(ctx, "capped_*", nil); status.Code(err) != codes.ResourceExhausted {
+		t.Fatalf("expected GetGlob to be capped, got: %v", err)
+	}
+	if _, err := store.WithinRadius(ctx, coorsField, 1000, nil, nil); status.Code(err) != codes.ResourceExhausted {
+		t.Fatalf("expected WithinRadius to be capped, got: %v", err)
+	}
+	bound := &api.Bound{Center: coorsField, Radius: 1000}
+	if _, err := store.ScanBound(ctx, bound, nil, nil); status.Code(err) != codes.ResourceExhausted {
+		t.Fatalf("expected ScanBound to be capped, got: %v", err)
+	}
+	// nearest keeps only the k nearest, so it isn't capped
+	nearest, err := store.Nearest(ctx, pepsiCenter, 2, nil, nil)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(nearest) != 2 || nearest[0].Object.GetObject().GetKey() != "capped_a" || nearest[1].Object.GetObject().GetKey() != "capped_b" {
+		t.Fatalf("expected the 2 nearest objects ordered by key, got: %v", nearest)
+	}
+	objects, err := store.GetGlob(db.WithMaxResults(context.Background(), 3), "capped_*", nil)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(objects) != 3 {
+		t.Fatalf("expected 3 objects under the cap, got: %v", len(objects))
+	}
+}
+
+func TestNamespaceScopedQueries(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	// a global key that looked like a namespaced one when namespaces were stored as namespace:key
+	if _, err := geoDB.Set(ctx, &api.SetRequest{Object: &api.Object{Key: "tenant_a:depot", Point: saintJosephHospital, Radius: 100}}); err != nil {
+		t.Fatal(err.Error())
+	}
+	defer geoDB.Delete(ctx, &api.DeleteRequest{Keys: []string{"tenant_a:depot"}})
+	control := &mockStreamControlServer{ctx: ctx, recv: make(chan *api.StreamControlRequest), sent: make(chan *api.ObjectDetail, 10)}
+	go geoDB.StreamControl(control)
+	control.recv <- &api.StreamControlRequest{ClientId: "namespace_control", Namespace: "tenant_b"}
+	waitFor(t, "stream client to connect", func() bool {
+		return streamHub.GetClientObjectStream("namespace_control") != nil
+	})
+	for _, ns := range []string{"tenant_a", "tenant_b"} {
+		defer geoDB.Delete(ctx, &api.DeleteRequest{Namespace: ns, Keys: []string{"*"}})
+		if _, err := geoDB.Set(ctx, &api.SetRequest{
+			Namespace: ns,
+			Object:    &api.Object{Key: "depot", Point: coorsField, Radius: 100, KeepHistory: true},
+		}); err != nil {
+			t.Fatal(err.Error())
+		}
+	}
+	if _, err := geoDB.Set(ctx, &api.SetRequest{Object: &api.Object{Key: "tenant_a\x1fdepot", Point: coorsField, Radius: 100}}); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected invalid argument for a global key containing the namespace separator, got: %v", err)
+	}
+	get, err := geoDB.Get(ctx, &api.GetRequest{Namespace: "tenant_a", Keys: []string{"depot"}})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if !proto.Equal(get.Objects["depot"].GetObject().GetPoint(), coorsField) {
+		t.Fatalf("expected the namespace's depot instead of the global tenant_a:depot, got: %s", helpers.PrettyJson(get))
+	}
+	count, err := geoDB.Count(ctx, &api.CountRequest{Namespace: "tenant_a"})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if count.Count != 1 {
+		t.Fatalf("expected 1 object in tenant_a, got: %v", count.Count)
+	}
+	count, err = geoDB.Count(ctx, &api.CountRequest{Prefix: "tenant_a"})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if count.Count != 1 {
+		t.Fatalf("expected the global count to exclude the namespaces, got: %v", count.Count)
+	}
+	glob, err := geoDB.GetGlob(ctx, &api.GetGlobRequest{Pattern: "tenant_a*"})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(glob.Objects) != 1 || glob.Objects["tenant_a:depot"] == nil {
+		t.Fatalf("expected the global glob to only match the global key, got: %s", helpers.PrettyJson(glob))
+	}
+	nearest, err := geoDB.Nearest(ctx, &api.NearestRequest{Namespace: "tenant_a", Center: coorsField, K: 10})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(nearest.Objects) != 1 || nearest.Objects[0].Object.Object.Key != "depot" {
+		t.Fatalf("expected only the namespace's depot to be nearest, got: %s", helpers.PrettyJson(nearest))
+	}
+	radius, err := geoDB.GetWithinRadius(ctx, &api.RadiusRequest{Namespace: "tenant_a", Center: coorsField, Meters: 1000})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(radius.Objects) != 1 || radius.Objects[0].Object.Object.Key != "depot" {
+		t.Fatalf("expected only the namespace's depot within the radius, got: %s", helpers.PrettyJson(radius))
+	}
+	bound, err := geoDB.ScanBound(ctx, &api.ScanBoundRequest{Namespace: "tenant_a", Bound: &api.Bound{Center: coorsField, Radius: 1000}})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(bound.Objects) != 1 || bound.Objects["depot"] == nil {
+		t.Fatalf("expected only the namespace's depot in the bound, got: %s", helpers.PrettyJson(bound))
+	}
+	cluster, err := geoDB.Cluster(ctx, &api.ClusterRequest{Namespace: "tenant_a", Precision: 5})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if cluster.Total != 1 {
+		t.Fatalf("expected 1 clustered object in tenant_a, got: %v", cluster.Total)
+	}
+	history, err := geoDB.GetHistory(ctx, &api.HistoryRequest{Namespace: "tenant_a", Key: "depot"})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(history.Points) != 1 {
+		t.Fatalf("expected the namespace's depot history, got: %v", len(history.Points))
+	}
+	history, err = geoDB.GetHistory(ctx, &api.HistoryRequest{Key: "tenant_a\x1fdepot"})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(history.Points) != 0 {
+		t.Fatalf("expected no global history of a namespaced key, got: %v", len(history.Points))
+	}
+	bulk, err := geoDB.BulkUpdatePositions(ctx, &api.BulkUpdatePositionsRequest{
+		Namespace: "tenant_b",
+		Updates:   []*api.PositionUpdate{{Key: "depot", Point: pepsiCenter}, {Key: "missing", Point: pepsiCenter}},
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(bulk.Objects) != 1 || bulk.Objects[0].Object.Key != "depot" || len(bulk.NotFound) != 1 || bulk.NotFound[0] != "missing" {
+		t.Fatalf("expected the namespace's depot to move, got: %s", helpers.PrettyJson(bulk))
+	}
+	many, err := geoDB.SetMany(ctx, &api.SetManyRequest{
+		Namespace: "tenant_b",
+		Objects:   []*api.Object{{Key: "truck", Point: pepsiCenter, Radius: 100}},
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(many.Objects) != 1 || many.Objects[0].Object.Key != "truck" {
+		t.Fatalf("expected the namespace to be stripped from the written keys, got: %s", helpers.PrettyJson(many))
+	}
+	// the depot is streamed when it's written & moved
+	for _, expect := range []string{"depot", "depot", "truck"} {
+		select {
+		case obj := <-control.sent:
+			if obj.Object.Key != expect {
+				t.Fatalf("expected to stream tenant_b's %s, got: %s", expect, helpers.PrettyJson(obj))
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("expected tenant_b's %s to be streamed", expect)
+		}
+	}
+	get, err = geoDB.Get(ctx, &api.GetRequest{Namespace: "tenant_a", Keys: []string{"depot", "truck"}})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(get.Objects) != 1 || !proto.Equal(get.Objects["depot"].Object.Point, coorsField) {
+		t.Fatalf("expected tenant_b's writes to leave tenant_a alone, got: %s", helpers.PrettyJson(get))
+	}
+	deleted, err := geoDB.DeleteRegex(ctx, &api.DeleteRegexRequest{Namespace: "tenant_b", Regex: "^(depot|truck)$"})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if deleted.Deleted != 2 {
+		t.Fatalf("expected 2 objects deleted from tenant_b, got: %v", deleted.Deleted)
+	}
+	deletedPrefix, err := geoDB.DeletePrefix(ctx, &api.DeletePrefixRequest{Prefix: "tenant_a"})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if deletedPrefix.Deleted != 1 {
+		t.Fatalf("expected the global prefix delete to only delete tenant_a:depot, got: %v", deletedPrefix.Deleted)
+	}
+	if count, err := geoDB.Count(ctx, &api.CountRequest{Namespace: "tenant_a"}); err != nil || count.Count != 1 {
+		t.Fatalf("expected tenant_a's depot to survive the global deletes, got: %v %v", count, err)
+	}
+}
+
+func TestSetIfVersion(t *testing.T) {
+	ctx := context.Background()
+	defer geoDB.Delete(ctx, &api.DeleteRequest{Keys: []string{"cas_object"}})
+	resp, err := geoDB.Set(ctx, &api.SetRequest{Object: &api.Object{Key: "cas_object", Point: coorsField, Radius: 1}})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if resp.Object.Object.Version != 1 {
+		t.Fatalf("expected version 1 after the first write, got: %v", resp.Object.Object.Version)
+	}
+	version := resp.Object.Object.Version
+	for round := 0; round < 10; round++ {
+		start := make(chan struct{})
+		errs := make(chan error, 2)
+		for _, point := range []*api.Point{coorsField, pepsiCenter} {
+			go func(point *api.Point) {
+				<-start
+				_, err := geoDB.Set(ctx, &api.SetRequest{
+					Object:    &api.Object{Key: "cas_object", Point: point, Radius: 1},
+					IfVersion: version,
+				})
+				errs <- err
+			}(point)
+		}
+		close(start)
+		succeeded := 0
+		for i := 0; i < 2; i++ {
+			err := <-errs
+			switch status.Code(err) {
+			case codes.OK:
+				succeeded++
+			case codes.FailedPrecondition:
+			default:
+				t.Fatalf("expected failed precondition for the losing write, got: %v", err)
+			}
+		}
+		if succeeded != 1 {
+			t.Fatalf("expected exactly one conditional write to succeed, got: %v", succeeded)
+		}
+		get, err := geoDB.Get(ctx, &api.GetRequest{Keys: []string{"cas_object"}})
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		if got := get.Objects["cas_object"].Object.Version; got != version+1 {
+			t.Fatalf("expected version %v after the winning write, got: %v", version+1, got)
+		}
+		version++
+	}
+	if _, err := geoDB.Set(ctx, &api.SetRequest{
+		Object:    &api.Object{Key: "cas_object", Point: coorsField, Radius: 1},
+		IfVersion: version - 1,
+	}); status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("expected failed precondition for a stale version, got: %v", err)
+	}
+	resp, err = geoDB.Set(ctx, &api.SetRequest{Object: &api.Object{Key: "cas_object", Point: coorsField, Radius: 1}})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if resp.Object.Object.Version != version+1 {
+		t.Fatalf("expected unconditional writes to increment the version, got: %v", resp.Object.Object.Version)
+	}
+}
+
+func TestStreamReplay(t *testing.T) {
+	ctx := context.Background()
+	keys := []string{"replay_old", "replay_first", "replay_second", "replay_live"}
+	defer geoDB.Delete(ctx, &api.DeleteRequest{Keys: keys})
+	now := time.Now()
+	for key, updated := range map[string]time.Time{
+		"replay_old":    now.Add(-time.Hour),
+		"replay_first":  now.Add(-time.Minute),
+		"replay_second": now.Add(-30 * time.Second),
+	} {
+		if _, err := geoDB.Set(ctx, &api.SetRequest{
+			Object: &api.Object{Key: key, Point: coorsField, Radius: 1, UpdatedUnix: updated.Unix()},
+		}); err != nil {
+			t.Fatal(err.Error())
+		}
+	}
+	ss := &mockStreamServer{ctx: ctx, sent: make(chan *api.ObjectDetail, 10)}
+	r := &api.StreamRequest{ClientId: "replay", Keys: keys, SinceUnix: now.Add(-2 * time.Minute).Unix()}
+	go geoDB.Stream(r, ss)
+	defer streamHub.RemoveObjectStreamClient(r.ClientId)
+	waitFor(t, "stream client to connect", func() bool {
+		return streamHub.GetClientObjectStream(r.ClientId) != nil
+	})
+	if _, err := geoDB.Set(ctx, &api.SetRequest{
+		Object: &api.Object{Key: "replay_live", Point: coorsField, Radius: 1},
+	}); err != nil {
+		t.Fatal(err.Error())
+	}
+	for _, expect := range []string{"replay_first", "replay_second"} {
+		select {
+		case obj := <-ss.sent:
+			if obj.Object.Key != expect {
+				t.Fatalf("expected the backlog to be replayed oldest first, expected %s got: %s", expect, obj.Object.Key)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("expected %s to be replayed", expect)
+		}
+	}
+	// the backlog writes may still be in flight to the new client, so they can be delivered again(at least once)
+	for {
+		select {
+		case obj := <-ss.sent:
+			switch obj.Object.Key {
+			case "replay_live":
+				return
+			case "replay_old", "replay_first", "replay_second":
+			default:
+				t.Fatalf("unexpected update: %s", obj.Object.Key)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatal("expected the live update to be streamed after the backlog")
+		}
+	}
+}
+
+func TestDeleteTombstone(t *testing.T) {
+	ctx := context.Background()
+	ss := &mockStreamServer{ctx: ctx, sent: make(chan *api.ObjectDetail, 10)}
+	r := &api.StreamRequest{ClientId: "tombstone", Keys: []string{"tombstone_object"}}
+	go geoDB.Stream(r, ss)
+	defer streamHub.RemoveObjectStreamClient(r.ClientId)
+	waitFor(t, "stream client to connect", func() bool {
+		return streamHub.GetClientObjectStream(r.ClientId) != nil
+	})
+	if _, err := geoDB.Set(ctx, &api.SetRequest{
+		Object: &api.Object{Key: "tombstone_object", Point: coorsField, Radius: 1},
+	}); err != nil {
+		t.Fatal(err.Error())
+	}
+	if _, err := geoDB.Delete(ctx, &api.DeleteRequest{Keys: []string{"tombstone_object", "tombstone_missing"}}); err != nil {
+		t.Fatal(err.Error())
+	}
+	for _, deleted := range []bool{false, true} {
+		select {
+		case obj := <-ss.sent:
+			if obj.Object.Key != "tombstone_object" || obj.Deleted != deleted {
+				t.Fatalf("expected tombstone_object with deleted=%v, got: %s", deleted, helpers.PrettyJson(obj))
+			}
+			if deleted && !proto.Equal(obj.Object.Point, coorsField) {
+				t.Fatalf("expected the tombstone to carry the deleted object, got: %s", helpers.PrettyJson(obj))
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("expected an update with deleted=%v", deleted)
+		}
+	}
+	select {
+	case obj := <-ss.sent:
+		t.Fatalf("unexpected update: %s", helpers.PrettyJson(obj))
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+func TestOdometer(t *testing.T) {
+	ctx := context.Background()
+	defer geoDB.Delete(ctx, &api.DeleteRequest{Keys: []string{"odometer_object"}})
+	path := []*api.Point{coorsField, pepsiCenter, pepsiCenter, saintJosephHospital, coorsField}
+	var expected float64
+	for i, point := range path {
+		if i > 0 {
+			expected += helpers.Distance(path[i-1], point)
+		}
+		resp, err := geoDB.Set(ctx, &api.SetRequest{
+			Object: &api.Object{Key: "odometer_object", Point: point, Radius: 1, TrackOdometer: true},
+		})
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		if got := resp.Object.Object.OdometerMeters; math.Abs(got-expected) > 1e-6 {
+			t.Fatalf("step %v: expected %v meters traveled, got: %v", i, expected, got)
+		}
+	}
+	if expected == 0 {
+		t.Fatal("expected the path to have a length")
+	}
+	update, err := geoDB.Update(ctx, &api.UpdateRequest{Key: "odometer_object", Point: pepsiCenter})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	expected += helpers.Distance(coorsField, pepsiCenter)
+	if got := update.Object.Object.OdometerMeters; math.Abs(got-expected) > 1e-6 {
+		t.Fatalf("expected updates to advance the odometer to %v, got: %v", expected, got)
+	}
+	resp, err := geoDB.Set(ctx, &api.SetRequest{
+		Object: &api.Object{Key: "odometer_object", Point: coorsField, Radius: 1, OdometerMeters: 100},
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if resp.Object.Object.OdometerMeters != 0 {
+		t.Fatalf("expected no odometer without track_odometer, got: %v", resp.Object.Object.OdometerMeters)
+	}
+}
+
+func TestListStreamClients(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	before := time.Now().Unix()
+	for _, id := range []string{"list_clients_a", "list_clients_b"} {
+		go geoDB.Stream(&api.StreamRequest{ClientId: id}, &mockStreamServer{ctx: ctx, sent: make(chan *api.ObjectDetail, 100)})
+		id := id
+		waitFor(t, "stream client to connect", func() bool {
+			return streamHub.GetClientObjectStream(id) != nil
+		})
+	}
+	resp, err := geoDB.ListStreamClients(context.Background(), &api.ListClientsRequest{})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	found := map[string]*api.StreamClient{}
+	for _, client := range resp.Clients {
+		found[client.ClientId] = client
+	}
+	for _, id := range []string{"list_clients_a", "list_clients_b"} {
+		client, ok := found[id]
+		if !ok {
+			t.Fatalf("expected %s to be listed, got: %s", id, helpers.PrettyJson(resp))
+		}
+		if client.ConnectedUnix < before || client.Dropped != 0 || client.Paused {
+			t.Fatalf("unexpected client state: %s", helpers.PrettyJson(client))
+		}
+	}
+	cancel()
+	waitFor(t, "stream clients to disconnect", func() bool {
+		resp, err := geoDB.ListStreamClients(context.Background(), &api.ListClientsRequest{})
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		for _, client := range resp.Clients {
+			if strings.HasPrefix(client.ClientId, "list_clients_") {
+				return false
+			}
+		}
+		return true
+	})
+}
+
+func TestSetValidatesPoint(t *testing.T) {
+	ctx := context.Background()
+	defer geoDB.Delete(ctx, &api.DeleteRequest{Keys: []string{"valid_point"}})
+	for name, point := range map[string]*api.Point{
+		"nil":       nil,
+		"lat_high":  {Lat: 90.0001, Lon: 0},
+		"lat_low":   {Lat: -9999, Lon: 0},
+		"lon_high":  {Lat: 0, Lon: 180.5},
+		"lon_low":   {Lat: 0, Lon: -500},
+		"both_high": {Lat: 9999, Lon: -500},
+	} {
+		_, err := geoDB.Set(ctx, &api.SetRequest{Object: &api.Object{Key: "invalid_point", Point: point, Radius: 1}})
+		if status.Code(err) != codes.InvalidArgument {
+			t.Fatalf("%s: expected invalid argument, got: %v", name, err)
+		}
+		if !strings.Contains(err.Error(), "invalid_point") {
+			t.Fatalf("%s: expected the error to name the key, got: %s", name, err.Error())
+		}
+		if _, err := geoDB.SetMany(ctx, &api.SetManyRequest{Objects: []*api.Object{{Key: "invalid_point", Point: point, Radius: 1}}}); status.Code(err) != codes.InvalidArgument {
+			t.Fatalf("%s: expected invalid argument from SetMany, got: %v", name, err)
+		}
+	}
+	for _, point := range []*api.Point{{Lat: 90, Lon: 180}, {Lat: -90, Lon: -180}} {
+		if _, err := geoDB.Set(ctx, &api.SetRequest{Object: &api.Object{Key: "valid_point", Point: point, Radius: 1}}); err != nil {
+			t.Fatalf("expected the range limits to be valid, got: %v", err)
+		}
+	}
+	if _, err := geoDB.Update(ctx, &api.UpdateRequest{Key: "valid_point", Point: &api.Point{Lat: 91}}); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected invalid argument for an out of range update, got: %v", err)
+	}
+	get, err := geoDB.Get(ctx, &api.GetRequest{Keys: []string{"invalid_point"}})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(get.Objects) != 0 {
+		t.Fatal("expected invalid points not to be written")
+	}
+}
+
+func TestSetSkipsSelfTracking(t *testing.T) {
+	ctx := context.Background()
+	defer geoDB.Delete(ctx, &api.DeleteRequest{Keys: []string{"self_tracking"}})
+	for i := 0; i < 2; i++ {
+		resp, err := geoDB.Set(ctx, &api.SetRequest{
+			Object: &api.Object{
+				Key:    "self_tracking",
+				Point:  coorsField,
+				Radius: 100,
+				Tracking: &api.ObjectTracking{
+					Trackers: []*api.ObjectTracker{{TargetObjectKey: "self_tracking", TrackDistance: true}},
+				},
+			},
+		})
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		if len(resp.Object.TrackerEvents) != 0 {
+			t.Fatalf("expected no tracker event against the object itself, got: %s", helpers.PrettyJson(resp.Object))
+		}
+	}
+}
+
+func TestInMemoryMode(t *testing.T) {
+	config.Config.Set("GEODB_IN_MEMORY", true)
+	defer config.Config.Set("GEODB_IN_MEMORY", false)
+	memDB, hub, gmaps, deadLetters, err := server.GetDeps()
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer memDB.Close()
+	if err := memDB.RunValueLogGC(0.7); err != badger.ErrGCInMemoryMode {
+		t.Fatalf("expected an in memory database, got: %v", err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go hub.StartObjectStream(ctx)
+	memGeoDB := services.NewGeoDB(db.NewStore(memDB, hub, gmaps, db.StoreOptionsFromConfig()...), hub, gmaps, deadLetters)
+	ss := &mockStreamServer{ctx: ctx, sent: make(chan *api.ObjectDetail, 10)}
+	go memGeoDB.Stream(&api.StreamRequest{ClientId: "in_memory"}, ss)
+	waitFor(t, "stream client to connect", func() bool {
+		return hub.GetClientObjectStream("in_memory") != nil
+	})
+	if _, err := memGeoDB.Set(ctx, &api.SetRequest{
+		Object: &api.Object{Key: "memory_depot", Point: coorsField, Radius: 100},
+	}); err != nil {
+		t.Fatal(err.Error())
+	}
+	resp, err := memGeoDB.Set(ctx, &api.SetRequest{
+		Object: &api.Object{
+			Key:    "memory_truck",
+			Point:  coorsField,
+			Radius: 100,
+			Tracking: &api.ObjectTracking{
+				Trackers: []*api.ObjectTracker{{TargetObjectKey: "memory_depot", TrackDistance: true}},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(resp.Object.TrackerEvents) != 1 || !resp.Object.TrackerEvents[0].Inside {
+		t.Fatalf("expected the truck to be inside the depot, got: %s", helpers.PrettyJson(resp.Object))
+	}
+	get, err := memGeoDB.Get(ctx, &api.GetRequest{Keys: []string{"memory_depot", "memory_truck"}})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(get.Objects) != 2 {
+		t.Fatalf("expected 2 objects, got: %s", helpers.PrettyJson(get))
+	}
+	for _, expect := range []string{"memory_depot", "memory_truck"} {
+		select {
+		case obj := <-ss.sent:
+			if obj.Object.Key != expect {
+				t.Fatalf("expected %s to be streamed, got: %s", expect, obj.Object.Key)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("expected %s to be streamed", expect)
+		}
+	}
+	persisted, err := geoDB.Get(ctx, &api.GetRequest{Keys: []string{"memory_depot", "memory_truck"}})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(persisted.Objects) != 0 {
+		t.Fatal("expected nothing to be written to the persistent database")
+	}
+}
+
+func TestRESTGateway(t *testing.T) {
+	ctx := context.Background()
+	defer geoDB.Delete(ctx, &api.DeleteRequest{Keys: []string{"rest_object"}})
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	grpcServer := grpc.NewServer()
+	api.RegisterGeoDBServer(grpcServer, geoDB)
+	go grpcServer.Serve(lis)
+	defer grpcServer.Stop()
+	conn, err := grpc.Dial(lis.Addr().String(), grpc.WithInsecure())
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer conn.Close()
+	router := echo.New()
+	gateway.Register(router, conn)
+	httpServer := httptest.NewServer(router)
+	defer httpServer.Close()
+	do := func(method, path, body string, resp proto.Message) int {
+		req, err := http.NewRequest(method, httpServer.URL+path, strings.NewReader(body))
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		res, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		defer res.Body.Close()
+		if res.StatusCode == http.StatusOK && resp != nil {
+			if err := jsonpb.Unmarshal(res.Body, resp); err != nil {
+				t.Fatal(err.Error())
+			}
+		}
+		return res.StatusCode
+	}
+	set := &api.SetResponse{}
+	if code := do(http.MethodPost, "/v1/objects", `{"object": {"key": "rest_object", "point": {"lat": 10.5, "lon": -20.5}, "radius": 10}}`, set); code != http.StatusOK {
+		t.Fatalf("expected 200 from POST /v1/objects, got: %v", code)
+	}
+	if set.Object.Object.Key != "rest_object" {
+		t.Fatalf("expected the set object in the response, got: %s", helpers.PrettyJson(set))
+	}
+	get := &api.GetResponse{}
+	if code := do(http.MethodGet, "/v1/objects?keys=rest_object&keys=rest_missing", "", get); code != http.StatusOK {
+		t.Fatalf("expected 200 from GET /v1/objects, got: %v", code)
+	}
+	if obj, ok := get.Objects["rest_object"]; !ok || !proto.Equal(obj.Object.Point, &api.Point{Lat: 10.5, Lon: -20.5}) {
+		t.Fatalf("expected rest_object to be returned, got: %s", helpers.PrettyJson(get))
+	}
+	if len(get.NotFound) != 1 || get.NotFound[0] != "rest_missing" {
+		t.Fatalf("expected rest_missing to be not found, got: %v", get.NotFound)
+	}
+	nearest := &api.NearestResponse{}
+	if code := do(http.MethodGet, "/v1/nearest?center.lat=10.5&center.lon=-20.5&k=1&unit=Kilometers", "", nearest); code != http.StatusOK {
+		t.Fatalf("expected 200 from GET /v1/nearest, got: %v", code)
+	}
+	if len(nearest.Objects) != 1 || nearest.Objects[0].Object.Object.Key != "rest_object" {
+		t.Fatalf("expected rest_object to be the nearest object, got: %s", helpers.PrettyJson(nearest))
+	}
+	if code := do(http.MethodPost, "/v1/objects", `{"object": {"key": "rest_invalid", "radius": 10}}`, nil); code != http.StatusBadRequest {
+		t.Fatalf("expected 400 for an invalid object, got: %v", code)
+	}
+	if code := do(http.MethodGet, "/v1/objects?unknown=1", "", nil); code != http.StatusBadRequest {
+		t.Fatalf("expected 400 for an unknown query parameter, got: %v", code)
+	}
+	if code := do(http.MethodDelete, "/v1/objects?keys=rest_object", "", &api.DeleteResponse{}); code != http.StatusOK {
+		t.Fatalf("expected 200 from DELETE /v1/objects, got: %v", code)
+	}
+	get = &api.GetResponse{}
+	do(http.MethodGet, "/v1/objects?keys=rest_object", "", get)
+	if len(get.Objects) != 0 {
+		t.Fatal("expected rest_object to be deleted")
+	}
+}
+
+func TestTrackerEventsCarryInsideState(t *testing.T) {
+	ctx := context.Background()
+	var (
+		store      *db.Store
+		concurrent bool
+	)
+	truck := func(point *api.Point) *api.Object {
+		return &api.Object{
+			Key:    "carried_truck",
+			Point:  point,
+			Radius: 100,
+			Tracking: &api.ObjectTracking{
+				Trackers: []*api.ObjectTracker{{TargetObjectKey: "carried_depot", TargetTags: &api.TagFilter{Any: []string{"open"}}}},
+			},
+		}
+	}
+	store = newMemStore(t, nil, db.WithClock(func() time.Time {
+		if concurrent {
+			// runs after the batch was read & before it's written
+			concurrent = false
+			if _, err := store.Set(ctx, truck(coorsField)); err != nil {
+				t.Fatal(err.Error())
+			}
+		}
+		return time.Now()
+	}))
+	depot := func(tag string) {
+		if _, err := store.Set(ctx, &api.Object{Key: "carried_depot", Point: coorsField, Radius: 100, Tags: []string{tag}}); err != nil {
+			t.Fatal(err.Error())
+		}
+	}
+	move := func() []*api.TrackerEvent {
+		detail, err := store.Set(ctx, truck(coorsField))
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		return detail.TrackerEvents
+	}
+	depot("open")
+	if events := move(); len(events) != 1 || events[0].EventType != api.EventType_Enter {
+		t.Fatalf("expected the truck to enter the depot, got: %v", events)
+	}
+	// the depot is filtered out by the tracker's target tags while it's closed
+	depot("closed")
+	if events := move(); len(events) != 0 {
+		t.Fatalf("expected no events for a filtered target, got: %v", events)
+	}
+	depot("open")
+	if events := move(); len(events) != 1 || events[0].EventType != api.EventType_Inside {
+		t.Fatalf("expected the truck to still be inside the depot, got: %v", events)
+	}
+	if _, err := store.Set(ctx, truck(saintJosephHospital)); err != nil {
+		t.Fatal(err.Error())
+	}
+	// the truck enters the depot concurrently with the batch moving it there
+	concurrent = true
+	details, _, err := store.BulkUpdatePositions(ctx, []*api.PositionUpdate{{Key: "carried_truck", Point: coorsField}})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if concurrent {
+		t.Fatal("expected the concurrent write to run during the batch")
+	}
+	if events := details[0].TrackerEvents; len(events) != 1 || events[0].EventType != api.EventType_Inside {
+		t.Fatalf("expected the batch's transition to be computed from the concurrent write, got: %v", events)
+	}
+}
+
+func TestBulkUpdatePositionsKeepsConcurrentWrites(t *testing.T) {
+	ctx := context.Background()
+	var (
+		store      *db.Store
+		concurrent bool
+	)
+	store = newMemStore(t, nil, db.WithClock(func() time.Time {
+		if concurrent {
+			// runs after the batch was read & before it's written
+			concurrent = false
+			if _, err := store.Update(ctx, &api.UpdateRequest{Key: "bulk_concurrent", Metadata: map[string]string{"driver": "colemak"}}); err != nil {
+				t.Fatal(err.Error())
+			}
+		}
+		return time.Now()
+	}))
+	if _, err := store.Set(ctx, &api.Object{Key: "bulk_concurrent", Point: coorsField, Radius: 100}); err != nil {
+		t.Fatal(err.Error())
+	}
+	concurrent = true
+	details, _, err := store.BulkUpdatePositions(ctx, []*api.PositionUpdate{{Key: "bulk_concurrent", Point: pepsiCenter}})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if concurrent {
+		t.Fatal("expected the concurrent update to run during the batch")
+	}
+	objects, err := store.Get(ctx, []string{"bulk_concurrent"})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	obj := objects["bulk_concurrent"].Object
+	if obj.Metadata["driver"] != "colemak" || details[0].Object.Metadata["driver"] != "colemak" {
+		t.Fatalf("expected the move to keep the concurrent metadata update, got: %s", helpers.PrettyJson(obj))
+	}
+	if !proto.Equal(obj.Point, pepsiCenter) || obj.Version != 3 {
+		t.Fatalf("expected the object to be moved after the update, got: %s", helpers.PrettyJson(obj))
+	}
+}
+
+func TestBulkUpdatePositions(t *testing.T) {
+	ctx := context.Background()
+	defer geoDB.Delete(ctx, &api.DeleteRequest{Keys: []string{"bulk_depot", "bulk_truck"}})
+	if _, err := geoDB.SetMany(ctx, &api.SetManyRequest{
+		Objects: []*api.Object{
+			{Key: "bulk_depot", Point: pepsiCenter, Radius: 100},
+			{
+				Key:      "bulk_truck",
+				Point:    saintJosephHospital,
+				Radius:   100,
+				Metadata: map[string]string{"driver": "colemak"},
+				Tracking: &api.ObjectTracking{
+					Trackers: []*api.ObjectTracker{{TargetObjectKey: "bulk_depot", TrackDistance: true}},
+				},
+			},
+		},
+	}); err != nil {
+		t.Fatal(err.Error())
+	}
+	before, err := geoDB.Get(ctx, &api.GetRequest{Keys: []string{"bulk_truck"}})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	ss := &mockStreamServer{ctx: ctx, sent: make(chan *api.ObjectDetail, 10)}
+	go geoDB.Stream(&api.StreamRequest{ClientId: "bulk_positions", Keys: []string{"bulk_truck"}}, ss)
+	waitFor(t, "stream client to connect", func() bool {
+		return streamHub.GetClientObjectStream("bulk_positions") != nil
+	})
+	resp, err := geoDB.BulkUpdatePositions(ctx, &api.BulkUpdatePositionsRequest{
+		Updates: []*api.PositionUpdate{
+			{Key: "bulk_truck", Point: saintJosephHospital},
+			{Key: "bulk_missing", Point: coorsField},
+			{Key: "bulk_depot", Point: coorsField},
+			// the last update of a key wins
+			{Key: "bulk_truck", Point: coorsField},
+		},
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(resp.NotFound) != 1 || resp.NotFound[0] != "bulk_missing" {
+		t.Fatalf("expected bulk_missing to be not found, got: %v", resp.NotFound)
+	}
+	if len(resp.Objects) != 2 {
+		t.Fatalf("expected 2 updated objects, got: %s", helpers.PrettyJson(resp))
+	}
+	truck := resp.Objects[0]
+	if truck.Object.Key != "bulk_truck" || truck.Object.Point.Lat != coorsField.Lat {
+		t.Fatalf("expected bulk_truck at coors field, got: %s", helpers.PrettyJson(truck))
+	}
+	if truck.Object.Metadata["driver"] != "colemak" {
+		t.Fatalf("expected metadata to be preserved, got: %s", helpers.PrettyJson(truck))
+	}
+	if truck.Object.Version != before.Objects["bulk_truck"].Object.Version+1 {
+		t.Fatalf("expected version %v, got: %v", before.Objects["bulk_truck"].Object.Version+1, truck.Object.Version)
+	}
+	// the depot moved in the same batch, so the event is against its new position
+	if len(truck.TrackerEvents) != 1 || truck.TrackerEvents[0].EventType != api.EventType_Enter {
+		t.Fatalf("expected an enter event, got: %s", helpers.PrettyJson(truck))
+	}
+	// the hub publishes asynchronously, so the SetMany may still be streamed before the update
+	timeout := time.After(5 * time.Second)
+	for streamed := false; !streamed; {
+		select {
+		case detail := <-ss.sent:
+			if detail.Object.Version < truck.Object.Version {
+				continue
+			}
+			if detail.Object.Key != "bulk_truck" || detail.Object.Version != truck.Object.Version || detail.Object.Point.Lat != coorsField.Lat {
+				t.Fatalf("unexpected streamed object: %s", helpers.PrettyJson(detail))
+			}
+			streamed = true
+		case <-timeout:
+			t.Fatal("timed out waiting for the streamed update")
+		}
+	}
+	if _, err := geoDB.BulkUpdatePositions(ctx, &api.BulkUpdatePositionsRequest{
+		Updates: []*api.PositionUpdate{{Key: "bulk_truck", Point: &api.Point{Lat: 91, Lon: 0}}},
+	}); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected invalid argument, got: %v", err)
+	}
+}
+
+func TestEventCooldown(t *testing.T) {
+	now := time.Unix(1600000000, 0)
+	store := newMemStore(t, nil, db.WithEventCooldown(time.Minute), db.WithClock(func() time.Time {
+		return now
+	}))
+	ctx := context.Background()
+	if _, err := store.Set(ctx, &api.Object{Key: "cooldown_depot", Point: coorsField, Radius: 100}); err != nil {
+		t.Fatal(err.Error())
+	}
+	events := func(point *api.Point) []*api.TrackerEvent {
+		detail, err := store.Set(ctx, &api.Object{
+			Key:    "cooldown_truck",
+			Point:  point,
+			Radius: 100,
+			Tracking: &api.ObjectTracking{
+				Trackers: []*api.ObjectTracker{{TargetObjectKey: "cooldown_depot"}},
+			},
+		})
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		return detail.TrackerEvents
+	}
+	var emitted []*api.TrackerEvent
+	for i := 0; i < 5; i++ {
+		emitted = append(emitted, events(coorsField)...)
+		now = now.Add(time.Second)
+	}
+	if len(emitted) != 1 || emitted[0].EventType != api.EventType_Enter {
+		t.Fatalf("expected a single enter event within the cooldown, got: %v", emitted)
+	}
+	// the stored detail keeps the suppressed event, so the pair is still known to be overlapping
+	stored, err := store.Get(ctx, []string{"cooldown_truck"})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(stored["cooldown_truck"].TrackerEvents) != 1 || stored["cooldown_truck"].TrackerEvents[0].EventType != api.EventType_Inside {
+		t.Fatalf("expected the stored inside event, got: %s", helpers.PrettyJson(stored["cooldown_truck"]))
+	}
+	now = now.Add(time.Minute)
+	if emitted := events(coorsField); len(emitted) != 1 || emitted[0].EventType != api.EventType_Inside {
+		t.Fatalf("expected an inside event after the cooldown, got: %v", emitted)
+	}
+	if emitted := events(pepsiCenter); len(emitted) != 1 || emitted[0].EventType != api.EventType_Exit {
+		t.Fatalf("expected an exit event, got: %v", emitted)
+	}
+	// leaving resets the pair, so re-entering within the window is emitted
+	if emitted := events(coorsField); len(emitted) != 1 || emitted[0].EventType != api.EventType_Enter {
+		t.Fatalf("expected an enter event after exiting, got: %v", emitted)
+	}
+}
+
+func TestExists(t *testing.T) {
+	ctx := context.Background()
+	defer geoDB.Delete(ctx, &api.DeleteRequest{Keys: []string{"exists_present"}})
+	defer geoDB.Delete(ctx, &api.DeleteRequest{Keys: []string{"exists_present"}, Namespace: "exists"})
+	defer badgerDB.Update(func(txn *badger.Txn) error {
+		return txn.Delete([]byte("exists_wrong_meta"))
+	})
+	if _, err := geoDB.Set(ctx, &api.SetRequest{
+		Object: &api.Object{Key: "exists_present", Point: coorsField, Radius: 100},
+	}); err != nil {
+		t.Fatal(err.Error())
+	}
+	if _, err := geoDB.Set(ctx, &api.SetRequest{
+		Object:    &api.Object{Key: "exists_present", Point: coorsField, Radius: 100},
+		Namespace: "exists",
+	}); err != nil {
+		t.Fatal(err.Error())
+	}
+	// a key that isn't an object, like an index or history entry
+	if err := badgerDB.Update(func(txn *badger.Txn) error {
+		return txn.SetEntry(&badger.Entry{Key: []byte("exists_wrong_meta"), Value: []byte("not an object"), UserMeta: 10})
+	}); err != nil {
+		t.Fatal(err.Error())
+	}
+	resp, err := geoDB.Exists(ctx, &api.ExistsRequest{Keys: []string{"exists_present", "exists_absent", "exists_wrong_meta"}})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	expected := map[string]bool{"exists_present": true, "exists_absent": false, "exists_wrong_meta": false}
+	if len(resp.Exists) != len(expected) {
+		t.Fatalf("expected %v, got: %v", expected, resp.Exists)
+	}
+	for key, ok := range expected {
+		if resp.Exists[key] != ok {
+			t.Fatalf("expected %v, got: %v", expected, resp.Exists)
+		}
+	}
+	resp, err = geoDB.Exists(ctx, &api.ExistsRequest{Keys: []string{"exists_present", "exists_absent"}, Namespace: "exists"})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if !resp.Exists["exists_present"] || resp.Exists["exists_absent"] || len(resp.Exists) != 2 {
+		t.Fatalf("expected namespaced existence, got: %v", resp.Exists)
+	}
+	if _, err := geoDB.Exists(ctx, &api.ExistsRequest{}); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected invalid argument, got: %v", err)
+	}
+}
+
+func TestRunGC(t *testing.T) {
+	ctx := context.Background()
+	var keys []string
+	for i := 0; i < 100; i++ {
+		key := fmt.Sprintf("gc_%v", i)
+		keys = append(keys, key)
+		if _, err := geoDB.Set(ctx, &api.SetRequest{
+			Object: &api.Object{Key: key, Point: coorsField, Radius: 100, Metadata: map[string]string{"padding": strings.Repeat("x", 1024)}},
+		}); err != nil {
+			t.Fatal(err.Error())
+		}
+	}
+	if _, err := geoDB.Delete(ctx, &api.DeleteRequest{Keys: keys}); err != nil {
+		t.Fatal(err.Error())
+	}
+	resp, err := geoDB.RunGC(ctx, &api.GCRequest{})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if resp.Runs < 0 || resp.ReclaimedBytes < 0 {
+		t.Fatalf("unexpected gc response: %s", helpers.PrettyJson(resp))
+	}
+	if _, err := geoDB.RunGC(ctx, &api.GCRequest{DiscardRatio: 0.5}); err != nil {
+		t.Fatal(err.Error())
+	}
+	if _, err := geoDB.RunGC(ctx, &api.GCRequest{DiscardRatio: 1}); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected invalid argument, got: %v", err)
+	}
+	if _, _, err := newMemStore(t, nil).RunGC(ctx, 0.5); status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("expected failed precondition for an in memory database, got: %v", err)
+	}
+}
+
+func TestAggregate(t *testing.T) {
+	ctx := context.Background()
+	defer geoDB.DeletePrefix(ctx, &api.DeletePrefixRequest{Prefix: "aggregate_"})
+	if _, err := geoDB.SetMany(ctx, &api.SetManyRequest{
+		Objects: []*api.Object{
+			{Key: "aggregate_fiji_1", Point: &api.Point{Lat: -17, Lon: 179}, Radius: 100, Tags: []string{"fiji"}},
+			{Key: "aggregate_fiji_2", Point: &api.Point{Lat: -18, Lon: -179}, Radius: 100, Tags: []string{"fiji"}},
+			{Key: "aggregate_fiji_3", Point: &api.Point{Lat: -16, Lon: 178.5}, Radius: 100, Tags: []string{"fiji"}},
+			{Key: "aggregate_denver", Point: coorsField, Radius: 100, Metadata: map[string]string{"city": "denver"}},
+		},
+	}); err != nil {
+		t.Fatal(err.Error())
+	}
+	resp, err := geoDB.Aggregate(ctx, &api.AggregateRequest{Prefix: "aggregate_", Tags: &api.TagFilter{All: []string{"fiji"}}})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if resp.Count != 3 {
+		t.Fatalf("expected 3 objects, got: %v", resp.Count)
+	}
+	// a naive mean of the longitudes would put the centroid near 59.5, on the other side of the world
+	if math.Abs(resp.Centroid.Lat+17) > 0.1 || math.Abs(math.Abs(resp.Centroid.Lon)-179.5) > 0.1 {
+		t.Fatalf("expected a centroid near the antimeridian, got: %s", helpers.PrettyJson(resp.Centroid))
+	}
+	if resp.Bounds.MinLat != -18 || resp.Bounds.MaxLat != -16 || resp.Bounds.MinLon != 178.5 || resp.Bounds.MaxLon != -179 {
+		t.Fatalf("expected bounds crossing the antimeridian, got: %s", helpers.PrettyJson(resp.Bounds))
+	}
+	resp, err = geoDB.Aggregate(ctx, &api.AggregateRequest{Keys: []string{"aggregate_denver", "aggregate_missing"}})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if resp.Count != 1 || math.Abs(resp.Centroid.Lat-coorsField.Lat) > 0.0001 || math.Abs(resp.Centroid.Lon-coorsField.Lon) > 0.0001 {
+		t.Fatalf("expected coors field, got: %s", helpers.PrettyJson(resp))
+	}
+	resp, err = geoDB.Aggregate(ctx, &api.AggregateRequest{Regex: "^aggregate_", MetadataSelector: map[string]string{"city": "denver"}})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if resp.Count != 1 {
+		t.Fatalf("expected the metadata selector to match 1 object, got: %v", resp.Count)
+	}
+	if _, err := geoDB.Aggregate(ctx, &api.AggregateRequest{Prefix: "aggregate_", Tags: &api.TagFilter{All: []string{"missing"}}}); status.Code(err) != codes.NotFound {
+		t.Fatalf("expected not found, got: %v", err)
+	}
+}
+
+func TestPolygonZoneEvents(t *testing.T) {
+	ctx := context.Background()
+	defer geoDB.Delete(ctx, &api.DeleteRequest{Keys: []string{"polygon_zone", "polygon_truck"}})
+	// a square zone around coors field
+	if _, err := geoDB.Set(ctx, &api.SetRequest{
+		Object: &api.Object{
+			Key:    "polygon_zone",
+			Point:  coorsField,
+			Radius: 1,
+			Polygon: []*api.Point{
+				{Lat: coorsField.Lat - 0.002, Lon: coorsField.Lon - 0.002},
+				{Lat: coorsField.Lat - 0.002, Lon: coorsField.Lon + 0.002},
+				{Lat: coorsField.Lat + 0.002, Lon: coorsField.Lon + 0.002},
+				{Lat: coorsField.Lat + 0.002, Lon: coorsField.Lon - 0.002},
+			},
+		},
+	}); err != nil {
+		t.Fatal(err.Error())
+	}
+	for _, tc := range []struct {
+		point    *api.Point
+		expected api.EventType
+	}{
+		{pepsiCenter, api.EventType_Outside},
+		// inside the polygon, but farther from its point than the radii
+		{&api.Point{Lat: coorsField.Lat + 0.0015, Lon: coorsField.Lon + 0.0015}, api.EventType_Enter},
+		{coorsField, api.EventType_Inside},
+		{saintJosephHospital, api.EventType_Exit},
+	} {
+		resp, err := geoDB.Set(ctx, &api.SetRequest{
+			Object: &api.Object{
+				Key:    "polygon_truck",
+				Point:  tc.point,
+				Radius: 1,
+				Tracking: &api.ObjectTracking{
+					Trackers: []*api.ObjectTracker{{TargetObjectKey: "polygon_zone"}},
+				},
+			},
+		})
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		if len(resp.Object.TrackerEvents) != 1 || resp.Object.TrackerEvents[0].EventType != tc.expected {
+			t.Fatalf("expected a %s event, got: %s", tc.expected, helpers.PrettyJson(resp.Object))
+		}
+	}
+	if _, err := geoDB.Set(ctx, &api.SetRequest{
+		Object: &api.Object{Key: "polygon_zone", Point: coorsField, Radius: 1, Polygon: []*api.Point{coorsField, pepsiCenter}},
+	}); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected invalid argument for a 2 vertex polygon, got: %v", err)
+	}
+}
+
+func TestGetKeysReverseAndEndKey(t *testing.T) {
+	ctx := context.Background()
+	keys := []string{"a", "b", "c", "d", "e"}
+	defer geoDB.Delete(ctx, &api.DeleteRequest{Keys: []string{"*"}, Namespace: "key_range"})
+	for _, key := range keys {
+		if _, err := geoDB.Set(ctx, &api.SetRequest{
+			Object:    &api.Object{Key: key, Point: coorsField, Radius: 100},
+			Namespace: "key_range",
+		}); err != nil {
+			t.Fatal(err.Error())
+		}
+	}
+	for _, tc := range []struct {
+		name     string
+		req      *api.GetKeysRequest
+		expected []string
+		next     string
+	}{
+		{"reverse", &api.GetKeysRequest{Reverse: true}, []string{"e", "d", "c", "b", "a"}, ""},
+		{"reverse page", &api.GetKeysRequest{Reverse: true, Limit: 2}, []string{"e", "d"}, "d"},
+		{"previous page", &api.GetKeysRequest{Reverse: true, Limit: 2, Cursor: "d"}, []string{"c", "b"}, "b"},
+		{"bounded range", &api.GetKeysRequest{Cursor: "a", EndKey: "d"}, []string{"b", "c"}, ""},
+		{"bounded page", &api.GetKeysRequest{Cursor: "a", EndKey: "d", Limit: 1}, []string{"b"}, "b"},
+		// the range ends exactly at the limit, so there is no next page
+		{"bounded last page", &api.GetKeysRequest{Cursor: "b", EndKey: "d", Limit: 1}, []string{"c"}, ""},
+		{"reverse bounded range", &api.GetKeysRequest{Reverse: true, Cursor: "e", EndKey: "b"}, []string{"d", "c"}, ""},
+		{"end key", &api.GetKeysRequest{EndKey: "c"}, []string{"a", "b"}, ""},
+	} {
+		tc.req.Namespace = "key_range"
+		resp, err := geoDB.GetKeys(ctx, tc.req)
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		if strings.Join(resp.Keys, ",") != strings.Join(tc.expected, ",") || resp.NextCursor != tc.next {
+			t.Fatalf("%s: expected %v(next: %q), got: %v(next: %q)", tc.name, tc.expected, tc.next, resp.Keys, resp.NextCursor)
+		}
+	}
+	// without a prefix, reverse iteration starts at the last key in the database
+	store := newMemStore(t, nil)
+	for _, key := range keys {
+		if _, err := store.Set(ctx, &api.Object{Key: key, Point: coorsField, Radius: 100}); err != nil {
+			t.Fatal(err.Error())
+		}
+	}
+	if got, _ := store.GetKeys(ctx, "", "", "", 0, true); strings.Join(got, ",") != "e,d,c,b,a" {
+		t.Fatalf("expected every key in reverse, got: %v", got)
+	}
+}
+
+func TestGetTTL(t *testing.T) {
+	ctx := context.Background()
+	defer geoDB.Delete(ctx, &api.DeleteRequest{Keys: []string{"ttl_expiring", "ttl_forever", "ttl_expired"}})
+	if _, err := geoDB.SetMany(ctx, &api.SetManyRequest{
+		Objects: []*api.Object{
+			{Key: "ttl_expiring", Point: coorsField, Radius: 100, TtlSeconds: 3600},
+			{Key: "ttl_forever", Point: coorsField, Radius: 100},
+			{Key: "ttl_expired", Point: coorsField, Radius: 100, ExpiresUnix: time.Now().Add(time.Second).Unix()},
+		},
+	}); err != nil {
+		t.Fatal(err.Error())
+	}
+	waitFor(t, "ttl_expired to expire", func() bool {
+		resp, err := geoDB.Get(ctx, &api.GetRequest{Keys: []string{"ttl_expired"}})
+		return err == nil && len(resp.Objects) == 0
+	})
+	resp, err := geoDB.GetTTL(ctx, &api.TTLRequest{Keys: []string{"ttl_expiring", "ttl_forever", "ttl_expired", "ttl_missing"}})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if ttl := resp.TtlSeconds["ttl_expiring"]; ttl <= 3590 || ttl > 3600 {
+		t.Fatalf("expected about an hour until ttl_expiring expires, got: %v", ttl)
+	}
+	if ttl := resp.TtlSeconds["ttl_forever"]; ttl != db.TTLNoExpiration {
+		t.Fatalf("expected no expiration, got: %v", ttl)
+	}
+	for _, key := range []string{"ttl_expired", "ttl_missing"} {
+		if ttl, ok := resp.TtlSeconds[key]; !ok || ttl != db.TTLNotFound {
+			t.Fatalf("expected %s to be not found, got: %v", key, resp.TtlSeconds)
+		}
+	}
+}
+
+func TestSetDryRun(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	defer geoDB.Delete(ctx, &api.DeleteRequest{Keys: []string{"dry_run_depot", "dry_run_truck"}})
+	truck := func(point *api.Point) *api.Object {
+		return &api.Object{
+			Key:    "dry_run_truck",
+			Point:  point,
+			Radius: 100,
+			Tracking: &api.ObjectTracking{
+				Trackers: []*api.ObjectTracker{{TargetObjectKey: "dry_run_depot"}},
+			},
+		}
+	}
+	if _, err := geoDB.SetMany(ctx, &api.SetManyRequest{
+		Objects: []*api.Object{{Key: "dry_run_depot", Point: coorsField, Radius: 100}, truck(pepsiCenter)},
+	}); err != nil {
+		t.Fatal(err.Error())
+	}
+	before, err := geoDB.Get(ctx, &api.GetRequest{Keys: []string{"dry_run_truck"}})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	ss := &mockStreamServer{ctx: ctx, sent: make(chan *api.ObjectDetail, 10)}
+	go geoDB.Stream(&api.StreamRequest{ClientId: "dry_run", Keys: []string{"dry_run_truck"}}, ss)
+	waitFor(t, "stream client to connect", func() bool {
+		return streamHub.GetClientObjectStream("dry_run") != nil
+	})
+	preview, err := geoDB.Set(ctx, &api.SetRequest{Object: truck(coorsField), DryRun: true})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	after, err := geoDB.Get(ctx, &api.GetRequest{Keys: []string{"dry_run_truck"}})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if stored := after.Objects["dry_run_truck"].Object; stored.Point.Lat != pepsiCenter.Lat || stored.Version != before.Objects["dry_run_truck"].Object.Version {
+		t.Fatalf("expected the dry run not to write the object, got: %s", helpers.PrettyJson(stored))
+	}
+	select {
+	case detail := <-ss.sent:
+		t.Fatalf("expected the dry run not to be streamed, got: %s", helpers.PrettyJson(detail))
+	case <-time.After(100 * time.Millisecond):
+	}
+	resp, err := geoDB.Set(ctx, &api.SetRequest{Object: truck(coorsField)})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if preview.Object.Object.Version != resp.Object.Object.Version {
+		t.Fatalf("expected the predicted version %v, got: %v", preview.Object.Object.Version, resp.Object.Object.Version)
+	}
+	predicted, actual := preview.Object.TrackerEvents, resp.Object.TrackerEvents
+	if len(predicted) != 1 || len(actual) != 1 || predicted[0].EventType != api.EventType_Enter {
+		t.Fatalf("expected a predicted enter event, got: %s", helpers.PrettyJson(preview.Object))
+	}
+	if predicted[0].EventType != actual[0].EventType || predicted[0].Inside != actual[0].Inside || predicted[0].Distance != actual[0].Distance || predicted[0].Object.Key != actual[0].Object.Key {
+		t.Fatalf("expected the predicted events to match the real write, predicted: %s, actual: %s", helpers.PrettyJson(preview.Object), helpers.PrettyJson(resp.Object))
+	}
+}
+
+func TestMaxResponseSize(t *testing.T) {
+	ctx := context.Background()
+	defer geoDB.DeletePrefix(ctx, &api.DeletePrefixRequest{Prefix: "large_"})
+	// ~4.5MB of objects, over the default 4MB message limit
+	payload := strings.Repeat("x", 2048)
+	var objects []*api.Object
+	for i := 0; i < 2200; i++ {
+		objects = append(objects, &api.Object{
+			Key:      fmt.Sprintf("large_%v", i),
+			Point:    &api.Point{Lat: 39.7, Lon: -104.9},
+			Radius:   10,
+			Metadata: map[string]string{"payload": payload},
+		})
+	}
+	if _, err := geoDB.SetMany(ctx, &api.SetManyRequest{Objects: objects, Atomic: true}); err != nil {
+		t.Fatal(err.Error())
+	}
+	serve := func(max int) (api.GeoDBClient, func()) {
+		lis, err := net.Listen("tcp", "127.0.0.1:0")
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		grpcServer := grpc.NewServer(
+			grpc.UnaryInterceptor(server.MaxResponseSizeInterceptor(max)),
+			grpc.MaxSendMsgSize(max),
+		)
+		api.RegisterGeoDBServer(grpcServer, geoDB)
+		go grpcServer.Serve(lis)
+		conn, err := grpc.Dial(lis.Addr().String(), grpc.WithInsecure(), grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(max)))
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		return api.NewGeoDBClient(conn), func() {
+			conn.Close()
+			grpcServer.Stop()
+		}
+	}
+	client, stop := serve(config.Config.GetInt("GEODB_GRPC_MAX_SEND_MSG_SIZE"))
+	defer stop()
+	_, err := client.GetPrefix(ctx, &api.GetPrefixRequest{Prefix: "large_"})
+	if status.Code(err) != codes.ResourceExhausted {
+		t.Fatalf("expected the oversized response to be rejected, got: %v", err)
+	}
+	if !strings.Contains(status.Convert(err).Message(), "GetPrefix response is") {
+		t.Fatalf("expected the error to name the response, got: %s", status.Convert(err).Message())
+	}
+	// small responses are unaffected
+	if _, err := client.Get(ctx, &api.GetRequest{Keys: []string{"large_0"}}); err != nil {
+		t.Fatal(err.Error())
+	}
+	// raising the limits on both ends allows the full response
+	raised, stopRaised := serve(16 << 20)
+	defer stopRaised()
+	resp, err := raised.GetPrefix(ctx, &api.GetPrefixRequest{Prefix: "large_"})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(resp.Objects) != 2200 {
+		t.Fatalf("expected 2200 objects, got: %v", len(resp.Objects))
+	}
+}
+
+func TestSetMergeMetadata(t *testing.T) {
+	ctx := context.Background()
+	defer geoDB.Delete(ctx, &api.DeleteRequest{Keys: []string{"merge_truck"}})
+	truck := func(metadata map[string]string) *api.Object {
+		return &api.Object{Key: "merge_truck", Point: coorsField, Radius: 10, Metadata: metadata}
+	}
+	if _, err := geoDB.Set(ctx, &api.SetRequest{Object: truck(map[string]string{"driver": "alice", "status": "idle"})}); err != nil {
+		t.Fatal(err.Error())
+	}
+	resp, err := geoDB.Set(ctx, &api.SetRequest{Object: truck(map[string]string{"status": "en_route", "load": "full"}), MergeMetadata: true})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	expected := map[string]string{"driver": "alice", "status": "en_route", "load": "full"}
+	got := resp.Object.Object.Metadata
+	if len(got) != len(expected) {
+		t.Fatalf("expected metadata: %v, got: %v", expected, got)
+	}
+	for k, v := range expected {
+		if got[k] != v {
+			t.Fatalf("expected metadata: %v, got: %v", expected, got)
+		}
+	}
+	stored, err := geoDB.Get(ctx, &api.GetRequest{Keys: []string{"merge_truck"}})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if stored.Objects["merge_truck"].Object.Metadata["driver"] != "alice" {
+		t.Fatalf("expected the prior metadata to be stored, got: %v", stored.Objects["merge_truck"].Object.Metadata)
+	}
+	// without the flag, metadata is replaced
+	resp, err = geoDB.Set(ctx, &api.SetRequest{Object: truck(map[string]string{"load": "empty"})})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if got := resp.Object.Object.Metadata; len(got) != 1 || got["load"] != "empty" {
+		t.Fatalf("expected the metadata to be replaced, got: %v", got)
+	}
+}
+
+func TestCluster(t *testing.T) {
+	ctx := context.Background()
+	defer geoDB.DeletePrefix(ctx, &api.DeletePrefixRequest{Prefix: "cluster_"})
+	random := rand.New(rand.NewSource(313))
+	var objects []*api.Object
+	for i := 0; i < 500; i++ {
+		objects = append(objects, &api.Object{
+			Key:    fmt.Sprintf("cluster_%v", i),
+			Point:  &api.Point{Lat: 39 + random.Float64()*2, Lon: -106 + random.Float64()*2},
+			Radius: 10,
+			Tags:   []string{"cluster"},
+		})
+	}
+	if _, err := geoDB.SetMany(ctx, &api.SetManyRequest{Objects: objects}); err != nil {
+		t.Fatal(err.Error())
+	}
+	tags := &api.TagFilter{All: []string{"cluster"}}
+	for _, precision := range []int32{1, 3, 4, 5} {
+		resp, err := geoDB.Cluster(ctx, &api.ClusterRequest{Precision: precision, Tags: tags})
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		var sum int64
+		for i, cluster := range resp.Clusters {
+			sum += cluster.Count
+			if len(cluster.Geohash) != int(precision) || cluster.Count < 1 {
+				t.Fatalf("unexpected cluster: %s", helpers.PrettyJson(cluster))
+			}
+			if i > 0 && resp.Clusters[i-1].Geohash >= cluster.Geohash {
+				t.Fatalf("expected clusters ordered by geohash, got: %s after %s", cluster.Geohash, resp.Clusters[i-1].Geohash)
+			}
+			c := cluster.Cell
+			if !helpers.BoxContains(c.MinLat, c.MinLon, c.MaxLat, c.MaxLon, cluster.Centroid) {
+				t.Fatalf("expected the centroid to be within its cell: %s", helpers.PrettyJson(cluster))
+			}
+			if helpers.Geohash(cluster.Centroid, int(precision)) != cluster.Geohash {
+				t.Fatalf("expected the centroid to hash to its cell: %s", helpers.PrettyJson(cluster))
+			}
+		}
+		if sum != 500 || resp.Total != 500 {
+			t.Fatalf("expected the precision %v cluster counts to sum to 500, got: %v(total: %v)", precision, sum, resp.Total)
+		}
+		if precision == 1 && len(resp.Clusters) != 1 {
+			t.Fatalf("expected a single precision 1 cluster, got: %v", len(resp.Clusters))
+		}
+	}
+	// only the objects inside the viewport are clustered
+	bounds := &api.Box{MinLat: 39, MinLon: -106, MaxLat: 40, MaxLon: -105}
+	resp, err := geoDB.Cluster(ctx, &api.ClusterRequest{Precision: 4, Bounds: bounds, Tags: tags})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	var inside int64
+	for _, obj := range objects {
+		if helpers.BoxContains(bounds.MinLat, bounds.MinLon, bounds.MaxLat, bounds.MaxLon, obj.Point) {
+			inside++
+		}
+	}
+	if resp.Total != inside {
+		t.Fatalf("expected %v objects inside the viewport, got: %v", inside, resp.Total)
+	}
+	if _, err := geoDB.Cluster(ctx, &api.ClusterRequest{Precision: 0}); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected an invalid precision to be rejected, got: %v", err)
+	}
+}
+
+func TestSetWithoutPoint(t *testing.T) {
+	ctx := context.Background()
+	defer geoDB.Delete(ctx, &api.DeleteRequest{Keys: []string{"no_point_target", "no_point"}})
+	if _, err := geoDB.Set(ctx, &api.SetRequest{Object: &api.Object{Key: "no_point_target", Point: coorsField, Radius: 100}}); err != nil {
+		t.Fatal(err.Error())
+	}
+	noPoint := func() *api.Object {
+		return &api.Object{
+			Key:    "no_point",
+			Radius: 100,
+			Tracking: &api.ObjectTracking{
+				Trackers: []*api.ObjectTracker{{TargetObjectKey: "no_point_target"}},
+			},
+		}
+	}
+	if _, err := geoDB.Set(ctx, &api.SetRequest{Object: noPoint()}); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected a set without a point to be rejected, got: %v", err)
+	}
+	if _, err := geoDB.SetMany(ctx, &api.SetManyRequest{Objects: []*api.Object{noPoint()}}); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected a set many without a point to be rejected, got: %v", err)
+	}
+	// the store validates objects written without the service's request validation
+	store := newMemStore(t, nil)
+	if _, err := store.Set(ctx, &api.Object{Key: "no_point_target", Point: coorsField, Radius: 100}); err != nil {
+		t.Fatal(err.Error())
+	}
+	if _, err := store.Set(ctx, noPoint()); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected the store to reject an object without a point, got: %v", err)
+	}
+	if _, err := store.DryRun(ctx, noPoint(), 0); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected a dry run without a point to be rejected, got: %v", err)
+	}
+	exists, err := store.Exists(ctx, []string{"no_point"})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if exists["no_point"] {
+		t.Fatal("expected the object not to be written")
+	}
+}
+
+func TestGetEvents(t *testing.T) {
+	now := time.Unix(1600000000, 0)
+	store := newMemStore(t, nil, db.WithEventLog(0), db.WithClock(func() time.Time {
+		return now
+	}))
+	ctx := context.Background()
+	if _, err := store.SetMany(ctx, []*api.Object{
+		{Key: "events_depot", Point: coorsField, Radius: 100},
+		{Key: "events_hospital", Point: saintJosephHospital, Radius: 100},
+	}, false, true); err != nil {
+		t.Fatal(err.Error())
+	}
+	move := func(key string, point *api.Point) *api.ObjectDetail {
+		now = now.Add(time.Minute)
+		detail, err := store.Set(ctx, &api.Object{
+			Key:    key,
+			Point:  point,
+			Radius: 100,
+			Tracking: &api.ObjectTracking{
+				Trackers: []*api.ObjectTracker{{TargetObjectKey: "events_depot"}, {TargetObjectKey: "events_hospital"}},
+			},
+		})
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		return detail
+	}
+	first := move("events_truck", pepsiCenter)
+	second := move("events_truck", coorsField)
+	third := move("events_van", saintJosephHospital)
+	all, err := store.GetEvents(ctx, time.Time{}, time.Time{}, "", 0)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(all) != 6 {
+		t.Fatalf("expected 6 events, got: %v", len(all))
+	}
+	for i := 1; i < len(all); i++ {
+		if all[i].Event.TimestampNanos < all[i-1].Event.TimestampNanos {
+			t.Fatal("expected events oldest first")
+		}
+	}
+	// the second move is the only one in [second, third)
+	ranged, err := store.GetEvents(ctx, time.Unix(0, second.TrackerEvents[0].TimestampNanos), time.Unix(0, third.TrackerEvents[0].TimestampNanos), "", 0)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(ranged) != 2 {
+		t.Fatalf("expected 2 events in range, got: %v", len(ranged))
+	}
+	for _, event := range ranged {
+		if event.Key != "events_truck" || event.Event.TimestampNanos != second.TrackerEvents[0].TimestampNanos {
+			t.Fatalf("unexpected event in range: %s", helpers.PrettyJson(event))
+		}
+		if event.Event.Object.Key == "events_depot" && event.Event.EventType != api.EventType_Enter {
+			t.Fatalf("expected the truck to enter the depot, got: %v", event.Event.EventType)
+		}
+	}
+	// events are matched by the tracking object or the target
+	truck, err := store.GetEvents(ctx, time.Time{}, time.Time{}, "events_truck", 0)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(truck) != 4 || truck[0].Event.TimestampNanos != first.TrackerEvents[0].TimestampNanos {
+		t.Fatalf("expected the truck's 4 events, got: %v", len(truck))
+	}
+	hospital, err := store.GetEvents(ctx, time.Unix(0, second.TrackerEvents[0].TimestampNanos), time.Time{}, "events_hospital", 1)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(hospital) != 1 || hospital[0].Key != "events_truck" || hospital[0].Event.Object.Key != "events_hospital" {
+		t.Fatalf("expected the truck's second hospital event, got: %v", hospital)
+	}
+	if _, err := store.GetEvents(ctx, now, now, "", 0); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected an empty range to be rejected, got: %v", err)
+	}
+	if _, err := geoDB.GetEvents(ctx, &api.GetEventsRequest{}); status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("expected the event log to be disabled by default, got: %v", err)
+	}
+}
+
+func TestTriggerRoles(t *testing.T) {
+	pairs, err := db.ParseRolePairs("vehicle:zone, *:no_fly_zone")
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(pairs) != 2 || pairs[1].Tracking != db.AnyRole || pairs[1].Target != "no_fly_zone" {
+		t.Fatalf("unexpected role pairs: %v", pairs)
+	}
+	if _, err := db.ParseRolePairs("vehicle:zone,zone"); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected an invalid pair to be rejected, got: %v", err)
+	}
+	store := newMemStore(t, nil, db.WithTriggerRoles(pairs...))
+	ctx := context.Background()
+	tracking := func(targets ...string) *api.ObjectTracking {
+		tracking := &api.ObjectTracking{}
+		for _, target := range targets {
+			tracking.Trackers = append(tracking.Trackers, &api.ObjectTracker{TargetObjectKey: target})
+		}
+		return tracking
+	}
+	if _, err := store.SetMany(ctx, []*api.Object{
+		{Key: "stadium_zone", Point: coorsField, Radius: 1000, Role: "zone"},
+		{Key: "airspace", Point: coorsField, Radius: 1000, Role: "no_fly_zone"},
+		{Key: "parked_truck", Point: coorsField, Radius: 10, Role: "vehicle"},
+	}, false, true); err != nil {
+		t.Fatal(err.Error())
+	}
+	targets := func(detail *api.ObjectDetail) []string {
+		var keys []string
+		for _, event := range detail.TrackerEvents {
+			keys = append(keys, event.Object.Key)
+		}
+		sort.Strings(keys)
+		return keys
+	}
+	// zones detecting each other produce no events
+	zone, err := store.Set(ctx, &api.Object{Key: "parking_zone", Point: coorsField, Radius: 1000, Role: "zone", Tracking: tracking("stadium_zone", "parked_truck")})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(zone.TrackerEvents) != 0 {
+		t.Fatalf("expected zone -> zone & zone -> vehicle pairs not to produce events, got: %v", targets(zone))
+	}
+	// a vehicle entering a zone does, but not a vehicle near another vehicle
+	truck, err := store.Set(ctx, &api.Object{Key: "truck", Point: coorsField, Radius: 10, Role: "vehicle", Tracking: tracking("stadium_zone", "parked_truck", "airspace")})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if got := targets(truck); len(got) != 2 || got[0] != "airspace" || got[1] != "stadium_zone" {
+		t.Fatalf("expected vehicle -> zone & * -> no_fly_zone events, got: %v", got)
+	}
+	if truck.TrackerEvents[0].EventType != api.EventType_Enter {
+		t.Fatalf("expected the truck to enter, got: %v", truck.TrackerEvents[0].EventType)
+	}
+	// objects without a role only match *
+	drone, err := store.Set(ctx, &api.Object{Key: "drone", Point: coorsField, Radius: 10, Tracking: tracking("stadium_zone", "airspace")})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if got := targets(drone); len(got) != 1 || got[0] != "airspace" {
+		t.Fatalf("expected only the * -> no_fly_zone event, got: %v", got)
+	}
+	details, _, err := store.BulkUpdatePositions(ctx, []*api.PositionUpdate{
+		{Key: "parking_zone", Point: pepsiCenter},
+		{Key: "truck", Point: pepsiCenter},
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	for _, detail := range details {
+		switch detail.Object.Key {
+		case "parking_zone":
+			if len(detail.TrackerEvents) != 0 {
+				t.Fatalf("expected the bulk zone update not to produce events, got: %v", targets(detail))
+			}
+		case "truck":
+			if len(detail.TrackerEvents) != 2 || detail.TrackerEvents[0].EventType != api.EventType_Exit {
+				t.Fatalf("expected the truck to exit 2 zones, got: %v", targets(detail))
+			}
+		}
+	}
+}
+
+func TestWatchKey(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	defer geoDB.Delete(context.Background(), &api.DeleteRequest{Keys: []string{"watched", "watched_2", "watche"}})
+	defer geoDB.Delete(context.Background(), &api.DeleteRequest{Keys: []string{"watched"}, Namespace: "watch_ns"})
+	ss := &mockWatchKeyServer{ctx: ctx, sent: make(chan *api.ObjectDetail, 10)}
+	done := make(chan error, 1)
+	go func() {
+		done <- geoDB.WatchKey(&api.WatchKeyRequest{ClientId: "watch_key", Key: "watched"}, ss)
+	}()
+	waitFor(t, "watch client to connect", func() bool {
+		return streamHub.GetClientObjectStream("watch_key") != nil
+	})
+	// keys sharing a prefix & the same key in another namespace aren't delivered
+	for _, req := range []*api.SetRequest{
+		{Object: &api.Object{Key: "watched_2", Point: coorsField, Radius: 10}},
+		{Object: &api.Object{Key: "watche", Point: coorsField, Radius: 10}},
+		{Object: &api.Object{Key: "watched", Point: coorsField, Radius: 10}, Namespace: "watch_ns"},
+		{Object: &api.Object{Key: "watched", Point: pepsiCenter, Radius: 10}},
+	} {
+		if _, err := geoDB.Set(ctx, req); err != nil {
+			t.Fatal(err.Error())
+		}
+	}
+	select {
+	case detail := <-ss.sent:
+		if detail.Object.Key != "watched" || detail.Object.Point.Lat != pepsiCenter.Lat {
+			t.Fatalf("expected the watched object's update, got: %s", helpers.PrettyJson(detail))
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("timeout waiting for the watched object's update")
+	}
+	select {
+	case detail := <-ss.sent:
+		t.Fatalf("expected only the watched key to be delivered, got: %s", detail.Object.Key)
+	case <-time.After(100 * time.Millisecond):
+	}
+	cancel()
+	if err := <-done; err != nil {
+		t.Fatal(err.Error())
+	}
+	if err := geoDB.WatchKey(&api.WatchKeyRequest{}, ss); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected a missing key to be rejected, got: %v", err)
+	}
+}
+
+func TestSortedResponses(t *testing.T) {
+	ctx := context.Background()
+	defer geoDB.DeletePrefix(ctx, &api.DeletePrefixRequest{Prefix: "sorted_"})
+	if _, err := geoDB.SetMany(ctx, &api.SetManyRequest{
+		Objects: []*api.Object{
+			{Key: "sorted_b", Point: coorsField, Radius: 10, UpdatedUnix: 1600000300},
+			{Key: "sorted_a", Point: saintJosephHospital, Radius: 10, UpdatedUnix: 1600000100},
+			{Key: "sorted_c", Point: pepsiCenter, Radius: 10, UpdatedUnix: 1600000200},
+			{Key: "sorted_d", Point: pepsiCenter, Radius: 10, UpdatedUnix: 1600000200},
+		},
+	}); err != nil {
+		t.Fatal(err.Error())
+	}
+	keysOf := func(ordered []*api.ObjectDetail) string {
+		var keys []string
+		for _, detail := range ordered {
+			keys = append(keys, detail.Object.Key)
+		}
+		return strings.Join(keys, ",")
+	}
+	// the pepsi center is ~1.4km from coors field & the hospital is ~2.2km away. sorted_c & sorted_d tie on distance &
+	// updated time, so they're ordered by key
+	for _, tc := range []struct {
+		sort     *api.Sort
+		expected string
+	}{
+		{&api.Sort{By: api.SortBy_SortKey, Ascending: true}, "sorted_a,sorted_b,sorted_c,sorted_d"},
+		{&api.Sort{By: api.SortBy_SortKey}, "sorted_d,sorted_c,sorted_b,sorted_a"},
+		{&api.Sort{By: api.SortBy_SortUpdated, Ascending: true}, "sorted_a,sorted_c,sorted_d,sorted_b"},
+		{&api.Sort{By: api.SortBy_SortUpdated}, "sorted_b,sorted_c,sorted_d,sorted_a"},
+		{&api.Sort{By: api.SortBy_SortDistance, Ascending: true, Anchor: coorsField}, "sorted_b,sorted_c,sorted_d,sorted_a"},
+		{&api.Sort{By: api.SortBy_SortDistance, Anchor: coorsField}, "sorted_a,sorted_c,sorted_d,sorted_b"},
+	} {
+		get, err := geoDB.Get(ctx, &api.GetRequest{Keys: []string{"sorted_a", "sorted_b", "sorted_c", "sorted_d"}, Sort: tc.sort})
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		if got := keysOf(get.Ordered); got != tc.expected {
+			t.Fatalf("expected Get sorted by %v(ascending: %v) to be %s, got: %s", tc.sort.By, tc.sort.Ascending, tc.expected, got)
+		}
+		regex, err := geoDB.GetRegex(ctx, &api.GetRegexRequest{Regex: "^sorted_", Sort: tc.sort})
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		if got := keysOf(regex.Ordered); got != tc.expected {
+			t.Fatalf("expected GetRegex sorted by %v(ascending: %v) to be %s, got: %s", tc.sort.By, tc.sort.Ascending, tc.expected, got)
+		}
+		prefix, err := geoDB.GetPrefix(ctx, &api.GetPrefixRequest{Prefix: "sorted_", Sort: tc.sort})
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		if got := keysOf(prefix.Ordered); got != tc.expected {
+			t.Fatalf("expected GetPrefix sorted by %v(ascending: %v) to be %s, got: %s", tc.sort.By, tc.sort.Ascending, tc.expected, got)
+		}
+		if len(prefix.Objects) != 4 {
+			t.Fatalf("expected the objects map to be returned alongside the sorted list, got: %v", len(prefix.Objects))
+		}
+	}
+	unsorted, err := geoDB.GetPrefix(ctx, &api.GetPrefixRequest{Prefix: "sorted_"})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(unsorted.Ordered) != 0 {
+		t.Fatalf("expected no sorted list without a sort, got: %v", len(unsorted.Ordered))
+	}
+	if _, err := geoDB.Get(ctx, &api.GetRequest{Keys: []string{"sorted_a"}, Sort: &api.Sort{By: api.SortBy_SortDistance}}); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected a distance sort without an anchor to be rejected, got: %v", err)
+	}
+}
+
+func TestStats(t *testing.T) {
+	ctx := context.Background()
+	before, err := geoDB.Stats(ctx, &api.StatsRequest{})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if before.LsmSize < 0 || before.VlogSize < 0 || before.EstimatedKeys < 0 {
+		t.Fatalf("unexpected stats: %s", helpers.PrettyJson(before))
+	}
+	defer geoDB.DeletePrefix(ctx, &api.DeletePrefixRequest{Prefix: "stats_"})
+	var objects []*api.Object
+	for i := 0; i < 100; i++ {
+		objects = append(objects, &api.Object{
+			Key:      fmt.Sprintf("stats_%v", i),
+			Point:    coorsField,
+			Radius:   10,
+			Metadata: map[string]string{"padding": strings.Repeat("x", 1024)},
+		})
+	}
+	if _, err := geoDB.SetMany(ctx, &api.SetManyRequest{Objects: objects}); err != nil {
+		t.Fatal(err.Error())
+	}
+	after, err := geoDB.Stats(ctx, &api.StatsRequest{})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	// writes are appended to the value log immediately, while the LSM tree grows as memtables are flushed
+	if after.VlogSize <= before.VlogSize {
+		t.Fatalf("expected the value log to grow from %v bytes, got: %v", before.VlogSize, after.VlogSize)
+	}
+	if after.LsmSize < before.LsmSize || after.EstimatedKeys < 0 {
+		t.Fatalf("unexpected stats after writes: %s", helpers.PrettyJson(after))
+	}
+	// the estimate counts keys in flushed tables
+	store := newMemStore(t, func(opts badger.Options) badger.Options {
+		return opts.WithMaxTableSize(1 << 16)
+	})
+	if _, err := store.SetMany(ctx, objects, false, false); err != nil {
+		t.Fatal(err.Error())
+	}
+	waitFor(t, "memtables to be flushed", func() bool {
+		_, _, keys := store.Stats(ctx)
+		return keys > 0
+	})
+}
+
+func TestStatsValuePath(t *testing.T) {
+	dir, err := ioutil.TempDir("", "geodb_values")
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer os.RemoveAll(dir)
+	if err := ioutil.WriteFile(filepath.Join(dir, "000001.vlog"), make([]byte, 100), 0600); err != nil {
+		t.Fatal(err.Error())
+	}
+	config.Config.Set("GEODB_VALUE_PATH", dir)
+	defer config.Config.Set("GEODB_VALUE_PATH", "")
+	resp, err := geoDB.Stats(context.Background(), &api.StatsRequest{})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if resp.VlogSize != 100 {
+		t.Fatalf("expected the value log to be measured under GEODB_VALUE_PATH, got: %v", resp.VlogSize)
+	}
+	config.Config.Set("GEODB_IN_MEMORY", true)
+	defer config.Config.Set("GEODB_IN_MEMORY", false)
+	resp, err = geoDB.Stats(context.Background(), &api.StatsRequest{})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if resp.VlogSize != 0 {
+		t.Fatalf("expected in memory databases not to report a value log, got: %v", resp.VlogSize)
+	}
+}
+
+func TestCompositeKeys(t *testing.T) {
+	ctx := context.Background()
+	keys := []helpers.CompositeKey{
+		{"acme", "gate/1", "temp"},
+		{"acme", "gate", "temp"},
+		{"acme/west", "gate", "temp"},
+		{"acme2", "gate", "temp"},
+		{`acme\`, "gate", "temp"},
+	}
+	var objects []*api.Object
+	for _, key := range keys {
+		objects = append(objects, &api.Object{Key: key.String(), Point: coorsField, Radius: 10})
+		defer geoDB.Delete(ctx, &api.DeleteRequest{Keys: []string{key.String()}})
+	}
+	if _, err := geoDB.SetMany(ctx, &api.SetManyRequest{Objects: objects}); err != nil {
+		t.Fatal(err.Error())
+	}
+	prefixed := func(prefix helpers.CompositeKey) []string {
+		resp, err := geoDB.GetPrefixKeys(ctx, &api.GetPrefixKeysRequest{Prefix: prefix.Prefix()})
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		return resp.Keys
+	}
+	// the tenant's devices, but not the tenants whose names start with or contain acme/
+	tenant := prefixed(helpers.CompositeKey{"acme"})
+	if len(tenant) != 2 || tenant[0] != keys[1].String() || tenant[1] != keys[0].String() {
+		t.Fatalf("expected the acme tenant's 2 keys, got: %v", tenant)
+	}
+	if device := prefixed(helpers.CompositeKey{"acme", "gate/1"}); len(device) != 1 || device[0] != keys[0].String() {
+		t.Fatalf("expected the gate/1 device's key, got: %v", device)
+	}
+	if device := prefixed(helpers.CompositeKey{"acme", "gate"}); len(device) != 1 || device[0] != keys[1].String() {
+		t.Fatalf("expected the gate device's key, got: %v", device)
+	}
+	got, err := geoDB.Get(ctx, &api.GetRequest{Keys: []string{keys[2].String()}})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	parts, err := helpers.ParseCompositeKey(got.Objects[keys[2].String()].Object.Key)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(parts) != 3 || parts[0] != "acme/west" {
+		t.Fatalf("expected the stored key to decode to its components, got: %q", parts)
+	}
+}
+
+func TestMaxResults(t *testing.T) {
+	ctx := context.Background()
+	config.Config.Set("GEODB_MAX_RESULTS", 3)
+	defer config.Config.Set("GEODB_MAX_RESULTS", 10000)
+	config.Config.Set("GEODB_MAX_RESULTS_CEILING", 4)
+	defer config.Config.Set("GEODB_MAX_RESULTS_CEILING", 100000)
+	var objects []*api.Object
+	for i := 0; i < 5; i++ {
+		key := fmt.Sprintf("maxresults_%v", i)
+		objects = append(objects, &api.Object{Key: key, Point: coorsField, Radius: 10})
+		defer geoDB.Delete(ctx, &api.DeleteRequest{Keys: []string{key}})
+	}
+	if _, err := geoDB.SetMany(ctx, &api.SetManyRequest{Objects: objects}); err != nil {
+		t.Fatal(err.Error())
+	}
+	// without a limit, the response stops at the server's max results
+	resp, err := geoDB.GetRegex(ctx, &api.GetRegexRequest{Regex: "^maxresults_"})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(resp.Objects) != 3 || !resp.Truncated || resp.NextCursor != "maxresults_2" {
+		t.Fatalf("expected 3 objects & a truncated response, got: %v objects truncated: %v cursor: %s", len(resp.Objects), resp.Truncated, resp.NextCursor)
+	}
+	rest, err := geoDB.GetRegex(ctx, &api.GetRegexRequest{Regex: "^maxresults_", Cursor: resp.NextCursor})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(rest.Objects) != 2 || rest.Truncated || rest.NextCursor != "" {
+		t.Fatalf("expected the remaining 2 objects, got: %v objects truncated: %v cursor: %s", len(rest.Objects), rest.Truncated, rest.NextCursor)
+	}
+	// callers may raise the limit up to the ceiling
+	resp, err = geoDB.GetRegex(ctx, &api.GetRegexRequest{Regex: "^maxresults_", Limit: 4})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(resp.Objects) != 4 || resp.Truncated {
+		t.Fatalf("expected 4 objects without truncation, got: %v objects truncated: %v", len(resp.Objects), resp.Truncated)
+	}
+	if _, err := geoDB.GetRegex(ctx, &api.GetRegexRequest{Regex: "^maxresults_", Limit: 5}); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected a limit over the ceiling to be rejected, got: %v", err)
+	}
+	// prefix queries can't be resumed, so they fail rather than truncate
+	if _, err := geoDB.GetPrefix(ctx, &api.GetPrefixRequest{Prefix: "maxresults_"}); status.Code(err) != codes.ResourceExhausted {
+		t.Fatalf("expected a prefix query over the max results to be rejected, got: %v", err)
+	}
+	if resp, err := geoDB.GetPrefix(ctx, &api.GetPrefixRequest{Prefix: "maxresults_1"}); err != nil || len(resp.Objects) != 1 {
+		t.Fatalf("expected a prefix query under the max results to succeed, got: %v", err)
+	}
+}
+
+func TestGeofences(t *testing.T) {
+	ctx := context.Background()
+	fence := &api.Geofence{Name: "coors_field", Center: coorsField, Radius: 500, Metadata: map[string]string{"type": "stadium"}}
+	if _, err := geoDB.CreateGeofence(ctx, &api.CreateGeofenceRequest{Geofence: fence}); err != nil {
+		t.Fatal(err.Error())
+	}
+	defer geoDB.DeleteGeofence(ctx, &api.DeleteGeofenceRequest{Name: fence.Name})
+	if _, err := geoDB.CreateGeofence(ctx, &api.CreateGeofenceRequest{Geofence: fence}); status.Code(err) != codes.AlreadyExists {
+		t.Fatalf("expected a duplicate geofence to be rejected, got: %v", err)
+	}
+	if _, err := geoDB.CreateGeofence(ctx, &api.CreateGeofenceRequest{Geofence: &api.Geofence{Name: "shapeless"}}); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected a geofence without a geometry to be rejected, got: %v", err)
+	}
+	list, err := geoDB.ListGeofences(ctx, &api.ListGeofencesRequest{})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(list.Geofences) != 1 || list.Geofences[0].Name != fence.Name || list.Geofences[0].Metadata["type"] != "stadium" {
+		t.Fatalf("expected the registered geofence, got: %v", list.Geofences)
+	}
+	defer geoDB.Delete(ctx, &api.DeleteRequest{Keys: []string{"fan"}})
+	move := func(point *api.Point) []*api.GeofenceEvent {
+		resp, err := geoDB.Set(ctx, &api.SetRequest{Object: &api.Object{Key: "fan", Point: point, Radius: 10}})
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		return resp.Object.GeofenceEvents
+	}
+	expect := func(events []*api.GeofenceEvent, eventType api.EventType) {
+		t.Helper()
+		if len(events) != 1 || events[0].Fence != fence.Name || events[0].EventType != eventType {
+			t.Fatalf("expected a %s event for %s, got: %v", eventType, fence.Name, events)
+		}
+	}
+	// the fan never was near the stadium, so there's nothing to report
+	if events := move(saintJosephHospital); len(events) != 0 {
+		t.Fatalf("expected no geofence events outside of the geofence, got: %v", events)
+	}
+	expect(move(coorsField), api.EventType_Enter)
+	expect(move(coorsField), api.EventType_Inside)
+	exit := move(pepsiCenter)
+	expect(exit, api.EventType_Exit)
+	if exit[0].Inside || exit[0].Distance < 1000 {
+		t.Fatalf("expected the exit event to be outside & over 1km from the center, got: %v", exit[0])
+	}
+	if events := move(pepsiCenter); len(events) != 0 {
+		t.Fatalf("expected no geofence events after leaving the geofence, got: %v", events)
+	}
+	// bulk position updates are checked against geofences too
+	bulk, err := geoDB.BulkUpdatePositions(ctx, &api.BulkUpdatePositionsRequest{
+		Updates: []*api.PositionUpdate{{Key: "fan", Point: coorsField}},
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	expect(bulk.Objects[0].GeofenceEvents, api.EventType_Enter)
+	if _, err := geoDB.DeleteGeofence(ctx, &api.DeleteGeofenceRequest{Name: fence.Name}); err != nil {
+		t.Fatal(err.Error())
+	}
+	if _, err := geoDB.DeleteGeofence(ctx, &api.DeleteGeofenceRequest{Name: fence.Name}); status.Code(err) != codes.NotFound {
+		t.Fatalf("expected deleting a missing geofence to fail, got: %v", err)
+	}
+	if events := move(pepsiCenter); len(events) != 0 {
+		t.Fatalf("expected no geofence events for a deleted geofence, got: %v", events)
+	}
+}
+
+func TestDistanceToPolyline(t *testing.T) {
+	ctx := context.Background()
+	// a route from saint joseph hospital past coors field to the pepsi center
+	route := []*api.Point{saintJosephHospital, coorsField, pepsiCenter}
+	midpoint := &api.Point{Lat: (coorsField.Lat + pepsiCenter.Lat) / 2, Lon: (coorsField.Lon + pepsiCenter.Lon) / 2}
+	// a point just off the middle of the second segment
+	offRoute := &api.Point{Lat: midpoint.Lat + 0.001, Lon: midpoint.Lon + 0.001}
+	resp, err := geoDB.DistanceToPolyline(ctx, &api.PolylineRequest{Route: route, Point: offRoute})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if resp.Segment != 1 {
+		t.Fatalf("expected the coors field -> pepsi center segment, got: %v", resp.Segment)
+	}
+	if resp.Distance >= helpers.Distance(offRoute, coorsField) || resp.Distance >= helpers.Distance(offRoute, pepsiCenter) {
+		t.Fatalf("expected the distance to the segment to be less than to its vertices, got: %v", resp.Distance)
+	}
+	if resp.Distance > 150 || helpers.Distance(resp.Nearest, midpoint) > 150 {
+		t.Fatalf("expected the nearest point to be close to the segment's midpoint, got: %v %vm", resp.Nearest, resp.Distance)
+	}
+	miles, err := geoDB.DistanceToPolyline(ctx, &api.PolylineRequest{Route: route, Point: offRoute, Unit: api.DistanceUnit_Miles})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if math.Abs(miles.Distance-helpers.FromMeters(resp.Distance, api.DistanceUnit_Miles)) > 1e-9 {
+		t.Fatalf("expected the distance in miles, got: %v", miles.Distance)
+	}
+	if _, err := geoDB.DistanceToPolyline(ctx, &api.PolylineRequest{Route: route[:1], Point: offRoute}); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected a route of a single point to be rejected, got: %v", err)
+	}
+}
+
+func TestReadSessions(t *testing.T) {
+	ctx := context.Background()
+	const ns = "read_sessions"
+	defer geoDB.Delete(ctx, &api.DeleteRequest{Namespace: ns, Keys: []string{"*"}})
+	set := func(key, status string) {
+		if _, err := geoDB.Set(ctx, &api.SetRequest{Namespace: ns, Object: &api.Object{Key: key, Point: coorsField, Radius: 10, Metadata: map[string]string{"status": status}}}); err != nil {
+			t.Fatal(err.Error())
+		}
+	}
+	set("export_a", "before")
+	session, err := geoDB.OpenReadSession(ctx, &api.OpenReadSessionRequest{})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	// writes during the session
+	set("export_a", "after")
+	set("export_b", "after")
+	got, err := geoDB.Get(ctx, &api.GetRequest{Namespace: ns, Keys: []string{"export_a", "export_b"}, ReadSession: session.Token})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if got.Objects["export_a"].GetObject().GetMetadata()["status"] != "before" || len(got.NotFound) != 1 || got.NotFound[0] != "export_b" {
+		t.Fatalf("expected the session to read the snapshot from before the writes, got: %v not found: %v", got.Objects, got.NotFound)
+	}
+	prefix, err := geoDB.GetPrefix(ctx, &api.GetPrefixRequest{Namespace: ns, Prefix: "export_", ReadSession: session.Token})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	regex, err := geoDB.GetRegex(ctx, &api.GetRegexRequest{Namespace: ns, Regex: "^export_", ReadSession: session.Token})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	keys, err := geoDB.GetKeys(ctx, &api.GetKeysRequest{Namespace: ns, ReadSession: session.Token})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(prefix.Objects) != 1 || len(regex.Objects) != 1 || len(keys.Keys) != 1 || keys.Keys[0] != "export_a" {
+		t.Fatalf("expected every read in the session to only see export_a, got: %v %v %v", prefix.Objects, regex.Objects, keys.Keys)
+	}
+	// reads without the session see the writes
+	latest, err := geoDB.GetKeys(ctx, &api.GetKeysRequest{Namespace: ns})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(latest.Keys) != 2 {
+		t.Fatalf("expected reads outside of the session to see both objects, got: %v", latest.Keys)
+	}
+	if _, err := geoDB.CloseReadSession(ctx, &api.CloseReadSessionRequest{Token: session.Token}); err != nil {
+		t.Fatal(err.Error())
+	}
+	if _, err := geoDB.GetKeys(ctx, &api.GetKeysRequest{Namespace: ns, ReadSession: session.Token}); status.Code(err) != codes.NotFound {
+		t.Fatalf("expected reads with a closed session to fail, got: %v", err)
+	}
+	// sessions expire after their ttl without a read
+	expiring, err := geoDB.OpenReadSession(ctx, &api.OpenReadSessionRequest{TtlSeconds: 1})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	// every read restarts the countdown, so the session is left idle
+	time.Sleep(1500 * time.Millisecond)
+	if _, err := geoDB.GetKeys(ctx, &api.GetKeysRequest{Namespace: ns, ReadSession: expiring.Token}); status.Code(err) != codes.NotFound {
+		t.Fatalf("expected reads with an expired session to fail, got: %v", err)
+	}
+}
+
+func TestMaxReadSessions(t *testing.T) {
+	ctx := context.Background()
+	store := newMemStore(t, nil, db.WithMaxReadSessions(1))
+	token, err := store.OpenReadSession(ctx, time.Minute)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if _, err := store.OpenReadSession(ctx, time.Minute); status.Code(err) != codes.ResourceExhausted {
+		t.Fatalf("expected a session over the max to be rejected, got: %v", err)
+	}
+	store.CloseReadSession(ctx, token)
+	token, err = store.OpenReadSession(ctx, time.Minute)
+	if err != nil {
+		t.Fatalf("expected closing a session to make room for another, got: %v", err)
+	}
+	store.CloseReadSession(ctx, token)
+}
+
+func TestTimeWindow(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now().Unix()
+	updated := map[string]int64{
+		"window_old":    now - 3600,
+		"window_recent": now - 60,
+		"window_stale":  now - 86400,
+	}
+	var keys []string
+	for key, unix := range updated {
+		keys = append(keys, key)
+		if _, err := geoDB.Set(ctx, &api.SetRequest{Object: &api.Object{Key: key, Point: coorsField, Radius: 10, UpdatedUnix: unix}}); err != nil {
+			t.Fatal(err.Error())
+		}
+	}
+	defer geoDB.Delete(ctx, &api.DeleteRequest{Keys: keys})
+	active := &api.TimeWindow{UpdatedAfterUnix: now - 600}
+	got, err := geoDB.Get(ctx, &api.GetRequest{Keys: keys, Window: active})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(got.Objects) != 1 || got.Objects["window_recent"] == nil {
+		t.Fatalf("expected only the recently updated object, got: %v", got.Objects)
+	}
+	// stale objects are skipped during iteration, so they don't use up the limit
+	regex, err := geoDB.GetRegex(ctx, &api.GetRegexRequest{Regex: "^window_", Limit: 1, Window: active})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(regex.Objects) != 1 || regex.Objects["window_recent"] == nil || regex.NextCursor != "" {
+		t.Fatalf("expected only the recently updated object without a next page, got: %v cursor: %s", regex.Objects, regex.NextCursor)
+	}
+	prefix, err := geoDB.GetPrefix(ctx, &api.GetPrefixRequest{Prefix: "window_", Window: &api.TimeWindow{UpdatedAfterUnix: now - 7200, UpdatedBeforeUnix: now - 600}})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(prefix.Objects) != 1 || prefix.Objects["window_old"] == nil {
+		t.Fatalf("expected only the object updated within the window, got: %v", prefix.Objects)
+	}
+	if _, err := geoDB.GetPrefix(ctx, &api.GetPrefixRequest{Prefix: "window_", Window: &api.TimeWindow{UpdatedAfterUnix: now, UpdatedBeforeUnix: now - 600}}); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected an empty window to be rejected, got: %v", err)
+	}
+}
+
+func TestIncrement(t *testing.T) {
+	ctx := context.Background()
+	// counters are server assigned, so setting them is ignored
+	if _, err := geoDB.Set(ctx, &api.SetRequest{Object: &api.Object{Key: "counted_truck", Point: coorsField, Radius: 10, Counters: map[string]int64{"trips": 999}}}); err != nil {
+		t.Fatal(err.Error())
+	}
+	defer geoDB.Delete(ctx, &api.DeleteRequest{Keys: []string{"counted_truck"}})
+	const workers, increments = 20, 10
+	wg := &sync.WaitGroup{}
+	errs := make(chan error, workers*increments)
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < increments; j++ {
+				if _, err := geoDB.Increment(ctx, &api.IncrementRequest{Key: "counted_truck", Counter: "trips", Delta: 1}); err != nil {
+					errs <- err
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		t.Fatal(err.Error())
+	}
+	resp, err := geoDB.Increment(ctx, &api.IncrementRequest{Key: "counted_truck", Counter: "trips", Delta: -1})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if want := int64(workers*increments - 1); resp.Value != want || resp.Object.Object.Counters["trips"] != want {
+		t.Fatalf("expected every concurrent increment to land(%v), got: %v", want, resp.Value)
+	}
+	// later writes keep the counters
+	set, err := geoDB.Set(ctx, &api.SetRequest{Object: &api.Object{Key: "counted_truck", Point: pepsiCenter, Radius: 10}})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if set.Object.Object.Counters["trips"] != resp.Value {
+		t.Fatalf("expected the counter to survive a write, got: %v", set.Object.Object.Counters)
+	}
+	if _, err := geoDB.Increment(ctx, &api.IncrementRequest{Key: "uncounted", Counter: "trips", Delta: 1}); status.Code(err) != codes.NotFound {
+		t.Fatalf("expected incrementing a missing object to fail, got: %v", err)
+	}
+}
+
+func TestDeleteExpired(t *testing.T) {
+	// the store's clock runs ahead of badger's, so badger still returns objects the store considers expired
+	now := time.Now()
+	store := newMemStore(t, nil, db.WithClock(func() time.Time {
+		return now
+	}))
+	ctx := context.Background()
+	if _, err := store.SetMany(ctx, []*api.Object{
+		{Key: "expire_leased", Point: coorsField, Radius: 100, TtlSeconds: 60, Tags: []string{"expire"}, KeepHistory: true},
+		{Key: "expire_lapsed", Point: pepsiCenter, Radius: 100, ExpiresUnix: time.Now().Add(-time.Minute).Unix()},
+		{Key: "expire_kept", Point: saintJosephHospital, Radius: 100, Tags: []string{"expire"}},
+	}, false, true); err != nil {
+		t.Fatal(err.Error())
+	}
+	keys, _ := store.GetKeys(ctx, "expire_", "", "", 0, false)
+	if len(keys) != 2 {
+		t.Fatalf("expected the unexpired keys, got: %v", keys)
+	}
+	now = now.Add(2 * time.Minute)
+	keys, _ = store.GetKeys(ctx, "expire_", "", "", 0, false)
+	if len(keys) != 1 || keys[0] != "expire_kept" {
+		t.Fatalf("expected expired keys to be skipped, got: %v", keys)
+	}
+	objs, _, err := store.GetRegex(ctx, "", "", "^expire_", "", 0, nil, nil)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(objs) != 1 || objs["expire_kept"] == nil {
+		t.Fatalf("expected expired objects to be skipped, got: %v", objs)
+	}
+	tagged, err := store.GetTagged(ctx, &api.TagFilter{Any: []string{"expire"}})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(tagged) != 1 || tagged["expire_kept"] == nil {
+		t.Fatalf("expected expired objects to be skipped, got: %v", tagged)
+	}
+	deleted, err := store.DeleteExpired(ctx)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if deleted != 2 {
+		t.Fatalf("expected the leased & lapsed objects to be deleted, got: %v", deleted)
+	}
+	history, err := store.GetHistory(ctx, "expire_leased", 0)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(history) != 0 {
+		t.Fatalf("expected the expired object's history to be deleted, got: %v", history)
+	}
+	deleted, err = store.DeleteExpired(ctx)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if deleted != 0 {
+		t.Fatalf("expected nothing left to delete, got: %v", deleted)
+	}
+	exists, err := store.Exists(ctx, []string{"expire_kept"})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if !exists["expire_kept"] {
+		t.Fatal("expected expire_kept to be kept")
+	}
+}
+
+// panickingGeoDB panics in Ping & ScanObjects
+type panickingGeoDB struct {
+	*services.GeoDB
+}
+
+func (p *panickingGeoDB) Ping(ctx context.Context, r *api.PingRequest) (*api.PingResponse, error) {
+	var point *api.Point
+	return &api.PingResponse{Ok: point.Lat == 0}, nil
+}
+
+func (p *panickingGeoDB) ScanObjects(r *api.ScanObjectsRequest, ss api.GeoDB_ScanObjectsServer) error {
+	panic("scan panic")
+}
+
+func TestPanicRecovery(t *testing.T) {
+	ctx := context.Background()
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	grpcServer := grpc.NewServer(
+		grpc.UnaryInterceptor(server.RecoveryUnaryInterceptor()),
+		grpc.StreamInterceptor(server.RecoveryStreamInterceptor()),
+	)
+	api.RegisterGeoDBServer(grpcServer, &panickingGeoDB{GeoDB: geoDB})
+	go grpcServer.Serve(lis)
+	defer grpcServer.Stop()
+	conn, err := grpc.Dial(lis.Addr().String(), grpc.WithInsecure())
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer conn.Close()
+	client := api.NewGeoDBClient(conn)
+	for i := 0; i < 2; i++ {
+		_, err := client.Ping(ctx, &api.PingRequest{})
+		if status.Code(err) != codes.Internal {
+			t.Fatalf("expected the panic to fail with Internal, got: %v", err)
+		}
+		if !strings.Contains(status.Convert(err).Message(), "nil pointer dereference") {
+			t.Fatalf("expected the error to describe the panic, got: %s", status.Convert(err).Message())
+		}
+	}
+	scan, err := client.ScanObjects(ctx, &api.ScanObjectsRequest{})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if _, err := scan.Recv(); status.Code(err) != codes.Internal {
+		t.Fatalf("expected the stream panic to fail with Internal, got: %v", err)
+	}
+	// the server is still up & serving other rpcs
+	if _, err := client.Set(ctx, &api.SetRequest{Object: &api.Object{Key: "panic_survivor", Point: coorsField, Radius: 10}}); err != nil {
+		t.Fatal(err.Error())
+	}
+	defer geoDB.Delete(ctx, &api.DeleteRequest{Keys: []string{"panic_survivor"}})
+	resp, err := client.Get(ctx, &api.GetRequest{Keys: []string{"panic_survivor"}})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if resp.Objects["panic_survivor"] == nil {
+		t.Fatalf("expected panic_survivor, got: %v", resp.Objects)
+	}
+}
+
+func TestGetRegexPrefix(t *testing.T) {
+	ctx := context.Background()
+	keys := []string{"rxp_fleet_a_1", "rxp_fleet_a_2", "rxp_fleet_b_1", "rxp_fleet_b_2", "rxp_depot_a_1"}
+	var objects []*api.Object
+	for _, key := range keys {
+		objects = append(objects, &api.Object{Key: key, Point: coorsField, Radius: 10})
+	}
+	if _, err := geoDB.SetMany(ctx, &api.SetManyRequest{Objects: objects}); err != nil {
+		t.Fatal(err.Error())
+	}
+	defer geoDB.Delete(ctx, &api.DeleteRequest{Keys: keys})
+	prefixed, err := geoDB.GetPrefix(ctx, &api.GetPrefixRequest{Prefix: "rxp_fleet_"})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	matched, err := geoDB.GetRegex(ctx, &api.GetRegexRequest{Regex: "_1$"})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	var intersection []string
+	for key := range prefixed.Objects {
+		if matched.Objects[key] != nil {
+			intersection = append(intersection, key)
+		}
+	}
+	sort.Strings(intersection)
+	resp, err := geoDB.GetRegex(ctx, &api.GetRegexRequest{Prefix: "rxp_fleet_", Regex: "_1$"})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	var got []string
+	for key := range resp.Objects {
+		got = append(got, key)
+	}
+	sort.Strings(got)
+	if fmt.Sprint(got) != fmt.Sprint(intersection) || fmt.Sprint(got) != "[rxp_fleet_a_1 rxp_fleet_b_1]" {
+		t.Fatalf("expected the intersection of the prefix & regex matches %v, got: %v", intersection, got)
+	}
+	// the regex is matched against the full key, not the key after the prefix
+	resp, err = geoDB.GetRegex(ctx, &api.GetRegexRequest{Prefix: "rxp_fleet_", Regex: "^rxp_fleet_b"})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(resp.Objects) != 2 || resp.Objects["rxp_fleet_b_1"] == nil || resp.Objects["rxp_fleet_b_2"] == nil {
+		t.Fatalf("expected the fleet b objects, got: %v", resp.Objects)
+	}
+	// pages stay within the prefix, including those resumed from a cursor before it
+	var paged []string
+	cursor := "rxp_a"
+	for {
+		page, err := geoDB.GetRegex(ctx, &api.GetRegexRequest{Prefix: "rxp_fleet_", Regex: "_a_", Limit: 1, Cursor: cursor})
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		for key := range page.Objects {
+			paged = append(paged, key)
+		}
+		if page.NextCursor == "" {
+			break
+		}
+		cursor = page.NextCursor
+	}
+	if fmt.Sprint(paged) != "[rxp_fleet_a_1 rxp_fleet_a_2]" {
+		t.Fatalf("expected the fleet a objects, got: %v", paged)
+	}
+	// within a namespace, the prefix & regex apply to the keys without the namespace
+	if _, err := geoDB.Set(ctx, &api.SetRequest{Namespace: "rxp", Object: &api.Object{Key: "fleet_a_1", Point: coorsField, Radius: 10}}); err != nil {
+		t.Fatal(err.Error())
+	}
+	defer geoDB.Delete(ctx, &api.DeleteRequest{Namespace: "rxp", Keys: []string{"fleet_a_1"}})
+	resp, err = geoDB.GetRegex(ctx, &api.GetRegexRequest{Namespace: "rxp", Prefix: "fleet_", Regex: "^fleet_a"})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(resp.Objects) != 1 || resp.Objects["fleet_a_1"] == nil {
+		t.Fatalf("expected fleet_a_1, got: %v", resp.Objects)
+	}
+}
+
+func TestBadgerConfig(t *testing.T) {
+	ctx := context.Background()
+	dir, err := ioutil.TempDir("", "geodb_encrypted")
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer os.RemoveAll(dir)
+	cfg := db.BadgerConfig{
+		Dir:           filepath.Join(dir, "keys"),
+		ValueDir:      filepath.Join(dir, "values"),
+		SyncWrites:    true,
+		EncryptionKey: []byte("0123456789abcdef0123456789abcdef"),
+	}
+	encrypted, err := cfg.Open()
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if _, err := db.NewStore(encrypted, stream.NewHub(), nil).Set(ctx, &api.Object{Key: "encrypted_depot", Point: coorsField, Radius: 100}); err != nil {
+		t.Fatal(err.Error())
+	}
+	if err := encrypted.Close(); err != nil {
+		t.Fatal(err.Error())
+	}
+	// the value log is written to its own directory
+	if values, err := filepath.Glob(filepath.Join(dir, "values", "*.vlog")); err != nil || len(values) == 0 {
+		t.Fatalf("expected the value log in the value dir, got: %v %v", values, err)
+	}
+	// the data isn't readable on disk without the key
+	files, err := filepath.Glob(filepath.Join(dir, "*", "*"))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	for _, file := range files {
+		bits, err := ioutil.ReadFile(file)
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		if bytes.Contains(bits, []byte("encrypted_depot")) {
+			t.Fatalf("expected %s to be encrypted", file)
+		}
+	}
+	reopened, err := cfg.Open()
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	objects, err := db.NewStore(reopened, stream.NewHub(), nil).Get(ctx, []string{"encrypted_depot"})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if objects["encrypted_depot"] == nil || objects["encrypted_depot"].Object.Point.Lat != coorsField.Lat {
+		t.Fatalf("expected encrypted_depot to be read back, got: %v", objects)
+	}
+	if err := reopened.Close(); err != nil {
+		t.Fatal(err.Error())
+	}
+	// the database can't be opened with another key or without one
+	for _, key := range [][]byte{[]byte("fedcba9876543210fedcba9876543210"), nil} {
+		wrong := cfg
+		wrong.EncryptionKey = key
+		if opened, err := wrong.Open(); err == nil {
+			opened.Close()
+			t.Fatalf("expected opening with the key %q to fail", key)
+		}
+	}
+	invalid := cfg
+	invalid.EncryptionKey = []byte("short")
+	if _, err := invalid.Open(); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected an invalid key length to be rejected, got: %v", err)
+	}
+}
+
+func TestRefreshTTL(t *testing.T) {
+	now := time.Now()
+	start := now
+	store := newMemStore(t, nil, db.WithClock(func() time.Time {
+		return now
+	}))
+	ctx := context.Background()
+	if _, err := store.SetMany(ctx, []*api.Object{
+		{Key: "presence_active", Point: coorsField, Radius: 10, TtlSeconds: 60},
+		{Key: "presence_idle", Point: pepsiCenter, Radius: 10, TtlSeconds: 60},
+		{Key: "presence_fixed", Point: saintJosephHospital, Radius: 10, ExpiresUnix: start.Add(time.Minute).Unix()},
+	}, false, true); err != nil {
+		t.Fatal(err.Error())
+	}
+	// reading the active object restarts its ttl
+	now = start.Add(40 * time.Second)
+	refreshed, err := store.RefreshTTL(ctx, []string{"presence_active", "presence_fixed", "presence_missing"})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(refreshed) != 1 || refreshed["presence_active"] != start.Add(100*time.Second).Unix() {
+		t.Fatalf("expected only presence_active to be refreshed, got: %v", refreshed)
+	}
+	// past the original expiry, only the refreshed object is alive
+	now = start.Add(80 * time.Second)
+	objects, err := store.Get(ctx, []string{"presence_active", "presence_idle", "presence_fixed"})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(objects) != 1 || objects["presence_active"] == nil {
+		t.Fatalf("expected presence_active to be kept alive, got: %v", objects)
+	}
+	if objects["presence_active"].Object.Version != 1 {
+		t.Fatalf("expected refreshing not to change the version, got: %v", objects["presence_active"].Object.Version)
+	}
+	// an expired object isn't revived by a read
+	refreshed, err = store.RefreshTTL(ctx, []string{"presence_idle"})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(refreshed) != 0 {
+		t.Fatalf("expected the expired object not to be refreshed, got: %v", refreshed)
+	}
+	// writes without an expiration keep the stored ttl when refreshing
+	detail, err := store.SetWithOptions(ctx, &api.Object{Key: "presence_active", Point: pepsiCenter, Radius: 10}, db.SetOptions{RefreshTTL: true})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if detail.Object.TtlSeconds != 60 || detail.Object.ExpiresUnix != start.Add(140*time.Second).Unix() {
+		t.Fatalf("expected the write to restart the stored ttl, got: %v %v", detail.Object.TtlSeconds, detail.Object.ExpiresUnix)
+	}
+	now = start.Add(120 * time.Second)
+	if objects, err := store.Get(ctx, []string{"presence_active"}); err != nil || objects["presence_active"] == nil {
+		t.Fatalf("expected presence_active to be kept alive by the write, got: %v %v", objects, err)
+	}
+	// without refreshing, the write replaces the expiration
+	detail, err = store.Set(ctx, &api.Object{Key: "presence_active", Point: pepsiCenter, Radius: 10})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if detail.Object.TtlSeconds != 0 || detail.Object.ExpiresUnix != 0 {
+		t.Fatalf("expected the write without a ttl not to expire, got: %v %v", detail.Object.TtlSeconds, detail.Object.ExpiresUnix)
+	}
+}
+
+func TestGetRefreshTTL(t *testing.T) {
+	ctx := context.Background()
+	set, err := geoDB.Set(ctx, &api.SetRequest{Namespace: "presence", Object: &api.Object{Key: "courier", Point: coorsField, Radius: 10, TtlSeconds: 100}})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer geoDB.Delete(ctx, &api.DeleteRequest{Namespace: "presence", Keys: []string{"courier"}})
+	time.Sleep(1100 * time.Millisecond)
+	resp, err := geoDB.Get(ctx, &api.GetRequest{Namespace: "presence", Keys: []string{"courier"}, RefreshTtl: true})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if resp.Objects["courier"] == nil || resp.Objects["courier"].Object.ExpiresUnix <= set.Object.Object.ExpiresUnix {
+		t.Fatalf("expected the read to extend the expiration past %v, got: %v", set.Object.Object.ExpiresUnix, resp.Objects)
+	}
+	ttl, err := geoDB.GetTTL(ctx, &api.TTLRequest{Namespace: "presence", Keys: []string{"courier"}})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if ttl.TtlSeconds["courier"] < 99 {
+		t.Fatalf("expected the stored ttl to be restarted, got: %v", ttl.TtlSeconds["courier"])
+	}
+	if _, err := geoDB.Get(ctx, &api.GetRequest{Namespace: "presence", RefreshTtl: true}); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected refreshing every object to be rejected, got: %v", err)
+	}
+}
+
+func TestStreamExitsWhenDisconnectedMidSend(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	// nothing reads the sent object until the client is disconnected, so the handler is blocked sending it
+	ss := &mockStreamServer{ctx: ctx, sent: make(chan *api.ObjectDetail)}
+	done := make(chan error, 1)
+	go func() {
+		done <- geoDB.Stream(&api.StreamRequest{ClientId: "disconnected_mid_send", Keys: []string{"disconnected_mid_send"}}, ss)
+	}()
+	waitFor(t, "expected client to be registered", func() bool {
+		return streamHub.GetClientObjectStream("disconnected_mid_send") != nil
+	})
+	defer geoDB.Delete(context.Background(), &api.DeleteRequest{Keys: []string{"disconnected_mid_send"}})
+	if _, err := geoDB.Set(context.Background(), &api.SetRequest{Object: &api.Object{Key: "disconnected_mid_send", Point: coorsField, Radius: 10}}); err != nil {
+		t.Fatal(err.Error())
+	}
+	// the hub removes the client(ex: GEODB_STREAM_SLOW_CLIENT_POLICY=DISCONNECT) while its handler is sending
+	time.Sleep(50 * time.Millisecond)
+	streamHub.RemoveObjectStreamClient("disconnected_mid_send")
+	<-ss.sent
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+	case <-time.After(time.Second):
+		t.Fatal("expected the handler to return once its client was disconnected")
+	}
+}
+
+func TestBulkDelete(t *testing.T) {
+	keys := []string{"tenant_a_1", "tenant_a_2", "tenant_a_3", "tenant_b_1", "tenant_b_2", "tenant_bb_1"}
+	for _, key := range keys {
+		if _, err := geoDB.Set(context.Background(), &api.SetRequest{
+			Object: &api.Object{
+				Key:    key,
+				Point:  coorsField,
+				Radius: 100,
+				Tags:   []string{"tenant"},
+			},
+		}); err != nil {
+			t.Fatal(err.Error())
+		}
+	}
+	defer geoDB.Delete(context.Background(), &api.DeleteRequest{Keys: keys})
+	prefix, err := geoDB.DeletePrefix(context.Background(), &api.DeletePrefixRequest{Prefix: "tenant_a_"})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if prefix.Deleted != 3 {
+		t.Fatalf("expected 3 objects deleted by prefix, got: %v", prefix.Deleted)
+	}
+	regex, err := geoDB.DeleteRegex(context.Background(), &api.DeleteRegexRequest{Regex: "^tenant_b_[0-9]$"})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if regex.Deleted != 2 {
+		t.Fatalf("expected 2 objects deleted by regex, got: %v", regex.Deleted)
+	}
+	tagged, err := geoDB.GetTagged(context.Background(), &api.GetTaggedRequest{Filter: &api.TagFilter{Any: []string{"tenant"}}})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(tagged.Objects) != 1 || tagged.Objects["tenant_bb_1"] == nil {
+		t.Fatalf("expected only tenant_bb_1 to remain, got: %v", tagged.Objects)
+	}
+	if _, err := geoDB.DeletePrefix(context.Background(), &api.DeletePrefixRequest{}); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected invalid argument for an empty prefix, got: %v", err)
+	}
+	if _, err := geoDB.DeleteRegex(context.Background(), &api.DeleteRegexRequest{}); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected invalid argument for an empty regex, got: %v", err)
+	}
+}
+
+func TestTTL(t *testing.T) {
+	store := db.NewStore(badgerDB, streamHub, nil)
+	detail, err := store.Set(context.Background(), &api.Object{
+		Key:        "ttl_driver",
+		Point:      coorsField,
+		Radius:     100,
+		TtlSeconds: 1,
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer store.Delete(context.Background(), []string{"ttl_driver", "ttl_default", "ttl_explicit"})
+	if detail.Object.ExpiresUnix == 0 {
+		t.Fatal("expected ttl_seconds to set expires_unix")
+	}
+	objects, err := store.Get(context.Background(), []string{"ttl_driver"})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if _, ok := objects["ttl_driver"]; !ok {
+		t.Fatal("expected ttl_driver before it expires")
+	}
+	time.Sleep(time.Until(time.Unix(detail.Object.ExpiresUnix, 0)) + 100*time.Millisecond)
+	objects, err = store.Get(context.Background(), []string{"ttl_driver"})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if _, ok := objects["ttl_driver"]; ok {
+		t.Fatal("expected ttl_driver to expire")
+	}
+	// writes from an hour ago with a minute long default ttl have already expired
+	past := db.NewStore(badgerDB, streamHub, nil, db.WithDefaultTTL(time.Minute), db.WithClock(func() time.Time {
+		return time.Now().Add(-time.Hour)
+	}))
+	if _, err := past.Set(context.Background(), &api.Object{
+		Key:    "ttl_default",
+		Point:  coorsField,
+		Radius: 100,
+	}); err != nil {
+		t.Fatal(err.Error())
+	}
+	explicit := time.Now().Add(time.Hour).Unix()
+	if _, err := past.Set(context.Background(), &api.Object{
+		Key:         "ttl_explicit",
+		Point:       coorsField,
+		Radius:      100,
+		ExpiresUnix: explicit,
+	}); err != nil {
+		t.Fatal(err.Error())
+	}
+	objects, err = store.Get(context.Background(), []string{"ttl_default", "ttl_explicit"})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if _, ok := objects["ttl_default"]; ok {
+		t.Fatal("expected the default ttl to expire ttl_default")
+	}
+	if obj, ok := objects["ttl_explicit"]; !ok || obj.Object.ExpiresUnix != explicit {
+		t.Fatal("expected the default ttl not to override expires_unix")
+	}
+}
+
+func TestScanObjects(t *testing.T) {
+	var keys []string
+	for i := 0; i < 10; i++ {
+		keys = append(keys, fmt.Sprintf("export_%v", i))
+		if _, err := geoDB.Set(context.Background(), &api.SetRequest{
+			Object: &api.Object{
+				Key:    keys[i],
+				Point:  coorsField,
+				Radius: 100,
+			},
+		}); err != nil {
+			t.Fatal(err.Error())
+		}
+	}
+	defer geoDB.Delete(context.Background(), &api.DeleteRequest{Keys: keys})
+	var scanned []string
+	if err := geoDB.ScanObjects(&api.ScanObjectsRequest{Prefix: "export_", Regex: "[02468]$"}, &mockScanObjectsServer{
+		ctx: context.Background(),
+		send: func(resp *api.ScanObjectsResponse) error {
+			scanned = append(scanned, resp.Object.Object.Key)
+			return nil
+		},
+	}); err != nil {
+		t.Fatal(err.Error())
+	}
+	if strings.Join(scanned, ",") != "export_0,export_2,export_4,export_6,export_8" {
+		t.Fatalf("unexpected scanned keys: %v", scanned)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	sent := 0
+	err := geoDB.ScanObjects(&api.ScanObjectsRequest{Prefix: "export_"}, &mockScanObjectsServer{
+		ctx: ctx,
+		send: func(resp *api.ScanObjectsResponse) error {
+			sent++
+			if sent == 3 {
+				cancel()
+			}
+			return nil
+		},
+	})
+	if status.Code(err) != codes.Canceled {
+		t.Fatalf("expected canceled error, got: %v", err)
+	}
+	if sent != 3 {
+		t.Fatalf("expected the scan to stop after cancellation, sent: %v", sent)
+	}
+}
+
+func TestMetadataSelector(t *testing.T) {
+	objects := []*api.Object{
+		{Key: "label_1", Point: pepsiCenter, Radius: 1, Metadata: map[string]string{"tenant": "a", "status": "active"}},
+		{Key: "label_2", Point: coorsField, Radius: 1, Metadata: map[string]string{"tenant": "a", "status": "idle"}},
+		{Key: "label_3", Point: coorsField, Radius: 1, Metadata: map[string]string{"tenant": "b", "status": "active"}},
+	}
+	for _, obj := range objects {
+		if _, err := geoDB.Set(context.Background(), &api.SetRequest{Object: obj}); err != nil {
+			t.Fatal(err.Error())
+		}
+	}
+	defer geoDB.Delete(context.Background(), &api.DeleteRequest{Keys: []string{"label_1", "label_2", "label_3"}})
+	keys := func(objects map[string]*api.ObjectDetail) string {
+		var keys []string
+		for key := range objects {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		return strings.Join(keys, ",")
+	}
+	prefix, err := geoDB.GetPrefix(context.Background(), &api.GetPrefixRequest{
+		Prefix:           "label_",
+		MetadataSelector: map[string]string{"tenant": "a", "status": "active"},
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if got := keys(prefix.Objects); got != "label_1" {
+		t.Fatalf("expected every selector label to match, got: %v", got)
+	}
+	get, err := geoDB.Get(context.Background(), &api.GetRequest{
+		Keys:             []string{"label_1", "label_2", "label_3"},
+		MetadataSelector: map[string]string{"status": "active"},
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if got := keys(get.Objects); got != "label_1,label_3" {
+		t.Fatalf("unexpected objects for status=active: %v", got)
+	}
+	regex, err := geoDB.GetRegex(context.Background(), &api.GetRegexRequest{
+		Regex:            "^label_",
+		MetadataSelector: map[string]string{"tenant": "a"},
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if got := keys(regex.Objects); got != "label_1,label_2" {
+		t.Fatalf("unexpected objects for tenant=a: %v", got)
+	}
+	nearest, err := geoDB.Nearest(context.Background(), &api.NearestRequest{
+		Center:           coorsField,
+		K:                1,
+		MetadataSelector: map[string]string{"tenant": "a", "status": "active"},
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(nearest.Objects) != 1 || nearest.Objects[0].Object.Object.Key != "label_1" {
+		t.Fatalf("expected label_1 to be the nearest matching object, got: %v", nearest.Objects)
+	}
+	radius, err := geoDB.GetWithinRadius(context.Background(), &api.RadiusRequest{
+		Center:           coorsField,
+		Meters:           100,
+		MetadataSelector: map[string]string{"tenant": "b"},
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(radius.Objects) != 1 || radius.Objects[0].Object.Object.Key != "label_3" {
+		t.Fatalf("expected only label_3 within radius, got: %v", radius.Objects)
+	}
+}
+
+func TestSetWriteFailure(t *testing.T) {
+	memDB := newMemDB(t, func(opts badger.Options) badger.Options {
+		return opts.WithMaxTableSize(1 << 16)
+	})
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	hub := stream.NewHub()
+	go hub.StartObjectStream(ctx)
+	published := hub.GetClientObjectStream(hub.AddObjectStreamClient(""))
+	store := db.NewStore(memDB, hub, nil)
+	// too large for a single transaction
+	if _, err := store.Set(context.Background(), &api.Object{
+		Key:      "write_failure",
+		Point:    coorsField,
+		Radius:   1,
+		Metadata: map[string]string{"blob": strings.Repeat("x", 1<<16)},
+	}); status.Code(err) != codes.Internal {
+		t.Fatalf("expected an internal error for a failed write, got: %v", err)
+	}
+	memDB.Close()
+	if _, err := store.Set(context.Background(), &api.Object{Key: "write_failure", Point: coorsField, Radius: 1}); status.Code(err) != codes.Internal {
+		t.Fatalf("expected an internal error for a closed database, got: %v", err)
+	}
+	select {
+	case obj := <-published:
+		t.Fatalf("expected failed writes not to be published, got: %s", obj.Object.Key)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+func TestHealth(t *testing.T) {
+	resp, err := geoDB.Health(context.Background(), &api.HealthRequest{})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if !resp.Ok {
+		t.Fatal("expected a healthy database")
+	}
+	keys, err := geoDB.GetKeys(context.Background(), &api.GetKeysRequest{})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	for _, key := range keys.Keys {
+		if strings.Contains(key, "health") {
+			t.Fatalf("expected the health check sentinel to be hidden from keys, got: %s", key)
+		}
+	}
+	memDB := newMemDB(t, nil)
+	store := db.NewStore(memDB, stream.NewHub(), nil)
+	if _, _, err := store.Health(context.Background()); err != nil {
+		t.Fatal(err.Error())
+	}
+	memDB.Close()
+	if _, _, err := store.Health(context.Background()); status.Code(err) != codes.Unavailable {
+		t.Fatalf("expected unavailable for a closed database, got: %v", err)
+	}
+}
+
+func TestBackupRestore(t *testing.T) {
+	if _, err := geoDB.Set(context.Background(), &api.SetRequest{
+		Object: &api.Object{
+			Key:      "backup_driver",
+			Point:    coorsField,
+			Radius:   100,
+			Metadata: map[string]string{"vehicle": "van"},
+			Tags:     []string{"backup"},
+		},
+	}); err != nil {
+		t.Fatal(err.Error())
+	}
+	defer geoDB.Delete(context.Background(), &api.DeleteRequest{Keys: []string{"backup_driver"}})
+	backup := &mockBackupServer{}
+	if err := geoDB.Backup(&api.BackupRequest{}, backup); err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(backup.sent) < 2 || backup.sent[len(backup.sent)-1].Version == 0 {
+		t.Fatal("expected backup chunks followed by a version")
+	}
+	dir, err := ioutil.TempDir("", "geodb_restore")
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer os.RemoveAll(dir)
+	restoreDB, err := badger.Open(badger.DefaultOptions(dir).WithLogger(nil))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer restoreDB.Close()
+	restoreHub := stream.NewHub()
+	restored := services.NewGeoDB(db.NewStore(restoreDB, restoreHub, nil, db.StoreOptionsFromConfig()...), restoreHub, nil, nil)
+	if err := restored.Restore(&mockRestoreServer{recv: backup.sent}); err != nil {
+		t.Fatal(err.Error())
+	}
+	want, err := geoDB.Get(context.Background(), &api.GetRequest{})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	got, err := restored.Get(context.Background(), &api.GetRequest{})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(got.Objects) != len(want.Objects) {
+		t.Fatalf("expected %v restored objects, got: %v", len(want.Objects), len(got.Objects))
+	}
+	for key, obj := range want.Objects {
+		if !proto.Equal(obj, got.Objects[key]) {
+			t.Fatalf("expected restored object %s to equal the original", key)
+		}
+	}
+	tagged, err := restored.GetTagged(context.Background(), &api.GetTaggedRequest{Filter: &api.TagFilter{Any: []string{"backup"}}})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if tagged.Objects["backup_driver"] == nil {
+		t.Fatal("expected the tag index to be restored")
+	}
+}
+
+func BenchmarkGetRegexKeys(b *testing.B) {
+	memDB := newMemDB(b, nil)
+	batch := memDB.NewWriteBatch()
+	for i := 0; i < 50000; i++ {
+		if err := batch.SetEntry(&badger.Entry{
+			Key:      []byte(fmt.Sprintf("bench_%v", i)),
+			UserMeta: 1,
+		}); err != nil {
+			b.Fatal(err.Error())
+		}
+	}
+	if err := batch.Flush(); err != nil {
+		b.Fatal(err.Error())
+	}
+	store := db.NewStore(memDB, stream.NewHub(), nil)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := store.GetRegexKeys(context.Background(), "", "^bench_[0-9]*7$"); err != nil {
+			b.Fatal(err.Error())
+		}
+	}
+}
+
+func BenchmarkKeyIteration(b *testing.B) {
+	memDB := newMemDB(b, nil)
+	batch := memDB.NewWriteBatch()
+	value := make([]byte, 1024)
+	for i := 0; i < 10000; i++ {
+		if err := batch.SetEntry(&badger.Entry{
+			Key:      []byte(fmt.Sprintf("bench_%v", i)),
+			Value:    value,
+			UserMeta: 1,
+		}); err != nil {
+			b.Fatal(err.Error())
+		}
+	}
+	if err := batch.Flush(); err != nil {
+		b.Fatal(err.Error())
+	}
+	store := db.NewStore(memDB, stream.NewHub(), nil)
+	// listing keys with the default iterator options, which prefetch every value
+	b.Run("prefetch_values", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			txn := memDB.NewTransaction(false)
+			iter := txn.NewIterator(badger.DefaultIteratorOptions)
+			var keys []string
+			for iter.Rewind(); iter.Valid(); iter.Next() {
+				if iter.Item().UserMeta() == 1 {
+					keys = append(keys, string(iter.Item().Key()))
+				}
+			}
+			iter.Close()
+			txn.Discard()
+		}
+	})
+	b.Run("keys_only", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			store.GetKeys(context.Background(), "", "", "", 0, false)
+		}
+	})
+}
+
+func BenchmarkPrefixScan(b *testing.B) {
+	store := newMemStore(b, nil)
+	metadata := map[string]string{"payload": strings.Repeat("x", 256)}
+	var objects []*api.Object
+	for i := 0; i < 10000; i++ {
+		prefix := "other"
+		if i%2 == 0 {
+			prefix = "bench"
+		}
+		objects = append(objects, &api.Object{
+			Key:      fmt.Sprintf("%s_%v", prefix, i),
+			Point:    coorsField,
+			Radius:   10,
+			Metadata: metadata,
+		})
+	}
+	if _, err := store.SetMany(context.Background(), objects, false, true); err != nil {
+		b.Fatal(err.Error())
+	}
+	b.Run("objects", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			if _, err := store.GetPrefix(context.Background(), "bench_", nil); err != nil {
+				b.Fatal(err.Error())
+			}
+		}
+	})
+	b.Run("keys_only", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			store.GetPrefixKeys(context.Background(), "bench_", 0)
+		}
+	})
+}
+
+func BenchmarkBulkUpdatePositions(b *testing.B) {
+	store := newMemStore(b, nil)
+	var objects []*api.Object
+	for i := 0; i < 10000; i++ {
+		objects = append(objects, &api.Object{
+			Key:    fmt.Sprintf("bench_%v", i),
+			Point:  coorsField,
+			Radius: 100,
+			Tracking: &api.ObjectTracking{
+				Trackers: []*api.ObjectTracker{{TargetObjectKey: fmt.Sprintf("bench_%v", (i+1)%10000), TrackDistance: true}},
+			},
+		})
+	}
+	if _, err := store.SetMany(context.Background(), objects, false, false); err != nil {
+		b.Fatal(err.Error())
+	}
+	updates := func(i int) []*api.PositionUpdate {
+		point := pepsiCenter
+		if i%2 == 0 {
+			point = coorsField
+		}
+		var updates []*api.PositionUpdate
+		for _, obj := range objects {
+			updates = append(updates, &api.PositionUpdate{Key: obj.Key, Point: point})
+		}
+		return updates
+	}
+	b.Run("bulk", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			if _, _, err := store.BulkUpdatePositions(context.Background(), updates(i)); err != nil {
+				b.Fatal(err.Error())
+			}
+		}
+	})
+	b.Run("set", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			for _, update := range updates(i) {
+				if _, err := store.Set(context.Background(), &api.Object{
+					Key:      update.Key,
+					Point:    update.Point,
+					Radius:   100,
+					Tracking: objects[0].Tracking,
+				}); err != nil {
+					b.Fatal(err.Error())
+				}
+			}
+		}
+	})
+}
+
+func BenchmarkWithinRadius(b *testing.B) {
+	memDB := newMemDB(b, nil)
+	random := rand.New(rand.NewSource(1))
+	batch := memDB.NewWriteBatch()
+	// 100k objects spread over ~110km x 85km around coors field
+	for i := 0; i < 100000; i++ {
+		bits, err := proto.Marshal(&api.ObjectDetail{Object: &api.Object{
+			Key:    fmt.Sprintf("bench_%v", i),
+			Point:  &api.Point{Lat: coorsField.Lat + random.Float64() - 0.5, Lon: coorsField.Lon + random.Float64() - 0.5},
+			Radius: 1,
+		}})
+		if err != nil {
+			b.Fatal(err.Error())
+		}
+		if err := batch.SetEntry(&badger.Entry{
+			Key:      []byte(fmt.Sprintf("bench_%v", i)),
+			Value:    bits,
+			UserMeta: 1,
+		}); err != nil {
+			b.Fatal(err.Error())
+		}
+	}
+	if err := batch.Flush(); err != nil {
+		b.Fatal(err.Error())
+	}
+	store := db.NewStore(memDB, stream.NewHub(), nil)
+	// computes geohashes & writes the geohash index
+	if _, err := store.RebuildTagIndex(context.Background(), nil); err != nil {
+		b.Fatal(err.Error())
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := store.WithinRadius(context.Background(), coorsField, 500, nil, nil); err != nil {
+			b.Fatal(err.Error())
+		}
+	}
+}
@@ -6,7 +6,7 @@ import (
 )
 
 func init() {
-	prometheus.MustRegister(objectLat, objectLon)
+	prometheus.MustRegister(objectLat, objectLon, droppedObjects, clientDroppedObjects, streamClientDisconnects, streamClients, warmupIndexed, warmupComplete, gcReclaimedBytes, recoveredPanics)
 }
 
 var (
@@ -18,9 +18,77 @@ var (
 		Name: "object_longitude",
 		Help: "the objects longitude",
 	}, []string{"key"})
+	droppedObjects = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "stream_dropped_objects_total",
+		Help: "the number of object updates dropped because the stream buffer was full",
+	})
+	clientDroppedObjects = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "stream_client_dropped_objects_total",
+		Help: "the number of object updates dropped because a stream client's buffer was full",
+	})
+	streamClientDisconnects = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "stream_client_disconnects_total",
+		Help: "the number of stream clients disconnected because their buffer was full(GEODB_STREAM_SLOW_CLIENT_POLICY=DISCONNECT)",
+	})
+	streamClients = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "stream_clients",
+		Help: "the number of connected object stream clients",
+	})
+	warmupIndexed = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "warmup_indexed_objects",
+		Help: "the number of objects indexed by the startup warmup",
+	})
+	warmupComplete = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "warmup_complete",
+		Help: "1 once the startup warmup has finished & the server reports SERVING",
+	})
+	gcReclaimedBytes = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "gc_reclaimed_bytes_total",
+		Help: "the value log disk space reclaimed by garbage collection",
+	})
+	recoveredPanics = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "recovered_panics_total",
+		Help: "the number of panics in rpc handlers & stream broadcasts recovered without crashing the server",
+	})
 )
 
 func GaugeObjectLocation(key string, point *api.Point) {
 	objectLat.WithLabelValues(key).Set(point.Lat)
 	objectLon.WithLabelValues(key).Set(point.Lon)
 }
+
+func IncDroppedObjects() {
+	droppedObjects.Inc()
+}
+
+func IncClientDroppedObjects() {
+	clientDroppedObjects.Inc()
+}
+
+func IncStreamClientDisconnects() {
+	streamClientDisconnects.Inc()
+}
+
+func IncStreamClients() {
+	streamClients.Inc()
+}
+
+func DecStreamClients() {
+	streamClients.Dec()
+}
+
+func SetWarmupProgress(indexed int) {
+	warmupIndexed.Set(float64(indexed))
+}
+
+func SetWarmupComplete() {
+	warmupComplete.Set(1)
+}
+
+func AddGCReclaimedBytes(bytes int64) {
+	gcReclaimedBytes.Add(float64(bytes))
+}
+
+func IncRecoveredPanics() {
+	recoveredPanics.Inc()
+}
@@ -0,0 +1,189 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	grpc_auth "github.com/grpc-ecosystem/go-grpc-middleware/auth"
+	"golang.org/x/time/rate"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"net"
+	"sync"
+	"time"
+)
+
+// Limit is a token bucket: PerSecond calls are allowed on average, bursting up to Burst calls. a PerSecond <= 0 is unlimited
+type Limit struct {
+	PerSecond float64
+	Burst     int
+}
+
+// writeMethods are limited by the write limit. every other method(including opening a stream) is limited by the read limit
+var writeMethods = map[string]bool{
+	"/api.GeoDB/Set":                 true,
+	"/api.GeoDB/SetMany":             true,
+	"/api.GeoDB/Update":              true,
+	"/api.GeoDB/Increment":           true,
+	"/api.GeoDB/BulkUpdatePositions": true,
+	"/api.GeoDB/ImportCSV":           true,
+	"/api.GeoDB/Delete":              true,
+	"/api.GeoDB/DeletePrefix":        true,
+	"/api.GeoDB/DeleteRegex":         true,
+	"/api.GeoDB/DeleteExpired":       true,
+	"/api.GeoDB/Restore":             true,
+	"/api.GeoDB/RunGC":               true,
+	"/api.GeoDB/CreateGeofence":      true,
+	"/api.GeoDB/DeleteGeofence":      true,
+}
+
+// exemptMethods are never limited so load balancers & orchestrators can always probe the server
+var exemptMethods = map[string]bool{
+	"/api.GeoDB/Ping":              true,
+	"/api.GeoDB/Health":            true,
+	"/grpc.health.v1.Health/Check": true,
+	"/grpc.health.v1.Health/Watch": true,
+}
+
+// ClientLimiter is a token bucket per client & method class(reads or writes)
+type ClientLimiter struct {
+	read      Limit
+	write     Limit
+	byAPIKey  bool
+	now       func() time.Time
+	mu        *sync.Mutex
+	buckets   map[string]*bucket
+	lastSweep time.Time
+}
+
+type bucket struct {
+	limiter  *rate.Limiter
+	lastSeen time.Time
+}
+
+// NewClientLimiter creates a ClientLimiter. if byAPIKey is set, clients are identified by the api key in their authorization
+// header(authorization: bearer <key>), otherwise by their peer address. only enable byAPIKey when api keys are validated
+// before the limiter runs, or a client could escape its limit by sending a new key with every call
+func NewClientLimiter(read, write Limit, byAPIKey bool) *ClientLimiter {
+	return &ClientLimiter{
+		read:     read,
+		write:    write,
+		byAPIKey: byAPIKey,
+		now:      time.Now,
+		mu:       &sync.Mutex{},
+		buckets:  map[string]*bucket{},
+	}
+}
+
+// Allow reports whether the client of ctx may call method, returning codes.ResourceExhausted if it's over its limit
+func (c *ClientLimiter) Allow(ctx context.Context, method string) error {
+	if exemptMethods[method] {
+		return nil
+	}
+	limit, class := c.read, "read"
+	if writeMethods[method] {
+		limit, class = c.write, "write"
+	}
+	if limit.PerSecond <= 0 {
+		return nil
+	}
+	client := c.clientID(ctx)
+	if !c.allow(class+"\x00"+client, limit) {
+		return status.Errorf(codes.ResourceExhausted, "%s rate limit exceeded for client: %s", class, client)
+	}
+	return nil
+}
+
+func (c *ClientLimiter) allow(key string, limit Limit) bool {
+	burst := limit.Burst
+	if burst < 1 {
+		burst = 1
+	}
+	now := c.now()
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	// a bucket that has been idle long enough to refill is identical to a new one, so it can be dropped
+	refill := c.refill()
+	if now.Sub(c.lastSweep) > refill {
+		for key, b := range c.buckets {
+			if now.Sub(b.lastSeen) > refill {
+				delete(c.buckets, key)
+			}
+		}
+		c.lastSweep = now
+	}
+	b, ok := c.buckets[key]
+	if !ok {
+		b = &bucket{limiter: rate.NewLimiter(rate.Limit(limit.PerSecond), burst)}
+		c.buckets[key] = b
+	}
+	b.lastSeen = now
+	return b.limiter.AllowN(now, 1)
+}
+
+// refill returns the longest time an empty bucket takes to refill
+func (c *ClientLimiter) refill() time.Duration {
+	var longest time.Duration
+	for _, limit := range []Limit{c.read, c.write} {
+		if limit.PerSecond <= 0 {
+			continue
+		}
+		burst := limit.Burst
+		if burst < 1 {
+			burst = 1
+		}
+		if d := time.Duration(float64(burst) / limit.PerSecond * float64(time.Second)); d > longest {
+			longest = d
+		}
+	}
+	return longest
+}
+
+// ForwardedForHeader is the metadata key the REST gateway forwards its client's address in. it's only trusted from
+// loopback peers(the gateway's connection), so remote grpc clients can't escape their limit by setting it
+const ForwardedForHeader = "x-forwarded-for"
+
+// clientID returns the api key or address identifying the client of ctx
+func (c *ClientLimiter) clientID(ctx context.Context) string {
+	if c.byAPIKey {
+		if key, err := grpc_auth.AuthFromMD(ctx, "bearer"); err == nil {
+			return fmt.Sprintf("key:%s", key)
+		}
+	}
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		addr := p.Addr.String()
+		// every connection from a host shares its limit
+		if host, _, err := net.SplitHostPort(addr); err == nil {
+			addr = host
+		}
+		if ip := net.ParseIP(addr); ip != nil && ip.IsLoopback() {
+			if md, ok := metadata.FromIncomingContext(ctx); ok && len(md.Get(ForwardedForHeader)) > 0 {
+				addr = md.Get(ForwardedForHeader)[0]
+			}
+		}
+		return fmt.Sprintf("addr:%s", addr)
+	}
+	return "unknown"
+}
+
+// UnaryServerInterceptor rejects unary calls from clients over their rate limit
+func UnaryServerInterceptor(c *ClientLimiter) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if err := c.Allow(ctx, info.FullMethod); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor rejects streams opened by clients over their rate limit
+func StreamServerInterceptor(c *ClientLimiter) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := c.Allow(ss.Context(), info.FullMethod); err != nil {
+			return err
+		}
+		return handler(srv, ss)
+	}
+}
@@ -0,0 +1,133 @@
+package ratelimit
+
+import (
+	"context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"net"
+	"testing"
+	"time"
+)
+
+type mockServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (m *mockServerStream) Context() context.Context {
+	return m.ctx
+}
+
+func fromAddr(addr string) context.Context {
+	tcp, _ := net.ResolveTCPAddr("tcp", addr)
+	return peer.NewContext(context.Background(), &peer.Peer{Addr: tcp})
+}
+
+func TestClientLimiter(t *testing.T) {
+	now := time.Unix(1600000000, 0)
+	limiter := NewClientLimiter(Limit{PerSecond: 10, Burst: 5}, Limit{PerSecond: 1, Burst: 2}, false)
+	limiter.now = func() time.Time {
+		return now
+	}
+	interceptor := UnaryServerInterceptor(limiter)
+	call := func(ctx context.Context, method string) error {
+		_, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: method}, func(ctx context.Context, req interface{}) (interface{}, error) {
+			return nil, nil
+		})
+		return err
+	}
+	client := fromAddr("10.0.0.1:5000")
+	for i := 0; i < 2; i++ {
+		if err := call(client, "/api.GeoDB/Set"); err != nil {
+			t.Fatalf("expected write %v within the burst, got: %v", i, err)
+		}
+	}
+	if err := call(client, "/api.GeoDB/Set"); status.Code(err) != codes.ResourceExhausted {
+		t.Fatalf("expected the write to be throttled, got: %v", err)
+	}
+	// reads & other clients have their own buckets. connections from the same host share one
+	for i := 0; i < 5; i++ {
+		if err := call(fromAddr("10.0.0.1:6000"), "/api.GeoDB/Get"); err != nil {
+			t.Fatalf("expected read %v within the burst, got: %v", i, err)
+		}
+	}
+	if err := call(fromAddr("10.0.0.1:7000"), "/api.GeoDB/Get"); status.Code(err) != codes.ResourceExhausted {
+		t.Fatalf("expected the read to be throttled, got: %v", err)
+	}
+	if err := call(fromAddr("10.0.0.2:5000"), "/api.GeoDB/Set"); err != nil {
+		t.Fatalf("expected another client to be allowed, got: %v", err)
+	}
+	if err := call(client, "/api.GeoDB/Ping"); err != nil {
+		t.Fatalf("expected ping to be exempt, got: %v", err)
+	}
+	// the bucket refills at 1 write per second
+	now = now.Add(time.Second)
+	if err := call(client, "/api.GeoDB/Set"); err != nil {
+		t.Fatalf("expected the write to be allowed after refilling, got: %v", err)
+	}
+	if err := call(client, "/api.GeoDB/Set"); status.Code(err) != codes.ResourceExhausted {
+		t.Fatalf("expected the write to be throttled again, got: %v", err)
+	}
+	now = now.Add(time.Minute)
+	for i := 0; i < 2; i++ {
+		if err := call(client, "/api.GeoDB/Set"); err != nil {
+			t.Fatalf("expected the full burst after a minute, got: %v", err)
+		}
+	}
+}
+
+func TestClientLimiterByAPIKey(t *testing.T) {
+	limiter := NewClientLimiter(Limit{PerSecond: 1, Burst: 1}, Limit{}, true)
+	withKey := func(key string) context.Context {
+		return metadata.NewIncomingContext(fromAddr("10.0.0.1:5000"), metadata.Pairs("authorization", "bearer "+key))
+	}
+	stream := StreamServerInterceptor(limiter)
+	open := func(ctx context.Context) error {
+		return stream(nil, &mockServerStream{ctx: ctx}, &grpc.StreamServerInfo{FullMethod: "/api.GeoDB/Stream"}, func(srv interface{}, ss grpc.ServerStream) error {
+			return nil
+		})
+	}
+	if err := open(withKey("dispatch")); err != nil {
+		t.Fatal(err.Error())
+	}
+	if err := open(withKey("dispatch")); status.Code(err) != codes.ResourceExhausted {
+		t.Fatalf("expected the stream to be throttled, got: %v", err)
+	}
+	// a different key from the same address has its own bucket
+	if err := open(withKey("billing")); err != nil {
+		t.Fatal(err.Error())
+	}
+	// writes are unlimited
+	for i := 0; i < 10; i++ {
+		if err := limiter.Allow(withKey("dispatch"), "/api.GeoDB/Set"); err != nil {
+			t.Fatal(err.Error())
+		}
+	}
+}
+
+func TestClientLimiterForwardedFor(t *testing.T) {
+	limiter := NewClientLimiter(Limit{PerSecond: 1, Burst: 1}, Limit{}, false)
+	forwarded := func(peerAddr, client string) context.Context {
+		return metadata.NewIncomingContext(fromAddr(peerAddr), metadata.Pairs(ForwardedForHeader, client))
+	}
+	if err := limiter.Allow(forwarded("127.0.0.1:5000", "10.0.0.1"), "/api.GeoDB/Get"); err != nil {
+		t.Fatal(err.Error())
+	}
+	// the gateway's clients share its loopback connection, but not their limits
+	if err := limiter.Allow(forwarded("127.0.0.1:5000", "10.0.0.2"), "/api.GeoDB/Get"); err != nil {
+		t.Fatalf("expected another gateway client to be allowed, got: %v", err)
+	}
+	if err := limiter.Allow(forwarded("127.0.0.1:5000", "10.0.0.1"), "/api.GeoDB/Get"); status.Code(err) != codes.ResourceExhausted {
+		t.Fatalf("expected the gateway client to be throttled, got: %v", err)
+	}
+	// remote clients can't pick their address
+	if err := limiter.Allow(forwarded("10.0.0.3:5000", "10.0.0.4"), "/api.GeoDB/Get"); err != nil {
+		t.Fatal(err.Error())
+	}
+	if err := limiter.Allow(forwarded("10.0.0.3:5000", "10.0.0.5"), "/api.GeoDB/Get"); status.Code(err) != codes.ResourceExhausted {
+		t.Fatalf("expected the forwarded address of a remote client to be ignored, got: %v", err)
+	}
+}
@@ -0,0 +1,29 @@
+package server
+
+import (
+	"context"
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"path"
+)
+
+// MaxResponseSizeInterceptor rejects unary responses larger than max bytes with codes.ResourceExhausted, naming the rpc
+// & the response size. without it, oversized responses fail on the client with a generic message size error
+func MaxResponseSizeInterceptor(max int) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		resp, err := handler(ctx, req)
+		if err != nil {
+			return resp, err
+		}
+		if msg, ok := resp.(proto.Message); ok {
+			if size := proto.Size(msg); size > max {
+				return nil, status.Errorf(codes.ResourceExhausted,
+					"%s response is %v bytes, over the %v byte limit(GEODB_GRPC_MAX_SEND_MSG_SIZE). page the results with a limit & cursor or stream them with ScanObjects",
+					path.Base(info.FullMethod), size, max)
+			}
+		}
+		return resp, nil
+	}
+}
@@ -0,0 +1,31 @@
+package server
+
+import (
+	"context"
+	"github.com/autom8ter/geodb/logging"
+	"github.com/autom8ter/geodb/metrics"
+	grpc_recovery "github.com/grpc-ecosystem/go-grpc-middleware/recovery"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"runtime/debug"
+)
+
+// recoverPanic logs a panic in an rpc handler with its stack trace & converts it to a codes.Internal error
+func recoverPanic(ctx context.Context, p interface{}) error {
+	logging.Entry(ctx).WithField("stack", string(debug.Stack())).Errorf("recovered from panic: %v", p)
+	metrics.IncRecoveredPanics()
+	return status.Errorf(codes.Internal, "panic: %v", p)
+}
+
+// RecoveryUnaryInterceptor recovers from panics in unary handlers(& the interceptors after it), so a single bad request
+// fails with codes.Internal instead of crashing the server
+func RecoveryUnaryInterceptor() grpc.UnaryServerInterceptor {
+	return grpc_recovery.UnaryServerInterceptor(grpc_recovery.WithRecoveryHandlerContext(recoverPanic))
+}
+
+// RecoveryStreamInterceptor recovers from panics in streaming handlers(& the interceptors after it), so a single bad
+// stream fails with codes.Internal instead of crashing the server
+func RecoveryStreamInterceptor() grpc.StreamServerInterceptor {
+	return grpc_recovery.StreamServerInterceptor(grpc_recovery.WithRecoveryHandlerContext(recoverPanic))
+}
@@ -5,13 +5,18 @@ import (
 	"fmt"
 	"github.com/autom8ter/geodb/auth"
 	"github.com/autom8ter/geodb/config"
+	"github.com/autom8ter/geodb/db"
+	"github.com/autom8ter/geodb/gateway"
+	"github.com/autom8ter/geodb/helpers"
+	"github.com/autom8ter/geodb/logging"
 	"github.com/autom8ter/geodb/maps"
+	"github.com/autom8ter/geodb/metrics"
+	"github.com/autom8ter/geodb/ratelimit"
 	"github.com/autom8ter/geodb/stream"
 	"github.com/dgraph-io/badger/v2"
 	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	grpc_auth "github.com/grpc-ecosystem/go-grpc-middleware/auth"
 	grpc_logrus "github.com/grpc-ecosystem/go-grpc-middleware/logging/logrus"
-	grpc_recovery "github.com/grpc-ecosystem/go-grpc-middleware/recovery"
 	grpc_ctxtags "github.com/grpc-ecosystem/go-grpc-middleware/tags"
 	grpc_validator "github.com/grpc-ecosystem/go-grpc-middleware/validator"
 	"github.com/labstack/echo"
@@ -23,19 +28,26 @@ import (
 	"github.com/soheilhy/cmux"
 	"golang.org/x/sync/errgroup"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/encoding/gzip"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
 	"net"
 	"net/http"
 	"time"
 )
 
 type Server struct {
-	server     *grpc.Server
-	router     *echo.Echo
-	streamHub  *stream.Hub
-	db         *badger.DB
-	hTTPClient *http.Client
-	gmaps      *maps.Client
-	logger     *log.Logger
+	server      *grpc.Server
+	router      *echo.Echo
+	streamHub   *stream.Hub
+	db          *badger.DB
+	hTTPClient  *http.Client
+	gmaps       *maps.Client
+	deadLetters *db.DeadLetters
+	store       *db.Store
+	logger      *log.Logger
+	health      *health.Server
 }
 
 func (s *Server) GetGRPCServer() *grpc.Server {
@@ -65,57 +77,142 @@ func (s *Server) GetGmaps() *maps.Client {
 	return s.gmaps
 }
 
-func GetDeps() (*badger.DB, *stream.Hub, *maps.Client, error) {
-	db, err := badger.Open(badger.DefaultOptions(config.Config.GetString("GEODB_PATH")))
+// GetStore returns the store configured by the environment(see db.StoreOptionsFromConfig). the grpc service & the
+// server's background jobs share it, so they share its rate limits, cooldowns & read sessions
+func (s *Server) GetStore() *db.Store {
+	return s.store
+}
+
+// GetDeadLetters returns the dead letter log the server's stream hub records to(nil if it's disabled)
+func (s *Server) GetDeadLetters() *db.DeadLetters {
+	return s.deadLetters
+}
+
+// GetDeps opens the configured badger database & creates the stream hub, the google maps client(nil unless
+// GEODB_GMAPS_KEY is set) & the dead letter log the hub records to(nil unless GEODB_DEAD_LETTER_MAX is set)
+func GetDeps() (*badger.DB, *stream.Hub, *maps.Client, *db.DeadLetters, error) {
+	badgerConfig, err := db.BadgerConfigFromConfig()
+	if err != nil {
+		return nil, nil, nil, nil, err
+	}
+	badgerDB, err := badgerConfig.Open()
 	if err != nil {
-		return nil, nil, nil, err
+		return nil, nil, nil, nil, err
 	}
-	hub := stream.NewHub()
+	var (
+		hubOpts     []stream.HubOption
+		deadLetters *db.DeadLetters
+	)
+	if config.Config.IsSet("GEODB_DEAD_LETTER_MAX") {
+		deadLetters = db.NewDeadLetters(badgerDB, config.Config.GetInt("GEODB_DEAD_LETTER_MAX"))
+		hubOpts = append(hubOpts, stream.WithDeadLetter(deadLetters.Record))
+	}
+	hub := stream.NewHub(hubOpts...)
 	if config.Config.IsSet("GEODB_GMAPS_KEY") {
-		client, err := maps.NewClient(db, config.Config.GetString("GEODB_GMAPS_KEY"), config.Config.GetDuration("GEODB_GMAPS_CACHE_DURATION"))
+		client, err := maps.NewClient(badgerDB, config.Config.GetString("GEODB_GMAPS_KEY"), config.Config.GetDuration("GEODB_GMAPS_CACHE_DURATION"))
 		if err != nil {
-			return db, hub, nil, err
+			return badgerDB, hub, nil, deadLetters, err
 		}
-		return db, hub, client, err
+		return badgerDB, hub, client, deadLetters, err
 	}
-	return db, stream.NewHub(), nil, nil
+	return badgerDB, hub, nil, deadLetters, nil
 }
 
 func NewServer() (*Server, error) {
-	db, hub, gmaps, err := GetDeps()
+	if _, err := db.ParseRolePairs(config.Config.GetString("GEODB_TRACKER_TRIGGER_ROLES")); err != nil {
+		return nil, err
+	}
+	if _, err := stream.ParseSlowClientPolicy(config.Config.GetString("GEODB_STREAM_SLOW_CLIENT_POLICY")); err != nil {
+		return nil, err
+	}
+	if err := stream.ValidateStreamBuffer(config.Config.GetInt("GEODB_STREAM_BUFFER")); err != nil {
+		return nil, err
+	}
+	if err := stream.ValidateClientBuffer(config.Config.GetInt("GEODB_STREAM_CLIENT_BUFFER")); err != nil {
+		return nil, err
+	}
+	badgerDB, hub, gmaps, deadLetters, err := GetDeps()
 	if err != nil {
 		return nil, err
 	}
+	if err := gzip.SetLevel(config.Config.GetInt("GEODB_GRPC_COMPRESSION_LEVEL")); err != nil {
+		return nil, err
+	}
+	if err := helpers.SetDistanceMode(config.Config.GetString("GEODB_DISTANCE_MODE")); err != nil {
+		return nil, err
+	}
+	if err := logging.SetLevel(config.Config.GetString("GEODB_LOG_LEVEL")); err != nil {
+		return nil, err
+	}
 	var promInterceptor = promgrpc.NewInterceptor(promgrpc.InterceptorOpts{})
 	if err := prometheus.DefaultRegisterer.Register(promInterceptor); err != nil {
 		return nil, err
 	}
-	server := grpc.NewServer(grpc.UnaryInterceptor(grpc_middleware.ChainUnaryServer(
+	unary := []grpc.UnaryServerInterceptor{
 		grpc_ctxtags.UnaryServerInterceptor(),
+		logging.UnaryServerInterceptor(),
 		promInterceptor.UnaryServer(),
-		grpc_logrus.UnaryServerInterceptor(log.NewEntry(log.New())),
-		grpc_validator.UnaryServerInterceptor(),
-		grpc_auth.UnaryServerInterceptor(auth.BasicAuthFunc()),
-		grpc_recovery.UnaryServerInterceptor(),
-	)),
-		grpc.StreamInterceptor(grpc_middleware.ChainStreamServer(
-			grpc_ctxtags.StreamServerInterceptor(),
-			promInterceptor.StreamServer(),
-			grpc_validator.StreamServerInterceptor(),
-			grpc_auth.StreamServerInterceptor(auth.BasicAuthFunc()),
-			grpc_recovery.StreamServerInterceptor(),
-		)),
+		grpc_logrus.UnaryServerInterceptor(log.NewEntry(log.StandardLogger())),
+		// after the request logs & metrics, so they record recovered panics as Internal errors
+		RecoveryUnaryInterceptor(),
+	}
+	streaming := []grpc.StreamServerInterceptor{
+		grpc_ctxtags.StreamServerInterceptor(),
+		logging.StreamServerInterceptor(),
+		promInterceptor.StreamServer(),
+		grpc_logrus.StreamServerInterceptor(log.NewEntry(log.StandardLogger())),
+		RecoveryStreamInterceptor(),
+	}
+	keys, err := auth.KeyStoreFromConfig()
+	if err != nil {
+		return nil, err
+	}
+	if keys != nil {
+		unary = append(unary, auth.APIKeyUnaryInterceptor(keys))
+		streaming = append(streaming, auth.APIKeyStreamInterceptor(keys))
+	}
+	if config.Config.IsSet("GEODB_CLIENT_READ_RATE_LIMIT") || config.Config.IsSet("GEODB_CLIENT_WRITE_RATE_LIMIT") {
+		// api keys have already been validated, so clients are limited by key when they're enabled
+		limiter := ratelimit.NewClientLimiter(
+			ratelimit.Limit{PerSecond: config.Config.GetFloat64("GEODB_CLIENT_READ_RATE_LIMIT"), Burst: config.Config.GetInt("GEODB_CLIENT_READ_RATE_BURST")},
+			ratelimit.Limit{PerSecond: config.Config.GetFloat64("GEODB_CLIENT_WRITE_RATE_LIMIT"), Burst: config.Config.GetInt("GEODB_CLIENT_WRITE_RATE_BURST")},
+			keys != nil,
+		)
+		unary = append(unary, ratelimit.UnaryServerInterceptor(limiter))
+		streaming = append(streaming, ratelimit.StreamServerInterceptor(limiter))
+	}
+	maxSend := config.Config.GetInt("GEODB_GRPC_MAX_SEND_MSG_SIZE")
+	unary = append(unary, grpc_validator.UnaryServerInterceptor())
+	streaming = append(streaming, grpc_validator.StreamServerInterceptor())
+	if keys == nil {
+		// api keys replace the password, so Ping & Health stay exempt when they're enabled
+		unary = append(unary, grpc_auth.UnaryServerInterceptor(auth.BasicAuthFunc()))
+		streaming = append(streaming, grpc_auth.StreamServerInterceptor(auth.BasicAuthFunc()))
+	}
+	// innermost, so the request logs & metrics record the rejection
+	unary = append(unary, MaxResponseSizeInterceptor(maxSend))
+	server := grpc.NewServer(
+		grpc.UnaryInterceptor(grpc_middleware.ChainUnaryServer(unary...)),
+		grpc.StreamInterceptor(grpc_middleware.ChainStreamServer(streaming...)),
 		grpc.StatsHandler(promInterceptor),
+		grpc.MaxRecvMsgSize(config.Config.GetInt("GEODB_GRPC_MAX_RECV_MSG_SIZE")),
+		grpc.MaxSendMsgSize(maxSend),
 	)
 	s := &Server{
-		server:     server,
-		router:     echo.New(),
-		db:         db,
-		hTTPClient: http.DefaultClient,
-		logger:     log.New(),
-		streamHub:  hub,
-		gmaps:      gmaps,
+		server:      server,
+		router:      echo.New(),
+		db:          badgerDB,
+		hTTPClient:  http.DefaultClient,
+		logger:      log.StandardLogger(),
+		streamHub:   hub,
+		gmaps:       gmaps,
+		deadLetters: deadLetters,
+		store:       db.NewStore(badgerDB, hub, gmaps, db.StoreOptionsFromConfig()...),
+		health:      health.NewServer(),
 	}
+	s.health.SetServingStatus("", healthpb.HealthCheckResponse_NOT_SERVING)
+	healthpb.RegisterHealthServer(server, s.health)
+	reflection.Register(server)
 	s.router.Use(
 		middleware.Recover(),
 	)
@@ -131,6 +228,16 @@ func (s *Server) Run() {
 	}
 	defer lis.Close()
 	defer s.GetDB().Close()
+	// the REST gateway forwards requests to the grpc server over a loopback connection
+	conn, err := grpc.Dial(lis.Addr().String(), grpc.WithInsecure(), grpc.WithDefaultCallOptions(
+		grpc.MaxCallRecvMsgSize(config.Config.GetInt("GEODB_GRPC_MAX_SEND_MSG_SIZE")),
+		grpc.MaxCallSendMsgSize(config.Config.GetInt("GEODB_GRPC_MAX_RECV_MSG_SIZE")),
+	))
+	if err != nil {
+		s.router.Logger.Fatal(err.Error())
+	}
+	defer conn.Close()
+	gateway.Register(s.router, conn)
 
 	mux := cmux.New(lis)
 	gMux := mux.Match(cmux.HTTP2())
@@ -140,14 +247,45 @@ func (s *Server) Run() {
 
 	egp, ctx := errgroup.WithContext(context.Background())
 	egp.Go(func() error {
-		return s.streamHub.StartObjectStream(ctx)
+		err := s.streamHub.StartObjectStream(ctx)
+		// stop the streaming handlers once the server is shutting down
+		s.streamHub.Close()
+		return err
 	})
 	egp.Go(func() error {
-		for {
-			time.Sleep(config.Config.GetDuration("GEODB_GC_INTERVAL"))
-			s.db.RunValueLogGC(0.7)
-		}
+		return s.warmup(ctx)
 	})
+	if !config.Config.GetBool("GEODB_IN_MEMORY") {
+		// in memory databases don't have a value log to collect
+		egp.Go(func() error {
+			for {
+				time.Sleep(config.Config.GetDuration("GEODB_GC_INTERVAL"))
+				runs, reclaimed, err := s.store.RunGC(ctx, config.Config.GetFloat64("GEODB_GC_DISCARD_RATIO"))
+				if err != nil {
+					s.logger.Error(err.Error())
+					continue
+				}
+				if runs > 0 {
+					s.logger.Infof("value log gc: rewrote %v files, reclaimed %v bytes", runs, reclaimed)
+				}
+			}
+		})
+	}
+	if config.Config.IsSet("GEODB_MAX_INACTIVITY") {
+		egp.Go(func() error {
+			for {
+				time.Sleep(config.Config.GetDuration("GEODB_INACTIVITY_SWEEP_INTERVAL"))
+				keys, err := s.store.DeleteInactive(ctx, config.Config.GetDuration("GEODB_MAX_INACTIVITY"))
+				if err != nil {
+					s.logger.Error(err.Error())
+					continue
+				}
+				for _, key := range keys {
+					s.logger.Infof("deleted inactive object: %s", key)
+				}
+			}
+		})
+	}
 	egp.Go(func() error {
 		return s.router.Server.Serve(hMux)
 	})
@@ -162,6 +300,27 @@ func (s *Server) Run() {
 	}
 }
 
+// warmup rebuilds the tag & geohash indexes(if enabled) and then flips the grpc health check to SERVING
+func (s *Server) warmup(ctx context.Context) error {
+	if config.Config.GetBool("GEODB_WARMUP") {
+		s.logger.Info("warmup: rebuilding tag & geohash indexes")
+		indexed, err := s.store.RebuildTagIndex(ctx, func(indexed int) {
+			metrics.SetWarmupProgress(indexed)
+			if indexed%10000 == 0 {
+				s.logger.Infof("warmup: indexed %v objects", indexed)
+			}
+		})
+		if err != nil {
+			return err
+		}
+		metrics.SetWarmupProgress(indexed)
+		s.logger.Infof("warmup: complete - indexed %v objects", indexed)
+	}
+	metrics.SetWarmupComplete()
+	s.health.SetServingStatus("", healthpb.HealthCheckResponse_SERVING)
+	return nil
+}
+
 func (s *Server) Setup(fn func(s *Server) error) {
 	if err := fn(s); err != nil {
 		s.GetLogger().Fatal(err.Error())
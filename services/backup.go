@@ -0,0 +1,85 @@
+package services
+
+import (
+	"bufio"
+	"context"
+	"github.com/autom8ter/geodb/config"
+	api "github.com/autom8ter/geodb/gen/go/geodb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// the size of each backup chunk sent to the client
+const backupChunkSize = 64 << 10
+
+// backupWriter sends each write as a backup chunk
+type backupWriter struct {
+	ss api.GeoDB_BackupServer
+}
+
+func (w *backupWriter) Write(p []byte) (int, error) {
+	if err := w.ss.Send(&api.BackupResponse{
+		Data: p,
+	}); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+// restoreReader reads the backup chunks sent by the client
+type restoreReader struct {
+	ss  api.GeoDB_RestoreServer
+	buf []byte
+}
+
+func (r *restoreReader) Read(p []byte) (int, error) {
+	for len(r.buf) == 0 {
+		req, err := r.ss.Recv()
+		if err != nil {
+			return 0, err
+		}
+		r.buf = req.Data
+	}
+	n := copy(p, r.buf)
+	r.buf = r.buf[n:]
+	return n, nil
+}
+
+func (p *GeoDB) Backup(r *api.BackupRequest, ss api.GeoDB_BackupServer) error {
+	w := bufio.NewWriterSize(&backupWriter{ss: ss}, backupChunkSize)
+	version, err := p.store.Backup(ss.Context(), w, r.Since)
+	if err != nil {
+		return err
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+	return ss.Send(&api.BackupResponse{
+		Version: version,
+	})
+}
+
+func (p *GeoDB) Restore(ss api.GeoDB_RestoreServer) error {
+	if err := p.store.Restore(ss.Context(), &restoreReader{ss: ss}); err != nil {
+		return err
+	}
+	return ss.SendAndClose(&api.RestoreResponse{})
+}
+
+func (p *GeoDB) RunGC(ctx context.Context, r *api.GCRequest) (*api.GCResponse, error) {
+	if err := r.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	discardRatio := r.DiscardRatio
+	if discardRatio == 0 {
+		discardRatio = config.Config.GetFloat64("GEODB_GC_DISCARD_RATIO")
+	}
+	runs, reclaimed, err := p.store.RunGC(ctx, discardRatio)
+	if err != nil {
+		return nil, err
+	}
+	return &api.GCResponse{
+		Runs:           runs,
+		ReclaimedBytes: reclaimed,
+	}, nil
+}
@@ -2,23 +2,30 @@ package services
 
 import (
 	"context"
+	"github.com/autom8ter/geodb/db"
 	api "github.com/autom8ter/geodb/gen/go/geodb"
 	"github.com/autom8ter/geodb/maps"
 	"github.com/autom8ter/geodb/stream"
-	"github.com/dgraph-io/badger/v2"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"time"
 )
 
 type GeoDB struct {
-	hub   *stream.Hub
-	db    *badger.DB
-	gmaps *maps.Client
+	hub         *stream.Hub
+	gmaps       *maps.Client
+	store       *db.Store
+	deadLetters *db.DeadLetters
 }
 
-func NewGeoDB(db *badger.DB, hub *stream.Hub, gmaps *maps.Client) *GeoDB {
+// NewGeoDB creates the GeoDB service backed by store(see db.StoreOptionsFromConfig). deadLetters is the log the hub
+// records undeliverable object details to(see server.GetDeps). GetDeadLetters fails if it's nil
+func NewGeoDB(store *db.Store, hub *stream.Hub, gmaps *maps.Client, deadLetters *db.DeadLetters) *GeoDB {
 	return &GeoDB{
-		hub:   hub,
-		db:    db,
-		gmaps: gmaps,
+		hub:         hub,
+		gmaps:       gmaps,
+		store:       store,
+		deadLetters: deadLetters,
 	}
 }
 
@@ -27,3 +34,65 @@ func (p *GeoDB) Ping(ctx context.Context, req *api.PingRequest) (*api.PingRespon
 		Ok: true,
 	}, nil
 }
+
+func (p *GeoDB) Health(ctx context.Context, req *api.HealthRequest) (*api.HealthResponse, error) {
+	lsm, vlog, err := p.store.Health(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &api.HealthResponse{
+		Ok:       true,
+		LsmSize:  lsm,
+		VlogSize: vlog,
+	}, nil
+}
+
+func (p *GeoDB) Stats(ctx context.Context, req *api.StatsRequest) (*api.StatsResponse, error) {
+	lsm, vlog, keys := p.store.Stats(ctx)
+	return &api.StatsResponse{
+		LsmSize:       lsm,
+		VlogSize:      vlog,
+		EstimatedKeys: keys,
+	}, nil
+}
+
+func (p *GeoDB) GetDeadLetters(ctx context.Context, r *api.GetDeadLettersRequest) (*api.GetDeadLettersResponse, error) {
+	if p.deadLetters == nil {
+		return nil, status.Error(codes.FailedPrecondition, "dead letter log is disabled(see GEODB_DEAD_LETTER_MAX)")
+	}
+	letters, err := p.deadLetters.List(ctx, int(r.Limit))
+	if err != nil {
+		return nil, err
+	}
+	return &api.GetDeadLettersResponse{
+		DeadLetters: letters,
+	}, nil
+}
+
+func (p *GeoDB) GetEvents(ctx context.Context, r *api.GetEventsRequest) (*api.GetEventsResponse, error) {
+	if err := r.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	var start, end time.Time
+	if r.StartNanos > 0 {
+		start = time.Unix(0, r.StartNanos)
+	}
+	if r.EndNanos > 0 {
+		end = time.Unix(0, r.EndNanos)
+	}
+	ctx, prefix, err := scope(ctx, r.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	key := r.Key
+	if key != "" {
+		key = prefix + key
+	}
+	events, err := p.store.GetEvents(ctx, start, end, key, int(r.Limit))
+	if err != nil {
+		return nil, err
+	}
+	return &api.GetEventsResponse{
+		Events: stripEvents(prefix, events),
+	}, nil
+}
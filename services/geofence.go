@@ -0,0 +1,41 @@
+package services
+
+import (
+	"context"
+	api "github.com/autom8ter/geodb/gen/go/geodb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (p *GeoDB) CreateGeofence(ctx context.Context, r *api.CreateGeofenceRequest) (*api.CreateGeofenceResponse, error) {
+	if err := r.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	fence, err := p.store.CreateGeofence(ctx, r.Geofence)
+	if err != nil {
+		return nil, err
+	}
+	return &api.CreateGeofenceResponse{
+		Geofence: fence,
+	}, nil
+}
+
+func (p *GeoDB) DeleteGeofence(ctx context.Context, r *api.DeleteGeofenceRequest) (*api.DeleteGeofenceResponse, error) {
+	if err := r.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := p.store.DeleteGeofence(ctx, r.Name); err != nil {
+		return nil, err
+	}
+	return &api.DeleteGeofenceResponse{}, nil
+}
+
+func (p *GeoDB) ListGeofences(ctx context.Context, r *api.ListGeofencesRequest) (*api.ListGeofencesResponse, error) {
+	fences, err := p.store.ListGeofences(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &api.ListGeofencesResponse{
+		Geofences: fences,
+	}, nil
+}
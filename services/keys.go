@@ -2,28 +2,114 @@ package services
 
 import (
 	"context"
-	"github.com/autom8ter/geodb/db"
 	api "github.com/autom8ter/geodb/gen/go/geodb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"strings"
 )
 
 func (p *GeoDB) GetKeys(ctx context.Context, r *api.GetKeysRequest) (*api.GetKeysResponse, error) {
+	ctx, prefix, err := scope(ctx, r.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	ctx, release, err := p.store.ReadSession(ctx, r.ReadSession)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+	cursor := r.Cursor
+	if cursor != "" {
+		cursor = prefix + cursor
+	}
+	endKey := r.EndKey
+	if endKey != "" {
+		endKey = prefix + endKey
+	}
+	keys, next := p.store.GetKeys(ctx, prefix, cursor, endKey, int(r.Limit), r.Reverse)
 	return &api.GetKeysResponse{
-		Keys: db.GetKeys(p.db),
+		Keys:       stripKeys(prefix, keys),
+		NextCursor: strings.TrimPrefix(next, prefix),
 	}, nil
 }
 
 func (p *GeoDB) GetPrefixKeys(ctx context.Context, r *api.GetPrefixKeysRequest) (*api.GetPrefixKeysResponse, error) {
+	ctx, prefix, err := scope(ctx, r.Namespace)
+	if err != nil {
+		return nil, err
+	}
 	return &api.GetPrefixKeysResponse{
-		Keys: db.GetPrefixKeys(p.db, r.Prefix),
+		Keys: stripKeys(prefix, p.store.GetPrefixKeys(ctx, prefix+r.Prefix, int(r.Limit))),
 	}, nil
 }
 
 func (p *GeoDB) GetRegexKeys(ctx context.Context, r *api.GetRegexKeysRequest) (*api.GetRegexKeysResponse, error) {
-	keys, err := db.GetRegexKeys(p.db, r.Regex)
+	ctx, prefix, err := scope(ctx, r.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	ctx = capResults(ctx)
+	keys, err := p.store.GetRegexKeys(ctx, prefix, r.Regex)
 	if err != nil {
 		return nil, err
 	}
 	return &api.GetRegexKeysResponse{
-		Keys: keys,
+		Keys: stripKeys(prefix, keys),
+	}, nil
+}
+
+func (p *GeoDB) Exists(ctx context.Context, r *api.ExistsRequest) (*api.ExistsResponse, error) {
+	if err := r.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx, prefix, err := scope(ctx, r.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	exists, err := p.store.Exists(ctx, namespaceKeys(prefix, r.Keys))
+	if err != nil {
+		return nil, err
+	}
+	stripped := map[string]bool{}
+	for key, ok := range exists {
+		stripped[strings.TrimPrefix(key, prefix)] = ok
+	}
+	return &api.ExistsResponse{
+		Exists: stripped,
+	}, nil
+}
+
+func (p *GeoDB) GetTTL(ctx context.Context, r *api.TTLRequest) (*api.TTLResponse, error) {
+	if err := r.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx, prefix, err := scope(ctx, r.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	ttls, err := p.store.GetTTL(ctx, namespaceKeys(prefix, r.Keys))
+	if err != nil {
+		return nil, err
+	}
+	stripped := map[string]int64{}
+	for key, ttl := range ttls {
+		stripped[strings.TrimPrefix(key, prefix)] = ttl
+	}
+	return &api.TTLResponse{
+		TtlSeconds: stripped,
+	}, nil
+}
+
+func (p *GeoDB) Count(ctx context.Context, r *api.CountRequest) (*api.CountResponse, error) {
+	ctx, prefix, err := scope(ctx, r.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	count, err := p.store.Count(ctx, prefix+r.Prefix, r.Regex)
+	if err != nil {
+		return nil, err
+	}
+	return &api.CountResponse{
+		Count: count,
 	}, nil
 }
@@ -0,0 +1,12 @@
+package services
+
+import (
+	"context"
+	"github.com/autom8ter/geodb/config"
+	"github.com/autom8ter/geodb/db"
+)
+
+// capResults caps the objects returned by the store's scans with the returned ctx at GEODB_MAX_RESULTS(see db.WithMaxResults)
+func capResults(ctx context.Context) context.Context {
+	return db.WithMaxResults(ctx, config.Config.GetInt("GEODB_MAX_RESULTS"))
+}
@@ -0,0 +1,137 @@
+package services
+
+import (
+	"context"
+	"github.com/autom8ter/geodb/db"
+	api "github.com/autom8ter/geodb/gen/go/geodb"
+	"github.com/gogo/protobuf/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"regexp"
+	"strings"
+)
+
+var namespaceRegex = regexp.MustCompile(`^[A-Za-z0-9_.-]{0,64}$`)
+
+// namespacePrefix returns the key prefix of the namespace's objects. the empty namespace is the global keyspace & has no prefix
+func namespacePrefix(namespace string) (string, error) {
+	if !namespaceRegex.MatchString(namespace) {
+		return "", status.Errorf(codes.InvalidArgument, "invalid namespace: %s", namespace)
+	}
+	return db.NamespacePrefix(namespace), nil
+}
+
+// scope validates the namespace & scopes the store's reads & writes with the returned ctx to it(see db.WithNamespace).
+// it also returns the namespace's key prefix
+func scope(ctx context.Context, namespace string) (context.Context, string, error) {
+	prefix, err := namespacePrefix(namespace)
+	if err != nil {
+		return ctx, "", err
+	}
+	return db.WithNamespace(ctx, namespace), prefix, nil
+}
+
+// namespaceObject prefixes the object's key & tracker targets, so trackers only target objects in the same namespace
+func namespaceObject(prefix string, obj *api.Object) {
+	if prefix == "" || obj == nil {
+		return
+	}
+	obj.Key = prefix + obj.Key
+	for _, tracker := range obj.GetTracking().GetTrackers() {
+		tracker.TargetObjectKey = prefix + tracker.TargetObjectKey
+	}
+}
+
+// namespaceKeys prefixes each key with the namespace prefix
+func namespaceKeys(prefix string, keys []string) []string {
+	if prefix == "" {
+		return keys
+	}
+	namespaced := make([]string, 0, len(keys))
+	for _, key := range keys {
+		namespaced = append(namespaced, prefix+key)
+	}
+	return namespaced
+}
+
+// stripKeys removes the namespace prefix from each key
+func stripKeys(prefix string, keys []string) []string {
+	if prefix == "" {
+		return keys
+	}
+	stripped := make([]string, 0, len(keys))
+	for _, key := range keys {
+		stripped = append(stripped, strings.TrimPrefix(key, prefix))
+	}
+	return stripped
+}
+
+// stripDetail returns a copy of the detail with the namespace prefix removed from the object key, tracker targets, tracker events &
+// inside targets.
+// details are shared with other stream clients, so they're never modified in place
+func stripDetail(prefix string, detail *api.ObjectDetail) *api.ObjectDetail {
+	if prefix == "" || detail == nil {
+		return detail
+	}
+	detail = proto.Clone(detail).(*api.ObjectDetail)
+	stripObject(prefix, detail.Object)
+	for _, event := range detail.TrackerEvents {
+		stripObject(prefix, event.Object)
+	}
+	detail.InsideTargets = stripKeys(prefix, detail.InsideTargets)
+	return detail
+}
+
+func stripObject(prefix string, obj *api.Object) {
+	if obj == nil {
+		return
+	}
+	obj.Key = strings.TrimPrefix(obj.Key, prefix)
+	for _, tracker := range obj.GetTracking().GetTrackers() {
+		tracker.TargetObjectKey = strings.TrimPrefix(tracker.TargetObjectKey, prefix)
+	}
+}
+
+// stripDetails returns the details keyed by their keys without the namespace prefix
+func stripDetails(prefix string, details map[string]*api.ObjectDetail) map[string]*api.ObjectDetail {
+	if prefix == "" {
+		return details
+	}
+	stripped := map[string]*api.ObjectDetail{}
+	for key, detail := range details {
+		stripped[strings.TrimPrefix(key, prefix)] = stripDetail(prefix, detail)
+	}
+	return stripped
+}
+
+// stripList returns the details with the namespace prefix removed
+func stripList(prefix string, details []*api.ObjectDetail) []*api.ObjectDetail {
+	if prefix == "" {
+		return details
+	}
+	stripped := make([]*api.ObjectDetail, 0, len(details))
+	for _, detail := range details {
+		stripped = append(stripped, stripDetail(prefix, detail))
+	}
+	return stripped
+}
+
+// stripNearest removes the namespace prefix from the nearest objects
+func stripNearest(prefix string, nearest []*api.NearestObject) []*api.NearestObject {
+	for _, obj := range nearest {
+		obj.Object = stripDetail(prefix, obj.Object)
+	}
+	return nearest
+}
+
+// stripEvents removes the namespace prefix from the events' tracking & target keys
+func stripEvents(prefix string, events []*api.ObjectEvent) []*api.ObjectEvent {
+	if prefix == "" {
+		return events
+	}
+	for _, event := range events {
+		event.Key = strings.TrimPrefix(event.Key, prefix)
+		stripObject(prefix, event.GetEvent().GetObject())
+	}
+	return events
+}
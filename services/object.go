@@ -2,58 +2,390 @@ package services
 
 import (
 	"context"
+	"github.com/autom8ter/geodb/config"
 	"github.com/autom8ter/geodb/db"
 	api "github.com/autom8ter/geodb/gen/go/geodb"
+	"github.com/autom8ter/geodb/helpers"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"strings"
 )
 
 func (p *GeoDB) Set(ctx context.Context, r *api.SetRequest) (*api.SetResponse, error) {
 	if err := r.Validate(); err != nil {
-		return nil, status.Error(codes.InvalidArgument, err.Error())
+		return nil, status.Errorf(codes.InvalidArgument, "%s: %s", r.Object.GetKey(), err.Error())
+	}
+	ctx, prefix, err := scope(ctx, r.Namespace)
+	if err != nil {
+		return nil, err
 	}
-	objects, err := db.Set(p.db, p.gmaps, p.hub, r.Object)
+	namespaceObject(prefix, r.Object)
+	objects, err := p.store.SetWithOptions(ctx, r.Object, db.SetOptions{
+		IfVersion:     r.IfVersion,
+		DryRun:        r.DryRun,
+		MergeMetadata: r.MergeMetadata,
+		RefreshTTL:    r.RefreshTtl,
+	})
 	if err != nil {
 		return nil, err
 	}
 	return &api.SetResponse{
-		Object: objects,
+		Object: stripDetail(prefix, objects),
+	}, nil
+}
+
+func (p *GeoDB) SetMany(ctx context.Context, r *api.SetManyRequest) (*api.SetManyResponse, error) {
+	if err := r.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx, prefix, err := scope(ctx, r.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	for _, obj := range r.Objects {
+		namespaceObject(prefix, obj)
+	}
+	objects, err := p.store.SetMany(ctx, r.Objects, r.RejectDuplicates, r.Atomic)
+	if err != nil {
+		return nil, err
+	}
+	return &api.SetManyResponse{
+		Objects: stripList(prefix, objects),
+	}, nil
+}
+
+func (p *GeoDB) BulkUpdatePositions(ctx context.Context, r *api.BulkUpdatePositionsRequest) (*api.BulkUpdatePositionsResponse, error) {
+	if err := r.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx, prefix, err := scope(ctx, r.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	for _, update := range r.Updates {
+		update.Key = prefix + update.Key
+	}
+	objects, notFound, err := p.store.BulkUpdatePositions(ctx, r.Updates)
+	if err != nil {
+		return nil, err
+	}
+	return &api.BulkUpdatePositionsResponse{
+		Objects:  stripList(prefix, objects),
+		NotFound: stripKeys(prefix, notFound),
+	}, nil
+}
+
+func (p *GeoDB) Update(ctx context.Context, r *api.UpdateRequest) (*api.UpdateResponse, error) {
+	if err := r.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx, prefix, err := scope(ctx, r.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	r.Key = prefix + r.Key
+	for _, tracker := range r.GetTracking().GetTrackers() {
+		tracker.TargetObjectKey = prefix + tracker.TargetObjectKey
+	}
+	object, err := p.store.Update(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+	return &api.UpdateResponse{
+		Object: stripDetail(prefix, object),
 	}, nil
 }
 
 func (p *GeoDB) GetRegex(ctx context.Context, r *api.GetRegexRequest) (*api.GetRegexResponse, error) {
-	objects, err := db.GetRegex(p.db, r.Regex)
+	ctx, prefix, err := scope(ctx, r.Namespace)
 	if err != nil {
 		return nil, err
 	}
+	if err := validateSort(r.Sort); err != nil {
+		return nil, err
+	}
+	if err := validateWindow(r.Window); err != nil {
+		return nil, err
+	}
+	ctx, release, err := p.store.ReadSession(ctx, r.ReadSession)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+	if ceiling := config.Config.GetInt64("GEODB_MAX_RESULTS_CEILING"); ceiling > 0 && r.Limit > ceiling {
+		return nil, status.Errorf(codes.InvalidArgument, "limit too large: %v > %v", r.Limit, ceiling)
+	}
+	limit := int(r.Limit)
+	capped := limit == 0 && config.Config.GetInt("GEODB_MAX_RESULTS") > 0
+	if capped {
+		limit = config.Config.GetInt("GEODB_MAX_RESULTS")
+	}
+	cursor := r.Cursor
+	if cursor != "" {
+		cursor = prefix + cursor
+	}
+	objects, next, err := p.store.GetRegex(ctx, prefix, r.Prefix, r.Regex, cursor, limit, r.MetadataSelector, r.Window)
+	if err != nil {
+		return nil, err
+	}
+	objects = stripDetails(prefix, objects)
 	return &api.GetRegexResponse{
-		Objects: objects,
+		Objects:    objects,
+		NextCursor: strings.TrimPrefix(next, prefix),
+		Ordered:    sortDetails(objects, r.Sort),
+		Truncated:  capped && next != "",
 	}, nil
 }
 
 func (p *GeoDB) Get(ctx context.Context, r *api.GetRequest) (*api.GetResponse, error) {
-	objects, err := db.Get(p.db, r.Keys)
+	ctx, prefix, err := scope(ctx, r.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateSort(r.Sort); err != nil {
+		return nil, err
+	}
+	if err := validateWindow(r.Window); err != nil {
+		return nil, err
+	}
+	if r.RefreshTtl && len(r.Keys) == 0 {
+		// refreshing rewrites every returned object
+		return nil, status.Error(codes.InvalidArgument, "refresh_ttl requires keys")
+	}
+	ctx, release, err := p.store.ReadSession(ctx, r.ReadSession)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+	var objects map[string]*api.ObjectDetail
+	if len(r.Keys) == 0 {
+		// every object in the namespace
+		objects, err = p.store.GetPrefixMax(ctx, prefix, nil, r.Window, config.Config.GetInt("GEODB_MAX_RESULTS"))
+	} else {
+		objects, err = p.store.Get(ctx, namespaceKeys(prefix, r.Keys))
+	}
 	if err != nil {
 		return nil, err
 	}
+	objects = stripDetails(prefix, objects)
+	var notFound []string
+	seen := map[string]struct{}{}
+	for _, key := range r.Keys {
+		if _, ok := seen[key]; ok {
+			continue
+		}
+		seen[key] = struct{}{}
+		if _, ok := objects[key]; !ok {
+			notFound = append(notFound, key)
+		}
+	}
+	for key, obj := range objects {
+		if !helpers.MatchMetadata(obj.Object.Metadata, r.MetadataSelector) || !helpers.MatchTimeWindow(obj.Object, r.Window) {
+			delete(objects, key)
+		}
+	}
+	if r.RefreshTtl && len(objects) > 0 {
+		var keys []string
+		for key := range objects {
+			keys = append(keys, prefix+key)
+		}
+		refreshed, err := p.store.RefreshTTL(ctx, keys)
+		if err != nil {
+			return nil, err
+		}
+		for key, obj := range objects {
+			if expires, ok := refreshed[prefix+key]; ok {
+				obj.Object.ExpiresUnix = expires
+			}
+		}
+	}
 	return &api.GetResponse{
-		Objects: objects,
+		Objects:  objects,
+		NotFound: notFound,
+		Ordered:  sortDetails(objects, r.Sort),
 	}, nil
 }
 
 func (p *GeoDB) GetPrefix(ctx context.Context, r *api.GetPrefixRequest) (*api.GetPrefixResponse, error) {
-	objects, err := db.GetPrefix(p.db, r.Prefix)
+	ctx, prefix, err := scope(ctx, r.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateSort(r.Sort); err != nil {
+		return nil, err
+	}
+	if err := validateWindow(r.Window); err != nil {
+		return nil, err
+	}
+	ctx, release, err := p.store.ReadSession(ctx, r.ReadSession)
 	if err != nil {
 		return nil, err
 	}
+	defer release()
+	objects, err := p.store.GetPrefixMax(ctx, prefix+r.Prefix, r.MetadataSelector, r.Window, config.Config.GetInt("GEODB_MAX_RESULTS"))
+	if err != nil {
+		return nil, err
+	}
+	objects = stripDetails(prefix, objects)
 	return &api.GetPrefixResponse{
 		Objects: objects,
+		Ordered: sortDetails(objects, r.Sort),
+	}, nil
+}
+
+func (p *GeoDB) GetByGeohashPrefix(ctx context.Context, r *api.GeohashRequest) (*api.GeohashResponse, error) {
+	if err := r.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx, prefix, err := scope(ctx, r.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	ctx = capResults(ctx)
+	objects, err := p.store.GetByGeohashPrefix(ctx, r.Prefix)
+	if err != nil {
+		return nil, err
+	}
+	return &api.GeohashResponse{
+		Objects: stripDetails(prefix, objects),
+	}, nil
+}
+
+func (p *GeoDB) GetHistory(ctx context.Context, r *api.HistoryRequest) (*api.HistoryResponse, error) {
+	if err := r.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx, prefix, err := scope(ctx, r.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	points, err := p.store.GetHistory(ctx, prefix+r.Key, int(r.Limit))
+	if err != nil {
+		return nil, err
+	}
+	return &api.HistoryResponse{
+		Points: points,
 	}, nil
 }
 
 func (p *GeoDB) Delete(ctx context.Context, r *api.DeleteRequest) (*api.DeleteResponse, error) {
-	if err := db.Delete(p.db, r.Keys); err != nil {
+	ctx, prefix, err := scope(ctx, r.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	keys := r.Keys
+	if len(keys) == 0 || keys[0] != "*" {
+		keys = namespaceKeys(prefix, keys)
+	}
+	// "*" only drops the namespace's objects
+	if err := p.store.Delete(ctx, keys); err != nil {
 		return nil, err
 	}
 	return &api.DeleteResponse{}, nil
 }
+
+func (p *GeoDB) DeletePrefix(ctx context.Context, r *api.DeletePrefixRequest) (*api.DeletePrefixResponse, error) {
+	if err := r.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx, prefix, err := scope(ctx, r.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	deleted, err := p.store.DeletePrefix(ctx, prefix+r.Prefix)
+	if err != nil {
+		return nil, err
+	}
+	return &api.DeletePrefixResponse{
+		Deleted: deleted,
+	}, nil
+}
+
+func (p *GeoDB) DeleteRegex(ctx context.Context, r *api.DeleteRegexRequest) (*api.DeleteRegexResponse, error) {
+	if err := r.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx, _, err := scope(ctx, r.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	deleted, err := p.store.DeleteRegex(ctx, r.Regex)
+	if err != nil {
+		return nil, err
+	}
+	return &api.DeleteRegexResponse{
+		Deleted: deleted,
+	}, nil
+}
+
+func (p *GeoDB) DeleteExpired(ctx context.Context, r *api.DeleteExpiredRequest) (*api.DeleteExpiredResponse, error) {
+	deleted, err := p.store.DeleteExpired(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &api.DeleteExpiredResponse{
+		Deleted: deleted,
+	}, nil
+}
+
+func (p *GeoDB) ImportCSV(ctx context.Context, r *api.ImportCSVRequest) (*api.ImportCSVResponse, error) {
+	if err := r.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx, _, err := scope(ctx, r.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	return p.store.ImportCSV(ctx, r)
+}
+
+func (p *GeoDB) GetGlob(ctx context.Context, r *api.GetGlobRequest) (*api.GetGlobResponse, error) {
+	ctx, prefix, err := scope(ctx, r.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	ctx = capResults(ctx)
+	objects, err := p.store.GetGlob(ctx, prefix+r.Pattern, r.MetadataSelector)
+	if err != nil {
+		return nil, err
+	}
+	return &api.GetGlobResponse{
+		Objects: stripDetails(prefix, objects),
+	}, nil
+}
+
+func (p *GeoDB) GetTagged(ctx context.Context, r *api.GetTaggedRequest) (*api.GetTaggedResponse, error) {
+	if err := r.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx, prefix, err := scope(ctx, r.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	ctx = capResults(ctx)
+	objects, err := p.store.GetTagged(ctx, r.Filter)
+	if err != nil {
+		return nil, err
+	}
+	return &api.GetTaggedResponse{
+		Objects: stripDetails(prefix, objects),
+	}, nil
+}
+
+func (p *GeoDB) Increment(ctx context.Context, r *api.IncrementRequest) (*api.IncrementResponse, error) {
+	if err := r.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx, prefix, err := scope(ctx, r.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	value, detail, err := p.store.Increment(ctx, prefix+r.Key, r.Counter, r.Delta)
+	if err != nil {
+		return nil, err
+	}
+	return &api.IncrementResponse{
+		Value:  value,
+		Object: stripDetail(prefix, detail),
+	}, nil
+}
@@ -0,0 +1,86 @@
+package services
+
+import (
+	"context"
+	"github.com/autom8ter/geodb/config"
+	api "github.com/autom8ter/geodb/gen/go/geodb"
+	"github.com/autom8ter/geodb/helpers"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (p *GeoDB) ProximityMatrix(ctx context.Context, r *api.ProximityMatrixRequest) (*api.ProximityMatrixResponse, error) {
+	if max := config.Config.GetInt("GEODB_MAX_MATRIX_KEYS"); len(r.Keys) > max {
+		return nil, status.Errorf(codes.InvalidArgument, "too many keys: %v > %v", len(r.Keys), max)
+	}
+	ctx, prefix, err := scope(ctx, r.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := p.store.ProximityMatrix(ctx, namespaceKeys(prefix, r.Keys))
+	if err != nil {
+		return nil, err
+	}
+	for _, row := range rows {
+		for i := range row.Distances {
+			row.Distances[i] = helpers.FromMeters(row.Distances[i], r.Unit)
+		}
+	}
+	return &api.ProximityMatrixResponse{
+		Keys: r.Keys,
+		Rows: rows,
+	}, nil
+}
+
+func (p *GeoDB) Aggregate(ctx context.Context, r *api.AggregateRequest) (*api.AggregateResponse, error) {
+	ctx, prefix, err := scope(ctx, r.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	count, centroid, bounds, err := p.store.Aggregate(ctx, namespaceKeys(prefix, r.Keys), prefix+r.Prefix, r.Regex, r.Tags, r.MetadataSelector)
+	if err != nil {
+		return nil, err
+	}
+	return &api.AggregateResponse{
+		Count:    count,
+		Centroid: centroid,
+		Bounds:   bounds,
+	}, nil
+}
+
+func (p *GeoDB) Cluster(ctx context.Context, r *api.ClusterRequest) (*api.ClusterResponse, error) {
+	if err := r.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx, _, err := scope(ctx, r.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	clusters, err := p.store.Cluster(ctx, int(r.Precision), r.Bounds, r.Tags, r.MetadataSelector)
+	if err != nil {
+		return nil, err
+	}
+	var total int64
+	for _, cluster := range clusters {
+		total += cluster.Count
+	}
+	return &api.ClusterResponse{
+		Clusters: clusters,
+		Total:    total,
+	}, nil
+}
+
+func (p *GeoDB) BoundingCircle(ctx context.Context, r *api.BoundingCircleRequest) (*api.BoundingCircleResponse, error) {
+	ctx, prefix, err := scope(ctx, r.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	center, radius, err := p.store.BoundingCircle(ctx, namespaceKeys(prefix, r.Keys), prefix+r.Prefix)
+	if err != nil {
+		return nil, err
+	}
+	return &api.BoundingCircleResponse{
+		Center: center,
+		Radius: helpers.FromMeters(radius, r.Unit),
+	}, nil
+}
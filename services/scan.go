@@ -2,36 +2,186 @@ package services
 
 import (
 	"context"
-	"github.com/autom8ter/geodb/db"
 	api "github.com/autom8ter/geodb/gen/go/geodb"
+	"github.com/autom8ter/geodb/helpers"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"time"
 )
 
 func (p *GeoDB) ScanBound(ctx context.Context, r *api.ScanBoundRequest) (*api.ScanBoundResponse, error) {
-	objects, err := db.ScanBound(p.db, r.Bound, r.Keys)
+	ctx, prefix, err := scope(ctx, r.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	ctx = capResults(ctx)
+	objects, err := p.store.ScanBound(ctx, r.Bound, namespaceKeys(prefix, r.Keys), r.Tags)
 	if err != nil {
 		return nil, err
 	}
 	return &api.ScanBoundResponse{
-		Objects: objects,
+		Objects: stripDetails(prefix, objects),
 	}, nil
 }
 
 func (p *GeoDB) ScanRegexBound(ctx context.Context, r *api.ScanRegexBoundRequest) (*api.ScanRegexBoundResponse, error) {
-	objects, err := db.ScanRegexBound(p.db, r.Bound, r.Regex)
+	ctx, prefix, err := scope(ctx, r.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	ctx = capResults(ctx)
+	objects, err := p.store.ScanRegexBound(ctx, r.Bound, r.Regex, r.Tags)
 	if err != nil {
 		return nil, err
 	}
 	return &api.ScanRegexBoundResponse{
-		Objects: objects,
+		Objects: stripDetails(prefix, objects),
 	}, nil
 }
 
 func (p *GeoDB) ScanPrefixBound(ctx context.Context, r *api.ScanPrefixBoundRequest) (*api.ScanPrefixBoundResponse, error) {
-	objects, err := db.ScanPrefixBound(p.db, r.Bound, r.Prefix)
+	ctx, prefix, err := scope(ctx, r.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	ctx = capResults(ctx)
+	objects, err := p.store.ScanPrefixBound(ctx, r.Bound, prefix+r.Prefix, r.Tags)
 	if err != nil {
 		return nil, err
 	}
 	return &api.ScanPrefixBoundResponse{
-		Objects: objects,
+		Objects: stripDetails(prefix, objects),
+	}, nil
+}
+
+func (p *GeoDB) ScanIsochrone(ctx context.Context, r *api.ScanIsochroneRequest) (*api.ScanIsochroneResponse, error) {
+	ctx, prefix, err := scope(ctx, r.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	ctx = capResults(ctx)
+	objects, polygon, err := p.store.ScanIsochrone(ctx, r.Polygon, r.Center, time.Duration(r.TravelSeconds)*time.Second, r.TravelMode, r.Tags)
+	if err != nil {
+		return nil, err
+	}
+	return &api.ScanIsochroneResponse{
+		Objects: stripDetails(prefix, objects),
+		Polygon: polygon,
+	}, nil
+}
+
+func (p *GeoDB) WithinCorridor(ctx context.Context, r *api.WithinCorridorRequest) (*api.WithinCorridorResponse, error) {
+	if err := r.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx, prefix, err := scope(ctx, r.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	ctx = capResults(ctx)
+	objects, err := p.store.WithinCorridor(ctx, r.Route, helpers.ToMeters(r.Buffer, r.Unit), r.Tags)
+	if err != nil {
+		return nil, err
+	}
+	return &api.WithinCorridorResponse{
+		Objects: stripDetails(prefix, objects),
+	}, nil
+}
+
+func (p *GeoDB) GetWithinPolygon(ctx context.Context, r *api.PolygonRequest) (*api.PolygonResponse, error) {
+	if err := r.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx, prefix, err := scope(ctx, r.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	ctx = capResults(ctx)
+	objects, err := p.store.WithinPolygon(ctx, r.Vertices, r.Tags)
+	if err != nil {
+		return nil, err
+	}
+	return &api.PolygonResponse{
+		Objects: stripDetails(prefix, objects),
+	}, nil
+}
+
+func (p *GeoDB) GetWithinBounds(ctx context.Context, r *api.BoundsRequest) (*api.BoundsResponse, error) {
+	if err := r.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx, prefix, err := scope(ctx, r.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	ctx = capResults(ctx)
+	objects, err := p.store.GetWithinBounds(ctx, r.MinLat, r.MinLon, r.MaxLat, r.MaxLon, r.Tags)
+	if err != nil {
+		return nil, err
+	}
+	return &api.BoundsResponse{
+		Objects: stripDetails(prefix, objects),
+	}, nil
+}
+
+func (p *GeoDB) Nearest(ctx context.Context, r *api.NearestRequest) (*api.NearestResponse, error) {
+	if err := r.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx, prefix, err := scope(ctx, r.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	objects, err := p.store.Nearest(ctx, r.Center, int(r.K), r.Tags, r.MetadataSelector)
+	if err != nil {
+		return nil, err
+	}
+	for _, obj := range objects {
+		obj.Distance = helpers.FromMeters(obj.Distance, r.Unit)
+	}
+	return &api.NearestResponse{
+		Objects: stripNearest(prefix, objects),
+	}, nil
+}
+
+func (p *GeoDB) GetWithinRadius(ctx context.Context, r *api.RadiusRequest) (*api.RadiusResponse, error) {
+	if err := r.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx, prefix, err := scope(ctx, r.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	ctx = capResults(ctx)
+	objects, err := p.store.WithinRadius(ctx, r.Center, r.Meters, r.Tags, r.MetadataSelector)
+	if err != nil {
+		return nil, err
+	}
+	return &api.RadiusResponse{
+		Objects: stripNearest(prefix, objects),
+	}, nil
+}
+
+func (p *GeoDB) ScanObjects(r *api.ScanObjectsRequest, ss api.GeoDB_ScanObjectsServer) error {
+	ctx, prefix, err := scope(ss.Context(), r.Namespace)
+	if err != nil {
+		return err
+	}
+	return p.store.ForEach(ctx, prefix+r.Prefix, r.Regex, func(obj *api.ObjectDetail) error {
+		return ss.Send(&api.ScanObjectsResponse{
+			Object: stripDetail(prefix, obj),
+		})
+	})
+}
+
+func (p *GeoDB) DistanceToPolyline(ctx context.Context, r *api.PolylineRequest) (*api.PolylineResponse, error) {
+	if err := r.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	nearest, dist, segment := helpers.NearestPointOnRoute(r.Point, r.Route)
+	return &api.PolylineResponse{
+		Distance: helpers.FromMeters(dist, r.Unit),
+		Nearest:  nearest,
+		Segment:  int64(segment),
 	}, nil
 }
@@ -0,0 +1,35 @@
+package services
+
+import (
+	"context"
+	"github.com/autom8ter/geodb/config"
+	api "github.com/autom8ter/geodb/gen/go/geodb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"time"
+)
+
+func (p *GeoDB) OpenReadSession(ctx context.Context, r *api.OpenReadSessionRequest) (*api.OpenReadSessionResponse, error) {
+	if err := r.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ttl := config.Config.GetDuration("GEODB_READ_SESSION_TTL")
+	if r.TtlSeconds > 0 {
+		ttl = time.Duration(r.TtlSeconds) * time.Second
+	}
+	token, err := p.store.OpenReadSession(ctx, ttl)
+	if err != nil {
+		return nil, err
+	}
+	return &api.OpenReadSessionResponse{
+		Token: token,
+	}, nil
+}
+
+func (p *GeoDB) CloseReadSession(ctx context.Context, r *api.CloseReadSessionRequest) (*api.CloseReadSessionResponse, error) {
+	if err := r.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	p.store.CloseReadSession(ctx, r.Token)
+	return &api.CloseReadSessionResponse{}, nil
+}
@@ -0,0 +1,68 @@
+package services
+
+import (
+	api "github.com/autom8ter/geodb/gen/go/geodb"
+	"github.com/autom8ter/geodb/helpers"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"sort"
+)
+
+// validateSort rejects a distance sort without a valid anchor point
+func validateSort(s *api.Sort) error {
+	if s.GetBy() != api.SortBy_SortDistance {
+		return nil
+	}
+	if s.Anchor == nil {
+		return status.Error(codes.InvalidArgument, "sorting by distance requires an anchor point")
+	}
+	if err := s.Anchor.Validate(); err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid anchor: %s", err.Error())
+	}
+	return nil
+}
+
+// sortDetails returns the details ordered by s(ties are ordered by key) or nil if s is unsorted
+func sortDetails(details map[string]*api.ObjectDetail, s *api.Sort) []*api.ObjectDetail {
+	if s.GetBy() == api.SortBy_Unsorted {
+		return nil
+	}
+	ordered := make([]*api.ObjectDetail, 0, len(details))
+	distances := map[string]float64{}
+	for _, detail := range details {
+		ordered = append(ordered, detail)
+		if s.By == api.SortBy_SortDistance {
+			distances[detail.Object.Key] = helpers.Distance(s.Anchor, detail.Object.Point)
+		}
+	}
+	// compare returns < 0 if a sorts before b in ascending order
+	compare := func(a, b *api.ObjectDetail) float64 {
+		switch s.By {
+		case api.SortBy_SortDistance:
+			return distances[a.Object.Key] - distances[b.Object.Key]
+		case api.SortBy_SortUpdated:
+			return float64(a.Object.UpdatedUnix - b.Object.UpdatedUnix)
+		}
+		return 0
+	}
+	sort.Slice(ordered, func(i, j int) bool {
+		a, b := ordered[i], ordered[j]
+		c := compare(a, b)
+		if c == 0 {
+			if s.By != api.SortBy_SortKey {
+				// ties are always ordered a-z
+				return a.Object.Key < b.Object.Key
+			}
+			if a.Object.Key < b.Object.Key {
+				c = -1
+			} else {
+				c = 1
+			}
+		}
+		if s.Ascending {
+			return c < 0
+		}
+		return c > 0
+	})
+	return ordered
+}
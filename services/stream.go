@@ -1,94 +1,298 @@
 package services
 
 import (
+	"context"
+	"fmt"
+	"github.com/autom8ter/geodb/config"
+	"github.com/autom8ter/geodb/db"
 	api "github.com/autom8ter/geodb/gen/go/geodb"
-	log "github.com/sirupsen/logrus"
+	"github.com/autom8ter/geodb/helpers"
+	"github.com/autom8ter/geodb/logging"
 	"github.com/thoas/go-funk"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"regexp"
 	"strings"
 )
 
 func (p *GeoDB) Stream(r *api.StreamRequest, ss api.GeoDB_StreamServer) error {
+	if err := r.Validate(); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx, prefix, err := scope(ss.Context(), r.Namespace)
+	if err != nil {
+		return err
+	}
 	clientID := p.hub.AddObjectStreamClient(r.ClientId)
+	defer p.hub.RemoveObjectStreamClient(clientID)
+	// fetched once: a disconnected client's stream is closed & removed from the hub, so it must not be looked up again
+	updates := p.hub.GetClientObjectStream(clientID)
+	matches := func(msg *api.ObjectDetail) bool {
+		if !db.InNamespace(prefix, msg.Object.Key) {
+			return false
+		}
+		if !helpers.MatchTags(msg.Object.Tags, r.Tags) || !helpers.RegionContains(r.Box, r.Bound, msg.Object.Point) {
+			return false
+		}
+		return len(r.Keys) == 0 || funk.ContainsString(r.Keys, strings.TrimPrefix(msg.Object.Key, prefix))
+	}
+	send := func(msg *api.ObjectDetail) {
+		if err := ss.Send(&api.StreamResponse{
+			Object: stripDetail(prefix, msg),
+		}); err != nil {
+			logging.Entry(ss.Context()).Error(err.Error())
+			p.hub.DeadLetter(msg, fmt.Sprintf("failed to send to client %s: %s", clientID, err.Error()))
+		}
+	}
+	if r.SinceUnix > 0 {
+		// the client is registered before the replay so live updates made during it are buffered, not missed
+		backlog, err := p.store.GetUpdatedSince(ctx, prefix, r.SinceUnix)
+		if err != nil {
+			return err
+		}
+		for _, msg := range backlog {
+			if matches(msg) {
+				send(msg)
+			}
+		}
+	}
 	for {
 		select {
-		case msg := <-p.hub.GetClientObjectStream(clientID):
-			if len(r.Keys) > 0 {
-				if funk.ContainsString(r.Keys, msg.Object.Key) {
-					if err := ss.Send(&api.StreamResponse{
-						Object: msg,
-					}); err != nil {
-						log.Error(err.Error())
-					}
-				}
-			} else {
-				if err := ss.Send(&api.StreamResponse{
-					Object: msg,
-				}); err != nil {
-					log.Error(err.Error())
-				}
+		case msg, ok := <-updates:
+			if !ok {
+				// the client was removed from the hub
+				return nil
+			}
+			if matches(msg) {
+				send(msg)
 			}
 		case <-ss.Context().Done():
-			p.hub.RemoveObjectStreamClient(clientID)
-			break
+			return nil
 		}
 	}
 }
 
 func (p *GeoDB) StreamRegex(r *api.StreamRegexRequest, ss api.GeoDB_StreamRegexServer) error {
+	var re *regexp.Regexp
+	if r.Regex != "" {
+		var err error
+		re, err = regexp.Compile(r.Regex)
+		if err != nil {
+			return status.Errorf(codes.InvalidArgument, "failed to match regex: %s", err.Error())
+		}
+	}
+	prefix, err := namespacePrefix(r.Namespace)
+	if err != nil {
+		return err
+	}
 	clientID := p.hub.AddObjectStreamClient(r.ClientId)
+	defer p.hub.RemoveObjectStreamClient(clientID)
+	updates := p.hub.GetClientObjectStream(clientID)
 	for {
 		select {
-		case msg := <-p.hub.GetClientObjectStream(clientID):
-			if r.Regex != "" {
-				match, err := regexp.MatchString(r.Regex, msg.Object.Key)
-				if err != nil {
-					return err
-				}
-				if match {
+		case msg, ok := <-updates:
+			if !ok {
+				// the client was removed from the hub
+				return nil
+			}
+			if !db.InNamespace(prefix, msg.Object.Key) || !helpers.MatchTags(msg.Object.Tags, r.Tags) {
+				continue
+			}
+			msg = stripDetail(prefix, msg)
+			if re != nil {
+				if re.MatchString(msg.Object.Key) {
 					if err := ss.Send(&api.StreamRegexResponse{
 						Object: msg,
 					}); err != nil {
-						log.Error(err.Error())
+						logging.Entry(ss.Context()).Error(err.Error())
+						p.hub.DeadLetter(msg, fmt.Sprintf("failed to send to client %s: %s", clientID, err.Error()))
 					}
 				}
 			} else {
 				if err := ss.Send(&api.StreamRegexResponse{
 					Object: msg,
 				}); err != nil {
-					log.Error(err.Error())
+					logging.Entry(ss.Context()).Error(err.Error())
+					p.hub.DeadLetter(msg, fmt.Sprintf("failed to send to client %s: %s", clientID, err.Error()))
 				}
 			}
 		case <-ss.Context().Done():
-			p.hub.RemoveObjectStreamClient(clientID)
-			break
+			return nil
 		}
 	}
 }
 
 func (p *GeoDB) StreamPrefix(r *api.StreamPrefixRequest, ss api.GeoDB_StreamPrefixServer) error {
+	prefix, err := namespacePrefix(r.Namespace)
+	if err != nil {
+		return err
+	}
 	clientID := p.hub.AddObjectStreamClient(r.ClientId)
+	defer p.hub.RemoveObjectStreamClient(clientID)
+	updates := p.hub.GetClientObjectStream(clientID)
 	for {
 		select {
-		case msg := <-p.hub.GetClientObjectStream(clientID):
+		case msg, ok := <-updates:
+			if !ok {
+				// the client was removed from the hub
+				return nil
+			}
+			if !db.InNamespace(prefix, msg.Object.Key) || !helpers.MatchTags(msg.Object.Tags, r.Tags) {
+				continue
+			}
+			msg = stripDetail(prefix, msg)
 			if r.Prefix != "" {
 				if strings.HasPrefix(msg.Object.Key, r.Prefix) {
 					if err := ss.Send(&api.StreamPrefixResponse{
 						Object: msg,
 					}); err != nil {
-						log.Error(err.Error())
+						logging.Entry(ss.Context()).Error(err.Error())
+						p.hub.DeadLetter(msg, fmt.Sprintf("failed to send to client %s: %s", clientID, err.Error()))
 					}
 				}
 			} else {
 				if err := ss.Send(&api.StreamPrefixResponse{
 					Object: msg,
 				}); err != nil {
-					log.Error(err.Error())
+					logging.Entry(ss.Context()).Error(err.Error())
+					p.hub.DeadLetter(msg, fmt.Sprintf("failed to send to client %s: %s", clientID, err.Error()))
+				}
+			}
+		case <-ss.Context().Done():
+			return nil
+		}
+	}
+}
+
+func (p *GeoDB) WatchKey(r *api.WatchKeyRequest, ss api.GeoDB_WatchKeyServer) error {
+	if err := r.Validate(); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	prefix, err := namespacePrefix(r.Namespace)
+	if err != nil {
+		return err
+	}
+	key := prefix + r.Key
+	clientID := p.hub.AddObjectStreamClient(r.ClientId)
+	defer p.hub.RemoveObjectStreamClient(clientID)
+	updates := p.hub.GetClientObjectStream(clientID)
+	for {
+		select {
+		case msg, ok := <-updates:
+			if !ok {
+				// the client was removed from the hub
+				return nil
+			}
+			if msg.Object.Key != key {
+				continue
+			}
+			if err := ss.Send(&api.WatchKeyResponse{
+				Object: stripDetail(prefix, msg),
+			}); err != nil {
+				logging.Entry(ss.Context()).Error(err.Error())
+				p.hub.DeadLetter(msg, fmt.Sprintf("failed to send to client %s: %s", clientID, err.Error()))
+			}
+		case <-ss.Context().Done():
+			return nil
+		}
+	}
+}
+
+func (p *GeoDB) StreamControl(ss api.GeoDB_StreamControlServer) error {
+	r, err := ss.Recv()
+	if err != nil {
+		return err
+	}
+	if err := r.Validate(); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	prefix, err := namespacePrefix(r.Namespace)
+	if err != nil {
+		return err
+	}
+	keys := namespaceKeys(prefix, r.Keys)
+	clientID := p.hub.AddObjectStreamClient(r.ClientId)
+	defer p.hub.RemoveObjectStreamClient(clientID)
+	updates := p.hub.GetClientObjectStream(clientID)
+	controls := make(chan api.StreamAction)
+	go func() {
+		defer close(controls)
+		for {
+			msg, err := ss.Recv()
+			if err != nil {
+				return
+			}
+			select {
+			case controls <- msg.Action:
+			case <-ss.Context().Done():
+				return
+			}
+		}
+	}()
+	var (
+		buffered  []*api.ObjectDetail
+		maxBuffer = config.Config.GetInt("GEODB_STREAM_PAUSE_BUFFER")
+	)
+	send := func(msg *api.ObjectDetail) {
+		if err := ss.Send(&api.StreamControlResponse{
+			Object: stripDetail(prefix, msg),
+		}); err != nil {
+			logging.Entry(ss.Context()).Error(err.Error())
+			p.hub.DeadLetter(msg, fmt.Sprintf("failed to send to client %s: %s", clientID, err.Error()))
+		}
+	}
+	for {
+		select {
+		case action, ok := <-controls:
+			if !ok {
+				controls = nil
+				continue
+			}
+			switch action {
+			case api.StreamAction_Pause:
+				p.hub.PauseObjectStreamClient(clientID)
+			case api.StreamAction_Resume:
+				p.hub.ResumeObjectStreamClient(clientID)
+				for _, msg := range buffered {
+					send(msg)
+				}
+				buffered = nil
+			}
+		case msg, ok := <-updates:
+			if !ok {
+				// the client was removed from the hub
+				return nil
+			}
+			if !db.InNamespace(prefix, msg.Object.Key) || (len(keys) > 0 && !funk.ContainsString(keys, msg.Object.Key)) {
+				continue
+			}
+			if p.hub.IsObjectStreamClientPaused(clientID) {
+				buffered = append(buffered, msg)
+				if len(buffered) > maxBuffer {
+					p.hub.DeadLetter(buffered[0], fmt.Sprintf("pause buffer full for client %s", clientID))
+					buffered = buffered[1:]
 				}
+				continue
 			}
+			send(msg)
 		case <-ss.Context().Done():
-			p.hub.RemoveObjectStreamClient(clientID)
-			break
+			return nil
 		}
 	}
 }
+
+func (p *GeoDB) ListStreamClients(ctx context.Context, r *api.ListClientsRequest) (*api.ListClientsResponse, error) {
+	var clients []*api.StreamClient
+	for _, client := range p.hub.ObjectStreamClients() {
+		clients = append(clients, &api.StreamClient{
+			ClientId:      client.ID,
+			ConnectedUnix: client.Connected.Unix(),
+			Queued:        int64(client.Queued),
+			Dropped:       client.Dropped,
+			Paused:        client.Paused,
+		})
+	}
+	return &api.ListClientsResponse{
+		Clients: clients,
+	}, nil
+}
@@ -0,0 +1,21 @@
+package services
+
+import (
+	api "github.com/autom8ter/geodb/gen/go/geodb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// validateWindow rejects a time window that can't match anything(updated_after_unix isn't before updated_before_unix)
+func validateWindow(w *api.TimeWindow) error {
+	if w == nil {
+		return nil
+	}
+	if err := w.Validate(); err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid window: %s", err.Error())
+	}
+	if w.UpdatedAfterUnix > 0 && w.UpdatedBeforeUnix > 0 && w.UpdatedAfterUnix >= w.UpdatedBeforeUnix {
+		return status.Errorf(codes.InvalidArgument, "invalid window: updated_after_unix %v is not before updated_before_unix %v", w.UpdatedAfterUnix, w.UpdatedBeforeUnix)
+	}
+	return nil
+}
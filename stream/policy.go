@@ -0,0 +1,84 @@
+package stream
+
+import (
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"strings"
+)
+
+// SlowClientPolicy decides what happens to an object detail broadcast to a client whose buffer is full
+type SlowClientPolicy int
+
+const (
+	// DropNewest drops the object detail being broadcast, keeping the client's buffered backlog
+	DropNewest SlowClientPolicy = iota
+	// DropOldest drops the client's oldest buffered object detail to make room for the one being broadcast
+	DropOldest
+	// Disconnect closes & removes the client's stream, so its streaming goroutine exits
+	Disconnect
+)
+
+var slowClientPolicies = map[string]SlowClientPolicy{
+	"DROP_NEWEST": DropNewest,
+	"DROP_OLDEST": DropOldest,
+	"DISCONNECT":  Disconnect,
+}
+
+func (p SlowClientPolicy) String() string {
+	for name, policy := range slowClientPolicies {
+		if policy == p {
+			return name
+		}
+	}
+	return "UNKNOWN"
+}
+
+// ParseSlowClientPolicy parses a policy name(DROP_NEWEST, DROP_OLDEST or DISCONNECT, case insensitive). empty is DROP_NEWEST
+func ParseSlowClientPolicy(policy string) (SlowClientPolicy, error) {
+	if policy == "" {
+		return DropNewest, nil
+	}
+	if p, ok := slowClientPolicies[strings.ToUpper(policy)]; ok {
+		return p, nil
+	}
+	return DropNewest, status.Errorf(codes.InvalidArgument, "invalid slow client policy: %s(expected DROP_NEWEST, DROP_OLDEST or DISCONNECT)", policy)
+}
+
+func parseSlowClientPolicy(policy string) SlowClientPolicy {
+	p, _ := ParseSlowClientPolicy(policy)
+	return p
+}
+
+// ValidateStreamBuffer returns an error if size can't be used as the max object details queued for stream clients. 0
+// is unbuffered, so updates are dropped unless the hub is ready to receive them
+func ValidateStreamBuffer(size int) error {
+	if size < 0 {
+		return status.Errorf(codes.InvalidArgument, "invalid stream buffer: %v(GEODB_STREAM_BUFFER must be at least 0)", size)
+	}
+	return nil
+}
+
+// ValidateClientBuffer returns an error if size can't be used as the max object details queued per client
+func ValidateClientBuffer(size int) error {
+	if size < 1 {
+		return status.Errorf(codes.InvalidArgument, "invalid stream client buffer: %v(GEODB_STREAM_CLIENT_BUFFER must be at least 1)", size)
+	}
+	return nil
+}
+
+// WithClientBuffer overrides the max object details queued per client. defaults to GEODB_STREAM_CLIENT_BUFFER. sizes
+// below 1(see ValidateClientBuffer) are ignored
+func WithClientBuffer(size int) HubOption {
+	return func(h *Hub) {
+		if ValidateClientBuffer(size) == nil {
+			h.clientBuffer = size
+		}
+	}
+}
+
+// WithSlowClientPolicy sets what happens when a client's buffer is full. defaults to DropNewest
+func WithSlowClientPolicy(policy SlowClientPolicy) HubOption {
+	return func(h *Hub) {
+		h.slowClients = policy
+	}
+}
@@ -2,44 +2,160 @@ package stream
 
 import (
 	"context"
+	"github.com/autom8ter/geodb/config"
 	api "github.com/autom8ter/geodb/gen/go/geodb"
+	"github.com/autom8ter/geodb/metrics"
 	"github.com/gofrs/uuid"
+	log "github.com/sirupsen/logrus"
+	"runtime/debug"
+	"sort"
 	"sync"
+	"time"
 )
 
-var objectChan = make(chan *api.ObjectDetail, 5000)
-
 type Hub struct {
+	objects       chan *api.ObjectDetail
 	objectClients map[string]chan *api.ObjectDetail
 	objMu         *sync.Mutex
+	paused        map[string]bool
+	dropped       map[string]uint64
+	connected     map[string]time.Time
+	clientBuffer  int
+	slowClients   SlowClientPolicy
+	newID         func() string
+	deadLetter    func(obj *api.ObjectDetail, reason string)
+	closed        chan *api.ObjectDetail
+}
+
+// HubOption configures optional Hub behavior
+type HubOption func(h *Hub)
+
+// WithIDGenerator overrides the function used to generate client ids for clients that don't provide one. defaults to uuid v4
+func WithIDGenerator(fn func() string) HubOption {
+	return func(h *Hub) {
+		h.newID = fn
+	}
+}
+
+// WithDeadLetter registers a function that receives object details that couldn't be delivered to stream clients & why
+func WithDeadLetter(fn func(obj *api.ObjectDetail, reason string)) HubOption {
+	return func(h *Hub) {
+		h.deadLetter = fn
+	}
 }
 
-func NewHub() *Hub {
-	return &Hub{
+func NewHub(opts ...HubOption) *Hub {
+	h := &Hub{
+		objects:       make(chan *api.ObjectDetail, config.Config.GetInt("GEODB_STREAM_BUFFER")),
 		objectClients: map[string]chan *api.ObjectDetail{},
 		objMu:         &sync.Mutex{},
+		paused:        map[string]bool{},
+		dropped:       map[string]uint64{},
+		connected:     map[string]time.Time{},
+		clientBuffer:  config.Config.GetInt("GEODB_STREAM_CLIENT_BUFFER"),
+		// invalid policies are rejected on startup by server.NewServer
+		slowClients: parseSlowClientPolicy(config.Config.GetString("GEODB_STREAM_SLOW_CLIENT_POLICY")),
+		newID: func() string {
+			id, _ := uuid.NewV4()
+			return id.String()
+		},
 	}
+	for _, opt := range opts {
+		opt(h)
+	}
+	return h
 }
 
 func (h *Hub) StartObjectStream(ctx context.Context) error {
 	for {
 		select {
-		case obj := <-objectChan:
-			if h.objectClients == nil {
-				h.objectClients = map[string]chan *api.ObjectDetail{}
-			}
+		case obj := <-h.objects:
+			h.safeBroadcast(obj)
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
 
-			for _, channel := range h.objectClients {
-				if channel != nil {
-					channel <- obj
+// safeBroadcast broadcasts the object detail, recovering from a panic(ex: in the dead letter function) so a single
+// object detail can't stop the fan out to every client
+func (h *Hub) safeBroadcast(obj *api.ObjectDetail) {
+	defer func() {
+		if p := recover(); p != nil {
+			log.WithField("stack", string(debug.Stack())).Errorf("recovered from panic broadcasting %s: %v", obj.GetObject().GetKey(), p)
+			metrics.IncRecoveredPanics()
+		}
+	}()
+	h.broadcast(obj)
+}
+
+// broadcast delivers the object detail to every client without blocking. clients that aren't draining their
+// stream fast enough are handled by the hub's slow client policy instead of stalling delivery to everyone else
+func (h *Hub) broadcast(obj *api.ObjectDetail) {
+	type deadLetter struct {
+		obj    *api.ObjectDetail
+		reason string
+	}
+	var undelivered []deadLetter
+	// unlocked by a defer, so a recovered panic(see safeBroadcast) doesn't leave the hub locked
+	func() {
+		h.objMu.Lock()
+		defer h.objMu.Unlock()
+		for id, channel := range h.objectClients {
+			if channel == nil {
+				continue
+			}
+			select {
+			case channel <- obj:
+				continue
+			default:
+			}
+			switch h.slowClients {
+			case Disconnect:
+				h.removeClient(id)
+				metrics.IncStreamClientDisconnects()
+				undelivered = append(undelivered, deadLetter{obj, "slow client disconnected: " + id})
+				continue
+			case DropOldest:
+				var (
+					oldest  *api.ObjectDetail
+					evicted bool
+				)
+				select {
+				case oldest, evicted = <-channel:
+				default:
+					// the client drained its buffer in the meantime
 				}
+				select {
+				case channel <- obj:
+					// broadcast is the only sender & holds objMu, so the freed slot can't be taken
+				default:
+					// the buffer has no slot to free(ex: an unbuffered stream), so the new object detail is dropped
+					oldest, evicted = obj, true
+				}
+				if !evicted {
+					continue
+				}
+				undelivered = append(undelivered, deadLetter{oldest, "client buffer full: " + id})
+			default:
+				undelivered = append(undelivered, deadLetter{obj, "client buffer full: " + id})
 			}
-		case <-ctx.Done():
-			break
+			h.dropped[id]++
+			metrics.IncClientDroppedObjects()
 		}
+	}()
+	for _, letter := range undelivered {
+		h.DeadLetter(letter.obj, letter.reason)
 	}
 }
 
+// ClientDroppedObjects returns the number of object details dropped because the client fell behind
+func (h *Hub) ClientDroppedObjects(id string) uint64 {
+	h.objMu.Lock()
+	defer h.objMu.Unlock()
+	return h.dropped[id]
+}
+
 func (h *Hub) AddObjectStreamClient(clientID string) string {
 	h.objMu.Lock()
 	defer h.objMu.Unlock()
@@ -47,35 +163,135 @@ func (h *Hub) AddObjectStreamClient(clientID string) string {
 		h.objectClients = map[string]chan *api.ObjectDetail{}
 	}
 	if clientID == "" {
-		id, _ := uuid.NewV4()
-		clientID = id.String()
+		clientID = h.newID()
+	}
+	if h.closed != nil {
+		return clientID
 	}
-	h.objectClients[clientID] = make(chan *api.ObjectDetail)
+	if _, ok := h.objectClients[clientID]; !ok {
+		metrics.IncStreamClients()
+	}
+	h.objectClients[clientID] = make(chan *api.ObjectDetail, h.clientBuffer)
+	h.connected[clientID] = time.Now()
 	return clientID
 }
 
 func (h *Hub) RemoveObjectStreamClient(id string) {
 	h.objMu.Lock()
 	defer h.objMu.Unlock()
+	h.removeClient(id)
+}
+
+// removeClient closes & removes the client's stream. the caller must hold objMu
+func (h *Hub) removeClient(id string) {
 	if _, ok := h.objectClients[id]; ok {
 		close(h.objectClients[id])
 		delete(h.objectClients, id)
+		metrics.DecStreamClients()
 	}
+	delete(h.paused, id)
+	delete(h.dropped, id)
+	delete(h.connected, id)
 }
 
+// ClientInfo describes a connected object stream client
+type ClientInfo struct {
+	ID        string
+	Connected time.Time
+	Queued    int
+	Dropped   uint64
+	Paused    bool
+}
+
+// ObjectStreamClients returns the connected object stream clients ordered by id, with the number of object details
+// waiting in each client's buffer(Queued) & dropped because it was full(Dropped)
+func (h *Hub) ObjectStreamClients() []ClientInfo {
+	h.objMu.Lock()
+	defer h.objMu.Unlock()
+	var clients []ClientInfo
+	for id, channel := range h.objectClients {
+		clients = append(clients, ClientInfo{
+			ID:        id,
+			Connected: h.connected[id],
+			Queued:    len(channel),
+			Dropped:   h.dropped[id],
+			Paused:    h.paused[id],
+		})
+	}
+	sort.Slice(clients, func(i, j int) bool {
+		return clients[i].ID < clients[j].ID
+	})
+	return clients
+}
+
+func (h *Hub) PauseObjectStreamClient(id string) {
+	h.objMu.Lock()
+	defer h.objMu.Unlock()
+	if _, ok := h.objectClients[id]; ok {
+		h.paused[id] = true
+	}
+}
+
+func (h *Hub) ResumeObjectStreamClient(id string) {
+	h.objMu.Lock()
+	defer h.objMu.Unlock()
+	delete(h.paused, id)
+}
+
+func (h *Hub) IsObjectStreamClientPaused(id string) bool {
+	h.objMu.Lock()
+	defer h.objMu.Unlock()
+	return h.paused[id]
+}
+
+// GetClientObjectStream returns the client's object stream, or nil if the client isn't registered. once the hub is
+// closed, every client gets a closed stream.
 func (h *Hub) GetClientObjectStream(id string) chan *api.ObjectDetail {
 	h.objMu.Lock()
 	defer h.objMu.Unlock()
+	if h.closed != nil {
+		return h.closed
+	}
 	if _, ok := h.objectClients[id]; ok {
 		return h.objectClients[id]
 	}
 	return nil
 }
 
-func PublishObject(obj *api.ObjectDetail) {
-	objectChan <- obj
+// Close closes & removes every client's object stream so streaming goroutines terminate. clients added after
+// Close get a closed stream.
+func (h *Hub) Close() {
+	h.objMu.Lock()
+	defer h.objMu.Unlock()
+	if h.closed != nil {
+		return
+	}
+	for id, channel := range h.objectClients {
+		close(channel)
+		delete(h.objectClients, id)
+		metrics.DecStreamClients()
+	}
+	h.paused = map[string]bool{}
+	h.dropped = map[string]uint64{}
+	h.connected = map[string]time.Time{}
+	h.closed = make(chan *api.ObjectDetail)
+	close(h.closed)
 }
 
+// PublishObject queues the object detail for delivery to the hub's stream clients without blocking.
+// If the stream buffer is full(ex: a stalled subscriber), the object detail is dropped so writes are never held up.
 func (h *Hub) PublishObject(obj *api.ObjectDetail) {
-	PublishObject(obj)
+	select {
+	case h.objects <- obj:
+	default:
+		metrics.IncDroppedObjects()
+		h.DeadLetter(obj, "stream buffer full")
+	}
+}
+
+// DeadLetter reports an object detail that couldn't be delivered to a stream client
+func (h *Hub) DeadLetter(obj *api.ObjectDetail, reason string) {
+	if h.deadLetter != nil {
+		h.deadLetter(obj, reason)
+	}
 }
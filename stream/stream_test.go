@@ -0,0 +1,412 @@
+package stream
+
+import (
+	"context"
+	"fmt"
+	api "github.com/autom8ter/geodb/gen/go/geodb"
+	"github.com/prometheus/client_golang/prometheus"
+	"sync"
+	"testing"
+	"time"
+)
+
+func sequentialIDs() func() string {
+	count := 0
+	return func() string {
+		count++
+		return fmt.Sprintf("client_%v", count)
+	}
+}
+
+func TestHubIDGenerator(t *testing.T) {
+	hub := NewHub(WithIDGenerator(sequentialIDs()))
+	first := hub.AddObjectStreamClient("")
+	second := hub.AddObjectStreamClient("")
+	if first != "client_1" || second != "client_2" {
+		t.Fatalf("expected deterministic client ids, got: %s %s", first, second)
+	}
+	if hub.GetClientObjectStream(first) == nil {
+		t.Fatal("expected first client to be registered")
+	}
+	hub.RemoveObjectStreamClient(first)
+	if hub.GetClientObjectStream(first) != nil {
+		t.Fatal("expected first client to be removed")
+	}
+	if hub.GetClientObjectStream(second) == nil {
+		t.Fatal("expected second client to remain registered")
+	}
+	if named := hub.AddObjectStreamClient("named"); named != "named" {
+		t.Fatalf("expected provided client id to be used, got: %s", named)
+	}
+}
+
+func TestPublishObjectDoesNotBlock(t *testing.T) {
+	hub := NewHub()
+	// nothing is consuming the stream
+	done := make(chan struct{})
+	go func() {
+		for i := 0; i < cap(hub.objects)+100; i++ {
+			hub.PublishObject(&api.ObjectDetail{})
+		}
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("expected publishing to a full stream buffer to return promptly")
+	}
+	if len(hub.objects) != cap(hub.objects) {
+		t.Fatalf("expected a full stream buffer, got: %v", len(hub.objects))
+	}
+}
+
+func TestHubDeadLetter(t *testing.T) {
+	var reasons []string
+	hub := NewHub(WithDeadLetter(func(obj *api.ObjectDetail, reason string) {
+		reasons = append(reasons, reason)
+	}))
+	for len(hub.objects) < cap(hub.objects) {
+		hub.objects <- &api.ObjectDetail{}
+	}
+	hub.PublishObject(&api.ObjectDetail{})
+	if len(reasons) != 1 || reasons[0] != "stream buffer full" {
+		t.Fatalf("expected a dead letter for the dropped object, got: %v", reasons)
+	}
+}
+
+func TestHubIsolation(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	hub1, hub2 := NewHub(), NewHub()
+	go hub1.StartObjectStream(ctx)
+	go hub2.StartObjectStream(ctx)
+	client1 := hub1.GetClientObjectStream(hub1.AddObjectStreamClient("client"))
+	client2 := hub2.GetClientObjectStream(hub2.AddObjectStreamClient("client"))
+	for _, tc := range []struct {
+		hub           *Hub
+		key           string
+		expect, other chan *api.ObjectDetail
+	}{
+		{hub1, "hub1_object", client1, client2},
+		{hub2, "hub2_object", client2, client1},
+	} {
+		tc.hub.PublishObject(&api.ObjectDetail{Object: &api.Object{Key: tc.key}})
+		select {
+		case obj := <-tc.expect:
+			if obj.Object.Key != tc.key {
+				t.Fatalf("expected %s, got: %s", tc.key, obj.Object.Key)
+			}
+		case <-time.After(time.Second):
+			t.Fatalf("expected %s to be delivered to its hub's client", tc.key)
+		}
+		select {
+		case obj := <-tc.other:
+			t.Fatalf("unexpected cross hub delivery of %s", obj.Object.Key)
+		case <-time.After(100 * time.Millisecond):
+		}
+	}
+}
+
+func TestSlowClientDoesNotStallFanOut(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	hub := NewHub()
+	go hub.StartObjectStream(ctx)
+	slow := hub.AddObjectStreamClient("slow")
+	fast := hub.GetClientObjectStream(hub.AddObjectStreamClient("fast"))
+	total := hub.clientBuffer + 10
+	for i := 0; i < total; i++ {
+		hub.PublishObject(&api.ObjectDetail{})
+		select {
+		case <-fast:
+		case <-time.After(time.Second):
+			t.Fatalf("expected fast client to keep receiving while the slow client isn't draining, got %v/%v", i, total)
+		}
+	}
+	if dropped := hub.ClientDroppedObjects(slow); dropped != 10 {
+		t.Fatalf("expected 10 dropped objects for the slow client, got: %v", dropped)
+	}
+	if dropped := hub.ClientDroppedObjects("fast"); dropped != 0 {
+		t.Fatalf("expected no dropped objects for the fast client, got: %v", dropped)
+	}
+}
+
+func TestConcurrentClientChurn(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	hub := NewHub()
+	go hub.StartObjectStream(ctx)
+	wg := &sync.WaitGroup{}
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				id := hub.AddObjectStreamClient(fmt.Sprintf("churn_%v_%v", i, j))
+				hub.PauseObjectStreamClient(id)
+				hub.ResumeObjectStreamClient(id)
+				hub.ClientDroppedObjects(id)
+				hub.RemoveObjectStreamClient(id)
+			}
+		}(i)
+	}
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 1000; i++ {
+			hub.PublishObject(&api.ObjectDetail{})
+		}
+	}()
+	wg.Wait()
+}
+
+func streamClientsGauge(t *testing.T) float64 {
+	families, err := prometheus.DefaultGatherer.Gather()
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	for _, family := range families {
+		if family.GetName() == "stream_clients" {
+			return family.GetMetric()[0].GetGauge().GetValue()
+		}
+	}
+	t.Fatal("stream_clients metric not registered")
+	return 0
+}
+
+func TestStreamClientsGauge(t *testing.T) {
+	hub := NewHub()
+	before := streamClientsGauge(t)
+	hub.AddObjectStreamClient("gauge_a")
+	hub.AddObjectStreamClient("gauge_a")
+	hub.AddObjectStreamClient("gauge_b")
+	if got := streamClientsGauge(t) - before; got != 2 {
+		t.Fatalf("expected 2 connected stream clients, got: %v", got)
+	}
+	hub.RemoveObjectStreamClient("gauge_a")
+	hub.RemoveObjectStreamClient("gauge_a")
+	hub.RemoveObjectStreamClient("gauge_b")
+	if got := streamClientsGauge(t) - before; got != 0 {
+		t.Fatalf("expected 0 connected stream clients, got: %v", got)
+	}
+}
+
+func TestHubClose(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	hub := NewHub()
+	stopped := make(chan struct{})
+	go func() {
+		hub.StartObjectStream(ctx)
+		close(stopped)
+	}()
+	before := streamClientsGauge(t)
+	wg := &sync.WaitGroup{}
+	for i := 0; i < 10; i++ {
+		stream := hub.GetClientObjectStream(hub.AddObjectStreamClient(""))
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range stream {
+			}
+		}()
+	}
+	hub.PublishObject(&api.ObjectDetail{})
+	cancel()
+	hub.Close()
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		<-stopped
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("expected the object stream & every client goroutine to exit")
+	}
+	if got := streamClientsGauge(t) - before; got != 0 {
+		t.Fatalf("expected every client to be removed, got: %v", got)
+	}
+	if _, ok := <-hub.GetClientObjectStream(hub.AddObjectStreamClient("late")); ok {
+		t.Fatal("expected clients added after close to get a closed stream")
+	}
+	// closing twice is a no-op
+	hub.Close()
+}
+
+func TestObjectStreamClients(t *testing.T) {
+	hub := NewHub()
+	hub.AddObjectStreamClient("clients_b")
+	hub.AddObjectStreamClient("clients_a")
+	hub.PauseObjectStreamClient("clients_a")
+	for i := 0; i < hub.clientBuffer+3; i++ {
+		hub.broadcast(&api.ObjectDetail{})
+	}
+	clients := hub.ObjectStreamClients()
+	if len(clients) != 2 || clients[0].ID != "clients_a" || clients[1].ID != "clients_b" {
+		t.Fatalf("expected both clients ordered by id, got: %v", clients)
+	}
+	for _, client := range clients {
+		if client.Queued != hub.clientBuffer || client.Dropped != 3 || client.Connected.IsZero() {
+			t.Fatalf("expected a full buffer & 3 dropped objects, got: %+v", client)
+		}
+	}
+	if !clients[0].Paused || clients[1].Paused {
+		t.Fatalf("expected only clients_a to be paused, got: %v", clients)
+	}
+	hub.RemoveObjectStreamClient("clients_a")
+	hub.RemoveObjectStreamClient("clients_b")
+	if clients := hub.ObjectStreamClients(); len(clients) != 0 {
+		t.Fatalf("expected no clients after removal, got: %v", clients)
+	}
+}
+
+func TestSlowClientPolicies(t *testing.T) {
+	for _, tc := range []struct {
+		policy       SlowClientPolicy
+		buffered     []string
+		deadLetters  []string
+		disconnected bool
+	}{
+		{DropNewest, []string{"0", "1"}, []string{"2", "3", "4"}, false},
+		{DropOldest, []string{"3", "4"}, []string{"0", "1", "2"}, false},
+		{Disconnect, []string{"0", "1"}, []string{"2"}, true},
+	} {
+		t.Run(tc.policy.String(), func(t *testing.T) {
+			var deadLetters []string
+			hub := NewHub(WithClientBuffer(2), WithSlowClientPolicy(tc.policy), WithDeadLetter(func(obj *api.ObjectDetail, reason string) {
+				deadLetters = append(deadLetters, obj.Object.Key)
+			}))
+			// the client never drains its stream while updates are broadcast
+			stream := hub.GetClientObjectStream(hub.AddObjectStreamClient("slow"))
+			for i := 0; i < 5; i++ {
+				hub.broadcast(&api.ObjectDetail{Object: &api.Object{Key: fmt.Sprint(i)}})
+			}
+			if fmt.Sprint(deadLetters) != fmt.Sprint(tc.deadLetters) {
+				t.Fatalf("expected dead letters %v, got: %v", tc.deadLetters, deadLetters)
+			}
+			var buffered []string
+			for i := 0; i < 2; i++ {
+				buffered = append(buffered, (<-stream).Object.Key)
+			}
+			if fmt.Sprint(buffered) != fmt.Sprint(tc.buffered) {
+				t.Fatalf("expected buffered %v, got: %v", tc.buffered, buffered)
+			}
+			registered := hub.GetClientObjectStream("slow") != nil
+			if registered == tc.disconnected {
+				t.Fatalf("expected the client to be disconnected: %v, got registered: %v", tc.disconnected, registered)
+			}
+			if tc.disconnected {
+				// the closed stream ends the client's streaming goroutine
+				if _, ok := <-stream; ok {
+					t.Fatal("expected the disconnected client's stream to be closed")
+				}
+				if clients := hub.ObjectStreamClients(); len(clients) != 0 {
+					t.Fatalf("expected the disconnected client to be removed, got: %v", clients)
+				}
+				// removing it again when its stream returns is a no-op
+				hub.RemoveObjectStreamClient("slow")
+				return
+			}
+			if dropped := hub.ClientDroppedObjects("slow"); dropped != 3 {
+				t.Fatalf("expected 3 dropped objects, got: %v", dropped)
+			}
+		})
+	}
+}
+
+func TestBroadcastRecoversFromPanics(t *testing.T) {
+	hub := NewHub(WithClientBuffer(1), WithDeadLetter(func(obj *api.ObjectDetail, reason string) {
+		panic("dead letter: " + obj.Object.Key)
+	}))
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stopped := make(chan error, 1)
+	go func() {
+		stopped <- hub.StartObjectStream(ctx)
+	}()
+	stream := hub.GetClientObjectStream(hub.AddObjectStreamClient("client"))
+	// the client's buffer holds 0, so 1 is dead lettered, which panics
+	hub.PublishObject(&api.ObjectDetail{Object: &api.Object{Key: "0"}})
+	hub.PublishObject(&api.ObjectDetail{Object: &api.Object{Key: "1"}})
+	deadline := time.Now().Add(time.Second)
+	for hub.ClientDroppedObjects("client") == 0 {
+		if time.Now().After(deadline) {
+			t.Fatal("expected 1 to be dropped")
+		}
+		time.Sleep(time.Millisecond)
+	}
+	if obj := <-stream; obj.Object.Key != "0" {
+		t.Fatalf("expected 0, got: %v", obj.Object.Key)
+	}
+	hub.PublishObject(&api.ObjectDetail{Object: &api.Object{Key: "2"}})
+	select {
+	case obj := <-stream:
+		if obj.Object.Key != "2" {
+			t.Fatalf("expected 2, got: %v", obj.Object.Key)
+		}
+	case err := <-stopped:
+		t.Fatalf("expected the stream to keep broadcasting after a panic, it stopped: %v", err)
+	case <-time.After(time.Second):
+		t.Fatal("expected the stream to keep broadcasting after a panic")
+	}
+	// the hub wasn't left locked
+	if clients := hub.ObjectStreamClients(); len(clients) != 1 {
+		t.Fatalf("expected 1 client, got: %v", clients)
+	}
+}
+
+func TestValidateStreamBuffer(t *testing.T) {
+	if err := ValidateStreamBuffer(-1); err == nil {
+		t.Fatal("expected a negative stream buffer to be rejected")
+	}
+	for _, size := range []int{0, 5000} {
+		if err := ValidateStreamBuffer(size); err != nil {
+			t.Fatal(err.Error())
+		}
+	}
+}
+
+func TestClientBufferSize(t *testing.T) {
+	for _, size := range []int{0, -1} {
+		if err := ValidateClientBuffer(size); err == nil {
+			t.Fatalf("expected a client buffer of %v to be rejected", size)
+		}
+		if hub := NewHub(WithClientBuffer(size)); hub.clientBuffer < 1 {
+			t.Fatalf("expected a client buffer of %v to be ignored, got: %v", size, hub.clientBuffer)
+		}
+	}
+	// an unbuffered stream drops new object details without blocking the hub
+	hub := NewHub(WithSlowClientPolicy(DropOldest))
+	hub.objectClients["unbuffered"] = make(chan *api.ObjectDetail)
+	done := make(chan struct{})
+	go func() {
+		hub.broadcast(&api.ObjectDetail{Object: &api.Object{Key: "0"}})
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("expected broadcasting to an unbuffered stream not to block")
+	}
+	if dropped := hub.ClientDroppedObjects("unbuffered"); dropped != 1 {
+		t.Fatalf("expected 1 dropped object, got: %v", dropped)
+	}
+}
+
+func TestParseSlowClientPolicy(t *testing.T) {
+	for input, expected := range map[string]SlowClientPolicy{
+		"":            DropNewest,
+		"DROP_NEWEST": DropNewest,
+		"drop_oldest": DropOldest,
+		"Disconnect":  Disconnect,
+	} {
+		policy, err := ParseSlowClientPolicy(input)
+		if err != nil || policy != expected {
+			t.Fatalf("expected %s to parse as %v, got: %v %v", input, expected, policy, err)
+		}
+	}
+	if _, err := ParseSlowClientPolicy("block"); err == nil {
+		t.Fatal("expected an invalid policy to be rejected")
+	}
+}